@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent/subscription"
+	"github.com/tereus-project/tereus-api/env"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// application holds the services shared by the HTTP API and the workers
+type application struct {
+	config *env.Env
+
+	databaseService       *services.DatabaseService
+	storageService        *services.StorageService
+	encryptionService     *services.EncryptionService
+	githubService         *services.GithubService
+	gitlabService         *services.GitlabService
+	tokenService          *services.TokenService
+	subscriptionService   *services.SubscriptionService
+	queueService          *services.QueueService
+	submissionService     *services.SubmissionService
+	notificationService   *services.NotificationService
+	retentionService      *services.RetentionService
+	reconciliationService *services.ReconciliationService
+	leaderElectionService *services.LeaderElectionService
+	healthService         *services.HealthService
+	adminService          *services.AdminService
+	auditService          *services.AuditService
+}
+
+func newApplication(config *env.Env) *application {
+	app := &application{config: config}
+	var err error
+
+	// Initialize database service
+	logrus.Debugln("Initializing database service")
+	app.databaseService, err = services.NewDatabaseService(config.DatabaseDriver, config.DatabaseEndpoint)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize database service")
+	}
+
+	migrationService, err := services.NewMigrationService(app.databaseService, config.DatabaseDriver)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize migration service")
+	}
+
+	if config.DatabaseMigrateOnStart {
+		applied, err := migrationService.Up(context.Background(), 0)
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to apply migrations")
+		}
+		if len(applied) > 0 {
+			logrus.WithField("count", len(applied)).Info("Applied migrations")
+		}
+	}
+
+	// The schema is only migrated by the migrate command, running against an
+	// older schema could corrupt the data
+	if err := migrationService.CheckSchema(context.Background()); err != nil {
+		logrus.WithError(err).Fatalln("The database schema is not up to date, run the migrate up command")
+	}
+
+	// Initialize storage service
+	logrus.Debugln("Initializing storage service")
+	var storageBackend services.StorageBackend
+	switch config.StorageBackend {
+	case "s3":
+		if config.S3Endpoint == "" || config.S3AccessKey == "" || config.S3SecretKey == "" || config.S3Bucket == "" {
+			logrus.Fatalln("S3_ENDPOINT, S3_ACCESS_KEY, S3_SECRET_KEY and S3_BUCKET are required by the s3 storage backend")
+		}
+
+		storageBackend, err = services.NewS3StorageBackend(config.S3Endpoint, config.S3AccessKey, config.S3SecretKey, config.S3Bucket, config.S3HTTPSEnabled)
+	case "local":
+		storageBackend, err = services.NewLocalStorageBackend(config.StorageLocalPath)
+	default:
+		logrus.WithField("backend", config.StorageBackend).Fatalln("Unknown storage backend")
+	}
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to initialize storage service")
+	}
+
+	// Initialize encryption service
+	logrus.Debugln("Initializing encryption service")
+	app.encryptionService, err = services.NewEncryptionService(app.databaseService, config.EncryptionMasterKey, config.EncryptionPreviousMasterKey)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize encryption service")
+	}
+
+	app.storageService = services.NewStorageService(storageBackend, app.databaseService, app.encryptionService)
+
+	// Initialize GitHub service
+	logrus.Debugln("Initializing GitHub service")
+	app.githubService, err = services.NewGithubService(config.GithubOAuthClientId, config.GithubOAuthClientSecret)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize GitHub service")
+	}
+
+	// Initialize GitLab service
+	logrus.Debugln("Initializing GitLab service")
+	app.gitlabService, err = services.NewGitlabService(config.GitlabOAuthClientId, config.GitlabOAuthClientSecret)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize GitLab service")
+	}
+
+	app.auditService = services.NewAuditService(app.databaseService)
+
+	// Initialize token service
+	logrus.Debugln("Initializing token service")
+	app.tokenService = services.NewTokenService(app.databaseService, app.auditService)
+
+	// Initialize subscription service
+	logrus.Debugln("Initializing subscription service")
+	app.subscriptionService = services.NewSubscriptionService(
+		config.StripeSecretKey,
+		services.TierPrices{
+			BasePriceId:    config.StripeTierProBase,
+			MeteredPriceId: config.StripeTierProMetered,
+		},
+		services.TierPrices{
+			BasePriceId:    config.StripeTierEnterpriseBase,
+			MeteredPriceId: config.StripeTierEnterpriseMetered,
+		},
+		app.databaseService,
+		app.auditService,
+	)
+
+	// Initialize queue service
+	logrus.Debugln("Initializing queue service")
+	app.queueService, err = services.NewQueueService(config.NSQEndpoint, config.NSQLookupdEndpoint)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize queue service")
+	}
+
+	// Initialize submission service
+	logrus.Debugln("Initializing submission service")
+	tierLanes := map[subscription.Tier][]services.SubmissionLane{}
+	for tier, value := range map[subscription.Tier]string{
+		subscription.TierFree:       config.SubmissionLanesFree,
+		subscription.TierPro:        config.SubmissionLanesPro,
+		subscription.TierEnterprise: config.SubmissionLanesEnterprise,
+	} {
+		tierLanes[tier], err = services.ParseSubmissionLanes(value)
+		if err != nil {
+			logrus.WithError(err).WithField("tier", tier).Fatalln("Failed to parse submission lanes")
+		}
+	}
+
+	transpilerVersions, err := services.ParseTranspilerVersions(config.TranspilerVersions)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to parse transpiler versions")
+	}
+
+	app.submissionService = services.NewSubmissionService(app.queueService, app.databaseService, app.storageService, tierLanes, transpilerVersions)
+
+	// Initialize leader election service
+	logrus.Debugln("Initializing leader election service")
+	app.leaderElectionService = services.NewLeaderElectionService(app.databaseService, config.LeaderLeaseDuration)
+
+	// Initialize retention service
+	logrus.Debugln("Initializing retention service")
+	tierRetentionPolicies := map[subscription.Tier]services.RetentionPolicy{}
+	for tier, value := range map[subscription.Tier]string{
+		subscription.TierFree:       config.RetentionPolicyFree,
+		subscription.TierPro:        config.RetentionPolicyPro,
+		subscription.TierEnterprise: config.RetentionPolicyEnterprise,
+	} {
+		tierRetentionPolicies[tier], err = services.ParseRetentionPolicy(value)
+		if err != nil {
+			logrus.WithError(err).WithField("tier", tier).Fatalln("Failed to parse retention policy")
+		}
+	}
+
+	// Initialize notification service
+	logrus.Debugln("Initializing notification service")
+	mailService := services.NewMailService(config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword, config.SMTPFrom)
+	app.notificationService = services.NewNotificationService(app.databaseService, mailService, config.APIURL, config.UpgradeURL)
+
+	app.retentionService = services.NewRetentionService(app.databaseService, app.subscriptionService, app.notificationService, tierRetentionPolicies, config.RetentionWarningLeadTime)
+
+	app.reconciliationService = services.NewReconciliationService(app.databaseService, app.storageService)
+
+	app.adminService = services.NewAdminService(app.databaseService)
+
+	app.healthService = services.NewHealthService(app.databaseService, app.storageService, config.NSQEndpoint, config.NSQLookupdEndpoint)
+
+	return app
+}
+
+// Close releases the connections once the HTTP API and the workers stopped
+func (app *application) Close() {
+	app.queueService.Close()
+	app.databaseService.Close()
+}
@@ -1,3 +1,3 @@
 #!/bin/bash
 
-go run entgo.io/ent/cmd/ent generate --feature sql/upsert --feature sql/modifier ./ent/schema
+go run entgo.io/ent/cmd/ent generate --feature sql/upsert --feature sql/modifier --feature sql/versioned-migration ./ent/schema
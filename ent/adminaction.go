@@ -0,0 +1,176 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+)
+
+// AdminAction is the model entity for the AdminAction schema.
+type AdminAction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AdminID holds the value of the "admin_id" field.
+	AdminID uuid.UUID `json:"admin_id,omitempty"`
+	// AdminEmail holds the value of the "admin_email" field.
+	AdminEmail string `json:"admin_email,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// TargetUserID holds the value of the "target_user_id" field.
+	TargetUserID *uuid.UUID `json:"target_user_id,omitempty"`
+	// TargetSubmissionID holds the value of the "target_submission_id" field.
+	TargetSubmissionID *uuid.UUID `json:"target_submission_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminAction) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminaction.FieldTargetUserID, adminaction.FieldTargetSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case adminaction.FieldMetadata:
+			values[i] = new([]byte)
+		case adminaction.FieldAdminEmail, adminaction.FieldAction:
+			values[i] = new(sql.NullString)
+		case adminaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case adminaction.FieldID, adminaction.FieldAdminID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AdminAction", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminAction fields.
+func (aa *AdminAction) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				aa.ID = *value
+			}
+		case adminaction.FieldAdminID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[i])
+			} else if value != nil {
+				aa.AdminID = *value
+			}
+		case adminaction.FieldAdminEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_email", values[i])
+			} else if value.Valid {
+				aa.AdminEmail = value.String
+			}
+		case adminaction.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				aa.Action = value.String
+			}
+		case adminaction.FieldTargetUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_id", values[i])
+			} else if value.Valid {
+				aa.TargetUserID = new(uuid.UUID)
+				*aa.TargetUserID = *value.S.(*uuid.UUID)
+			}
+		case adminaction.FieldTargetSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field target_submission_id", values[i])
+			} else if value.Valid {
+				aa.TargetSubmissionID = new(uuid.UUID)
+				*aa.TargetSubmissionID = *value.S.(*uuid.UUID)
+			}
+		case adminaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &aa.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case adminaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				aa.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AdminAction.
+// Note that you need to call AdminAction.Unwrap() before calling this method if this AdminAction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aa *AdminAction) Update() *AdminActionUpdateOne {
+	return (&AdminActionClient{config: aa.config}).UpdateOne(aa)
+}
+
+// Unwrap unwraps the AdminAction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aa *AdminAction) Unwrap() *AdminAction {
+	tx, ok := aa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminAction is not a transactional entity")
+	}
+	aa.config.driver = tx.drv
+	return aa
+}
+
+// String implements the fmt.Stringer.
+func (aa *AdminAction) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminAction(")
+	builder.WriteString(fmt.Sprintf("id=%v", aa.ID))
+	builder.WriteString(", admin_id=")
+	builder.WriteString(fmt.Sprintf("%v", aa.AdminID))
+	builder.WriteString(", admin_email=")
+	builder.WriteString(aa.AdminEmail)
+	builder.WriteString(", action=")
+	builder.WriteString(aa.Action)
+	if v := aa.TargetUserID; v != nil {
+		builder.WriteString(", target_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := aa.TargetSubmissionID; v != nil {
+		builder.WriteString(", target_submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Metadata))
+	builder.WriteString(", created_at=")
+	builder.WriteString(aa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminActions is a parsable slice of AdminAction.
+type AdminActions []*AdminAction
+
+func (aa AdminActions) config(cfg config) {
+	for _i := range aa {
+		aa[_i].config = cfg
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by entc, DO NOT EDIT.
+
+package adminaction
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the adminaction type in the database.
+	Label = "admin_action"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAdminID holds the string denoting the admin_id field in the database.
+	FieldAdminID = "admin_id"
+	// FieldAdminEmail holds the string denoting the admin_email field in the database.
+	FieldAdminEmail = "admin_email"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldTargetUserID holds the string denoting the target_user_id field in the database.
+	FieldTargetUserID = "target_user_id"
+	// FieldTargetSubmissionID holds the string denoting the target_submission_id field in the database.
+	FieldTargetSubmissionID = "target_submission_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the adminaction in the database.
+	Table = "admin_actions"
+)
+
+// Columns holds all SQL columns for adminaction fields.
+var Columns = []string{
+	FieldID,
+	FieldAdminID,
+	FieldAdminEmail,
+	FieldAction,
+	FieldTargetUserID,
+	FieldTargetSubmissionID,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
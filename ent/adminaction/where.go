@@ -0,0 +1,736 @@
+// Code generated by entc, DO NOT EDIT.
+
+package adminaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// AdminID applies equality check predicate on the "admin_id" field. It's identical to AdminIDEQ.
+func AdminID(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminEmail applies equality check predicate on the "admin_email" field. It's identical to AdminEmailEQ.
+func AdminEmail(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdminEmail), v))
+	})
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// TargetUserID applies equality check predicate on the "target_user_id" field. It's identical to TargetUserIDEQ.
+func TargetUserID(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetSubmissionID applies equality check predicate on the "target_submission_id" field. It's identical to TargetSubmissionIDEQ.
+func TargetSubmissionID(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// AdminIDEQ applies the EQ predicate on the "admin_id" field.
+func AdminIDEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminIDNEQ applies the NEQ predicate on the "admin_id" field.
+func AdminIDNEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminIDIn applies the In predicate on the "admin_id" field.
+func AdminIDIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAdminID), v...))
+	})
+}
+
+// AdminIDNotIn applies the NotIn predicate on the "admin_id" field.
+func AdminIDNotIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAdminID), v...))
+	})
+}
+
+// AdminIDGT applies the GT predicate on the "admin_id" field.
+func AdminIDGT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminIDGTE applies the GTE predicate on the "admin_id" field.
+func AdminIDGTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminIDLT applies the LT predicate on the "admin_id" field.
+func AdminIDLT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminIDLTE applies the LTE predicate on the "admin_id" field.
+func AdminIDLTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAdminID), v))
+	})
+}
+
+// AdminEmailEQ applies the EQ predicate on the "admin_email" field.
+func AdminEmailEQ(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailNEQ applies the NEQ predicate on the "admin_email" field.
+func AdminEmailNEQ(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailIn applies the In predicate on the "admin_email" field.
+func AdminEmailIn(vs ...string) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAdminEmail), v...))
+	})
+}
+
+// AdminEmailNotIn applies the NotIn predicate on the "admin_email" field.
+func AdminEmailNotIn(vs ...string) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAdminEmail), v...))
+	})
+}
+
+// AdminEmailGT applies the GT predicate on the "admin_email" field.
+func AdminEmailGT(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailGTE applies the GTE predicate on the "admin_email" field.
+func AdminEmailGTE(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailLT applies the LT predicate on the "admin_email" field.
+func AdminEmailLT(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailLTE applies the LTE predicate on the "admin_email" field.
+func AdminEmailLTE(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailContains applies the Contains predicate on the "admin_email" field.
+func AdminEmailContains(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailHasPrefix applies the HasPrefix predicate on the "admin_email" field.
+func AdminEmailHasPrefix(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailHasSuffix applies the HasSuffix predicate on the "admin_email" field.
+func AdminEmailHasSuffix(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailEqualFold applies the EqualFold predicate on the "admin_email" field.
+func AdminEmailEqualFold(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAdminEmail), v))
+	})
+}
+
+// AdminEmailContainsFold applies the ContainsFold predicate on the "admin_email" field.
+func AdminEmailContainsFold(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAdminEmail), v))
+	})
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAction), v...))
+	})
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAction), v...))
+	})
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAction), v))
+	})
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAction), v))
+	})
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAction), v))
+	})
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAction), v))
+	})
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAction), v))
+	})
+}
+
+// TargetUserIDEQ applies the EQ predicate on the "target_user_id" field.
+func TargetUserIDEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDNEQ applies the NEQ predicate on the "target_user_id" field.
+func TargetUserIDNEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDIn applies the In predicate on the "target_user_id" field.
+func TargetUserIDIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTargetUserID), v...))
+	})
+}
+
+// TargetUserIDNotIn applies the NotIn predicate on the "target_user_id" field.
+func TargetUserIDNotIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTargetUserID), v...))
+	})
+}
+
+// TargetUserIDGT applies the GT predicate on the "target_user_id" field.
+func TargetUserIDGT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDGTE applies the GTE predicate on the "target_user_id" field.
+func TargetUserIDGTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDLT applies the LT predicate on the "target_user_id" field.
+func TargetUserIDLT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDLTE applies the LTE predicate on the "target_user_id" field.
+func TargetUserIDLTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTargetUserID), v))
+	})
+}
+
+// TargetUserIDIsNil applies the IsNil predicate on the "target_user_id" field.
+func TargetUserIDIsNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTargetUserID)))
+	})
+}
+
+// TargetUserIDNotNil applies the NotNil predicate on the "target_user_id" field.
+func TargetUserIDNotNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTargetUserID)))
+	})
+}
+
+// TargetSubmissionIDEQ applies the EQ predicate on the "target_submission_id" field.
+func TargetSubmissionIDEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDNEQ applies the NEQ predicate on the "target_submission_id" field.
+func TargetSubmissionIDNEQ(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDIn applies the In predicate on the "target_submission_id" field.
+func TargetSubmissionIDIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTargetSubmissionID), v...))
+	})
+}
+
+// TargetSubmissionIDNotIn applies the NotIn predicate on the "target_submission_id" field.
+func TargetSubmissionIDNotIn(vs ...uuid.UUID) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTargetSubmissionID), v...))
+	})
+}
+
+// TargetSubmissionIDGT applies the GT predicate on the "target_submission_id" field.
+func TargetSubmissionIDGT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDGTE applies the GTE predicate on the "target_submission_id" field.
+func TargetSubmissionIDGTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDLT applies the LT predicate on the "target_submission_id" field.
+func TargetSubmissionIDLT(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDLTE applies the LTE predicate on the "target_submission_id" field.
+func TargetSubmissionIDLTE(v uuid.UUID) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTargetSubmissionID), v))
+	})
+}
+
+// TargetSubmissionIDIsNil applies the IsNil predicate on the "target_submission_id" field.
+func TargetSubmissionIDIsNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTargetSubmissionID)))
+	})
+}
+
+// TargetSubmissionIDNotNil applies the NotNil predicate on the "target_submission_id" field.
+func TargetSubmissionIDNotNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTargetSubmissionID)))
+	})
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMetadata)))
+	})
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMetadata)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminAction {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AdminAction(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminAction) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminAction) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminAction) predicate.AdminAction {
+	return predicate.AdminAction(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
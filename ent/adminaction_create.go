@@ -0,0 +1,1015 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+)
+
+// AdminActionCreate is the builder for creating a AdminAction entity.
+type AdminActionCreate struct {
+	config
+	mutation *AdminActionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAdminID sets the "admin_id" field.
+func (aac *AdminActionCreate) SetAdminID(u uuid.UUID) *AdminActionCreate {
+	aac.mutation.SetAdminID(u)
+	return aac
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (aac *AdminActionCreate) SetAdminEmail(s string) *AdminActionCreate {
+	aac.mutation.SetAdminEmail(s)
+	return aac
+}
+
+// SetAction sets the "action" field.
+func (aac *AdminActionCreate) SetAction(s string) *AdminActionCreate {
+	aac.mutation.SetAction(s)
+	return aac
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (aac *AdminActionCreate) SetTargetUserID(u uuid.UUID) *AdminActionCreate {
+	aac.mutation.SetTargetUserID(u)
+	return aac
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (aac *AdminActionCreate) SetNillableTargetUserID(u *uuid.UUID) *AdminActionCreate {
+	if u != nil {
+		aac.SetTargetUserID(*u)
+	}
+	return aac
+}
+
+// SetTargetSubmissionID sets the "target_submission_id" field.
+func (aac *AdminActionCreate) SetTargetSubmissionID(u uuid.UUID) *AdminActionCreate {
+	aac.mutation.SetTargetSubmissionID(u)
+	return aac
+}
+
+// SetNillableTargetSubmissionID sets the "target_submission_id" field if the given value is not nil.
+func (aac *AdminActionCreate) SetNillableTargetSubmissionID(u *uuid.UUID) *AdminActionCreate {
+	if u != nil {
+		aac.SetTargetSubmissionID(*u)
+	}
+	return aac
+}
+
+// SetMetadata sets the "metadata" field.
+func (aac *AdminActionCreate) SetMetadata(m map[string]interface{}) *AdminActionCreate {
+	aac.mutation.SetMetadata(m)
+	return aac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aac *AdminActionCreate) SetCreatedAt(t time.Time) *AdminActionCreate {
+	aac.mutation.SetCreatedAt(t)
+	return aac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aac *AdminActionCreate) SetNillableCreatedAt(t *time.Time) *AdminActionCreate {
+	if t != nil {
+		aac.SetCreatedAt(*t)
+	}
+	return aac
+}
+
+// SetID sets the "id" field.
+func (aac *AdminActionCreate) SetID(u uuid.UUID) *AdminActionCreate {
+	aac.mutation.SetID(u)
+	return aac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aac *AdminActionCreate) SetNillableID(u *uuid.UUID) *AdminActionCreate {
+	if u != nil {
+		aac.SetID(*u)
+	}
+	return aac
+}
+
+// Mutation returns the AdminActionMutation object of the builder.
+func (aac *AdminActionCreate) Mutation() *AdminActionMutation {
+	return aac.mutation
+}
+
+// Save creates the AdminAction in the database.
+func (aac *AdminActionCreate) Save(ctx context.Context) (*AdminAction, error) {
+	var (
+		err  error
+		node *AdminAction
+	)
+	aac.defaults()
+	if len(aac.hooks) == 0 {
+		if err = aac.check(); err != nil {
+			return nil, err
+		}
+		node, err = aac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AdminActionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aac.check(); err != nil {
+				return nil, err
+			}
+			aac.mutation = mutation
+			if node, err = aac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aac.hooks) - 1; i >= 0; i-- {
+			if aac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aac *AdminActionCreate) SaveX(ctx context.Context) *AdminAction {
+	v, err := aac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aac *AdminActionCreate) Exec(ctx context.Context) error {
+	_, err := aac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aac *AdminActionCreate) ExecX(ctx context.Context) {
+	if err := aac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aac *AdminActionCreate) defaults() {
+	if _, ok := aac.mutation.CreatedAt(); !ok {
+		v := adminaction.DefaultCreatedAt()
+		aac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aac.mutation.ID(); !ok {
+		v := adminaction.DefaultID()
+		aac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aac *AdminActionCreate) check() error {
+	if _, ok := aac.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "AdminAction.admin_id"`)}
+	}
+	if _, ok := aac.mutation.AdminEmail(); !ok {
+		return &ValidationError{Name: "admin_email", err: errors.New(`ent: missing required field "AdminAction.admin_email"`)}
+	}
+	if _, ok := aac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AdminAction.action"`)}
+	}
+	if _, ok := aac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminAction.created_at"`)}
+	}
+	return nil
+}
+
+func (aac *AdminActionCreate) sqlSave(ctx context.Context) (*AdminAction, error) {
+	_node, _spec := aac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (aac *AdminActionCreate) createSpec() (*AdminAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminAction{config: aac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: adminaction.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: adminaction.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = aac.conflict
+	if id, ok := aac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aac.mutation.AdminID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: adminaction.FieldAdminID,
+		})
+		_node.AdminID = value
+	}
+	if value, ok := aac.mutation.AdminEmail(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adminaction.FieldAdminEmail,
+		})
+		_node.AdminEmail = value
+	}
+	if value, ok := aac.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adminaction.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := aac.mutation.TargetUserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: adminaction.FieldTargetUserID,
+		})
+		_node.TargetUserID = &value
+	}
+	if value, ok := aac.mutation.TargetSubmissionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: adminaction.FieldTargetSubmissionID,
+		})
+		_node.TargetSubmissionID = &value
+	}
+	if value, ok := aac.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: adminaction.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := aac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: adminaction.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAction.Create().
+//		SetAdminID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminActionUpsert) {
+//			SetAdminID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (aac *AdminActionCreate) OnConflict(opts ...sql.ConflictOption) *AdminActionUpsertOne {
+	aac.conflict = opts
+	return &AdminActionUpsertOne{
+		create: aac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (aac *AdminActionCreate) OnConflictColumns(columns ...string) *AdminActionUpsertOne {
+	aac.conflict = append(aac.conflict, sql.ConflictColumns(columns...))
+	return &AdminActionUpsertOne{
+		create: aac,
+	}
+}
+
+type (
+	// AdminActionUpsertOne is the builder for "upsert"-ing
+	//  one AdminAction node.
+	AdminActionUpsertOne struct {
+		create *AdminActionCreate
+	}
+
+	// AdminActionUpsert is the "OnConflict" setter.
+	AdminActionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAdminID sets the "admin_id" field.
+func (u *AdminActionUpsert) SetAdminID(v uuid.UUID) *AdminActionUpsert {
+	u.Set(adminaction.FieldAdminID, v)
+	return u
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateAdminID() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldAdminID)
+	return u
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *AdminActionUpsert) SetAdminEmail(v string) *AdminActionUpsert {
+	u.Set(adminaction.FieldAdminEmail, v)
+	return u
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateAdminEmail() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldAdminEmail)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *AdminActionUpsert) SetAction(v string) *AdminActionUpsert {
+	u.Set(adminaction.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateAction() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldAction)
+	return u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AdminActionUpsert) SetTargetUserID(v uuid.UUID) *AdminActionUpsert {
+	u.Set(adminaction.FieldTargetUserID, v)
+	return u
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateTargetUserID() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldTargetUserID)
+	return u
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AdminActionUpsert) ClearTargetUserID() *AdminActionUpsert {
+	u.SetNull(adminaction.FieldTargetUserID)
+	return u
+}
+
+// SetTargetSubmissionID sets the "target_submission_id" field.
+func (u *AdminActionUpsert) SetTargetSubmissionID(v uuid.UUID) *AdminActionUpsert {
+	u.Set(adminaction.FieldTargetSubmissionID, v)
+	return u
+}
+
+// UpdateTargetSubmissionID sets the "target_submission_id" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateTargetSubmissionID() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldTargetSubmissionID)
+	return u
+}
+
+// ClearTargetSubmissionID clears the value of the "target_submission_id" field.
+func (u *AdminActionUpsert) ClearTargetSubmissionID() *AdminActionUpsert {
+	u.SetNull(adminaction.FieldTargetSubmissionID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AdminActionUpsert) SetMetadata(v map[string]interface{}) *AdminActionUpsert {
+	u.Set(adminaction.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateMetadata() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AdminActionUpsert) ClearMetadata() *AdminActionUpsert {
+	u.SetNull(adminaction.FieldMetadata)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AdminActionUpsert) SetCreatedAt(v time.Time) *AdminActionUpsert {
+	u.Set(adminaction.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AdminActionUpsert) UpdateCreatedAt() *AdminActionUpsert {
+	u.SetExcluded(adminaction.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AdminAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(adminaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *AdminActionUpsertOne) UpdateNewValues() *AdminActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(adminaction.FieldID)
+		}
+		if _, exists := u.create.mutation.AdminID(); exists {
+			s.SetIgnore(adminaction.FieldAdminID)
+		}
+		if _, exists := u.create.mutation.AdminEmail(); exists {
+			s.SetIgnore(adminaction.FieldAdminEmail)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(adminaction.FieldAction)
+		}
+		if _, exists := u.create.mutation.TargetUserID(); exists {
+			s.SetIgnore(adminaction.FieldTargetUserID)
+		}
+		if _, exists := u.create.mutation.TargetSubmissionID(); exists {
+			s.SetIgnore(adminaction.FieldTargetSubmissionID)
+		}
+		if _, exists := u.create.mutation.Metadata(); exists {
+			s.SetIgnore(adminaction.FieldMetadata)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(adminaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.AdminAction.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *AdminActionUpsertOne) Ignore() *AdminActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminActionUpsertOne) DoNothing() *AdminActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminActionCreate.OnConflict
+// documentation for more info.
+func (u *AdminActionUpsertOne) Update(set func(*AdminActionUpsert)) *AdminActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (u *AdminActionUpsertOne) SetAdminID(v uuid.UUID) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAdminID(v)
+	})
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateAdminID() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAdminID()
+	})
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *AdminActionUpsertOne) SetAdminEmail(v string) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAdminEmail(v)
+	})
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateAdminEmail() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAdminEmail()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AdminActionUpsertOne) SetAction(v string) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateAction() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AdminActionUpsertOne) SetTargetUserID(v uuid.UUID) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateTargetUserID() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AdminActionUpsertOne) ClearTargetUserID() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetTargetSubmissionID sets the "target_submission_id" field.
+func (u *AdminActionUpsertOne) SetTargetSubmissionID(v uuid.UUID) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetTargetSubmissionID(v)
+	})
+}
+
+// UpdateTargetSubmissionID sets the "target_submission_id" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateTargetSubmissionID() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateTargetSubmissionID()
+	})
+}
+
+// ClearTargetSubmissionID clears the value of the "target_submission_id" field.
+func (u *AdminActionUpsertOne) ClearTargetSubmissionID() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearTargetSubmissionID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AdminActionUpsertOne) SetMetadata(v map[string]interface{}) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateMetadata() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AdminActionUpsertOne) ClearMetadata() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AdminActionUpsertOne) SetCreatedAt(v time.Time) *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AdminActionUpsertOne) UpdateCreatedAt() *AdminActionUpsertOne {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AdminActionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminActionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminActionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AdminActionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AdminActionUpsertOne.ID is not supported by MySQL driver. Use AdminActionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AdminActionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AdminActionCreateBulk is the builder for creating many AdminAction entities in bulk.
+type AdminActionCreateBulk struct {
+	config
+	builders []*AdminActionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AdminAction entities in the database.
+func (aacb *AdminActionCreateBulk) Save(ctx context.Context) ([]*AdminAction, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aacb.builders))
+	nodes := make([]*AdminAction, len(aacb.builders))
+	mutators := make([]Mutator, len(aacb.builders))
+	for i := range aacb.builders {
+		func(i int, root context.Context) {
+			builder := aacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aacb *AdminActionCreateBulk) SaveX(ctx context.Context) []*AdminAction {
+	v, err := aacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aacb *AdminActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := aacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aacb *AdminActionCreateBulk) ExecX(ctx context.Context) {
+	if err := aacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminActionUpsert) {
+//			SetAdminID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (aacb *AdminActionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AdminActionUpsertBulk {
+	aacb.conflict = opts
+	return &AdminActionUpsertBulk{
+		create: aacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (aacb *AdminActionCreateBulk) OnConflictColumns(columns ...string) *AdminActionUpsertBulk {
+	aacb.conflict = append(aacb.conflict, sql.ConflictColumns(columns...))
+	return &AdminActionUpsertBulk{
+		create: aacb,
+	}
+}
+
+// AdminActionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AdminAction nodes.
+type AdminActionUpsertBulk struct {
+	create *AdminActionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AdminAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(adminaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *AdminActionUpsertBulk) UpdateNewValues() *AdminActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(adminaction.FieldID)
+				return
+			}
+			if _, exists := b.mutation.AdminID(); exists {
+				s.SetIgnore(adminaction.FieldAdminID)
+			}
+			if _, exists := b.mutation.AdminEmail(); exists {
+				s.SetIgnore(adminaction.FieldAdminEmail)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(adminaction.FieldAction)
+			}
+			if _, exists := b.mutation.TargetUserID(); exists {
+				s.SetIgnore(adminaction.FieldTargetUserID)
+			}
+			if _, exists := b.mutation.TargetSubmissionID(); exists {
+				s.SetIgnore(adminaction.FieldTargetSubmissionID)
+			}
+			if _, exists := b.mutation.Metadata(); exists {
+				s.SetIgnore(adminaction.FieldMetadata)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(adminaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AdminAction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *AdminActionUpsertBulk) Ignore() *AdminActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminActionUpsertBulk) DoNothing() *AdminActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminActionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AdminActionUpsertBulk) Update(set func(*AdminActionUpsert)) *AdminActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (u *AdminActionUpsertBulk) SetAdminID(v uuid.UUID) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAdminID(v)
+	})
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateAdminID() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAdminID()
+	})
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (u *AdminActionUpsertBulk) SetAdminEmail(v string) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAdminEmail(v)
+	})
+}
+
+// UpdateAdminEmail sets the "admin_email" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateAdminEmail() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAdminEmail()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AdminActionUpsertBulk) SetAction(v string) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateAction() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *AdminActionUpsertBulk) SetTargetUserID(v uuid.UUID) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateTargetUserID() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *AdminActionUpsertBulk) ClearTargetUserID() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetTargetSubmissionID sets the "target_submission_id" field.
+func (u *AdminActionUpsertBulk) SetTargetSubmissionID(v uuid.UUID) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetTargetSubmissionID(v)
+	})
+}
+
+// UpdateTargetSubmissionID sets the "target_submission_id" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateTargetSubmissionID() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateTargetSubmissionID()
+	})
+}
+
+// ClearTargetSubmissionID clears the value of the "target_submission_id" field.
+func (u *AdminActionUpsertBulk) ClearTargetSubmissionID() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearTargetSubmissionID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AdminActionUpsertBulk) SetMetadata(v map[string]interface{}) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateMetadata() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AdminActionUpsertBulk) ClearMetadata() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AdminActionUpsertBulk) SetCreatedAt(v time.Time) *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AdminActionUpsertBulk) UpdateCreatedAt() *AdminActionUpsertBulk {
+	return u.Update(func(s *AdminActionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AdminActionUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AdminActionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminActionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminActionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
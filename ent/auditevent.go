@@ -0,0 +1,183 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+)
+
+// AuditEvent is the model entity for the AuditEvent schema.
+type AuditEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type auditevent.Type `json:"type,omitempty"`
+	// ActorType holds the value of the "actor_type" field.
+	ActorType auditevent.ActorType `json:"actor_type,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldActorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditevent.FieldMetadata:
+			values[i] = new([]byte)
+		case auditevent.FieldType, auditevent.FieldActorType, auditevent.FieldIP, auditevent.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case auditevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case auditevent.FieldID, auditevent.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEvent fields.
+func (ae *AuditEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ae.ID = *value
+			}
+		case auditevent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ae.UserID = *value
+			}
+		case auditevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ae.Type = auditevent.Type(value.String)
+			}
+		case auditevent.FieldActorType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_type", values[i])
+			} else if value.Valid {
+				ae.ActorType = auditevent.ActorType(value.String)
+			}
+		case auditevent.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				ae.ActorID = new(uuid.UUID)
+				*ae.ActorID = *value.S.(*uuid.UUID)
+			}
+		case auditevent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ae.IP = value.String
+			}
+		case auditevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ae.UserAgent = value.String
+			}
+		case auditevent.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case auditevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditEvent.
+// Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEvent) Update() *AuditEventUpdateOne {
+	return (&AuditEventClient{config: ae.config}).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEvent) Unwrap() *AuditEvent {
+	tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEvent is not a transactional entity")
+	}
+	ae.config.driver = tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v", ae.ID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.UserID))
+	builder.WriteString(", type=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Type))
+	builder.WriteString(", actor_type=")
+	builder.WriteString(fmt.Sprintf("%v", ae.ActorType))
+	if v := ae.ActorID; v != nil {
+		builder.WriteString(", actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ip=")
+	builder.WriteString(ae.IP)
+	builder.WriteString(", user_agent=")
+	builder.WriteString(ae.UserAgent)
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Metadata))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEvents is a parsable slice of AuditEvent.
+type AuditEvents []*AuditEvent
+
+func (ae AuditEvents) config(cfg config) {
+	for _i := range ae {
+		ae[_i].config = cfg
+	}
+}
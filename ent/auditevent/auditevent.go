@@ -0,0 +1,120 @@
+// Code generated by entc, DO NOT EDIT.
+
+package auditevent
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the auditevent type in the database.
+	Label = "audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldActorType holds the string denoting the actor_type field in the database.
+	FieldActorType = "actor_type"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditevent in the database.
+	Table = "audit_events"
+)
+
+// Columns holds all SQL columns for auditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldType,
+	FieldActorType,
+	FieldActorID,
+	FieldIP,
+	FieldUserAgent,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeLogin                       Type = "login"
+	TypeSignup                      Type = "signup"
+	TypeAccountLinked               Type = "account_linked"
+	TypeAccountUnlinked             Type = "account_unlinked"
+	TypeTokenCreated                Type = "token_created"
+	TypeSubmissionVisibilityChanged Type = "submission_visibility_changed"
+	TypeSubscriptionUpdated         Type = "subscription_updated"
+	TypeSubscriptionCancelled       Type = "subscription_cancelled"
+	TypeSubscriptionDeleted         Type = "subscription_deleted"
+	TypeAccountDeleted              Type = "account_deleted"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeLogin, TypeSignup, TypeAccountLinked, TypeAccountUnlinked, TypeTokenCreated, TypeSubmissionVisibilityChanged, TypeSubscriptionUpdated, TypeSubscriptionCancelled, TypeSubscriptionDeleted, TypeAccountDeleted:
+		return nil
+	default:
+		return fmt.Errorf("auditevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// ActorType defines the type for the "actor_type" enum field.
+type ActorType string
+
+// ActorType values.
+const (
+	ActorTypeUser   ActorType = "user"
+	ActorTypeAdmin  ActorType = "admin"
+	ActorTypeStripe ActorType = "stripe"
+)
+
+func (at ActorType) String() string {
+	return string(at)
+}
+
+// ActorTypeValidator is a validator for the "actor_type" field enum values. It is called by the builders before save.
+func ActorTypeValidator(at ActorType) error {
+	switch at {
+	case ActorTypeUser, ActorTypeAdmin, ActorTypeStripe:
+		return nil
+	default:
+		return fmt.Errorf("auditevent: invalid enum value for actor_type field: %q", at)
+	}
+}
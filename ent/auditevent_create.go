@@ -0,0 +1,1113 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (aec *AuditEventCreate) SetUserID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetUserID(u)
+	return aec
+}
+
+// SetType sets the "type" field.
+func (aec *AuditEventCreate) SetType(a auditevent.Type) *AuditEventCreate {
+	aec.mutation.SetType(a)
+	return aec
+}
+
+// SetActorType sets the "actor_type" field.
+func (aec *AuditEventCreate) SetActorType(at auditevent.ActorType) *AuditEventCreate {
+	aec.mutation.SetActorType(at)
+	return aec
+}
+
+// SetActorID sets the "actor_id" field.
+func (aec *AuditEventCreate) SetActorID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetActorID(u)
+	return aec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableActorID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetActorID(*u)
+	}
+	return aec
+}
+
+// SetIP sets the "ip" field.
+func (aec *AuditEventCreate) SetIP(s string) *AuditEventCreate {
+	aec.mutation.SetIP(s)
+	return aec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableIP(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetIP(*s)
+	}
+	return aec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aec *AuditEventCreate) SetUserAgent(s string) *AuditEventCreate {
+	aec.mutation.SetUserAgent(s)
+	return aec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableUserAgent(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetUserAgent(*s)
+	}
+	return aec
+}
+
+// SetMetadata sets the "metadata" field.
+func (aec *AuditEventCreate) SetMetadata(m map[string]interface{}) *AuditEventCreate {
+	aec.mutation.SetMetadata(m)
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEventCreate) SetCreatedAt(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreatedAt(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetID(*u)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	var (
+		err  error
+		node *AuditEvent
+	)
+	aec.defaults()
+	if len(aec.hooks) == 0 {
+		if err = aec.check(); err != nil {
+			return nil, err
+		}
+		node, err = aec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aec.check(); err != nil {
+				return nil, err
+			}
+			aec.mutation = mutation
+			if node, err = aec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aec.hooks) - 1; i >= 0; i-- {
+			if aec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.ID(); !ok {
+		v := auditevent.DefaultID()
+		aec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AuditEvent.user_id"`)}
+	}
+	if _, ok := aec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AuditEvent.type"`)}
+	}
+	if v, ok := aec.mutation.GetType(); ok {
+		if err := auditevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.ActorType(); !ok {
+		return &ValidationError{Name: "actor_type", err: errors.New(`ent: missing required field "AuditEvent.actor_type"`)}
+	}
+	if v, ok := aec.mutation.ActorType(); ok {
+		if err := auditevent.ActorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "actor_type", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.actor_type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = aec.conflict
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: auditevent.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := aec.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: auditevent.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := aec.mutation.ActorType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: auditevent.FieldActorType,
+		})
+		_node.ActorType = value
+	}
+	if value, ok := aec.mutation.ActorID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: auditevent.FieldActorID,
+		})
+		_node.ActorID = &value
+	}
+	if value, ok := aec.mutation.IP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldIP,
+		})
+		_node.IP = value
+	}
+	if value, ok := aec.mutation.UserAgent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditevent.FieldUserAgent,
+		})
+		_node.UserAgent = value
+	}
+	if value, ok := aec.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditevent.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditEvent.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (aec *AuditEventCreate) OnConflict(opts ...sql.ConflictOption) *AuditEventUpsertOne {
+	aec.conflict = opts
+	return &AuditEventUpsertOne{
+		create: aec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (aec *AuditEventCreate) OnConflictColumns(columns ...string) *AuditEventUpsertOne {
+	aec.conflict = append(aec.conflict, sql.ConflictColumns(columns...))
+	return &AuditEventUpsertOne{
+		create: aec,
+	}
+}
+
+type (
+	// AuditEventUpsertOne is the builder for "upsert"-ing
+	//  one AuditEvent node.
+	AuditEventUpsertOne struct {
+		create *AuditEventCreate
+	}
+
+	// AuditEventUpsert is the "OnConflict" setter.
+	AuditEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *AuditEventUpsert) SetUserID(v uuid.UUID) *AuditEventUpsert {
+	u.Set(auditevent.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateUserID() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldUserID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *AuditEventUpsert) SetType(v auditevent.Type) *AuditEventUpsert {
+	u.Set(auditevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateType() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldType)
+	return u
+}
+
+// SetActorType sets the "actor_type" field.
+func (u *AuditEventUpsert) SetActorType(v auditevent.ActorType) *AuditEventUpsert {
+	u.Set(auditevent.FieldActorType, v)
+	return u
+}
+
+// UpdateActorType sets the "actor_type" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateActorType() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldActorType)
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditEventUpsert) SetActorID(v uuid.UUID) *AuditEventUpsert {
+	u.Set(auditevent.FieldActorID, v)
+	return u
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateActorID() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldActorID)
+	return u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditEventUpsert) ClearActorID() *AuditEventUpsert {
+	u.SetNull(auditevent.FieldActorID)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditEventUpsert) SetIP(v string) *AuditEventUpsert {
+	u.Set(auditevent.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateIP() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuditEventUpsert) ClearIP() *AuditEventUpsert {
+	u.SetNull(auditevent.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditEventUpsert) SetUserAgent(v string) *AuditEventUpsert {
+	u.Set(auditevent.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateUserAgent() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditEventUpsert) ClearUserAgent() *AuditEventUpsert {
+	u.SetNull(auditevent.FieldUserAgent)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditEventUpsert) SetMetadata(v map[string]interface{}) *AuditEventUpsert {
+	u.Set(auditevent.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateMetadata() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditEventUpsert) ClearMetadata() *AuditEventUpsert {
+	u.SetNull(auditevent.FieldMetadata)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditEventUpsert) SetCreatedAt(v time.Time) *AuditEventUpsert {
+	u.Set(auditevent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditEventUpsert) UpdateCreatedAt() *AuditEventUpsert {
+	u.SetExcluded(auditevent.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *AuditEventUpsertOne) UpdateNewValues() *AuditEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditevent.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(auditevent.FieldUserID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(auditevent.FieldType)
+		}
+		if _, exists := u.create.mutation.ActorType(); exists {
+			s.SetIgnore(auditevent.FieldActorType)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(auditevent.FieldActorID)
+		}
+		if _, exists := u.create.mutation.IP(); exists {
+			s.SetIgnore(auditevent.FieldIP)
+		}
+		if _, exists := u.create.mutation.UserAgent(); exists {
+			s.SetIgnore(auditevent.FieldUserAgent)
+		}
+		if _, exists := u.create.mutation.Metadata(); exists {
+			s.SetIgnore(auditevent.FieldMetadata)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.AuditEvent.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *AuditEventUpsertOne) Ignore() *AuditEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditEventUpsertOne) DoNothing() *AuditEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditEventCreate.OnConflict
+// documentation for more info.
+func (u *AuditEventUpsertOne) Update(set func(*AuditEventUpsert)) *AuditEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuditEventUpsertOne) SetUserID(v uuid.UUID) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateUserID() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *AuditEventUpsertOne) SetType(v auditevent.Type) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateType() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetActorType sets the "actor_type" field.
+func (u *AuditEventUpsertOne) SetActorType(v auditevent.ActorType) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetActorType(v)
+	})
+}
+
+// UpdateActorType sets the "actor_type" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateActorType() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateActorType()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditEventUpsertOne) SetActorID(v uuid.UUID) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateActorID() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditEventUpsertOne) ClearActorID() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditEventUpsertOne) SetIP(v string) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateIP() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuditEventUpsertOne) ClearIP() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditEventUpsertOne) SetUserAgent(v string) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateUserAgent() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditEventUpsertOne) ClearUserAgent() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditEventUpsertOne) SetMetadata(v map[string]interface{}) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateMetadata() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditEventUpsertOne) ClearMetadata() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditEventUpsertOne) SetCreatedAt(v time.Time) *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditEventUpsertOne) UpdateCreatedAt() *AuditEventUpsertOne {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AuditEventUpsertOne.ID is not supported by MySQL driver. Use AuditEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	builders []*AuditEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (aecb *AuditEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditEventUpsertBulk {
+	aecb.conflict = opts
+	return &AuditEventUpsertBulk{
+		create: aecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (aecb *AuditEventCreateBulk) OnConflictColumns(columns ...string) *AuditEventUpsertBulk {
+	aecb.conflict = append(aecb.conflict, sql.ConflictColumns(columns...))
+	return &AuditEventUpsertBulk{
+		create: aecb,
+	}
+}
+
+// AuditEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditEvent nodes.
+type AuditEventUpsertBulk struct {
+	create *AuditEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *AuditEventUpsertBulk) UpdateNewValues() *AuditEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditevent.FieldID)
+				return
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(auditevent.FieldUserID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(auditevent.FieldType)
+			}
+			if _, exists := b.mutation.ActorType(); exists {
+				s.SetIgnore(auditevent.FieldActorType)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(auditevent.FieldActorID)
+			}
+			if _, exists := b.mutation.IP(); exists {
+				s.SetIgnore(auditevent.FieldIP)
+			}
+			if _, exists := b.mutation.UserAgent(); exists {
+				s.SetIgnore(auditevent.FieldUserAgent)
+			}
+			if _, exists := b.mutation.Metadata(); exists {
+				s.SetIgnore(auditevent.FieldMetadata)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *AuditEventUpsertBulk) Ignore() *AuditEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditEventUpsertBulk) DoNothing() *AuditEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditEventUpsertBulk) Update(set func(*AuditEventUpsert)) *AuditEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuditEventUpsertBulk) SetUserID(v uuid.UUID) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateUserID() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *AuditEventUpsertBulk) SetType(v auditevent.Type) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateType() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetActorType sets the "actor_type" field.
+func (u *AuditEventUpsertBulk) SetActorType(v auditevent.ActorType) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetActorType(v)
+	})
+}
+
+// UpdateActorType sets the "actor_type" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateActorType() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateActorType()
+	})
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditEventUpsertBulk) SetActorID(v uuid.UUID) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateActorID() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditEventUpsertBulk) ClearActorID() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditEventUpsertBulk) SetIP(v string) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateIP() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *AuditEventUpsertBulk) ClearIP() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditEventUpsertBulk) SetUserAgent(v string) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateUserAgent() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditEventUpsertBulk) ClearUserAgent() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditEventUpsertBulk) SetMetadata(v map[string]interface{}) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateMetadata() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditEventUpsertBulk) ClearMetadata() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditEventUpsertBulk) SetCreatedAt(v time.Time) *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditEventUpsertBulk) UpdateCreatedAt() *AuditEventUpsertBulk {
+	return u.Update(func(s *AuditEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditEventUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// AuditEventDelete is the builder for deleting a AuditEvent entity.
+type AuditEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventDelete builder.
+func (aed *AuditEventDelete) Where(ps ...predicate.AuditEvent) *AuditEventDelete {
+	aed.mutation.Where(ps...)
+	return aed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aed *AuditEventDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(aed.hooks) == 0 {
+		affected, err = aed.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aed.mutation = mutation
+			affected, err = aed.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aed.hooks) - 1; i >= 0; i-- {
+			if aed.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aed.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aed.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aed *AuditEventDelete) ExecX(ctx context.Context) int {
+	n, err := aed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aed *AuditEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: auditevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditevent.FieldID,
+			},
+		},
+	}
+	if ps := aed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, aed.driver, _spec)
+}
+
+// AuditEventDeleteOne is the builder for deleting a single AuditEvent entity.
+type AuditEventDeleteOne struct {
+	aed *AuditEventDelete
+}
+
+// Exec executes the deletion query.
+func (aedo *AuditEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := aedo.aed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aedo *AuditEventDeleteOne) ExecX(ctx context.Context) {
+	aedo.aed.ExecX(ctx)
+}
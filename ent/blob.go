@@ -0,0 +1,170 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/tereus-project/tereus-api/ent/blob"
+)
+
+// Blob is the model entity for the Blob schema.
+type Blob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Encrypted holds the value of the "encrypted" field.
+	Encrypted bool `json:"encrypted,omitempty"`
+	// ReferenceCount holds the value of the "reference_count" field.
+	ReferenceCount int `json:"reference_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlobQuery when eager-loading is set.
+	Edges BlobEdges `json:"edges"`
+}
+
+// BlobEdges holds the relations/edges for other nodes in the graph.
+type BlobEdges struct {
+	// SourceFiles holds the value of the source_files edge.
+	SourceFiles []*SourceFile `json:"source_files,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SourceFilesOrErr returns the SourceFiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlobEdges) SourceFilesOrErr() ([]*SourceFile, error) {
+	if e.loadedTypes[0] {
+		return e.SourceFiles, nil
+	}
+	return nil, &NotLoadedError{edge: "source_files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Blob) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldEncrypted:
+			values[i] = new(sql.NullBool)
+		case blob.FieldSize, blob.FieldReferenceCount:
+			values[i] = new(sql.NullInt64)
+		case blob.FieldID:
+			values[i] = new(sql.NullString)
+		case blob.FieldCreatedAt, blob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Blob", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Blob fields.
+func (b *Blob) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				b.ID = value.String
+			}
+		case blob.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				b.Size = value.Int64
+			}
+		case blob.FieldEncrypted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted", values[i])
+			} else if value.Valid {
+				b.Encrypted = value.Bool
+			}
+		case blob.FieldReferenceCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_count", values[i])
+			} else if value.Valid {
+				b.ReferenceCount = int(value.Int64)
+			}
+		case blob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case blob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QuerySourceFiles queries the "source_files" edge of the Blob entity.
+func (b *Blob) QuerySourceFiles() *SourceFileQuery {
+	return (&BlobClient{config: b.config}).QuerySourceFiles(b)
+}
+
+// Update returns a builder for updating this Blob.
+// Note that you need to call Blob.Unwrap() before calling this method if this Blob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Blob) Update() *BlobUpdateOne {
+	return (&BlobClient{config: b.config}).UpdateOne(b)
+}
+
+// Unwrap unwraps the Blob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Blob) Unwrap() *Blob {
+	tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Blob is not a transactional entity")
+	}
+	b.config.driver = tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Blob) String() string {
+	var builder strings.Builder
+	builder.WriteString("Blob(")
+	builder.WriteString(fmt.Sprintf("id=%v", b.ID))
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", b.Size))
+	builder.WriteString(", encrypted=")
+	builder.WriteString(fmt.Sprintf("%v", b.Encrypted))
+	builder.WriteString(", reference_count=")
+	builder.WriteString(fmt.Sprintf("%v", b.ReferenceCount))
+	builder.WriteString(", created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blobs is a parsable slice of Blob.
+type Blobs []*Blob
+
+func (b Blobs) config(cfg config) {
+	for _i := range b {
+		b[_i].config = cfg
+	}
+}
@@ -0,0 +1,68 @@
+// Code generated by entc, DO NOT EDIT.
+
+package blob
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the blob type in the database.
+	Label = "blob"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldEncrypted holds the string denoting the encrypted field in the database.
+	FieldEncrypted = "encrypted"
+	// FieldReferenceCount holds the string denoting the reference_count field in the database.
+	FieldReferenceCount = "reference_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSourceFiles holds the string denoting the source_files edge name in mutations.
+	EdgeSourceFiles = "source_files"
+	// Table holds the table name of the blob in the database.
+	Table = "blobs"
+	// SourceFilesTable is the table that holds the source_files relation/edge.
+	SourceFilesTable = "source_files"
+	// SourceFilesInverseTable is the table name for the SourceFile entity.
+	// It exists in this package in order to avoid circular dependency with the "sourcefile" package.
+	SourceFilesInverseTable = "source_files"
+	// SourceFilesColumn is the table column denoting the source_files relation/edge.
+	SourceFilesColumn = "blob_source_files"
+)
+
+// Columns holds all SQL columns for blob fields.
+var Columns = []string{
+	FieldID,
+	FieldSize,
+	FieldEncrypted,
+	FieldReferenceCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEncrypted holds the default value on creation for the "encrypted" field.
+	DefaultEncrypted bool
+	// DefaultReferenceCount holds the default value on creation for the "reference_count" field.
+	DefaultReferenceCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,890 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// BlobCreate is the builder for creating a Blob entity.
+type BlobCreate struct {
+	config
+	mutation *BlobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSize sets the "size" field.
+func (bc *BlobCreate) SetSize(i int64) *BlobCreate {
+	bc.mutation.SetSize(i)
+	return bc
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (bc *BlobCreate) SetEncrypted(b bool) *BlobCreate {
+	bc.mutation.SetEncrypted(b)
+	return bc
+}
+
+// SetNillableEncrypted sets the "encrypted" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableEncrypted(b *bool) *BlobCreate {
+	if b != nil {
+		bc.SetEncrypted(*b)
+	}
+	return bc
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (bc *BlobCreate) SetReferenceCount(i int) *BlobCreate {
+	bc.mutation.SetReferenceCount(i)
+	return bc
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableReferenceCount(i *int) *BlobCreate {
+	if i != nil {
+		bc.SetReferenceCount(*i)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BlobCreate) SetCreatedAt(t time.Time) *BlobCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableCreatedAt(t *time.Time) *BlobCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BlobCreate) SetUpdatedAt(t time.Time) *BlobCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableUpdatedAt(t *time.Time) *BlobCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BlobCreate) SetID(s string) *BlobCreate {
+	bc.mutation.SetID(s)
+	return bc
+}
+
+// AddSourceFileIDs adds the "source_files" edge to the SourceFile entity by IDs.
+func (bc *BlobCreate) AddSourceFileIDs(ids ...int) *BlobCreate {
+	bc.mutation.AddSourceFileIDs(ids...)
+	return bc
+}
+
+// AddSourceFiles adds the "source_files" edges to the SourceFile entity.
+func (bc *BlobCreate) AddSourceFiles(s ...*SourceFile) *BlobCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bc.AddSourceFileIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bc *BlobCreate) Mutation() *BlobMutation {
+	return bc.mutation
+}
+
+// Save creates the Blob in the database.
+func (bc *BlobCreate) Save(ctx context.Context) (*Blob, error) {
+	var (
+		err  error
+		node *Blob
+	)
+	bc.defaults()
+	if len(bc.hooks) == 0 {
+		if err = bc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BlobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bc.check(); err != nil {
+				return nil, err
+			}
+			bc.mutation = mutation
+			if node, err = bc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bc.hooks) - 1; i >= 0; i-- {
+			if bc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BlobCreate) SaveX(ctx context.Context) *Blob {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BlobCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BlobCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BlobCreate) defaults() {
+	if _, ok := bc.mutation.Encrypted(); !ok {
+		v := blob.DefaultEncrypted
+		bc.mutation.SetEncrypted(v)
+	}
+	if _, ok := bc.mutation.ReferenceCount(); !ok {
+		v := blob.DefaultReferenceCount
+		bc.mutation.SetReferenceCount(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := blob.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := blob.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BlobCreate) check() error {
+	if _, ok := bc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Blob.size"`)}
+	}
+	if _, ok := bc.mutation.Encrypted(); !ok {
+		return &ValidationError{Name: "encrypted", err: errors.New(`ent: missing required field "Blob.encrypted"`)}
+	}
+	if _, ok := bc.mutation.ReferenceCount(); !ok {
+		return &ValidationError{Name: "reference_count", err: errors.New(`ent: missing required field "Blob.reference_count"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blob.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Blob.updated_at"`)}
+	}
+	return nil
+}
+
+func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Blob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Blob{config: bc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: blob.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: blob.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = bc.conflict
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: blob.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := bc.mutation.Encrypted(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: blob.FieldEncrypted,
+		})
+		_node.Encrypted = value
+	}
+	if value, ok := bc.mutation.ReferenceCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldReferenceCount,
+		})
+		_node.ReferenceCount = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := bc.mutation.SourceFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blob.Create().
+//		SetSize(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlobUpsert) {
+//			SetSize(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (bc *BlobCreate) OnConflict(opts ...sql.ConflictOption) *BlobUpsertOne {
+	bc.conflict = opts
+	return &BlobUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (bc *BlobCreate) OnConflictColumns(columns ...string) *BlobUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BlobUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BlobUpsertOne is the builder for "upsert"-ing
+	//  one Blob node.
+	BlobUpsertOne struct {
+		create *BlobCreate
+	}
+
+	// BlobUpsert is the "OnConflict" setter.
+	BlobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSize sets the "size" field.
+func (u *BlobUpsert) SetSize(v int64) *BlobUpsert {
+	u.Set(blob.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateSize() *BlobUpsert {
+	u.SetExcluded(blob.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *BlobUpsert) AddSize(v int64) *BlobUpsert {
+	u.Add(blob.FieldSize, v)
+	return u
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (u *BlobUpsert) SetEncrypted(v bool) *BlobUpsert {
+	u.Set(blob.FieldEncrypted, v)
+	return u
+}
+
+// UpdateEncrypted sets the "encrypted" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateEncrypted() *BlobUpsert {
+	u.SetExcluded(blob.FieldEncrypted)
+	return u
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *BlobUpsert) SetReferenceCount(v int) *BlobUpsert {
+	u.Set(blob.FieldReferenceCount, v)
+	return u
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateReferenceCount() *BlobUpsert {
+	u.SetExcluded(blob.FieldReferenceCount)
+	return u
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *BlobUpsert) AddReferenceCount(v int) *BlobUpsert {
+	u.Add(blob.FieldReferenceCount, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BlobUpsert) SetCreatedAt(v time.Time) *BlobUpsert {
+	u.Set(blob.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateCreatedAt() *BlobUpsert {
+	u.SetExcluded(blob.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlobUpsert) SetUpdatedAt(v time.Time) *BlobUpsert {
+	u.Set(blob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateUpdatedAt() *BlobUpsert {
+	u.SetExcluded(blob.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *BlobUpsertOne) UpdateNewValues() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(blob.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Blob.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *BlobUpsertOne) Ignore() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlobUpsertOne) DoNothing() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlobCreate.OnConflict
+// documentation for more info.
+func (u *BlobUpsertOne) Update(set func(*BlobUpsert)) *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *BlobUpsertOne) SetSize(v int64) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *BlobUpsertOne) AddSize(v int64) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateSize() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (u *BlobUpsertOne) SetEncrypted(v bool) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetEncrypted(v)
+	})
+}
+
+// UpdateEncrypted sets the "encrypted" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateEncrypted() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateEncrypted()
+	})
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *BlobUpsertOne) SetReferenceCount(v int) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetReferenceCount(v)
+	})
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *BlobUpsertOne) AddReferenceCount(v int) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddReferenceCount(v)
+	})
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateReferenceCount() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateReferenceCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BlobUpsertOne) SetCreatedAt(v time.Time) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateCreatedAt() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlobUpsertOne) SetUpdatedAt(v time.Time) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateUpdatedAt() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlobUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BlobUpsertOne.ID is not supported by MySQL driver. Use BlobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlobUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlobCreateBulk is the builder for creating many Blob entities in bulk.
+type BlobCreateBulk struct {
+	config
+	builders []*BlobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Blob entities in the database.
+func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Blob, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BlobCreateBulk) SaveX(ctx context.Context) []*Blob {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BlobCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlobUpsert) {
+//			SetSize(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (bcb *BlobCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlobUpsertBulk {
+	bcb.conflict = opts
+	return &BlobUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (bcb *BlobCreateBulk) OnConflictColumns(columns ...string) *BlobUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BlobUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BlobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Blob nodes.
+type BlobUpsertBulk struct {
+	create *BlobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *BlobUpsertBulk) UpdateNewValues() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(blob.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *BlobUpsertBulk) Ignore() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlobUpsertBulk) DoNothing() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlobCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlobUpsertBulk) Update(set func(*BlobUpsert)) *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *BlobUpsertBulk) SetSize(v int64) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *BlobUpsertBulk) AddSize(v int64) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateSize() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (u *BlobUpsertBulk) SetEncrypted(v bool) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetEncrypted(v)
+	})
+}
+
+// UpdateEncrypted sets the "encrypted" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateEncrypted() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateEncrypted()
+	})
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *BlobUpsertBulk) SetReferenceCount(v int) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetReferenceCount(v)
+	})
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *BlobUpsertBulk) AddReferenceCount(v int) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddReferenceCount(v)
+	})
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateReferenceCount() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateReferenceCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BlobUpsertBulk) SetCreatedAt(v time.Time) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateCreatedAt() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlobUpsertBulk) SetUpdatedAt(v time.Time) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateUpdatedAt() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlobUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1015 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// BlobQuery is the builder for querying Blob entities.
+type BlobQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Blob
+	// eager-loading edges.
+	withSourceFiles *SourceFileQuery
+	modifiers       []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlobQuery builder.
+func (bq *BlobQuery) Where(ps ...predicate.Blob) *BlobQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit adds a limit step to the query.
+func (bq *BlobQuery) Limit(limit int) *BlobQuery {
+	bq.limit = &limit
+	return bq
+}
+
+// Offset adds an offset step to the query.
+func (bq *BlobQuery) Offset(offset int) *BlobQuery {
+	bq.offset = &offset
+	return bq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bq *BlobQuery) Unique(unique bool) *BlobQuery {
+	bq.unique = &unique
+	return bq
+}
+
+// Order adds an order step to the query.
+func (bq *BlobQuery) Order(o ...OrderFunc) *BlobQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QuerySourceFiles chains the current query on the "source_files" edge.
+func (bq *BlobQuery) QuerySourceFiles() *SourceFileQuery {
+	query := &SourceFileQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, selector),
+			sqlgraph.To(sourcefile.Table, sourcefile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blob.SourceFilesTable, blob.SourceFilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Blob entity from the query.
+// Returns a *NotFoundError when no Blob was found.
+func (bq *BlobQuery) First(ctx context.Context) (*Blob, error) {
+	nodes, err := bq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BlobQuery) FirstX(ctx context.Context) *Blob {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Blob ID from the query.
+// Returns a *NotFoundError when no Blob ID was found.
+func (bq *BlobQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bq *BlobQuery) FirstIDX(ctx context.Context) string {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Blob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Blob entity is found.
+// Returns a *NotFoundError when no Blob entities are found.
+func (bq *BlobQuery) Only(ctx context.Context) (*Blob, error) {
+	nodes, err := bq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blob.Label}
+	default:
+		return nil, &NotSingularError{blob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BlobQuery) OnlyX(ctx context.Context) *Blob {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Blob ID in the query.
+// Returns a *NotSingularError when more than one Blob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bq *BlobQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = &NotSingularError{blob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BlobQuery) OnlyIDX(ctx context.Context) string {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Blobs.
+func (bq *BlobQuery) All(ctx context.Context) ([]*Blob, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BlobQuery) AllX(ctx context.Context) []*Blob {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Blob IDs.
+func (bq *BlobQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := bq.Select(blob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BlobQuery) IDsX(ctx context.Context) []string {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BlobQuery) Count(ctx context.Context) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BlobQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BlobQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BlobQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BlobQuery) Clone() *BlobQuery {
+	if bq == nil {
+		return nil
+	}
+	return &BlobQuery{
+		config:          bq.config,
+		limit:           bq.limit,
+		offset:          bq.offset,
+		order:           append([]OrderFunc{}, bq.order...),
+		predicates:      append([]predicate.Blob{}, bq.predicates...),
+		withSourceFiles: bq.withSourceFiles.Clone(),
+		// clone intermediate query.
+		sql:    bq.sql.Clone(),
+		path:   bq.path,
+		unique: bq.unique,
+	}
+}
+
+// WithSourceFiles tells the query-builder to eager-load the nodes that are connected to
+// the "source_files" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlobQuery) WithSourceFiles(opts ...func(*SourceFileQuery)) *BlobQuery {
+	query := &SourceFileQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withSourceFiles = query
+	return bq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Size int64 `json:"size,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Blob.Query().
+//		GroupBy(blob.FieldSize).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (bq *BlobQuery) GroupBy(field string, fields ...string) *BlobGroupBy {
+	group := &BlobGroupBy{config: bq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Size int64 `json:"size,omitempty"`
+//	}
+//
+//	client.Blob.Query().
+//		Select(blob.FieldSize).
+//		Scan(ctx, &v)
+//
+func (bq *BlobQuery) Select(fields ...string) *BlobSelect {
+	bq.fields = append(bq.fields, fields...)
+	return &BlobSelect{BlobQuery: bq}
+}
+
+func (bq *BlobQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bq.fields {
+		if !blob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
+	var (
+		nodes       = []*Blob{}
+		_spec       = bq.querySpec()
+		loadedTypes = [1]bool{
+			bq.withSourceFiles != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Blob{config: bq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := bq.withSourceFiles; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[string]*Blob)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.SourceFiles = []*SourceFile{}
+		}
+		query.withFKs = true
+		query.Where(predicate.SourceFile(func(s *sql.Selector) {
+			s.Where(sql.InValues(blob.SourceFilesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.blob_source_files
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "blob_source_files" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "blob_source_files" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.SourceFiles = append(node.Edges.SourceFiles, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	_spec.Node.Columns = bq.fields
+	if len(bq.fields) > 0 {
+		_spec.Unique = bq.unique != nil && *bq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BlobQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bq *BlobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   blob.Table,
+			Columns: blob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: blob.FieldID,
+			},
+		},
+		From:   bq.sql,
+		Unique: true,
+	}
+	if unique := bq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for i := range fields {
+			if fields[i] != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(blob.Table)
+	columns := bq.fields
+	if len(columns) == 0 {
+		columns = blob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bq.unique != nil && *bq.unique {
+		selector.Distinct()
+	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bq *BlobQuery) Modify(modifiers ...func(s *sql.Selector)) *BlobSelect {
+	bq.modifiers = append(bq.modifiers, modifiers...)
+	return bq.Select()
+}
+
+// BlobGroupBy is the group-by builder for Blob entities.
+type BlobGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BlobGroupBy) Aggregate(fns ...AggregateFunc) *BlobGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bgb *BlobGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bgb.sql = query
+	return bgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bgb *BlobGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BlobGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bgb *BlobGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bgb *BlobGroupBy) StringX(ctx context.Context) string {
+	v, err := bgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BlobGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bgb *BlobGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bgb *BlobGroupBy) IntX(ctx context.Context) int {
+	v, err := bgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BlobGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bgb *BlobGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bgb *BlobGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := bgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BlobGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bgb *BlobGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bgb *BlobGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bgb *BlobGroupBy) BoolX(ctx context.Context) bool {
+	v, err := bgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bgb *BlobGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bgb.fields {
+		if !blob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bgb *BlobGroupBy) sqlQuery() *sql.Selector {
+	selector := bgb.sql.Select()
+	aggregation := make([]string, 0, len(bgb.fns))
+	for _, fn := range bgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
+		for _, f := range bgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bgb.fields...)...)
+}
+
+// BlobSelect is the builder for selecting fields of Blob entities.
+type BlobSelect struct {
+	*BlobQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bs *BlobSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bs.sql = bs.BlobQuery.sqlQuery(ctx)
+	return bs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bs *BlobSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BlobSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bs *BlobSelect) StringsX(ctx context.Context) []string {
+	v, err := bs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bs *BlobSelect) StringX(ctx context.Context) string {
+	v, err := bs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BlobSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bs *BlobSelect) IntsX(ctx context.Context) []int {
+	v, err := bs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bs *BlobSelect) IntX(ctx context.Context) int {
+	v, err := bs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BlobSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bs *BlobSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bs *BlobSelect) Float64X(ctx context.Context) float64 {
+	v, err := bs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BlobSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bs *BlobSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{blob.Label}
+	default:
+		err = fmt.Errorf("ent: BlobSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bs *BlobSelect) BoolX(ctx context.Context) bool {
+	v, err := bs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bs *BlobSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bs.sql.Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bs *BlobSelect) Modify(modifiers ...func(s *sql.Selector)) *BlobSelect {
+	bs.modifiers = append(bs.modifiers, modifiers...)
+	return bs
+}
@@ -0,0 +1,673 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (bu *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetSize sets the "size" field.
+func (bu *BlobUpdate) SetSize(i int64) *BlobUpdate {
+	bu.mutation.ResetSize()
+	bu.mutation.SetSize(i)
+	return bu
+}
+
+// AddSize adds i to the "size" field.
+func (bu *BlobUpdate) AddSize(i int64) *BlobUpdate {
+	bu.mutation.AddSize(i)
+	return bu
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (bu *BlobUpdate) SetEncrypted(b bool) *BlobUpdate {
+	bu.mutation.SetEncrypted(b)
+	return bu
+}
+
+// SetNillableEncrypted sets the "encrypted" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableEncrypted(b *bool) *BlobUpdate {
+	if b != nil {
+		bu.SetEncrypted(*b)
+	}
+	return bu
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (bu *BlobUpdate) SetReferenceCount(i int) *BlobUpdate {
+	bu.mutation.ResetReferenceCount()
+	bu.mutation.SetReferenceCount(i)
+	return bu
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableReferenceCount(i *int) *BlobUpdate {
+	if i != nil {
+		bu.SetReferenceCount(*i)
+	}
+	return bu
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (bu *BlobUpdate) AddReferenceCount(i int) *BlobUpdate {
+	bu.mutation.AddReferenceCount(i)
+	return bu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bu *BlobUpdate) SetCreatedAt(t time.Time) *BlobUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableCreatedAt(t *time.Time) *BlobUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BlobUpdate) SetUpdatedAt(t time.Time) *BlobUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// AddSourceFileIDs adds the "source_files" edge to the SourceFile entity by IDs.
+func (bu *BlobUpdate) AddSourceFileIDs(ids ...int) *BlobUpdate {
+	bu.mutation.AddSourceFileIDs(ids...)
+	return bu
+}
+
+// AddSourceFiles adds the "source_files" edges to the SourceFile entity.
+func (bu *BlobUpdate) AddSourceFiles(s ...*SourceFile) *BlobUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.AddSourceFileIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bu *BlobUpdate) Mutation() *BlobMutation {
+	return bu.mutation
+}
+
+// ClearSourceFiles clears all "source_files" edges to the SourceFile entity.
+func (bu *BlobUpdate) ClearSourceFiles() *BlobUpdate {
+	bu.mutation.ClearSourceFiles()
+	return bu
+}
+
+// RemoveSourceFileIDs removes the "source_files" edge to SourceFile entities by IDs.
+func (bu *BlobUpdate) RemoveSourceFileIDs(ids ...int) *BlobUpdate {
+	bu.mutation.RemoveSourceFileIDs(ids...)
+	return bu
+}
+
+// RemoveSourceFiles removes "source_files" edges to SourceFile entities.
+func (bu *BlobUpdate) RemoveSourceFiles(s ...*SourceFile) *BlobUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.RemoveSourceFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	bu.defaults()
+	if len(bu.hooks) == 0 {
+		affected, err = bu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BlobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bu.mutation = mutation
+			affected, err = bu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bu.hooks) - 1; i >= 0; i-- {
+			if bu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlobUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BlobUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := blob.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   blob.Table,
+			Columns: blob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: blob.FieldID,
+			},
+		},
+	}
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: blob.FieldSize,
+		})
+	}
+	if value, ok := bu.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: blob.FieldSize,
+		})
+	}
+	if value, ok := bu.mutation.Encrypted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: blob.FieldEncrypted,
+		})
+	}
+	if value, ok := bu.mutation.ReferenceCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldReferenceCount,
+		})
+	}
+	if value, ok := bu.mutation.AddedReferenceCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldReferenceCount,
+		})
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldCreatedAt,
+		})
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldUpdatedAt,
+		})
+	}
+	if bu.mutation.SourceFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedSourceFilesIDs(); len(nodes) > 0 && !bu.mutation.SourceFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.SourceFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// SetSize sets the "size" field.
+func (buo *BlobUpdateOne) SetSize(i int64) *BlobUpdateOne {
+	buo.mutation.ResetSize()
+	buo.mutation.SetSize(i)
+	return buo
+}
+
+// AddSize adds i to the "size" field.
+func (buo *BlobUpdateOne) AddSize(i int64) *BlobUpdateOne {
+	buo.mutation.AddSize(i)
+	return buo
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (buo *BlobUpdateOne) SetEncrypted(b bool) *BlobUpdateOne {
+	buo.mutation.SetEncrypted(b)
+	return buo
+}
+
+// SetNillableEncrypted sets the "encrypted" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableEncrypted(b *bool) *BlobUpdateOne {
+	if b != nil {
+		buo.SetEncrypted(*b)
+	}
+	return buo
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (buo *BlobUpdateOne) SetReferenceCount(i int) *BlobUpdateOne {
+	buo.mutation.ResetReferenceCount()
+	buo.mutation.SetReferenceCount(i)
+	return buo
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableReferenceCount(i *int) *BlobUpdateOne {
+	if i != nil {
+		buo.SetReferenceCount(*i)
+	}
+	return buo
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (buo *BlobUpdateOne) AddReferenceCount(i int) *BlobUpdateOne {
+	buo.mutation.AddReferenceCount(i)
+	return buo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (buo *BlobUpdateOne) SetCreatedAt(t time.Time) *BlobUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableCreatedAt(t *time.Time) *BlobUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BlobUpdateOne) SetUpdatedAt(t time.Time) *BlobUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// AddSourceFileIDs adds the "source_files" edge to the SourceFile entity by IDs.
+func (buo *BlobUpdateOne) AddSourceFileIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.AddSourceFileIDs(ids...)
+	return buo
+}
+
+// AddSourceFiles adds the "source_files" edges to the SourceFile entity.
+func (buo *BlobUpdateOne) AddSourceFiles(s ...*SourceFile) *BlobUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.AddSourceFileIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (buo *BlobUpdateOne) Mutation() *BlobMutation {
+	return buo.mutation
+}
+
+// ClearSourceFiles clears all "source_files" edges to the SourceFile entity.
+func (buo *BlobUpdateOne) ClearSourceFiles() *BlobUpdateOne {
+	buo.mutation.ClearSourceFiles()
+	return buo
+}
+
+// RemoveSourceFileIDs removes the "source_files" edge to SourceFile entities by IDs.
+func (buo *BlobUpdateOne) RemoveSourceFileIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.RemoveSourceFileIDs(ids...)
+	return buo
+}
+
+// RemoveSourceFiles removes "source_files" edges to SourceFile entities.
+func (buo *BlobUpdateOne) RemoveSourceFiles(s ...*SourceFile) *BlobUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.RemoveSourceFileIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (buo *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	var (
+		err  error
+		node *Blob
+	)
+	buo.defaults()
+	if len(buo.hooks) == 0 {
+		node, err = buo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BlobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			buo.mutation = mutation
+			node, err = buo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(buo.hooks) - 1; i >= 0; i-- {
+			if buo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = buo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, buo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BlobUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := blob.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   blob.Table,
+			Columns: blob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: blob.FieldID,
+			},
+		},
+	}
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: blob.FieldSize,
+		})
+	}
+	if value, ok := buo.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: blob.FieldSize,
+		})
+	}
+	if value, ok := buo.mutation.Encrypted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: blob.FieldEncrypted,
+		})
+	}
+	if value, ok := buo.mutation.ReferenceCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldReferenceCount,
+		})
+	}
+	if value, ok := buo.mutation.AddedReferenceCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: blob.FieldReferenceCount,
+		})
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldCreatedAt,
+		})
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: blob.FieldUpdatedAt,
+		})
+	}
+	if buo.mutation.SourceFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedSourceFilesIDs(); len(nodes) > 0 && !buo.mutation.SourceFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.SourceFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SourceFilesTable,
+			Columns: []string{blob.SourceFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: sourcefile.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Blob{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
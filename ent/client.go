@@ -10,9 +10,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/tereus-project/tereus-api/ent/migrate"
 
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/lease"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 
 	"entgo.io/ent/dialect"
@@ -25,12 +37,36 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AdminAction is the client for interacting with the AdminAction builders.
+	AdminAction *AdminActionClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// DataKey is the client for interacting with the DataKey builders.
+	DataKey *DataKeyClient
+	// Diagnostic is the client for interacting with the Diagnostic builders.
+	Diagnostic *DiagnosticClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PendingDeletion is the client for interacting with the PendingDeletion builders.
+	PendingDeletion *PendingDeletionClient
+	// ResumableUpload is the client for interacting with the ResumableUpload builders.
+	ResumableUpload *ResumableUploadClient
+	// SourceFile is the client for interacting with the SourceFile builders.
+	SourceFile *SourceFileClient
 	// Submission is the client for interacting with the Submission builders.
 	Submission *SubmissionClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -46,9 +82,21 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AdminAction = NewAdminActionClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.Blob = NewBlobClient(c.config)
+	c.DataKey = NewDataKeyClient(c.config)
+	c.Diagnostic = NewDiagnosticClient(c.config)
+	c.Lease = NewLeaseClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.PendingDeletion = NewPendingDeletionClient(c.config)
+	c.ResumableUpload = NewResumableUploadClient(c.config)
+	c.SourceFile = NewSourceFileClient(c.config)
 	c.Submission = NewSubmissionClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.Token = NewTokenClient(c.config)
+	c.UploadSession = NewUploadSessionClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -81,12 +129,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Submission:   NewSubmissionClient(cfg),
-		Subscription: NewSubscriptionClient(cfg),
-		Token:        NewTokenClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AdminAction:            NewAdminActionClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		Blob:                   NewBlobClient(cfg),
+		DataKey:                NewDataKeyClient(cfg),
+		Diagnostic:             NewDiagnosticClient(cfg),
+		Lease:                  NewLeaseClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PendingDeletion:        NewPendingDeletionClient(cfg),
+		ResumableUpload:        NewResumableUploadClient(cfg),
+		SourceFile:             NewSourceFileClient(cfg),
+		Submission:             NewSubmissionClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		Token:                  NewTokenClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -104,19 +164,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Submission:   NewSubmissionClient(cfg),
-		Subscription: NewSubscriptionClient(cfg),
-		Token:        NewTokenClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AdminAction:            NewAdminActionClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		Blob:                   NewBlobClient(cfg),
+		DataKey:                NewDataKeyClient(cfg),
+		Diagnostic:             NewDiagnosticClient(cfg),
+		Lease:                  NewLeaseClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		PendingDeletion:        NewPendingDeletionClient(cfg),
+		ResumableUpload:        NewResumableUploadClient(cfg),
+		SourceFile:             NewSourceFileClient(cfg),
+		Submission:             NewSubmissionClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		Token:                  NewTokenClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Submission.
+//		AdminAction.
 //		Query().
 //		Count(ctx)
 //
@@ -139,12 +211,1126 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AdminAction.Use(hooks...)
+	c.AuditEvent.Use(hooks...)
+	c.Blob.Use(hooks...)
+	c.DataKey.Use(hooks...)
+	c.Diagnostic.Use(hooks...)
+	c.Lease.Use(hooks...)
+	c.Notification.Use(hooks...)
+	c.NotificationPreference.Use(hooks...)
+	c.PendingDeletion.Use(hooks...)
+	c.ResumableUpload.Use(hooks...)
+	c.SourceFile.Use(hooks...)
 	c.Submission.Use(hooks...)
 	c.Subscription.Use(hooks...)
 	c.Token.Use(hooks...)
+	c.UploadSession.Use(hooks...)
 	c.User.Use(hooks...)
 }
 
+// AdminActionClient is a client for the AdminAction schema.
+type AdminActionClient struct {
+	config
+}
+
+// NewAdminActionClient returns a client for the AdminAction from the given config.
+func NewAdminActionClient(c config) *AdminActionClient {
+	return &AdminActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `adminaction.Hooks(f(g(h())))`.
+func (c *AdminActionClient) Use(hooks ...Hook) {
+	c.hooks.AdminAction = append(c.hooks.AdminAction, hooks...)
+}
+
+// Create returns a create builder for AdminAction.
+func (c *AdminActionClient) Create() *AdminActionCreate {
+	mutation := newAdminActionMutation(c.config, OpCreate)
+	return &AdminActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdminAction entities.
+func (c *AdminActionClient) CreateBulk(builders ...*AdminActionCreate) *AdminActionCreateBulk {
+	return &AdminActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdminAction.
+func (c *AdminActionClient) Update() *AdminActionUpdate {
+	mutation := newAdminActionMutation(c.config, OpUpdate)
+	return &AdminActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdminActionClient) UpdateOne(aa *AdminAction) *AdminActionUpdateOne {
+	mutation := newAdminActionMutation(c.config, OpUpdateOne, withAdminAction(aa))
+	return &AdminActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdminActionClient) UpdateOneID(id uuid.UUID) *AdminActionUpdateOne {
+	mutation := newAdminActionMutation(c.config, OpUpdateOne, withAdminActionID(id))
+	return &AdminActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdminAction.
+func (c *AdminActionClient) Delete() *AdminActionDelete {
+	mutation := newAdminActionMutation(c.config, OpDelete)
+	return &AdminActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AdminActionClient) DeleteOne(aa *AdminAction) *AdminActionDeleteOne {
+	return c.DeleteOneID(aa.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AdminActionClient) DeleteOneID(id uuid.UUID) *AdminActionDeleteOne {
+	builder := c.Delete().Where(adminaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdminActionDeleteOne{builder}
+}
+
+// Query returns a query builder for AdminAction.
+func (c *AdminActionClient) Query() *AdminActionQuery {
+	return &AdminActionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AdminAction entity by its id.
+func (c *AdminActionClient) Get(ctx context.Context, id uuid.UUID) (*AdminAction, error) {
+	return c.Query().Where(adminaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdminActionClient) GetX(ctx context.Context, id uuid.UUID) *AdminAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AdminActionClient) Hooks() []Hook {
+	return c.hooks.AdminAction
+}
+
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Create returns a create builder for AuditEvent.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id uuid.UUID) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AuditEventClient) DeleteOneID(id uuid.UUID) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id uuid.UUID) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id uuid.UUID) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// BlobClient is a client for the Blob schema.
+type BlobClient struct {
+	config
+}
+
+// NewBlobClient returns a client for the Blob from the given config.
+func NewBlobClient(c config) *BlobClient {
+	return &BlobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blob.Hooks(f(g(h())))`.
+func (c *BlobClient) Use(hooks ...Hook) {
+	c.hooks.Blob = append(c.hooks.Blob, hooks...)
+}
+
+// Create returns a create builder for Blob.
+func (c *BlobClient) Create() *BlobCreate {
+	mutation := newBlobMutation(c.config, OpCreate)
+	return &BlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Blob entities.
+func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Blob.
+func (c *BlobClient) Update() *BlobUpdate {
+	mutation := newBlobMutation(c.config, OpUpdate)
+	return &BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlobClient) UpdateOne(b *Blob) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlob(b))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlobClient) UpdateOneID(id string) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlobID(id))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Blob.
+func (c *BlobClient) Delete() *BlobDelete {
+	mutation := newBlobMutation(c.config, OpDelete)
+	return &BlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *BlobClient) DeleteOne(b *Blob) *BlobDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *BlobClient) DeleteOneID(id string) *BlobDeleteOne {
+	builder := c.Delete().Where(blob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlobDeleteOne{builder}
+}
+
+// Query returns a query builder for Blob.
+func (c *BlobClient) Query() *BlobQuery {
+	return &BlobQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Blob entity by its id.
+func (c *BlobClient) Get(ctx context.Context, id string) (*Blob, error) {
+	return c.Query().Where(blob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlobClient) GetX(ctx context.Context, id string) *Blob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySourceFiles queries the source_files edge of a Blob.
+func (c *BlobClient) QuerySourceFiles(b *Blob) *SourceFileQuery {
+	query := &SourceFileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, id),
+			sqlgraph.To(sourcefile.Table, sourcefile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blob.SourceFilesTable, blob.SourceFilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BlobClient) Hooks() []Hook {
+	return c.hooks.Blob
+}
+
+// DataKeyClient is a client for the DataKey schema.
+type DataKeyClient struct {
+	config
+}
+
+// NewDataKeyClient returns a client for the DataKey from the given config.
+func NewDataKeyClient(c config) *DataKeyClient {
+	return &DataKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datakey.Hooks(f(g(h())))`.
+func (c *DataKeyClient) Use(hooks ...Hook) {
+	c.hooks.DataKey = append(c.hooks.DataKey, hooks...)
+}
+
+// Create returns a create builder for DataKey.
+func (c *DataKeyClient) Create() *DataKeyCreate {
+	mutation := newDataKeyMutation(c.config, OpCreate)
+	return &DataKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataKey entities.
+func (c *DataKeyClient) CreateBulk(builders ...*DataKeyCreate) *DataKeyCreateBulk {
+	return &DataKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataKey.
+func (c *DataKeyClient) Update() *DataKeyUpdate {
+	mutation := newDataKeyMutation(c.config, OpUpdate)
+	return &DataKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataKeyClient) UpdateOne(dk *DataKey) *DataKeyUpdateOne {
+	mutation := newDataKeyMutation(c.config, OpUpdateOne, withDataKey(dk))
+	return &DataKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataKeyClient) UpdateOneID(id uuid.UUID) *DataKeyUpdateOne {
+	mutation := newDataKeyMutation(c.config, OpUpdateOne, withDataKeyID(id))
+	return &DataKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataKey.
+func (c *DataKeyClient) Delete() *DataKeyDelete {
+	mutation := newDataKeyMutation(c.config, OpDelete)
+	return &DataKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *DataKeyClient) DeleteOne(dk *DataKey) *DataKeyDeleteOne {
+	return c.DeleteOneID(dk.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *DataKeyClient) DeleteOneID(id uuid.UUID) *DataKeyDeleteOne {
+	builder := c.Delete().Where(datakey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for DataKey.
+func (c *DataKeyClient) Query() *DataKeyQuery {
+	return &DataKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a DataKey entity by its id.
+func (c *DataKeyClient) Get(ctx context.Context, id uuid.UUID) (*DataKey, error) {
+	return c.Query().Where(datakey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataKeyClient) GetX(ctx context.Context, id uuid.UUID) *DataKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DataKey.
+func (c *DataKeyClient) QueryUser(dk *DataKey) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := dk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(datakey.Table, datakey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, datakey.UserTable, datakey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(dk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DataKeyClient) Hooks() []Hook {
+	return c.hooks.DataKey
+}
+
+// DiagnosticClient is a client for the Diagnostic schema.
+type DiagnosticClient struct {
+	config
+}
+
+// NewDiagnosticClient returns a client for the Diagnostic from the given config.
+func NewDiagnosticClient(c config) *DiagnosticClient {
+	return &DiagnosticClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `diagnostic.Hooks(f(g(h())))`.
+func (c *DiagnosticClient) Use(hooks ...Hook) {
+	c.hooks.Diagnostic = append(c.hooks.Diagnostic, hooks...)
+}
+
+// Create returns a create builder for Diagnostic.
+func (c *DiagnosticClient) Create() *DiagnosticCreate {
+	mutation := newDiagnosticMutation(c.config, OpCreate)
+	return &DiagnosticCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Diagnostic entities.
+func (c *DiagnosticClient) CreateBulk(builders ...*DiagnosticCreate) *DiagnosticCreateBulk {
+	return &DiagnosticCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Diagnostic.
+func (c *DiagnosticClient) Update() *DiagnosticUpdate {
+	mutation := newDiagnosticMutation(c.config, OpUpdate)
+	return &DiagnosticUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiagnosticClient) UpdateOne(d *Diagnostic) *DiagnosticUpdateOne {
+	mutation := newDiagnosticMutation(c.config, OpUpdateOne, withDiagnostic(d))
+	return &DiagnosticUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiagnosticClient) UpdateOneID(id uuid.UUID) *DiagnosticUpdateOne {
+	mutation := newDiagnosticMutation(c.config, OpUpdateOne, withDiagnosticID(id))
+	return &DiagnosticUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Diagnostic.
+func (c *DiagnosticClient) Delete() *DiagnosticDelete {
+	mutation := newDiagnosticMutation(c.config, OpDelete)
+	return &DiagnosticDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *DiagnosticClient) DeleteOne(d *Diagnostic) *DiagnosticDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *DiagnosticClient) DeleteOneID(id uuid.UUID) *DiagnosticDeleteOne {
+	builder := c.Delete().Where(diagnostic.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiagnosticDeleteOne{builder}
+}
+
+// Query returns a query builder for Diagnostic.
+func (c *DiagnosticClient) Query() *DiagnosticQuery {
+	return &DiagnosticQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Diagnostic entity by its id.
+func (c *DiagnosticClient) Get(ctx context.Context, id uuid.UUID) (*Diagnostic, error) {
+	return c.Query().Where(diagnostic.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiagnosticClient) GetX(ctx context.Context, id uuid.UUID) *Diagnostic {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubmission queries the submission edge of a Diagnostic.
+func (c *DiagnosticClient) QuerySubmission(d *Diagnostic) *SubmissionQuery {
+	query := &SubmissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(diagnostic.Table, diagnostic.FieldID, id),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, diagnostic.SubmissionTable, diagnostic.SubmissionColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiagnosticClient) Hooks() []Hook {
+	return c.hooks.Diagnostic
+}
+
+// LeaseClient is a client for the Lease schema.
+type LeaseClient struct {
+	config
+}
+
+// NewLeaseClient returns a client for the Lease from the given config.
+func NewLeaseClient(c config) *LeaseClient {
+	return &LeaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lease.Hooks(f(g(h())))`.
+func (c *LeaseClient) Use(hooks ...Hook) {
+	c.hooks.Lease = append(c.hooks.Lease, hooks...)
+}
+
+// Create returns a create builder for Lease.
+func (c *LeaseClient) Create() *LeaseCreate {
+	mutation := newLeaseMutation(c.config, OpCreate)
+	return &LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lease entities.
+func (c *LeaseClient) CreateBulk(builders ...*LeaseCreate) *LeaseCreateBulk {
+	return &LeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lease.
+func (c *LeaseClient) Update() *LeaseUpdate {
+	mutation := newLeaseMutation(c.config, OpUpdate)
+	return &LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaseClient) UpdateOne(l *Lease) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLease(l))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaseClient) UpdateOneID(id string) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLeaseID(id))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Lease.
+func (c *LeaseClient) Delete() *LeaseDelete {
+	mutation := newLeaseMutation(c.config, OpDelete)
+	return &LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *LeaseClient) DeleteOne(l *Lease) *LeaseDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *LeaseClient) DeleteOneID(id string) *LeaseDeleteOne {
+	builder := c.Delete().Where(lease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaseDeleteOne{builder}
+}
+
+// Query returns a query builder for Lease.
+func (c *LeaseClient) Query() *LeaseQuery {
+	return &LeaseQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Lease entity by its id.
+func (c *LeaseClient) Get(ctx context.Context, id string) (*Lease, error) {
+	return c.Query().Where(lease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaseClient) GetX(ctx context.Context, id string) *Lease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaseClient) Hooks() []Hook {
+	return c.hooks.Lease
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Create returns a create builder for Notification.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Notification.
+func (c *NotificationClient) QueryUser(n *Notification) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.UserTable, notification.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Create returns a create builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(np *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(np))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id uuid.UUID) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(np *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *NotificationPreferenceClient) DeleteOneID(id uuid.UUID) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id uuid.UUID) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id uuid.UUID) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NotificationPreference.
+func (c *NotificationPreferenceClient) QueryUser(np *NotificationPreference) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := np.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationpreference.Table, notificationpreference.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, notificationpreference.UserTable, notificationpreference.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// PendingDeletionClient is a client for the PendingDeletion schema.
+type PendingDeletionClient struct {
+	config
+}
+
+// NewPendingDeletionClient returns a client for the PendingDeletion from the given config.
+func NewPendingDeletionClient(c config) *PendingDeletionClient {
+	return &PendingDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pendingdeletion.Hooks(f(g(h())))`.
+func (c *PendingDeletionClient) Use(hooks ...Hook) {
+	c.hooks.PendingDeletion = append(c.hooks.PendingDeletion, hooks...)
+}
+
+// Create returns a create builder for PendingDeletion.
+func (c *PendingDeletionClient) Create() *PendingDeletionCreate {
+	mutation := newPendingDeletionMutation(c.config, OpCreate)
+	return &PendingDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PendingDeletion entities.
+func (c *PendingDeletionClient) CreateBulk(builders ...*PendingDeletionCreate) *PendingDeletionCreateBulk {
+	return &PendingDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PendingDeletion.
+func (c *PendingDeletionClient) Update() *PendingDeletionUpdate {
+	mutation := newPendingDeletionMutation(c.config, OpUpdate)
+	return &PendingDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PendingDeletionClient) UpdateOne(pd *PendingDeletion) *PendingDeletionUpdateOne {
+	mutation := newPendingDeletionMutation(c.config, OpUpdateOne, withPendingDeletion(pd))
+	return &PendingDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PendingDeletionClient) UpdateOneID(id uuid.UUID) *PendingDeletionUpdateOne {
+	mutation := newPendingDeletionMutation(c.config, OpUpdateOne, withPendingDeletionID(id))
+	return &PendingDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PendingDeletion.
+func (c *PendingDeletionClient) Delete() *PendingDeletionDelete {
+	mutation := newPendingDeletionMutation(c.config, OpDelete)
+	return &PendingDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PendingDeletionClient) DeleteOne(pd *PendingDeletion) *PendingDeletionDeleteOne {
+	return c.DeleteOneID(pd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PendingDeletionClient) DeleteOneID(id uuid.UUID) *PendingDeletionDeleteOne {
+	builder := c.Delete().Where(pendingdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PendingDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for PendingDeletion.
+func (c *PendingDeletionClient) Query() *PendingDeletionQuery {
+	return &PendingDeletionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PendingDeletion entity by its id.
+func (c *PendingDeletionClient) Get(ctx context.Context, id uuid.UUID) (*PendingDeletion, error) {
+	return c.Query().Where(pendingdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PendingDeletionClient) GetX(ctx context.Context, id uuid.UUID) *PendingDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PendingDeletionClient) Hooks() []Hook {
+	return c.hooks.PendingDeletion
+}
+
+// ResumableUploadClient is a client for the ResumableUpload schema.
+type ResumableUploadClient struct {
+	config
+}
+
+// NewResumableUploadClient returns a client for the ResumableUpload from the given config.
+func NewResumableUploadClient(c config) *ResumableUploadClient {
+	return &ResumableUploadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumableupload.Hooks(f(g(h())))`.
+func (c *ResumableUploadClient) Use(hooks ...Hook) {
+	c.hooks.ResumableUpload = append(c.hooks.ResumableUpload, hooks...)
+}
+
+// Create returns a create builder for ResumableUpload.
+func (c *ResumableUploadClient) Create() *ResumableUploadCreate {
+	mutation := newResumableUploadMutation(c.config, OpCreate)
+	return &ResumableUploadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumableUpload entities.
+func (c *ResumableUploadClient) CreateBulk(builders ...*ResumableUploadCreate) *ResumableUploadCreateBulk {
+	return &ResumableUploadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumableUpload.
+func (c *ResumableUploadClient) Update() *ResumableUploadUpdate {
+	mutation := newResumableUploadMutation(c.config, OpUpdate)
+	return &ResumableUploadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumableUploadClient) UpdateOne(ru *ResumableUpload) *ResumableUploadUpdateOne {
+	mutation := newResumableUploadMutation(c.config, OpUpdateOne, withResumableUpload(ru))
+	return &ResumableUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumableUploadClient) UpdateOneID(id uuid.UUID) *ResumableUploadUpdateOne {
+	mutation := newResumableUploadMutation(c.config, OpUpdateOne, withResumableUploadID(id))
+	return &ResumableUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumableUpload.
+func (c *ResumableUploadClient) Delete() *ResumableUploadDelete {
+	mutation := newResumableUploadMutation(c.config, OpDelete)
+	return &ResumableUploadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ResumableUploadClient) DeleteOne(ru *ResumableUpload) *ResumableUploadDeleteOne {
+	return c.DeleteOneID(ru.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ResumableUploadClient) DeleteOneID(id uuid.UUID) *ResumableUploadDeleteOne {
+	builder := c.Delete().Where(resumableupload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumableUploadDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumableUpload.
+func (c *ResumableUploadClient) Query() *ResumableUploadQuery {
+	return &ResumableUploadQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ResumableUpload entity by its id.
+func (c *ResumableUploadClient) Get(ctx context.Context, id uuid.UUID) (*ResumableUpload, error) {
+	return c.Query().Where(resumableupload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumableUploadClient) GetX(ctx context.Context, id uuid.UUID) *ResumableUpload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ResumableUpload.
+func (c *ResumableUploadClient) QueryUser(ru *ResumableUpload) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ru.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumableupload.Table, resumableupload.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumableupload.UserTable, resumableupload.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ru.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumableUploadClient) Hooks() []Hook {
+	return c.hooks.ResumableUpload
+}
+
+// SourceFileClient is a client for the SourceFile schema.
+type SourceFileClient struct {
+	config
+}
+
+// NewSourceFileClient returns a client for the SourceFile from the given config.
+func NewSourceFileClient(c config) *SourceFileClient {
+	return &SourceFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcefile.Hooks(f(g(h())))`.
+func (c *SourceFileClient) Use(hooks ...Hook) {
+	c.hooks.SourceFile = append(c.hooks.SourceFile, hooks...)
+}
+
+// Create returns a create builder for SourceFile.
+func (c *SourceFileClient) Create() *SourceFileCreate {
+	mutation := newSourceFileMutation(c.config, OpCreate)
+	return &SourceFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceFile entities.
+func (c *SourceFileClient) CreateBulk(builders ...*SourceFileCreate) *SourceFileCreateBulk {
+	return &SourceFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceFile.
+func (c *SourceFileClient) Update() *SourceFileUpdate {
+	mutation := newSourceFileMutation(c.config, OpUpdate)
+	return &SourceFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceFileClient) UpdateOne(sf *SourceFile) *SourceFileUpdateOne {
+	mutation := newSourceFileMutation(c.config, OpUpdateOne, withSourceFile(sf))
+	return &SourceFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceFileClient) UpdateOneID(id int) *SourceFileUpdateOne {
+	mutation := newSourceFileMutation(c.config, OpUpdateOne, withSourceFileID(id))
+	return &SourceFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceFile.
+func (c *SourceFileClient) Delete() *SourceFileDelete {
+	mutation := newSourceFileMutation(c.config, OpDelete)
+	return &SourceFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SourceFileClient) DeleteOne(sf *SourceFile) *SourceFileDeleteOne {
+	return c.DeleteOneID(sf.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SourceFileClient) DeleteOneID(id int) *SourceFileDeleteOne {
+	builder := c.Delete().Where(sourcefile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceFileDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceFile.
+func (c *SourceFileClient) Query() *SourceFileQuery {
+	return &SourceFileQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SourceFile entity by its id.
+func (c *SourceFileClient) Get(ctx context.Context, id int) (*SourceFile, error) {
+	return c.Query().Where(sourcefile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceFileClient) GetX(ctx context.Context, id int) *SourceFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBlob queries the blob edge of a SourceFile.
+func (c *SourceFileClient) QueryBlob(sf *SourceFile) *BlobQuery {
+	query := &BlobQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := sf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcefile.Table, sourcefile.FieldID, id),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sourcefile.BlobTable, sourcefile.BlobColumn),
+		)
+		fromV = sqlgraph.Neighbors(sf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceFileClient) Hooks() []Hook {
+	return c.hooks.SourceFile
+}
+
 // SubmissionClient is a client for the Submission schema.
 type SubmissionClient struct {
 	config
@@ -246,6 +1432,54 @@ func (c *SubmissionClient) QueryUser(s *Submission) *UserQuery {
 	return query
 }
 
+// QueryDiagnostics queries the diagnostics edge of a Submission.
+func (c *SubmissionClient) QueryDiagnostics(s *Submission) *DiagnosticQuery {
+	query := &DiagnosticQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, id),
+			sqlgraph.To(diagnostic.Table, diagnostic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, submission.DiagnosticsTable, submission.DiagnosticsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Submission.
+func (c *SubmissionClient) QueryParent(s *Submission) *SubmissionQuery {
+	query := &SubmissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, id),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, submission.ParentTable, submission.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReruns queries the reruns edge of a Submission.
+func (c *SubmissionClient) QueryReruns(s *Submission) *SubmissionQuery {
+	query := &SubmissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, id),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, submission.RerunsTable, submission.RerunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SubmissionClient) Hooks() []Hook {
 	return c.hooks.Submission
@@ -463,6 +1697,112 @@ func (c *TokenClient) Hooks() []Hook {
 	return c.hooks.Token
 }
 
+// UploadSessionClient is a client for the UploadSession schema.
+type UploadSessionClient struct {
+	config
+}
+
+// NewUploadSessionClient returns a client for the UploadSession from the given config.
+func NewUploadSessionClient(c config) *UploadSessionClient {
+	return &UploadSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `uploadsession.Hooks(f(g(h())))`.
+func (c *UploadSessionClient) Use(hooks ...Hook) {
+	c.hooks.UploadSession = append(c.hooks.UploadSession, hooks...)
+}
+
+// Create returns a create builder for UploadSession.
+func (c *UploadSessionClient) Create() *UploadSessionCreate {
+	mutation := newUploadSessionMutation(c.config, OpCreate)
+	return &UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UploadSession entities.
+func (c *UploadSessionClient) CreateBulk(builders ...*UploadSessionCreate) *UploadSessionCreateBulk {
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UploadSession.
+func (c *UploadSessionClient) Update() *UploadSessionUpdate {
+	mutation := newUploadSessionMutation(c.config, OpUpdate)
+	return &UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UploadSessionClient) UpdateOne(us *UploadSession) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSession(us))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UploadSessionClient) UpdateOneID(id uuid.UUID) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSessionID(id))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UploadSession.
+func (c *UploadSessionClient) Delete() *UploadSessionDelete {
+	mutation := newUploadSessionMutation(c.config, OpDelete)
+	return &UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *UploadSessionClient) DeleteOne(us *UploadSession) *UploadSessionDeleteOne {
+	return c.DeleteOneID(us.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *UploadSessionClient) DeleteOneID(id uuid.UUID) *UploadSessionDeleteOne {
+	builder := c.Delete().Where(uploadsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UploadSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UploadSession.
+func (c *UploadSessionClient) Query() *UploadSessionQuery {
+	return &UploadSessionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a UploadSession entity by its id.
+func (c *UploadSessionClient) Get(ctx context.Context, id uuid.UUID) (*UploadSession, error) {
+	return c.Query().Where(uploadsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UploadSessionClient) GetX(ctx context.Context, id uuid.UUID) *UploadSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UploadSession.
+func (c *UploadSessionClient) QueryUser(us *UploadSession) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := us.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(uploadsession.Table, uploadsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, uploadsession.UserTable, uploadsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(us.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UploadSessionClient) Hooks() []Hook {
+	return c.hooks.UploadSession
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -580,6 +1920,86 @@ func (c *UserClient) QuerySubmissions(u *User) *SubmissionQuery {
 	return query
 }
 
+// QueryUploadSessions queries the upload_sessions edge of a User.
+func (c *UserClient) QueryUploadSessions(u *User) *UploadSessionQuery {
+	query := &UploadSessionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(uploadsession.Table, uploadsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UploadSessionsTable, user.UploadSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResumableUploads queries the resumable_uploads edge of a User.
+func (c *UserClient) QueryResumableUploads(u *User) *ResumableUploadQuery {
+	query := &ResumableUploadQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(resumableupload.Table, resumableupload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ResumableUploadsTable, user.ResumableUploadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDataKeys queries the data_keys edge of a User.
+func (c *UserClient) QueryDataKeys(u *User) *DataKeyQuery {
+	query := &DataKeyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(datakey.Table, datakey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DataKeysTable, user.DataKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a User.
+func (c *UserClient) QueryNotifications(u *User) *NotificationQuery {
+	query := &NotificationQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference queries the notification_preference edge of a User.
+func (c *UserClient) QueryNotificationPreference(u *User) *NotificationPreferenceQuery {
+	query := &NotificationPreferenceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.NotificationPreferenceTable, user.NotificationPreferenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QuerySubscription queries the subscription edge of a User.
 func (c *UserClient) QuerySubscription(u *User) *SubscriptionQuery {
 	query := &SubscriptionQuery{config: c.config}
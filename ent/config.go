@@ -24,10 +24,22 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Submission   []ent.Hook
-	Subscription []ent.Hook
-	Token        []ent.Hook
-	User         []ent.Hook
+	AdminAction            []ent.Hook
+	AuditEvent             []ent.Hook
+	Blob                   []ent.Hook
+	DataKey                []ent.Hook
+	Diagnostic             []ent.Hook
+	Lease                  []ent.Hook
+	Notification           []ent.Hook
+	NotificationPreference []ent.Hook
+	PendingDeletion        []ent.Hook
+	ResumableUpload        []ent.Hook
+	SourceFile             []ent.Hook
+	Submission             []ent.Hook
+	Subscription           []ent.Hook
+	Token                  []ent.Hook
+	UploadSession          []ent.Hook
+	User                   []ent.Hook
 }
 
 // Options applies the options on the config object.
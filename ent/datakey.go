@@ -0,0 +1,191 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// DataKey is the model entity for the DataKey schema.
+type DataKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// EncryptedKey holds the value of the "encrypted_key" field.
+	EncryptedKey []byte `json:"-"`
+	// MasterKeyID holds the value of the "master_key_id" field.
+	MasterKeyID string `json:"master_key_id,omitempty"`
+	// ActiveUserID holds the value of the "active_user_id" field.
+	ActiveUserID *uuid.UUID `json:"active_user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RotatedAt holds the value of the "rotated_at" field.
+	RotatedAt time.Time `json:"rotated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DataKeyQuery when eager-loading is set.
+	Edges          DataKeyEdges `json:"edges"`
+	user_data_keys *uuid.UUID
+}
+
+// DataKeyEdges holds the relations/edges for other nodes in the graph.
+type DataKeyEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DataKeyEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataKey) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datakey.FieldActiveUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case datakey.FieldEncryptedKey:
+			values[i] = new([]byte)
+		case datakey.FieldMasterKeyID:
+			values[i] = new(sql.NullString)
+		case datakey.FieldCreatedAt, datakey.FieldRotatedAt:
+			values[i] = new(sql.NullTime)
+		case datakey.FieldID:
+			values[i] = new(uuid.UUID)
+		case datakey.ForeignKeys[0]: // user_data_keys
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type DataKey", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataKey fields.
+func (dk *DataKey) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datakey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dk.ID = *value
+			}
+		case datakey.FieldEncryptedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_key", values[i])
+			} else if value != nil {
+				dk.EncryptedKey = *value
+			}
+		case datakey.FieldMasterKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field master_key_id", values[i])
+			} else if value.Valid {
+				dk.MasterKeyID = value.String
+			}
+		case datakey.FieldActiveUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field active_user_id", values[i])
+			} else if value.Valid {
+				dk.ActiveUserID = new(uuid.UUID)
+				*dk.ActiveUserID = *value.S.(*uuid.UUID)
+			}
+		case datakey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dk.CreatedAt = value.Time
+			}
+		case datakey.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				dk.RotatedAt = value.Time
+			}
+		case datakey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_data_keys", values[i])
+			} else if value.Valid {
+				dk.user_data_keys = new(uuid.UUID)
+				*dk.user_data_keys = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the DataKey entity.
+func (dk *DataKey) QueryUser() *UserQuery {
+	return (&DataKeyClient{config: dk.config}).QueryUser(dk)
+}
+
+// Update returns a builder for updating this DataKey.
+// Note that you need to call DataKey.Unwrap() before calling this method if this DataKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dk *DataKey) Update() *DataKeyUpdateOne {
+	return (&DataKeyClient{config: dk.config}).UpdateOne(dk)
+}
+
+// Unwrap unwraps the DataKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dk *DataKey) Unwrap() *DataKey {
+	tx, ok := dk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataKey is not a transactional entity")
+	}
+	dk.config.driver = tx.drv
+	return dk
+}
+
+// String implements the fmt.Stringer.
+func (dk *DataKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", dk.ID))
+	builder.WriteString(", encrypted_key=<sensitive>")
+	builder.WriteString(", master_key_id=")
+	builder.WriteString(dk.MasterKeyID)
+	if v := dk.ActiveUserID; v != nil {
+		builder.WriteString(", active_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(dk.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", rotated_at=")
+	builder.WriteString(dk.RotatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataKeys is a parsable slice of DataKey.
+type DataKeys []*DataKey
+
+func (dk DataKeys) config(cfg config) {
+	for _i := range dk {
+		dk[_i].config = cfg
+	}
+}
@@ -0,0 +1,75 @@
+// Code generated by entc, DO NOT EDIT.
+
+package datakey
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the datakey type in the database.
+	Label = "data_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEncryptedKey holds the string denoting the encrypted_key field in the database.
+	FieldEncryptedKey = "encrypted_key"
+	// FieldMasterKeyID holds the string denoting the master_key_id field in the database.
+	FieldMasterKeyID = "master_key_id"
+	// FieldActiveUserID holds the string denoting the active_user_id field in the database.
+	FieldActiveUserID = "active_user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRotatedAt holds the string denoting the rotated_at field in the database.
+	FieldRotatedAt = "rotated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the datakey in the database.
+	Table = "data_keys"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "data_keys"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_data_keys"
+)
+
+// Columns holds all SQL columns for datakey fields.
+var Columns = []string{
+	FieldID,
+	FieldEncryptedKey,
+	FieldMasterKeyID,
+	FieldActiveUserID,
+	FieldCreatedAt,
+	FieldRotatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "data_keys"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_data_keys",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,633 @@
+// Code generated by entc, DO NOT EDIT.
+
+package datakey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// EncryptedKey applies equality check predicate on the "encrypted_key" field. It's identical to EncryptedKeyEQ.
+func EncryptedKey(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// MasterKeyID applies equality check predicate on the "master_key_id" field. It's identical to MasterKeyIDEQ.
+func MasterKeyID(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// ActiveUserID applies equality check predicate on the "active_user_id" field. It's identical to ActiveUserIDEQ.
+func ActiveUserID(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActiveUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// RotatedAt applies equality check predicate on the "rotated_at" field. It's identical to RotatedAtEQ.
+func RotatedAt(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotatedAt), v))
+	})
+}
+
+// EncryptedKeyEQ applies the EQ predicate on the "encrypted_key" field.
+func EncryptedKeyEQ(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// EncryptedKeyNEQ applies the NEQ predicate on the "encrypted_key" field.
+func EncryptedKeyNEQ(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// EncryptedKeyIn applies the In predicate on the "encrypted_key" field.
+func EncryptedKeyIn(vs ...[]byte) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEncryptedKey), v...))
+	})
+}
+
+// EncryptedKeyNotIn applies the NotIn predicate on the "encrypted_key" field.
+func EncryptedKeyNotIn(vs ...[]byte) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEncryptedKey), v...))
+	})
+}
+
+// EncryptedKeyGT applies the GT predicate on the "encrypted_key" field.
+func EncryptedKeyGT(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// EncryptedKeyGTE applies the GTE predicate on the "encrypted_key" field.
+func EncryptedKeyGTE(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// EncryptedKeyLT applies the LT predicate on the "encrypted_key" field.
+func EncryptedKeyLT(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// EncryptedKeyLTE applies the LTE predicate on the "encrypted_key" field.
+func EncryptedKeyLTE(v []byte) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEncryptedKey), v))
+	})
+}
+
+// MasterKeyIDEQ applies the EQ predicate on the "master_key_id" field.
+func MasterKeyIDEQ(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDNEQ applies the NEQ predicate on the "master_key_id" field.
+func MasterKeyIDNEQ(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDIn applies the In predicate on the "master_key_id" field.
+func MasterKeyIDIn(vs ...string) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMasterKeyID), v...))
+	})
+}
+
+// MasterKeyIDNotIn applies the NotIn predicate on the "master_key_id" field.
+func MasterKeyIDNotIn(vs ...string) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMasterKeyID), v...))
+	})
+}
+
+// MasterKeyIDGT applies the GT predicate on the "master_key_id" field.
+func MasterKeyIDGT(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDGTE applies the GTE predicate on the "master_key_id" field.
+func MasterKeyIDGTE(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDLT applies the LT predicate on the "master_key_id" field.
+func MasterKeyIDLT(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDLTE applies the LTE predicate on the "master_key_id" field.
+func MasterKeyIDLTE(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDContains applies the Contains predicate on the "master_key_id" field.
+func MasterKeyIDContains(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDHasPrefix applies the HasPrefix predicate on the "master_key_id" field.
+func MasterKeyIDHasPrefix(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDHasSuffix applies the HasSuffix predicate on the "master_key_id" field.
+func MasterKeyIDHasSuffix(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDEqualFold applies the EqualFold predicate on the "master_key_id" field.
+func MasterKeyIDEqualFold(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// MasterKeyIDContainsFold applies the ContainsFold predicate on the "master_key_id" field.
+func MasterKeyIDContainsFold(v string) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMasterKeyID), v))
+	})
+}
+
+// ActiveUserIDEQ applies the EQ predicate on the "active_user_id" field.
+func ActiveUserIDEQ(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDNEQ applies the NEQ predicate on the "active_user_id" field.
+func ActiveUserIDNEQ(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDIn applies the In predicate on the "active_user_id" field.
+func ActiveUserIDIn(vs ...uuid.UUID) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActiveUserID), v...))
+	})
+}
+
+// ActiveUserIDNotIn applies the NotIn predicate on the "active_user_id" field.
+func ActiveUserIDNotIn(vs ...uuid.UUID) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActiveUserID), v...))
+	})
+}
+
+// ActiveUserIDGT applies the GT predicate on the "active_user_id" field.
+func ActiveUserIDGT(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDGTE applies the GTE predicate on the "active_user_id" field.
+func ActiveUserIDGTE(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDLT applies the LT predicate on the "active_user_id" field.
+func ActiveUserIDLT(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDLTE applies the LTE predicate on the "active_user_id" field.
+func ActiveUserIDLTE(v uuid.UUID) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActiveUserID), v))
+	})
+}
+
+// ActiveUserIDIsNil applies the IsNil predicate on the "active_user_id" field.
+func ActiveUserIDIsNil() predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActiveUserID)))
+	})
+}
+
+// ActiveUserIDNotNil applies the NotNil predicate on the "active_user_id" field.
+func ActiveUserIDNotNil() predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActiveUserID)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// RotatedAtEQ applies the EQ predicate on the "rotated_at" field.
+func RotatedAtEQ(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtNEQ applies the NEQ predicate on the "rotated_at" field.
+func RotatedAtNEQ(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtIn applies the In predicate on the "rotated_at" field.
+func RotatedAtIn(vs ...time.Time) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRotatedAt), v...))
+	})
+}
+
+// RotatedAtNotIn applies the NotIn predicate on the "rotated_at" field.
+func RotatedAtNotIn(vs ...time.Time) predicate.DataKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DataKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRotatedAt), v...))
+	})
+}
+
+// RotatedAtGT applies the GT predicate on the "rotated_at" field.
+func RotatedAtGT(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtGTE applies the GTE predicate on the "rotated_at" field.
+func RotatedAtGTE(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtLT applies the LT predicate on the "rotated_at" field.
+func RotatedAtLT(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtLTE applies the LTE predicate on the "rotated_at" field.
+func RotatedAtLTE(v time.Time) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRotatedAt), v))
+	})
+}
+
+// RotatedAtIsNil applies the IsNil predicate on the "rotated_at" field.
+func RotatedAtIsNil() predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRotatedAt)))
+	})
+}
+
+// RotatedAtNotNil applies the NotNil predicate on the "rotated_at" field.
+func RotatedAtNotNil() predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRotatedAt)))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DataKey) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DataKey) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DataKey) predicate.DataKey {
+	return predicate.DataKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
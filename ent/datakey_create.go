@@ -0,0 +1,877 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// DataKeyCreate is the builder for creating a DataKey entity.
+type DataKeyCreate struct {
+	config
+	mutation *DataKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (dkc *DataKeyCreate) SetEncryptedKey(b []byte) *DataKeyCreate {
+	dkc.mutation.SetEncryptedKey(b)
+	return dkc
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (dkc *DataKeyCreate) SetMasterKeyID(s string) *DataKeyCreate {
+	dkc.mutation.SetMasterKeyID(s)
+	return dkc
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (dkc *DataKeyCreate) SetActiveUserID(u uuid.UUID) *DataKeyCreate {
+	dkc.mutation.SetActiveUserID(u)
+	return dkc
+}
+
+// SetNillableActiveUserID sets the "active_user_id" field if the given value is not nil.
+func (dkc *DataKeyCreate) SetNillableActiveUserID(u *uuid.UUID) *DataKeyCreate {
+	if u != nil {
+		dkc.SetActiveUserID(*u)
+	}
+	return dkc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dkc *DataKeyCreate) SetCreatedAt(t time.Time) *DataKeyCreate {
+	dkc.mutation.SetCreatedAt(t)
+	return dkc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dkc *DataKeyCreate) SetNillableCreatedAt(t *time.Time) *DataKeyCreate {
+	if t != nil {
+		dkc.SetCreatedAt(*t)
+	}
+	return dkc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (dkc *DataKeyCreate) SetRotatedAt(t time.Time) *DataKeyCreate {
+	dkc.mutation.SetRotatedAt(t)
+	return dkc
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (dkc *DataKeyCreate) SetNillableRotatedAt(t *time.Time) *DataKeyCreate {
+	if t != nil {
+		dkc.SetRotatedAt(*t)
+	}
+	return dkc
+}
+
+// SetID sets the "id" field.
+func (dkc *DataKeyCreate) SetID(u uuid.UUID) *DataKeyCreate {
+	dkc.mutation.SetID(u)
+	return dkc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dkc *DataKeyCreate) SetNillableID(u *uuid.UUID) *DataKeyCreate {
+	if u != nil {
+		dkc.SetID(*u)
+	}
+	return dkc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dkc *DataKeyCreate) SetUserID(id uuid.UUID) *DataKeyCreate {
+	dkc.mutation.SetUserID(id)
+	return dkc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dkc *DataKeyCreate) SetUser(u *User) *DataKeyCreate {
+	return dkc.SetUserID(u.ID)
+}
+
+// Mutation returns the DataKeyMutation object of the builder.
+func (dkc *DataKeyCreate) Mutation() *DataKeyMutation {
+	return dkc.mutation
+}
+
+// Save creates the DataKey in the database.
+func (dkc *DataKeyCreate) Save(ctx context.Context) (*DataKey, error) {
+	var (
+		err  error
+		node *DataKey
+	)
+	dkc.defaults()
+	if len(dkc.hooks) == 0 {
+		if err = dkc.check(); err != nil {
+			return nil, err
+		}
+		node, err = dkc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DataKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dkc.check(); err != nil {
+				return nil, err
+			}
+			dkc.mutation = mutation
+			if node, err = dkc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dkc.hooks) - 1; i >= 0; i-- {
+			if dkc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dkc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dkc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dkc *DataKeyCreate) SaveX(ctx context.Context) *DataKey {
+	v, err := dkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dkc *DataKeyCreate) Exec(ctx context.Context) error {
+	_, err := dkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dkc *DataKeyCreate) ExecX(ctx context.Context) {
+	if err := dkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dkc *DataKeyCreate) defaults() {
+	if _, ok := dkc.mutation.CreatedAt(); !ok {
+		v := datakey.DefaultCreatedAt()
+		dkc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dkc.mutation.ID(); !ok {
+		v := datakey.DefaultID()
+		dkc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dkc *DataKeyCreate) check() error {
+	if _, ok := dkc.mutation.EncryptedKey(); !ok {
+		return &ValidationError{Name: "encrypted_key", err: errors.New(`ent: missing required field "DataKey.encrypted_key"`)}
+	}
+	if _, ok := dkc.mutation.MasterKeyID(); !ok {
+		return &ValidationError{Name: "master_key_id", err: errors.New(`ent: missing required field "DataKey.master_key_id"`)}
+	}
+	if _, ok := dkc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DataKey.created_at"`)}
+	}
+	if _, ok := dkc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DataKey.user"`)}
+	}
+	return nil
+}
+
+func (dkc *DataKeyCreate) sqlSave(ctx context.Context) (*DataKey, error) {
+	_node, _spec := dkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (dkc *DataKeyCreate) createSpec() (*DataKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataKey{config: dkc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: datakey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: datakey.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = dkc.conflict
+	if id, ok := dkc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dkc.mutation.EncryptedKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: datakey.FieldEncryptedKey,
+		})
+		_node.EncryptedKey = value
+	}
+	if value, ok := dkc.mutation.MasterKeyID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: datakey.FieldMasterKeyID,
+		})
+		_node.MasterKeyID = value
+	}
+	if value, ok := dkc.mutation.ActiveUserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: datakey.FieldActiveUserID,
+		})
+		_node.ActiveUserID = &value
+	}
+	if value, ok := dkc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := dkc.mutation.RotatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldRotatedAt,
+		})
+		_node.RotatedAt = value
+	}
+	if nodes := dkc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   datakey.UserTable,
+			Columns: []string{datakey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_data_keys = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataKey.Create().
+//		SetEncryptedKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataKeyUpsert) {
+//			SetEncryptedKey(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (dkc *DataKeyCreate) OnConflict(opts ...sql.ConflictOption) *DataKeyUpsertOne {
+	dkc.conflict = opts
+	return &DataKeyUpsertOne{
+		create: dkc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (dkc *DataKeyCreate) OnConflictColumns(columns ...string) *DataKeyUpsertOne {
+	dkc.conflict = append(dkc.conflict, sql.ConflictColumns(columns...))
+	return &DataKeyUpsertOne{
+		create: dkc,
+	}
+}
+
+type (
+	// DataKeyUpsertOne is the builder for "upsert"-ing
+	//  one DataKey node.
+	DataKeyUpsertOne struct {
+		create *DataKeyCreate
+	}
+
+	// DataKeyUpsert is the "OnConflict" setter.
+	DataKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *DataKeyUpsert) SetEncryptedKey(v []byte) *DataKeyUpsert {
+	u.Set(datakey.FieldEncryptedKey, v)
+	return u
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *DataKeyUpsert) UpdateEncryptedKey() *DataKeyUpsert {
+	u.SetExcluded(datakey.FieldEncryptedKey)
+	return u
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *DataKeyUpsert) SetMasterKeyID(v string) *DataKeyUpsert {
+	u.Set(datakey.FieldMasterKeyID, v)
+	return u
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *DataKeyUpsert) UpdateMasterKeyID() *DataKeyUpsert {
+	u.SetExcluded(datakey.FieldMasterKeyID)
+	return u
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (u *DataKeyUpsert) SetActiveUserID(v uuid.UUID) *DataKeyUpsert {
+	u.Set(datakey.FieldActiveUserID, v)
+	return u
+}
+
+// UpdateActiveUserID sets the "active_user_id" field to the value that was provided on create.
+func (u *DataKeyUpsert) UpdateActiveUserID() *DataKeyUpsert {
+	u.SetExcluded(datakey.FieldActiveUserID)
+	return u
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (u *DataKeyUpsert) ClearActiveUserID() *DataKeyUpsert {
+	u.SetNull(datakey.FieldActiveUserID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataKeyUpsert) SetCreatedAt(v time.Time) *DataKeyUpsert {
+	u.Set(datakey.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataKeyUpsert) UpdateCreatedAt() *DataKeyUpsert {
+	u.SetExcluded(datakey.FieldCreatedAt)
+	return u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *DataKeyUpsert) SetRotatedAt(v time.Time) *DataKeyUpsert {
+	u.Set(datakey.FieldRotatedAt, v)
+	return u
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *DataKeyUpsert) UpdateRotatedAt() *DataKeyUpsert {
+	u.SetExcluded(datakey.FieldRotatedAt)
+	return u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *DataKeyUpsert) ClearRotatedAt() *DataKeyUpsert {
+	u.SetNull(datakey.FieldRotatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DataKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datakey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *DataKeyUpsertOne) UpdateNewValues() *DataKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(datakey.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.DataKey.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *DataKeyUpsertOne) Ignore() *DataKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataKeyUpsertOne) DoNothing() *DataKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataKeyCreate.OnConflict
+// documentation for more info.
+func (u *DataKeyUpsertOne) Update(set func(*DataKeyUpsert)) *DataKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *DataKeyUpsertOne) SetEncryptedKey(v []byte) *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *DataKeyUpsertOne) UpdateEncryptedKey() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *DataKeyUpsertOne) SetMasterKeyID(v string) *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetMasterKeyID(v)
+	})
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *DataKeyUpsertOne) UpdateMasterKeyID() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateMasterKeyID()
+	})
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (u *DataKeyUpsertOne) SetActiveUserID(v uuid.UUID) *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetActiveUserID(v)
+	})
+}
+
+// UpdateActiveUserID sets the "active_user_id" field to the value that was provided on create.
+func (u *DataKeyUpsertOne) UpdateActiveUserID() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateActiveUserID()
+	})
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (u *DataKeyUpsertOne) ClearActiveUserID() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.ClearActiveUserID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataKeyUpsertOne) SetCreatedAt(v time.Time) *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataKeyUpsertOne) UpdateCreatedAt() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *DataKeyUpsertOne) SetRotatedAt(v time.Time) *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *DataKeyUpsertOne) UpdateRotatedAt() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *DataKeyUpsertOne) ClearRotatedAt() *DataKeyUpsertOne {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataKeyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DataKeyUpsertOne.ID is not supported by MySQL driver. Use DataKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataKeyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataKeyCreateBulk is the builder for creating many DataKey entities in bulk.
+type DataKeyCreateBulk struct {
+	config
+	builders []*DataKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DataKey entities in the database.
+func (dkcb *DataKeyCreateBulk) Save(ctx context.Context) ([]*DataKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dkcb.builders))
+	nodes := make([]*DataKey, len(dkcb.builders))
+	mutators := make([]Mutator, len(dkcb.builders))
+	for i := range dkcb.builders {
+		func(i int, root context.Context) {
+			builder := dkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dkcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dkcb *DataKeyCreateBulk) SaveX(ctx context.Context) []*DataKey {
+	v, err := dkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dkcb *DataKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := dkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dkcb *DataKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := dkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataKeyUpsert) {
+//			SetEncryptedKey(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (dkcb *DataKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataKeyUpsertBulk {
+	dkcb.conflict = opts
+	return &DataKeyUpsertBulk{
+		create: dkcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (dkcb *DataKeyCreateBulk) OnConflictColumns(columns ...string) *DataKeyUpsertBulk {
+	dkcb.conflict = append(dkcb.conflict, sql.ConflictColumns(columns...))
+	return &DataKeyUpsertBulk{
+		create: dkcb,
+	}
+}
+
+// DataKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataKey nodes.
+type DataKeyUpsertBulk struct {
+	create *DataKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datakey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *DataKeyUpsertBulk) UpdateNewValues() *DataKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(datakey.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *DataKeyUpsertBulk) Ignore() *DataKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataKeyUpsertBulk) DoNothing() *DataKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataKeyUpsertBulk) Update(set func(*DataKeyUpsert)) *DataKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *DataKeyUpsertBulk) SetEncryptedKey(v []byte) *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *DataKeyUpsertBulk) UpdateEncryptedKey() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (u *DataKeyUpsertBulk) SetMasterKeyID(v string) *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetMasterKeyID(v)
+	})
+}
+
+// UpdateMasterKeyID sets the "master_key_id" field to the value that was provided on create.
+func (u *DataKeyUpsertBulk) UpdateMasterKeyID() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateMasterKeyID()
+	})
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (u *DataKeyUpsertBulk) SetActiveUserID(v uuid.UUID) *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetActiveUserID(v)
+	})
+}
+
+// UpdateActiveUserID sets the "active_user_id" field to the value that was provided on create.
+func (u *DataKeyUpsertBulk) UpdateActiveUserID() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateActiveUserID()
+	})
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (u *DataKeyUpsertBulk) ClearActiveUserID() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.ClearActiveUserID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataKeyUpsertBulk) SetCreatedAt(v time.Time) *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataKeyUpsertBulk) UpdateCreatedAt() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *DataKeyUpsertBulk) SetRotatedAt(v time.Time) *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *DataKeyUpsertBulk) UpdateRotatedAt() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *DataKeyUpsertBulk) ClearRotatedAt() *DataKeyUpsertBulk {
+	return u.Update(func(s *DataKeyUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataKeyUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DataKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
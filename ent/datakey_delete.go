@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// DataKeyDelete is the builder for deleting a DataKey entity.
+type DataKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *DataKeyMutation
+}
+
+// Where appends a list predicates to the DataKeyDelete builder.
+func (dkd *DataKeyDelete) Where(ps ...predicate.DataKey) *DataKeyDelete {
+	dkd.mutation.Where(ps...)
+	return dkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dkd *DataKeyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(dkd.hooks) == 0 {
+		affected, err = dkd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DataKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			dkd.mutation = mutation
+			affected, err = dkd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(dkd.hooks) - 1; i >= 0; i-- {
+			if dkd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dkd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dkd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dkd *DataKeyDelete) ExecX(ctx context.Context) int {
+	n, err := dkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dkd *DataKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: datakey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: datakey.FieldID,
+			},
+		},
+	}
+	if ps := dkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, dkd.driver, _spec)
+}
+
+// DataKeyDeleteOne is the builder for deleting a single DataKey entity.
+type DataKeyDeleteOne struct {
+	dkd *DataKeyDelete
+}
+
+// Exec executes the deletion query.
+func (dkdo *DataKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := dkdo.dkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{datakey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dkdo *DataKeyDeleteOne) ExecX(ctx context.Context) {
+	dkdo.dkd.ExecX(ctx)
+}
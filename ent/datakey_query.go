@@ -0,0 +1,1023 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// DataKeyQuery is the builder for querying DataKey entities.
+type DataKeyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.DataKey
+	// eager-loading edges.
+	withUser  *UserQuery
+	withFKs   bool
+	modifiers []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DataKeyQuery builder.
+func (dkq *DataKeyQuery) Where(ps ...predicate.DataKey) *DataKeyQuery {
+	dkq.predicates = append(dkq.predicates, ps...)
+	return dkq
+}
+
+// Limit adds a limit step to the query.
+func (dkq *DataKeyQuery) Limit(limit int) *DataKeyQuery {
+	dkq.limit = &limit
+	return dkq
+}
+
+// Offset adds an offset step to the query.
+func (dkq *DataKeyQuery) Offset(offset int) *DataKeyQuery {
+	dkq.offset = &offset
+	return dkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dkq *DataKeyQuery) Unique(unique bool) *DataKeyQuery {
+	dkq.unique = &unique
+	return dkq
+}
+
+// Order adds an order step to the query.
+func (dkq *DataKeyQuery) Order(o ...OrderFunc) *DataKeyQuery {
+	dkq.order = append(dkq.order, o...)
+	return dkq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (dkq *DataKeyQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: dkq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(datakey.Table, datakey.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, datakey.UserTable, datakey.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DataKey entity from the query.
+// Returns a *NotFoundError when no DataKey was found.
+func (dkq *DataKeyQuery) First(ctx context.Context) (*DataKey, error) {
+	nodes, err := dkq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{datakey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dkq *DataKeyQuery) FirstX(ctx context.Context) *DataKey {
+	node, err := dkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DataKey ID from the query.
+// Returns a *NotFoundError when no DataKey ID was found.
+func (dkq *DataKeyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dkq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{datakey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dkq *DataKeyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DataKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DataKey entity is found.
+// Returns a *NotFoundError when no DataKey entities are found.
+func (dkq *DataKeyQuery) Only(ctx context.Context) (*DataKey, error) {
+	nodes, err := dkq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{datakey.Label}
+	default:
+		return nil, &NotSingularError{datakey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dkq *DataKeyQuery) OnlyX(ctx context.Context) *DataKey {
+	node, err := dkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DataKey ID in the query.
+// Returns a *NotSingularError when more than one DataKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dkq *DataKeyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dkq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = &NotSingularError{datakey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dkq *DataKeyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DataKeys.
+func (dkq *DataKeyQuery) All(ctx context.Context) ([]*DataKey, error) {
+	if err := dkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return dkq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dkq *DataKeyQuery) AllX(ctx context.Context) []*DataKey {
+	nodes, err := dkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DataKey IDs.
+func (dkq *DataKeyQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := dkq.Select(datakey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dkq *DataKeyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dkq *DataKeyQuery) Count(ctx context.Context) (int, error) {
+	if err := dkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return dkq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dkq *DataKeyQuery) CountX(ctx context.Context) int {
+	count, err := dkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dkq *DataKeyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := dkq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return dkq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dkq *DataKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := dkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DataKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dkq *DataKeyQuery) Clone() *DataKeyQuery {
+	if dkq == nil {
+		return nil
+	}
+	return &DataKeyQuery{
+		config:     dkq.config,
+		limit:      dkq.limit,
+		offset:     dkq.offset,
+		order:      append([]OrderFunc{}, dkq.order...),
+		predicates: append([]predicate.DataKey{}, dkq.predicates...),
+		withUser:   dkq.withUser.Clone(),
+		// clone intermediate query.
+		sql:    dkq.sql.Clone(),
+		path:   dkq.path,
+		unique: dkq.unique,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (dkq *DataKeyQuery) WithUser(opts ...func(*UserQuery)) *DataKeyQuery {
+	query := &UserQuery{config: dkq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	dkq.withUser = query
+	return dkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EncryptedKey []byte `json:"encrypted_key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DataKey.Query().
+//		GroupBy(datakey.FieldEncryptedKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (dkq *DataKeyQuery) GroupBy(field string, fields ...string) *DataKeyGroupBy {
+	group := &DataKeyGroupBy{config: dkq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := dkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return dkq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EncryptedKey []byte `json:"encrypted_key,omitempty"`
+//	}
+//
+//	client.DataKey.Query().
+//		Select(datakey.FieldEncryptedKey).
+//		Scan(ctx, &v)
+//
+func (dkq *DataKeyQuery) Select(fields ...string) *DataKeySelect {
+	dkq.fields = append(dkq.fields, fields...)
+	return &DataKeySelect{DataKeyQuery: dkq}
+}
+
+func (dkq *DataKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range dkq.fields {
+		if !datakey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dkq.path != nil {
+		prev, err := dkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dkq.sql = prev
+	}
+	return nil
+}
+
+func (dkq *DataKeyQuery) sqlAll(ctx context.Context) ([]*DataKey, error) {
+	var (
+		nodes       = []*DataKey{}
+		withFKs     = dkq.withFKs
+		_spec       = dkq.querySpec()
+		loadedTypes = [1]bool{
+			dkq.withUser != nil,
+		}
+	)
+	if dkq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, datakey.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &DataKey{config: dkq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dkq.modifiers) > 0 {
+		_spec.Modifiers = dkq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, dkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := dkq.withUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*DataKey)
+		for i := range nodes {
+			if nodes[i].user_data_keys == nil {
+				continue
+			}
+			fk := *nodes[i].user_data_keys
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_data_keys" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (dkq *DataKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dkq.querySpec()
+	if len(dkq.modifiers) > 0 {
+		_spec.Modifiers = dkq.modifiers
+	}
+	_spec.Node.Columns = dkq.fields
+	if len(dkq.fields) > 0 {
+		_spec.Unique = dkq.unique != nil && *dkq.unique
+	}
+	return sqlgraph.CountNodes(ctx, dkq.driver, _spec)
+}
+
+func (dkq *DataKeyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := dkq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (dkq *DataKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   datakey.Table,
+			Columns: datakey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: datakey.FieldID,
+			},
+		},
+		From:   dkq.sql,
+		Unique: true,
+	}
+	if unique := dkq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := dkq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datakey.FieldID)
+		for i := range fields {
+			if fields[i] != datakey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dkq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dkq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dkq *DataKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dkq.driver.Dialect())
+	t1 := builder.Table(datakey.Table)
+	columns := dkq.fields
+	if len(columns) == 0 {
+		columns = datakey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dkq.sql != nil {
+		selector = dkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dkq.unique != nil && *dkq.unique {
+		selector.Distinct()
+	}
+	for _, m := range dkq.modifiers {
+		m(selector)
+	}
+	for _, p := range dkq.predicates {
+		p(selector)
+	}
+	for _, p := range dkq.order {
+		p(selector)
+	}
+	if offset := dkq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dkq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dkq *DataKeyQuery) Modify(modifiers ...func(s *sql.Selector)) *DataKeySelect {
+	dkq.modifiers = append(dkq.modifiers, modifiers...)
+	return dkq.Select()
+}
+
+// DataKeyGroupBy is the group-by builder for DataKey entities.
+type DataKeyGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dkgb *DataKeyGroupBy) Aggregate(fns ...AggregateFunc) *DataKeyGroupBy {
+	dkgb.fns = append(dkgb.fns, fns...)
+	return dkgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (dkgb *DataKeyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := dkgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	dkgb.sql = query
+	return dkgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := dkgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(dkgb.fields) > 1 {
+		return nil, errors.New("ent: DataKeyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := dkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := dkgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = dkgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeyGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) StringX(ctx context.Context) string {
+	v, err := dkgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(dkgb.fields) > 1 {
+		return nil, errors.New("ent: DataKeyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := dkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := dkgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = dkgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeyGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) IntX(ctx context.Context) int {
+	v, err := dkgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(dkgb.fields) > 1 {
+		return nil, errors.New("ent: DataKeyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := dkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := dkgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = dkgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeyGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := dkgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(dkgb.fields) > 1 {
+		return nil, errors.New("ent: DataKeyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := dkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := dkgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dkgb *DataKeyGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = dkgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeyGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (dkgb *DataKeyGroupBy) BoolX(ctx context.Context) bool {
+	v, err := dkgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (dkgb *DataKeyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range dkgb.fields {
+		if !datakey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := dkgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dkgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (dkgb *DataKeyGroupBy) sqlQuery() *sql.Selector {
+	selector := dkgb.sql.Select()
+	aggregation := make([]string, 0, len(dkgb.fns))
+	for _, fn := range dkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(dkgb.fields)+len(dkgb.fns))
+		for _, f := range dkgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(dkgb.fields...)...)
+}
+
+// DataKeySelect is the builder for selecting fields of DataKey entities.
+type DataKeySelect struct {
+	*DataKeyQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dks *DataKeySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := dks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	dks.sql = dks.DataKeyQuery.sqlQuery(ctx)
+	return dks.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (dks *DataKeySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := dks.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(dks.fields) > 1 {
+		return nil, errors.New("ent: DataKeySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := dks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (dks *DataKeySelect) StringsX(ctx context.Context) []string {
+	v, err := dks.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = dks.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (dks *DataKeySelect) StringX(ctx context.Context) string {
+	v, err := dks.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(dks.fields) > 1 {
+		return nil, errors.New("ent: DataKeySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := dks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (dks *DataKeySelect) IntsX(ctx context.Context) []int {
+	v, err := dks.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = dks.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (dks *DataKeySelect) IntX(ctx context.Context) int {
+	v, err := dks.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(dks.fields) > 1 {
+		return nil, errors.New("ent: DataKeySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := dks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (dks *DataKeySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := dks.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = dks.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (dks *DataKeySelect) Float64X(ctx context.Context) float64 {
+	v, err := dks.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(dks.fields) > 1 {
+		return nil, errors.New("ent: DataKeySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := dks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (dks *DataKeySelect) BoolsX(ctx context.Context) []bool {
+	v, err := dks.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (dks *DataKeySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = dks.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{datakey.Label}
+	default:
+		err = fmt.Errorf("ent: DataKeySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (dks *DataKeySelect) BoolX(ctx context.Context) bool {
+	v, err := dks.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (dks *DataKeySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := dks.sql.Query()
+	if err := dks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dks *DataKeySelect) Modify(modifiers ...func(s *sql.Selector)) *DataKeySelect {
+	dks.modifiers = append(dks.modifiers, modifiers...)
+	return dks
+}
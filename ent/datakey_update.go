@@ -0,0 +1,600 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// DataKeyUpdate is the builder for updating DataKey entities.
+type DataKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataKeyMutation
+}
+
+// Where appends a list predicates to the DataKeyUpdate builder.
+func (dku *DataKeyUpdate) Where(ps ...predicate.DataKey) *DataKeyUpdate {
+	dku.mutation.Where(ps...)
+	return dku
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (dku *DataKeyUpdate) SetEncryptedKey(b []byte) *DataKeyUpdate {
+	dku.mutation.SetEncryptedKey(b)
+	return dku
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (dku *DataKeyUpdate) SetMasterKeyID(s string) *DataKeyUpdate {
+	dku.mutation.SetMasterKeyID(s)
+	return dku
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (dku *DataKeyUpdate) SetActiveUserID(u uuid.UUID) *DataKeyUpdate {
+	dku.mutation.SetActiveUserID(u)
+	return dku
+}
+
+// SetNillableActiveUserID sets the "active_user_id" field if the given value is not nil.
+func (dku *DataKeyUpdate) SetNillableActiveUserID(u *uuid.UUID) *DataKeyUpdate {
+	if u != nil {
+		dku.SetActiveUserID(*u)
+	}
+	return dku
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (dku *DataKeyUpdate) ClearActiveUserID() *DataKeyUpdate {
+	dku.mutation.ClearActiveUserID()
+	return dku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dku *DataKeyUpdate) SetCreatedAt(t time.Time) *DataKeyUpdate {
+	dku.mutation.SetCreatedAt(t)
+	return dku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dku *DataKeyUpdate) SetNillableCreatedAt(t *time.Time) *DataKeyUpdate {
+	if t != nil {
+		dku.SetCreatedAt(*t)
+	}
+	return dku
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (dku *DataKeyUpdate) SetRotatedAt(t time.Time) *DataKeyUpdate {
+	dku.mutation.SetRotatedAt(t)
+	return dku
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (dku *DataKeyUpdate) SetNillableRotatedAt(t *time.Time) *DataKeyUpdate {
+	if t != nil {
+		dku.SetRotatedAt(*t)
+	}
+	return dku
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (dku *DataKeyUpdate) ClearRotatedAt() *DataKeyUpdate {
+	dku.mutation.ClearRotatedAt()
+	return dku
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dku *DataKeyUpdate) SetUserID(id uuid.UUID) *DataKeyUpdate {
+	dku.mutation.SetUserID(id)
+	return dku
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dku *DataKeyUpdate) SetUser(u *User) *DataKeyUpdate {
+	return dku.SetUserID(u.ID)
+}
+
+// Mutation returns the DataKeyMutation object of the builder.
+func (dku *DataKeyUpdate) Mutation() *DataKeyMutation {
+	return dku.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dku *DataKeyUpdate) ClearUser() *DataKeyUpdate {
+	dku.mutation.ClearUser()
+	return dku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dku *DataKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(dku.hooks) == 0 {
+		if err = dku.check(); err != nil {
+			return 0, err
+		}
+		affected, err = dku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DataKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dku.check(); err != nil {
+				return 0, err
+			}
+			dku.mutation = mutation
+			affected, err = dku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(dku.hooks) - 1; i >= 0; i-- {
+			if dku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dku *DataKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := dku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dku *DataKeyUpdate) Exec(ctx context.Context) error {
+	_, err := dku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dku *DataKeyUpdate) ExecX(ctx context.Context) {
+	if err := dku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dku *DataKeyUpdate) check() error {
+	if _, ok := dku.mutation.UserID(); dku.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DataKey.user"`)
+	}
+	return nil
+}
+
+func (dku *DataKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   datakey.Table,
+			Columns: datakey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: datakey.FieldID,
+			},
+		},
+	}
+	if ps := dku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dku.mutation.EncryptedKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: datakey.FieldEncryptedKey,
+		})
+	}
+	if value, ok := dku.mutation.MasterKeyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: datakey.FieldMasterKeyID,
+		})
+	}
+	if value, ok := dku.mutation.ActiveUserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: datakey.FieldActiveUserID,
+		})
+	}
+	if dku.mutation.ActiveUserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: datakey.FieldActiveUserID,
+		})
+	}
+	if value, ok := dku.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldCreatedAt,
+		})
+	}
+	if value, ok := dku.mutation.RotatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldRotatedAt,
+		})
+	}
+	if dku.mutation.RotatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: datakey.FieldRotatedAt,
+		})
+	}
+	if dku.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   datakey.UserTable,
+			Columns: []string{datakey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dku.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   datakey.UserTable,
+			Columns: []string{datakey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datakey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DataKeyUpdateOne is the builder for updating a single DataKey entity.
+type DataKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataKeyMutation
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (dkuo *DataKeyUpdateOne) SetEncryptedKey(b []byte) *DataKeyUpdateOne {
+	dkuo.mutation.SetEncryptedKey(b)
+	return dkuo
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (dkuo *DataKeyUpdateOne) SetMasterKeyID(s string) *DataKeyUpdateOne {
+	dkuo.mutation.SetMasterKeyID(s)
+	return dkuo
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (dkuo *DataKeyUpdateOne) SetActiveUserID(u uuid.UUID) *DataKeyUpdateOne {
+	dkuo.mutation.SetActiveUserID(u)
+	return dkuo
+}
+
+// SetNillableActiveUserID sets the "active_user_id" field if the given value is not nil.
+func (dkuo *DataKeyUpdateOne) SetNillableActiveUserID(u *uuid.UUID) *DataKeyUpdateOne {
+	if u != nil {
+		dkuo.SetActiveUserID(*u)
+	}
+	return dkuo
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (dkuo *DataKeyUpdateOne) ClearActiveUserID() *DataKeyUpdateOne {
+	dkuo.mutation.ClearActiveUserID()
+	return dkuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dkuo *DataKeyUpdateOne) SetCreatedAt(t time.Time) *DataKeyUpdateOne {
+	dkuo.mutation.SetCreatedAt(t)
+	return dkuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dkuo *DataKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *DataKeyUpdateOne {
+	if t != nil {
+		dkuo.SetCreatedAt(*t)
+	}
+	return dkuo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (dkuo *DataKeyUpdateOne) SetRotatedAt(t time.Time) *DataKeyUpdateOne {
+	dkuo.mutation.SetRotatedAt(t)
+	return dkuo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (dkuo *DataKeyUpdateOne) SetNillableRotatedAt(t *time.Time) *DataKeyUpdateOne {
+	if t != nil {
+		dkuo.SetRotatedAt(*t)
+	}
+	return dkuo
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (dkuo *DataKeyUpdateOne) ClearRotatedAt() *DataKeyUpdateOne {
+	dkuo.mutation.ClearRotatedAt()
+	return dkuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dkuo *DataKeyUpdateOne) SetUserID(id uuid.UUID) *DataKeyUpdateOne {
+	dkuo.mutation.SetUserID(id)
+	return dkuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dkuo *DataKeyUpdateOne) SetUser(u *User) *DataKeyUpdateOne {
+	return dkuo.SetUserID(u.ID)
+}
+
+// Mutation returns the DataKeyMutation object of the builder.
+func (dkuo *DataKeyUpdateOne) Mutation() *DataKeyMutation {
+	return dkuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dkuo *DataKeyUpdateOne) ClearUser() *DataKeyUpdateOne {
+	dkuo.mutation.ClearUser()
+	return dkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dkuo *DataKeyUpdateOne) Select(field string, fields ...string) *DataKeyUpdateOne {
+	dkuo.fields = append([]string{field}, fields...)
+	return dkuo
+}
+
+// Save executes the query and returns the updated DataKey entity.
+func (dkuo *DataKeyUpdateOne) Save(ctx context.Context) (*DataKey, error) {
+	var (
+		err  error
+		node *DataKey
+	)
+	if len(dkuo.hooks) == 0 {
+		if err = dkuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = dkuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DataKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dkuo.check(); err != nil {
+				return nil, err
+			}
+			dkuo.mutation = mutation
+			node, err = dkuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dkuo.hooks) - 1; i >= 0; i-- {
+			if dkuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dkuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dkuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dkuo *DataKeyUpdateOne) SaveX(ctx context.Context) *DataKey {
+	node, err := dkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dkuo *DataKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := dkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dkuo *DataKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := dkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dkuo *DataKeyUpdateOne) check() error {
+	if _, ok := dkuo.mutation.UserID(); dkuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "DataKey.user"`)
+	}
+	return nil
+}
+
+func (dkuo *DataKeyUpdateOne) sqlSave(ctx context.Context) (_node *DataKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   datakey.Table,
+			Columns: datakey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: datakey.FieldID,
+			},
+		},
+	}
+	id, ok := dkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datakey.FieldID)
+		for _, f := range fields {
+			if !datakey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != datakey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dkuo.mutation.EncryptedKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: datakey.FieldEncryptedKey,
+		})
+	}
+	if value, ok := dkuo.mutation.MasterKeyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: datakey.FieldMasterKeyID,
+		})
+	}
+	if value, ok := dkuo.mutation.ActiveUserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: datakey.FieldActiveUserID,
+		})
+	}
+	if dkuo.mutation.ActiveUserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: datakey.FieldActiveUserID,
+		})
+	}
+	if value, ok := dkuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldCreatedAt,
+		})
+	}
+	if value, ok := dkuo.mutation.RotatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: datakey.FieldRotatedAt,
+		})
+	}
+	if dkuo.mutation.RotatedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: datakey.FieldRotatedAt,
+		})
+	}
+	if dkuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   datakey.UserTable,
+			Columns: []string{datakey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dkuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   datakey.UserTable,
+			Columns: []string{datakey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DataKey{config: dkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datakey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
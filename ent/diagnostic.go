@@ -0,0 +1,207 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+// Diagnostic is the model entity for the Diagnostic schema.
+type Diagnostic struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity diagnostic.Severity `json:"severity,omitempty"`
+	// File holds the value of the "file" field.
+	File string `json:"file,omitempty"`
+	// Line holds the value of the "line" field.
+	Line int `json:"line,omitempty"`
+	// Column holds the value of the "column" field.
+	Column int `json:"column,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiagnosticQuery when eager-loading is set.
+	Edges                  DiagnosticEdges `json:"edges"`
+	submission_diagnostics *uuid.UUID
+}
+
+// DiagnosticEdges holds the relations/edges for other nodes in the graph.
+type DiagnosticEdges struct {
+	// Submission holds the value of the submission edge.
+	Submission *Submission `json:"submission,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubmissionOrErr returns the Submission value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiagnosticEdges) SubmissionOrErr() (*Submission, error) {
+	if e.loadedTypes[0] {
+		if e.Submission == nil {
+			// The edge submission was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: submission.Label}
+		}
+		return e.Submission, nil
+	}
+	return nil, &NotLoadedError{edge: "submission"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Diagnostic) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case diagnostic.FieldLine, diagnostic.FieldColumn:
+			values[i] = new(sql.NullInt64)
+		case diagnostic.FieldSeverity, diagnostic.FieldFile, diagnostic.FieldCode, diagnostic.FieldMessage:
+			values[i] = new(sql.NullString)
+		case diagnostic.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case diagnostic.FieldID:
+			values[i] = new(uuid.UUID)
+		case diagnostic.ForeignKeys[0]: // submission_diagnostics
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Diagnostic", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Diagnostic fields.
+func (d *Diagnostic) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case diagnostic.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case diagnostic.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				d.Severity = diagnostic.Severity(value.String)
+			}
+		case diagnostic.FieldFile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file", values[i])
+			} else if value.Valid {
+				d.File = value.String
+			}
+		case diagnostic.FieldLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line", values[i])
+			} else if value.Valid {
+				d.Line = int(value.Int64)
+			}
+		case diagnostic.FieldColumn:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field column", values[i])
+			} else if value.Valid {
+				d.Column = int(value.Int64)
+			}
+		case diagnostic.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				d.Code = value.String
+			}
+		case diagnostic.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				d.Message = value.String
+			}
+		case diagnostic.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case diagnostic.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_diagnostics", values[i])
+			} else if value.Valid {
+				d.submission_diagnostics = new(uuid.UUID)
+				*d.submission_diagnostics = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QuerySubmission queries the "submission" edge of the Diagnostic entity.
+func (d *Diagnostic) QuerySubmission() *SubmissionQuery {
+	return (&DiagnosticClient{config: d.config}).QuerySubmission(d)
+}
+
+// Update returns a builder for updating this Diagnostic.
+// Note that you need to call Diagnostic.Unwrap() before calling this method if this Diagnostic
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Diagnostic) Update() *DiagnosticUpdateOne {
+	return (&DiagnosticClient{config: d.config}).UpdateOne(d)
+}
+
+// Unwrap unwraps the Diagnostic entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Diagnostic) Unwrap() *Diagnostic {
+	tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Diagnostic is not a transactional entity")
+	}
+	d.config.driver = tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Diagnostic) String() string {
+	var builder strings.Builder
+	builder.WriteString("Diagnostic(")
+	builder.WriteString(fmt.Sprintf("id=%v", d.ID))
+	builder.WriteString(", severity=")
+	builder.WriteString(fmt.Sprintf("%v", d.Severity))
+	builder.WriteString(", file=")
+	builder.WriteString(d.File)
+	builder.WriteString(", line=")
+	builder.WriteString(fmt.Sprintf("%v", d.Line))
+	builder.WriteString(", column=")
+	builder.WriteString(fmt.Sprintf("%v", d.Column))
+	builder.WriteString(", code=")
+	builder.WriteString(d.Code)
+	builder.WriteString(", message=")
+	builder.WriteString(d.Message)
+	builder.WriteString(", created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Diagnostics is a parsable slice of Diagnostic.
+type Diagnostics []*Diagnostic
+
+func (d Diagnostics) config(cfg config) {
+	for _i := range d {
+		d[_i].config = cfg
+	}
+}
@@ -0,0 +1,106 @@
+// Code generated by entc, DO NOT EDIT.
+
+package diagnostic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the diagnostic type in the database.
+	Label = "diagnostic"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldFile holds the string denoting the file field in the database.
+	FieldFile = "file"
+	// FieldLine holds the string denoting the line field in the database.
+	FieldLine = "line"
+	// FieldColumn holds the string denoting the column field in the database.
+	FieldColumn = "column"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeSubmission holds the string denoting the submission edge name in mutations.
+	EdgeSubmission = "submission"
+	// Table holds the table name of the diagnostic in the database.
+	Table = "diagnostics"
+	// SubmissionTable is the table that holds the submission relation/edge.
+	SubmissionTable = "diagnostics"
+	// SubmissionInverseTable is the table name for the Submission entity.
+	// It exists in this package in order to avoid circular dependency with the "submission" package.
+	SubmissionInverseTable = "submissions"
+	// SubmissionColumn is the table column denoting the submission relation/edge.
+	SubmissionColumn = "submission_diagnostics"
+)
+
+// Columns holds all SQL columns for diagnostic fields.
+var Columns = []string{
+	FieldID,
+	FieldSeverity,
+	FieldFile,
+	FieldLine,
+	FieldColumn,
+	FieldCode,
+	FieldMessage,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "diagnostics"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"submission_diagnostics",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Severity defines the type for the "severity" enum field.
+type Severity string
+
+// Severity values.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+// SeverityValidator is a validator for the "severity" field enum values. It is called by the builders before save.
+func SeverityValidator(s Severity) error {
+	switch s {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return nil
+	default:
+		return fmt.Errorf("diagnostic: invalid enum value for severity field: %q", s)
+	}
+}
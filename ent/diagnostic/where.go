@@ -0,0 +1,862 @@
+// Code generated by entc, DO NOT EDIT.
+
+package diagnostic
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// File applies equality check predicate on the "file" field. It's identical to FileEQ.
+func File(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFile), v))
+	})
+}
+
+// Line applies equality check predicate on the "line" field. It's identical to LineEQ.
+func Line(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLine), v))
+	})
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldColumn), v))
+	})
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMessage), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v Severity) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v Severity) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeverity), v))
+	})
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...Severity) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeverity), v...))
+	})
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...Severity) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeverity), v...))
+	})
+}
+
+// FileEQ applies the EQ predicate on the "file" field.
+func FileEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFile), v))
+	})
+}
+
+// FileNEQ applies the NEQ predicate on the "file" field.
+func FileNEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFile), v))
+	})
+}
+
+// FileIn applies the In predicate on the "file" field.
+func FileIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFile), v...))
+	})
+}
+
+// FileNotIn applies the NotIn predicate on the "file" field.
+func FileNotIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFile), v...))
+	})
+}
+
+// FileGT applies the GT predicate on the "file" field.
+func FileGT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFile), v))
+	})
+}
+
+// FileGTE applies the GTE predicate on the "file" field.
+func FileGTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFile), v))
+	})
+}
+
+// FileLT applies the LT predicate on the "file" field.
+func FileLT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFile), v))
+	})
+}
+
+// FileLTE applies the LTE predicate on the "file" field.
+func FileLTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFile), v))
+	})
+}
+
+// FileContains applies the Contains predicate on the "file" field.
+func FileContains(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFile), v))
+	})
+}
+
+// FileHasPrefix applies the HasPrefix predicate on the "file" field.
+func FileHasPrefix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFile), v))
+	})
+}
+
+// FileHasSuffix applies the HasSuffix predicate on the "file" field.
+func FileHasSuffix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFile), v))
+	})
+}
+
+// FileIsNil applies the IsNil predicate on the "file" field.
+func FileIsNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFile)))
+	})
+}
+
+// FileNotNil applies the NotNil predicate on the "file" field.
+func FileNotNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFile)))
+	})
+}
+
+// FileEqualFold applies the EqualFold predicate on the "file" field.
+func FileEqualFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFile), v))
+	})
+}
+
+// FileContainsFold applies the ContainsFold predicate on the "file" field.
+func FileContainsFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFile), v))
+	})
+}
+
+// LineEQ applies the EQ predicate on the "line" field.
+func LineEQ(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLine), v))
+	})
+}
+
+// LineNEQ applies the NEQ predicate on the "line" field.
+func LineNEQ(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLine), v))
+	})
+}
+
+// LineIn applies the In predicate on the "line" field.
+func LineIn(vs ...int) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLine), v...))
+	})
+}
+
+// LineNotIn applies the NotIn predicate on the "line" field.
+func LineNotIn(vs ...int) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLine), v...))
+	})
+}
+
+// LineGT applies the GT predicate on the "line" field.
+func LineGT(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLine), v))
+	})
+}
+
+// LineGTE applies the GTE predicate on the "line" field.
+func LineGTE(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLine), v))
+	})
+}
+
+// LineLT applies the LT predicate on the "line" field.
+func LineLT(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLine), v))
+	})
+}
+
+// LineLTE applies the LTE predicate on the "line" field.
+func LineLTE(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLine), v))
+	})
+}
+
+// LineIsNil applies the IsNil predicate on the "line" field.
+func LineIsNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLine)))
+	})
+}
+
+// LineNotNil applies the NotNil predicate on the "line" field.
+func LineNotNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLine)))
+	})
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...int) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldColumn), v...))
+	})
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...int) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldColumn), v...))
+	})
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v int) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldColumn), v))
+	})
+}
+
+// ColumnIsNil applies the IsNil predicate on the "column" field.
+func ColumnIsNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldColumn)))
+	})
+}
+
+// ColumnNotNil applies the NotNil predicate on the "column" field.
+func ColumnNotNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldColumn)))
+	})
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCode), v...))
+	})
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCode), v...))
+	})
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCode), v))
+	})
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCode), v))
+	})
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCode), v))
+	})
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCode)))
+	})
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCode)))
+	})
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCode), v))
+	})
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCode), v))
+	})
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMessage), v))
+	})
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMessage), v))
+	})
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMessage), v...))
+	})
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMessage), v...))
+	})
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMessage), v))
+	})
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMessage), v))
+	})
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMessage), v))
+	})
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMessage), v))
+	})
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMessage), v))
+	})
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMessage), v))
+	})
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMessage), v))
+	})
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMessage), v))
+	})
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMessage), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Diagnostic {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasSubmission applies the HasEdge predicate on the "submission" edge.
+func HasSubmission() predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SubmissionTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubmissionTable, SubmissionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubmissionWith applies the HasEdge predicate on the "submission" edge with a given conditions (other predicates).
+func HasSubmissionWith(preds ...predicate.Submission) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SubmissionInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubmissionTable, SubmissionColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Diagnostic) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Diagnostic) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Diagnostic) predicate.Diagnostic {
+	return predicate.Diagnostic(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,1086 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+// DiagnosticCreate is the builder for creating a Diagnostic entity.
+type DiagnosticCreate struct {
+	config
+	mutation *DiagnosticMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSeverity sets the "severity" field.
+func (dc *DiagnosticCreate) SetSeverity(d diagnostic.Severity) *DiagnosticCreate {
+	dc.mutation.SetSeverity(d)
+	return dc
+}
+
+// SetFile sets the "file" field.
+func (dc *DiagnosticCreate) SetFile(s string) *DiagnosticCreate {
+	dc.mutation.SetFile(s)
+	return dc
+}
+
+// SetNillableFile sets the "file" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableFile(s *string) *DiagnosticCreate {
+	if s != nil {
+		dc.SetFile(*s)
+	}
+	return dc
+}
+
+// SetLine sets the "line" field.
+func (dc *DiagnosticCreate) SetLine(i int) *DiagnosticCreate {
+	dc.mutation.SetLine(i)
+	return dc
+}
+
+// SetNillableLine sets the "line" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableLine(i *int) *DiagnosticCreate {
+	if i != nil {
+		dc.SetLine(*i)
+	}
+	return dc
+}
+
+// SetColumn sets the "column" field.
+func (dc *DiagnosticCreate) SetColumn(i int) *DiagnosticCreate {
+	dc.mutation.SetColumn(i)
+	return dc
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableColumn(i *int) *DiagnosticCreate {
+	if i != nil {
+		dc.SetColumn(*i)
+	}
+	return dc
+}
+
+// SetCode sets the "code" field.
+func (dc *DiagnosticCreate) SetCode(s string) *DiagnosticCreate {
+	dc.mutation.SetCode(s)
+	return dc
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableCode(s *string) *DiagnosticCreate {
+	if s != nil {
+		dc.SetCode(*s)
+	}
+	return dc
+}
+
+// SetMessage sets the "message" field.
+func (dc *DiagnosticCreate) SetMessage(s string) *DiagnosticCreate {
+	dc.mutation.SetMessage(s)
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DiagnosticCreate) SetCreatedAt(t time.Time) *DiagnosticCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableCreatedAt(t *time.Time) *DiagnosticCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DiagnosticCreate) SetID(u uuid.UUID) *DiagnosticCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DiagnosticCreate) SetNillableID(u *uuid.UUID) *DiagnosticCreate {
+	if u != nil {
+		dc.SetID(*u)
+	}
+	return dc
+}
+
+// SetSubmissionID sets the "submission" edge to the Submission entity by ID.
+func (dc *DiagnosticCreate) SetSubmissionID(id uuid.UUID) *DiagnosticCreate {
+	dc.mutation.SetSubmissionID(id)
+	return dc
+}
+
+// SetSubmission sets the "submission" edge to the Submission entity.
+func (dc *DiagnosticCreate) SetSubmission(s *Submission) *DiagnosticCreate {
+	return dc.SetSubmissionID(s.ID)
+}
+
+// Mutation returns the DiagnosticMutation object of the builder.
+func (dc *DiagnosticCreate) Mutation() *DiagnosticMutation {
+	return dc.mutation
+}
+
+// Save creates the Diagnostic in the database.
+func (dc *DiagnosticCreate) Save(ctx context.Context) (*Diagnostic, error) {
+	var (
+		err  error
+		node *Diagnostic
+	)
+	dc.defaults()
+	if len(dc.hooks) == 0 {
+		if err = dc.check(); err != nil {
+			return nil, err
+		}
+		node, err = dc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DiagnosticMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dc.check(); err != nil {
+				return nil, err
+			}
+			dc.mutation = mutation
+			if node, err = dc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dc.hooks) - 1; i >= 0; i-- {
+			if dc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DiagnosticCreate) SaveX(ctx context.Context) *Diagnostic {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DiagnosticCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DiagnosticCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DiagnosticCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := diagnostic.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := diagnostic.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DiagnosticCreate) check() error {
+	if _, ok := dc.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "Diagnostic.severity"`)}
+	}
+	if v, ok := dc.mutation.Severity(); ok {
+		if err := diagnostic.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "Diagnostic.severity": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "Diagnostic.message"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Diagnostic.created_at"`)}
+	}
+	if _, ok := dc.mutation.SubmissionID(); !ok {
+		return &ValidationError{Name: "submission", err: errors.New(`ent: missing required edge "Diagnostic.submission"`)}
+	}
+	return nil
+}
+
+func (dc *DiagnosticCreate) sqlSave(ctx context.Context) (*Diagnostic, error) {
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (dc *DiagnosticCreate) createSpec() (*Diagnostic, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Diagnostic{config: dc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: diagnostic.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: diagnostic.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = dc.conflict
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dc.mutation.Severity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: diagnostic.FieldSeverity,
+		})
+		_node.Severity = value
+	}
+	if value, ok := dc.mutation.File(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldFile,
+		})
+		_node.File = value
+	}
+	if value, ok := dc.mutation.Line(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldLine,
+		})
+		_node.Line = value
+	}
+	if value, ok := dc.mutation.Column(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldColumn,
+		})
+		_node.Column = value
+	}
+	if value, ok := dc.mutation.Code(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldCode,
+		})
+		_node.Code = value
+	}
+	if value, ok := dc.mutation.Message(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldMessage,
+		})
+		_node.Message = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: diagnostic.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := dc.mutation.SubmissionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   diagnostic.SubmissionTable,
+			Columns: []string{diagnostic.SubmissionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.submission_diagnostics = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Diagnostic.Create().
+//		SetSeverity(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiagnosticUpsert) {
+//			SetSeverity(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (dc *DiagnosticCreate) OnConflict(opts ...sql.ConflictOption) *DiagnosticUpsertOne {
+	dc.conflict = opts
+	return &DiagnosticUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Diagnostic.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (dc *DiagnosticCreate) OnConflictColumns(columns ...string) *DiagnosticUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DiagnosticUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DiagnosticUpsertOne is the builder for "upsert"-ing
+	//  one Diagnostic node.
+	DiagnosticUpsertOne struct {
+		create *DiagnosticCreate
+	}
+
+	// DiagnosticUpsert is the "OnConflict" setter.
+	DiagnosticUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSeverity sets the "severity" field.
+func (u *DiagnosticUpsert) SetSeverity(v diagnostic.Severity) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldSeverity, v)
+	return u
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateSeverity() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldSeverity)
+	return u
+}
+
+// SetFile sets the "file" field.
+func (u *DiagnosticUpsert) SetFile(v string) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldFile, v)
+	return u
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateFile() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldFile)
+	return u
+}
+
+// ClearFile clears the value of the "file" field.
+func (u *DiagnosticUpsert) ClearFile() *DiagnosticUpsert {
+	u.SetNull(diagnostic.FieldFile)
+	return u
+}
+
+// SetLine sets the "line" field.
+func (u *DiagnosticUpsert) SetLine(v int) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldLine, v)
+	return u
+}
+
+// UpdateLine sets the "line" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateLine() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldLine)
+	return u
+}
+
+// AddLine adds v to the "line" field.
+func (u *DiagnosticUpsert) AddLine(v int) *DiagnosticUpsert {
+	u.Add(diagnostic.FieldLine, v)
+	return u
+}
+
+// ClearLine clears the value of the "line" field.
+func (u *DiagnosticUpsert) ClearLine() *DiagnosticUpsert {
+	u.SetNull(diagnostic.FieldLine)
+	return u
+}
+
+// SetColumn sets the "column" field.
+func (u *DiagnosticUpsert) SetColumn(v int) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldColumn, v)
+	return u
+}
+
+// UpdateColumn sets the "column" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateColumn() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldColumn)
+	return u
+}
+
+// AddColumn adds v to the "column" field.
+func (u *DiagnosticUpsert) AddColumn(v int) *DiagnosticUpsert {
+	u.Add(diagnostic.FieldColumn, v)
+	return u
+}
+
+// ClearColumn clears the value of the "column" field.
+func (u *DiagnosticUpsert) ClearColumn() *DiagnosticUpsert {
+	u.SetNull(diagnostic.FieldColumn)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *DiagnosticUpsert) SetCode(v string) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateCode() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldCode)
+	return u
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiagnosticUpsert) ClearCode() *DiagnosticUpsert {
+	u.SetNull(diagnostic.FieldCode)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *DiagnosticUpsert) SetMessage(v string) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateMessage() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldMessage)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DiagnosticUpsert) SetCreatedAt(v time.Time) *DiagnosticUpsert {
+	u.Set(diagnostic.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DiagnosticUpsert) UpdateCreatedAt() *DiagnosticUpsert {
+	u.SetExcluded(diagnostic.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Diagnostic.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(diagnostic.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *DiagnosticUpsertOne) UpdateNewValues() *DiagnosticUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(diagnostic.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Diagnostic.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *DiagnosticUpsertOne) Ignore() *DiagnosticUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiagnosticUpsertOne) DoNothing() *DiagnosticUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiagnosticCreate.OnConflict
+// documentation for more info.
+func (u *DiagnosticUpsertOne) Update(set func(*DiagnosticUpsert)) *DiagnosticUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiagnosticUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSeverity sets the "severity" field.
+func (u *DiagnosticUpsertOne) SetSeverity(v diagnostic.Severity) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateSeverity() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// SetFile sets the "file" field.
+func (u *DiagnosticUpsertOne) SetFile(v string) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetFile(v)
+	})
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateFile() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateFile()
+	})
+}
+
+// ClearFile clears the value of the "file" field.
+func (u *DiagnosticUpsertOne) ClearFile() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearFile()
+	})
+}
+
+// SetLine sets the "line" field.
+func (u *DiagnosticUpsertOne) SetLine(v int) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetLine(v)
+	})
+}
+
+// AddLine adds v to the "line" field.
+func (u *DiagnosticUpsertOne) AddLine(v int) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.AddLine(v)
+	})
+}
+
+// UpdateLine sets the "line" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateLine() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateLine()
+	})
+}
+
+// ClearLine clears the value of the "line" field.
+func (u *DiagnosticUpsertOne) ClearLine() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearLine()
+	})
+}
+
+// SetColumn sets the "column" field.
+func (u *DiagnosticUpsertOne) SetColumn(v int) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetColumn(v)
+	})
+}
+
+// AddColumn adds v to the "column" field.
+func (u *DiagnosticUpsertOne) AddColumn(v int) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.AddColumn(v)
+	})
+}
+
+// UpdateColumn sets the "column" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateColumn() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateColumn()
+	})
+}
+
+// ClearColumn clears the value of the "column" field.
+func (u *DiagnosticUpsertOne) ClearColumn() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearColumn()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *DiagnosticUpsertOne) SetCode(v string) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateCode() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiagnosticUpsertOne) ClearCode() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DiagnosticUpsertOne) SetMessage(v string) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateMessage() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DiagnosticUpsertOne) SetCreatedAt(v time.Time) *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DiagnosticUpsertOne) UpdateCreatedAt() *DiagnosticUpsertOne {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiagnosticUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiagnosticCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiagnosticUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiagnosticUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiagnosticUpsertOne.ID is not supported by MySQL driver. Use DiagnosticUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiagnosticUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiagnosticCreateBulk is the builder for creating many Diagnostic entities in bulk.
+type DiagnosticCreateBulk struct {
+	config
+	builders []*DiagnosticCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Diagnostic entities in the database.
+func (dcb *DiagnosticCreateBulk) Save(ctx context.Context) ([]*Diagnostic, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Diagnostic, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiagnosticMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DiagnosticCreateBulk) SaveX(ctx context.Context) []*Diagnostic {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DiagnosticCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DiagnosticCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Diagnostic.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiagnosticUpsert) {
+//			SetSeverity(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (dcb *DiagnosticCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiagnosticUpsertBulk {
+	dcb.conflict = opts
+	return &DiagnosticUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Diagnostic.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (dcb *DiagnosticCreateBulk) OnConflictColumns(columns ...string) *DiagnosticUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DiagnosticUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DiagnosticUpsertBulk is the builder for "upsert"-ing
+// a bulk of Diagnostic nodes.
+type DiagnosticUpsertBulk struct {
+	create *DiagnosticCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Diagnostic.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(diagnostic.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *DiagnosticUpsertBulk) UpdateNewValues() *DiagnosticUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(diagnostic.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Diagnostic.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *DiagnosticUpsertBulk) Ignore() *DiagnosticUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiagnosticUpsertBulk) DoNothing() *DiagnosticUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiagnosticCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiagnosticUpsertBulk) Update(set func(*DiagnosticUpsert)) *DiagnosticUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiagnosticUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSeverity sets the "severity" field.
+func (u *DiagnosticUpsertBulk) SetSeverity(v diagnostic.Severity) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateSeverity() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// SetFile sets the "file" field.
+func (u *DiagnosticUpsertBulk) SetFile(v string) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetFile(v)
+	})
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateFile() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateFile()
+	})
+}
+
+// ClearFile clears the value of the "file" field.
+func (u *DiagnosticUpsertBulk) ClearFile() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearFile()
+	})
+}
+
+// SetLine sets the "line" field.
+func (u *DiagnosticUpsertBulk) SetLine(v int) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetLine(v)
+	})
+}
+
+// AddLine adds v to the "line" field.
+func (u *DiagnosticUpsertBulk) AddLine(v int) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.AddLine(v)
+	})
+}
+
+// UpdateLine sets the "line" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateLine() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateLine()
+	})
+}
+
+// ClearLine clears the value of the "line" field.
+func (u *DiagnosticUpsertBulk) ClearLine() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearLine()
+	})
+}
+
+// SetColumn sets the "column" field.
+func (u *DiagnosticUpsertBulk) SetColumn(v int) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetColumn(v)
+	})
+}
+
+// AddColumn adds v to the "column" field.
+func (u *DiagnosticUpsertBulk) AddColumn(v int) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.AddColumn(v)
+	})
+}
+
+// UpdateColumn sets the "column" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateColumn() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateColumn()
+	})
+}
+
+// ClearColumn clears the value of the "column" field.
+func (u *DiagnosticUpsertBulk) ClearColumn() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearColumn()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *DiagnosticUpsertBulk) SetCode(v string) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateCode() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DiagnosticUpsertBulk) ClearCode() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DiagnosticUpsertBulk) SetMessage(v string) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateMessage() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DiagnosticUpsertBulk) SetCreatedAt(v time.Time) *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DiagnosticUpsertBulk) UpdateCreatedAt() *DiagnosticUpsertBulk {
+	return u.Update(func(s *DiagnosticUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiagnosticUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiagnosticCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiagnosticCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiagnosticUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
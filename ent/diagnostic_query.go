@@ -0,0 +1,1023 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+// DiagnosticQuery is the builder for querying Diagnostic entities.
+type DiagnosticQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Diagnostic
+	// eager-loading edges.
+	withSubmission *SubmissionQuery
+	withFKs        bool
+	modifiers      []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DiagnosticQuery builder.
+func (dq *DiagnosticQuery) Where(ps ...predicate.Diagnostic) *DiagnosticQuery {
+	dq.predicates = append(dq.predicates, ps...)
+	return dq
+}
+
+// Limit adds a limit step to the query.
+func (dq *DiagnosticQuery) Limit(limit int) *DiagnosticQuery {
+	dq.limit = &limit
+	return dq
+}
+
+// Offset adds an offset step to the query.
+func (dq *DiagnosticQuery) Offset(offset int) *DiagnosticQuery {
+	dq.offset = &offset
+	return dq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dq *DiagnosticQuery) Unique(unique bool) *DiagnosticQuery {
+	dq.unique = &unique
+	return dq
+}
+
+// Order adds an order step to the query.
+func (dq *DiagnosticQuery) Order(o ...OrderFunc) *DiagnosticQuery {
+	dq.order = append(dq.order, o...)
+	return dq
+}
+
+// QuerySubmission chains the current query on the "submission" edge.
+func (dq *DiagnosticQuery) QuerySubmission() *SubmissionQuery {
+	query := &SubmissionQuery{config: dq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(diagnostic.Table, diagnostic.FieldID, selector),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, diagnostic.SubmissionTable, diagnostic.SubmissionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Diagnostic entity from the query.
+// Returns a *NotFoundError when no Diagnostic was found.
+func (dq *DiagnosticQuery) First(ctx context.Context) (*Diagnostic, error) {
+	nodes, err := dq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{diagnostic.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dq *DiagnosticQuery) FirstX(ctx context.Context) *Diagnostic {
+	node, err := dq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Diagnostic ID from the query.
+// Returns a *NotFoundError when no Diagnostic ID was found.
+func (dq *DiagnosticQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{diagnostic.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dq *DiagnosticQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Diagnostic entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Diagnostic entity is found.
+// Returns a *NotFoundError when no Diagnostic entities are found.
+func (dq *DiagnosticQuery) Only(ctx context.Context) (*Diagnostic, error) {
+	nodes, err := dq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{diagnostic.Label}
+	default:
+		return nil, &NotSingularError{diagnostic.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dq *DiagnosticQuery) OnlyX(ctx context.Context) *Diagnostic {
+	node, err := dq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Diagnostic ID in the query.
+// Returns a *NotSingularError when more than one Diagnostic ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dq *DiagnosticQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = &NotSingularError{diagnostic.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dq *DiagnosticQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Diagnostics.
+func (dq *DiagnosticQuery) All(ctx context.Context) ([]*Diagnostic, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return dq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dq *DiagnosticQuery) AllX(ctx context.Context) []*Diagnostic {
+	nodes, err := dq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Diagnostic IDs.
+func (dq *DiagnosticQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := dq.Select(diagnostic.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dq *DiagnosticQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dq *DiagnosticQuery) Count(ctx context.Context) (int, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return dq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dq *DiagnosticQuery) CountX(ctx context.Context) int {
+	count, err := dq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dq *DiagnosticQuery) Exist(ctx context.Context) (bool, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return dq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dq *DiagnosticQuery) ExistX(ctx context.Context) bool {
+	exist, err := dq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DiagnosticQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dq *DiagnosticQuery) Clone() *DiagnosticQuery {
+	if dq == nil {
+		return nil
+	}
+	return &DiagnosticQuery{
+		config:         dq.config,
+		limit:          dq.limit,
+		offset:         dq.offset,
+		order:          append([]OrderFunc{}, dq.order...),
+		predicates:     append([]predicate.Diagnostic{}, dq.predicates...),
+		withSubmission: dq.withSubmission.Clone(),
+		// clone intermediate query.
+		sql:    dq.sql.Clone(),
+		path:   dq.path,
+		unique: dq.unique,
+	}
+}
+
+// WithSubmission tells the query-builder to eager-load the nodes that are connected to
+// the "submission" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DiagnosticQuery) WithSubmission(opts ...func(*SubmissionQuery)) *DiagnosticQuery {
+	query := &SubmissionQuery{config: dq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSubmission = query
+	return dq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Severity diagnostic.Severity `json:"severity,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Diagnostic.Query().
+//		GroupBy(diagnostic.FieldSeverity).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (dq *DiagnosticQuery) GroupBy(field string, fields ...string) *DiagnosticGroupBy {
+	group := &DiagnosticGroupBy{config: dq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return dq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Severity diagnostic.Severity `json:"severity,omitempty"`
+//	}
+//
+//	client.Diagnostic.Query().
+//		Select(diagnostic.FieldSeverity).
+//		Scan(ctx, &v)
+//
+func (dq *DiagnosticQuery) Select(fields ...string) *DiagnosticSelect {
+	dq.fields = append(dq.fields, fields...)
+	return &DiagnosticSelect{DiagnosticQuery: dq}
+}
+
+func (dq *DiagnosticQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range dq.fields {
+		if !diagnostic.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dq.path != nil {
+		prev, err := dq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dq.sql = prev
+	}
+	return nil
+}
+
+func (dq *DiagnosticQuery) sqlAll(ctx context.Context) ([]*Diagnostic, error) {
+	var (
+		nodes       = []*Diagnostic{}
+		withFKs     = dq.withFKs
+		_spec       = dq.querySpec()
+		loadedTypes = [1]bool{
+			dq.withSubmission != nil,
+		}
+	)
+	if dq.withSubmission != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, diagnostic.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Diagnostic{config: dq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := dq.withSubmission; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*Diagnostic)
+		for i := range nodes {
+			if nodes[i].submission_diagnostics == nil {
+				continue
+			}
+			fk := *nodes[i].submission_diagnostics
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(submission.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "submission_diagnostics" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Submission = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (dq *DiagnosticQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dq.querySpec()
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
+	_spec.Node.Columns = dq.fields
+	if len(dq.fields) > 0 {
+		_spec.Unique = dq.unique != nil && *dq.unique
+	}
+	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+}
+
+func (dq *DiagnosticQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := dq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (dq *DiagnosticQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   diagnostic.Table,
+			Columns: diagnostic.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: diagnostic.FieldID,
+			},
+		},
+		From:   dq.sql,
+		Unique: true,
+	}
+	if unique := dq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := dq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, diagnostic.FieldID)
+		for i := range fields {
+			if fields[i] != diagnostic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dq *DiagnosticQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dq.driver.Dialect())
+	t1 := builder.Table(diagnostic.Table)
+	columns := dq.fields
+	if len(columns) == 0 {
+		columns = diagnostic.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dq.sql != nil {
+		selector = dq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dq.unique != nil && *dq.unique {
+		selector.Distinct()
+	}
+	for _, m := range dq.modifiers {
+		m(selector)
+	}
+	for _, p := range dq.predicates {
+		p(selector)
+	}
+	for _, p := range dq.order {
+		p(selector)
+	}
+	if offset := dq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dq *DiagnosticQuery) Modify(modifiers ...func(s *sql.Selector)) *DiagnosticSelect {
+	dq.modifiers = append(dq.modifiers, modifiers...)
+	return dq.Select()
+}
+
+// DiagnosticGroupBy is the group-by builder for Diagnostic entities.
+type DiagnosticGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dgb *DiagnosticGroupBy) Aggregate(fns ...AggregateFunc) *DiagnosticGroupBy {
+	dgb.fns = append(dgb.fns, fns...)
+	return dgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (dgb *DiagnosticGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := dgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	dgb.sql = query
+	return dgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := dgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) StringsX(ctx context.Context) []string {
+	v, err := dgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = dgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) StringX(ctx context.Context) string {
+	v, err := dgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) IntsX(ctx context.Context) []int {
+	v, err := dgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = dgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) IntX(ctx context.Context) int {
+	v, err := dgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := dgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = dgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := dgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := dgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (dgb *DiagnosticGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = dgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (dgb *DiagnosticGroupBy) BoolX(ctx context.Context) bool {
+	v, err := dgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (dgb *DiagnosticGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range dgb.fields {
+		if !diagnostic.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := dgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (dgb *DiagnosticGroupBy) sqlQuery() *sql.Selector {
+	selector := dgb.sql.Select()
+	aggregation := make([]string, 0, len(dgb.fns))
+	for _, fn := range dgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(dgb.fields)+len(dgb.fns))
+		for _, f := range dgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(dgb.fields...)...)
+}
+
+// DiagnosticSelect is the builder for selecting fields of Diagnostic entities.
+type DiagnosticSelect struct {
+	*DiagnosticQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ds *DiagnosticSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ds.sql = ds.DiagnosticQuery.sqlQuery(ctx)
+	return ds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ds *DiagnosticSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ds *DiagnosticSelect) StringsX(ctx context.Context) []string {
+	v, err := ds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ds.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ds *DiagnosticSelect) StringX(ctx context.Context) string {
+	v, err := ds.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ds *DiagnosticSelect) IntsX(ctx context.Context) []int {
+	v, err := ds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ds.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ds *DiagnosticSelect) IntX(ctx context.Context) int {
+	v, err := ds.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ds *DiagnosticSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ds.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ds *DiagnosticSelect) Float64X(ctx context.Context) float64 {
+	v, err := ds.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DiagnosticSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ds *DiagnosticSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ds *DiagnosticSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ds.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{diagnostic.Label}
+	default:
+		err = fmt.Errorf("ent: DiagnosticSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ds *DiagnosticSelect) BoolX(ctx context.Context) bool {
+	v, err := ds.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ds *DiagnosticSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ds.sql.Query()
+	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ds *DiagnosticSelect) Modify(modifiers ...func(s *sql.Selector)) *DiagnosticSelect {
+	ds.modifiers = append(ds.modifiers, modifiers...)
+	return ds
+}
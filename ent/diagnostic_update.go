@@ -0,0 +1,798 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+// DiagnosticUpdate is the builder for updating Diagnostic entities.
+type DiagnosticUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiagnosticMutation
+}
+
+// Where appends a list predicates to the DiagnosticUpdate builder.
+func (du *DiagnosticUpdate) Where(ps ...predicate.Diagnostic) *DiagnosticUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetSeverity sets the "severity" field.
+func (du *DiagnosticUpdate) SetSeverity(d diagnostic.Severity) *DiagnosticUpdate {
+	du.mutation.SetSeverity(d)
+	return du
+}
+
+// SetFile sets the "file" field.
+func (du *DiagnosticUpdate) SetFile(s string) *DiagnosticUpdate {
+	du.mutation.SetFile(s)
+	return du
+}
+
+// SetNillableFile sets the "file" field if the given value is not nil.
+func (du *DiagnosticUpdate) SetNillableFile(s *string) *DiagnosticUpdate {
+	if s != nil {
+		du.SetFile(*s)
+	}
+	return du
+}
+
+// ClearFile clears the value of the "file" field.
+func (du *DiagnosticUpdate) ClearFile() *DiagnosticUpdate {
+	du.mutation.ClearFile()
+	return du
+}
+
+// SetLine sets the "line" field.
+func (du *DiagnosticUpdate) SetLine(i int) *DiagnosticUpdate {
+	du.mutation.ResetLine()
+	du.mutation.SetLine(i)
+	return du
+}
+
+// SetNillableLine sets the "line" field if the given value is not nil.
+func (du *DiagnosticUpdate) SetNillableLine(i *int) *DiagnosticUpdate {
+	if i != nil {
+		du.SetLine(*i)
+	}
+	return du
+}
+
+// AddLine adds i to the "line" field.
+func (du *DiagnosticUpdate) AddLine(i int) *DiagnosticUpdate {
+	du.mutation.AddLine(i)
+	return du
+}
+
+// ClearLine clears the value of the "line" field.
+func (du *DiagnosticUpdate) ClearLine() *DiagnosticUpdate {
+	du.mutation.ClearLine()
+	return du
+}
+
+// SetColumn sets the "column" field.
+func (du *DiagnosticUpdate) SetColumn(i int) *DiagnosticUpdate {
+	du.mutation.ResetColumn()
+	du.mutation.SetColumn(i)
+	return du
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (du *DiagnosticUpdate) SetNillableColumn(i *int) *DiagnosticUpdate {
+	if i != nil {
+		du.SetColumn(*i)
+	}
+	return du
+}
+
+// AddColumn adds i to the "column" field.
+func (du *DiagnosticUpdate) AddColumn(i int) *DiagnosticUpdate {
+	du.mutation.AddColumn(i)
+	return du
+}
+
+// ClearColumn clears the value of the "column" field.
+func (du *DiagnosticUpdate) ClearColumn() *DiagnosticUpdate {
+	du.mutation.ClearColumn()
+	return du
+}
+
+// SetCode sets the "code" field.
+func (du *DiagnosticUpdate) SetCode(s string) *DiagnosticUpdate {
+	du.mutation.SetCode(s)
+	return du
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (du *DiagnosticUpdate) SetNillableCode(s *string) *DiagnosticUpdate {
+	if s != nil {
+		du.SetCode(*s)
+	}
+	return du
+}
+
+// ClearCode clears the value of the "code" field.
+func (du *DiagnosticUpdate) ClearCode() *DiagnosticUpdate {
+	du.mutation.ClearCode()
+	return du
+}
+
+// SetMessage sets the "message" field.
+func (du *DiagnosticUpdate) SetMessage(s string) *DiagnosticUpdate {
+	du.mutation.SetMessage(s)
+	return du
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (du *DiagnosticUpdate) SetCreatedAt(t time.Time) *DiagnosticUpdate {
+	du.mutation.SetCreatedAt(t)
+	return du
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (du *DiagnosticUpdate) SetNillableCreatedAt(t *time.Time) *DiagnosticUpdate {
+	if t != nil {
+		du.SetCreatedAt(*t)
+	}
+	return du
+}
+
+// SetSubmissionID sets the "submission" edge to the Submission entity by ID.
+func (du *DiagnosticUpdate) SetSubmissionID(id uuid.UUID) *DiagnosticUpdate {
+	du.mutation.SetSubmissionID(id)
+	return du
+}
+
+// SetSubmission sets the "submission" edge to the Submission entity.
+func (du *DiagnosticUpdate) SetSubmission(s *Submission) *DiagnosticUpdate {
+	return du.SetSubmissionID(s.ID)
+}
+
+// Mutation returns the DiagnosticMutation object of the builder.
+func (du *DiagnosticUpdate) Mutation() *DiagnosticMutation {
+	return du.mutation
+}
+
+// ClearSubmission clears the "submission" edge to the Submission entity.
+func (du *DiagnosticUpdate) ClearSubmission() *DiagnosticUpdate {
+	du.mutation.ClearSubmission()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DiagnosticUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(du.hooks) == 0 {
+		if err = du.check(); err != nil {
+			return 0, err
+		}
+		affected, err = du.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DiagnosticMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = du.check(); err != nil {
+				return 0, err
+			}
+			du.mutation = mutation
+			affected, err = du.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(du.hooks) - 1; i >= 0; i-- {
+			if du.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = du.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, du.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DiagnosticUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DiagnosticUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DiagnosticUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DiagnosticUpdate) check() error {
+	if v, ok := du.mutation.Severity(); ok {
+		if err := diagnostic.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "Diagnostic.severity": %w`, err)}
+		}
+	}
+	if _, ok := du.mutation.SubmissionID(); du.mutation.SubmissionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Diagnostic.submission"`)
+	}
+	return nil
+}
+
+func (du *DiagnosticUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   diagnostic.Table,
+			Columns: diagnostic.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: diagnostic.FieldID,
+			},
+		},
+	}
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Severity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: diagnostic.FieldSeverity,
+		})
+	}
+	if value, ok := du.mutation.File(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldFile,
+		})
+	}
+	if du.mutation.FileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: diagnostic.FieldFile,
+		})
+	}
+	if value, ok := du.mutation.Line(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if value, ok := du.mutation.AddedLine(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if du.mutation.LineCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if value, ok := du.mutation.Column(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if value, ok := du.mutation.AddedColumn(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if du.mutation.ColumnCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if value, ok := du.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldCode,
+		})
+	}
+	if du.mutation.CodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: diagnostic.FieldCode,
+		})
+	}
+	if value, ok := du.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldMessage,
+		})
+	}
+	if value, ok := du.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: diagnostic.FieldCreatedAt,
+		})
+	}
+	if du.mutation.SubmissionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   diagnostic.SubmissionTable,
+			Columns: []string{diagnostic.SubmissionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SubmissionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   diagnostic.SubmissionTable,
+			Columns: []string{diagnostic.SubmissionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{diagnostic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DiagnosticUpdateOne is the builder for updating a single Diagnostic entity.
+type DiagnosticUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiagnosticMutation
+}
+
+// SetSeverity sets the "severity" field.
+func (duo *DiagnosticUpdateOne) SetSeverity(d diagnostic.Severity) *DiagnosticUpdateOne {
+	duo.mutation.SetSeverity(d)
+	return duo
+}
+
+// SetFile sets the "file" field.
+func (duo *DiagnosticUpdateOne) SetFile(s string) *DiagnosticUpdateOne {
+	duo.mutation.SetFile(s)
+	return duo
+}
+
+// SetNillableFile sets the "file" field if the given value is not nil.
+func (duo *DiagnosticUpdateOne) SetNillableFile(s *string) *DiagnosticUpdateOne {
+	if s != nil {
+		duo.SetFile(*s)
+	}
+	return duo
+}
+
+// ClearFile clears the value of the "file" field.
+func (duo *DiagnosticUpdateOne) ClearFile() *DiagnosticUpdateOne {
+	duo.mutation.ClearFile()
+	return duo
+}
+
+// SetLine sets the "line" field.
+func (duo *DiagnosticUpdateOne) SetLine(i int) *DiagnosticUpdateOne {
+	duo.mutation.ResetLine()
+	duo.mutation.SetLine(i)
+	return duo
+}
+
+// SetNillableLine sets the "line" field if the given value is not nil.
+func (duo *DiagnosticUpdateOne) SetNillableLine(i *int) *DiagnosticUpdateOne {
+	if i != nil {
+		duo.SetLine(*i)
+	}
+	return duo
+}
+
+// AddLine adds i to the "line" field.
+func (duo *DiagnosticUpdateOne) AddLine(i int) *DiagnosticUpdateOne {
+	duo.mutation.AddLine(i)
+	return duo
+}
+
+// ClearLine clears the value of the "line" field.
+func (duo *DiagnosticUpdateOne) ClearLine() *DiagnosticUpdateOne {
+	duo.mutation.ClearLine()
+	return duo
+}
+
+// SetColumn sets the "column" field.
+func (duo *DiagnosticUpdateOne) SetColumn(i int) *DiagnosticUpdateOne {
+	duo.mutation.ResetColumn()
+	duo.mutation.SetColumn(i)
+	return duo
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (duo *DiagnosticUpdateOne) SetNillableColumn(i *int) *DiagnosticUpdateOne {
+	if i != nil {
+		duo.SetColumn(*i)
+	}
+	return duo
+}
+
+// AddColumn adds i to the "column" field.
+func (duo *DiagnosticUpdateOne) AddColumn(i int) *DiagnosticUpdateOne {
+	duo.mutation.AddColumn(i)
+	return duo
+}
+
+// ClearColumn clears the value of the "column" field.
+func (duo *DiagnosticUpdateOne) ClearColumn() *DiagnosticUpdateOne {
+	duo.mutation.ClearColumn()
+	return duo
+}
+
+// SetCode sets the "code" field.
+func (duo *DiagnosticUpdateOne) SetCode(s string) *DiagnosticUpdateOne {
+	duo.mutation.SetCode(s)
+	return duo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (duo *DiagnosticUpdateOne) SetNillableCode(s *string) *DiagnosticUpdateOne {
+	if s != nil {
+		duo.SetCode(*s)
+	}
+	return duo
+}
+
+// ClearCode clears the value of the "code" field.
+func (duo *DiagnosticUpdateOne) ClearCode() *DiagnosticUpdateOne {
+	duo.mutation.ClearCode()
+	return duo
+}
+
+// SetMessage sets the "message" field.
+func (duo *DiagnosticUpdateOne) SetMessage(s string) *DiagnosticUpdateOne {
+	duo.mutation.SetMessage(s)
+	return duo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (duo *DiagnosticUpdateOne) SetCreatedAt(t time.Time) *DiagnosticUpdateOne {
+	duo.mutation.SetCreatedAt(t)
+	return duo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (duo *DiagnosticUpdateOne) SetNillableCreatedAt(t *time.Time) *DiagnosticUpdateOne {
+	if t != nil {
+		duo.SetCreatedAt(*t)
+	}
+	return duo
+}
+
+// SetSubmissionID sets the "submission" edge to the Submission entity by ID.
+func (duo *DiagnosticUpdateOne) SetSubmissionID(id uuid.UUID) *DiagnosticUpdateOne {
+	duo.mutation.SetSubmissionID(id)
+	return duo
+}
+
+// SetSubmission sets the "submission" edge to the Submission entity.
+func (duo *DiagnosticUpdateOne) SetSubmission(s *Submission) *DiagnosticUpdateOne {
+	return duo.SetSubmissionID(s.ID)
+}
+
+// Mutation returns the DiagnosticMutation object of the builder.
+func (duo *DiagnosticUpdateOne) Mutation() *DiagnosticMutation {
+	return duo.mutation
+}
+
+// ClearSubmission clears the "submission" edge to the Submission entity.
+func (duo *DiagnosticUpdateOne) ClearSubmission() *DiagnosticUpdateOne {
+	duo.mutation.ClearSubmission()
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DiagnosticUpdateOne) Select(field string, fields ...string) *DiagnosticUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Diagnostic entity.
+func (duo *DiagnosticUpdateOne) Save(ctx context.Context) (*Diagnostic, error) {
+	var (
+		err  error
+		node *Diagnostic
+	)
+	if len(duo.hooks) == 0 {
+		if err = duo.check(); err != nil {
+			return nil, err
+		}
+		node, err = duo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DiagnosticMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = duo.check(); err != nil {
+				return nil, err
+			}
+			duo.mutation = mutation
+			node, err = duo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(duo.hooks) - 1; i >= 0; i-- {
+			if duo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = duo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, duo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DiagnosticUpdateOne) SaveX(ctx context.Context) *Diagnostic {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DiagnosticUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DiagnosticUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DiagnosticUpdateOne) check() error {
+	if v, ok := duo.mutation.Severity(); ok {
+		if err := diagnostic.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "Diagnostic.severity": %w`, err)}
+		}
+	}
+	if _, ok := duo.mutation.SubmissionID(); duo.mutation.SubmissionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Diagnostic.submission"`)
+	}
+	return nil
+}
+
+func (duo *DiagnosticUpdateOne) sqlSave(ctx context.Context) (_node *Diagnostic, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   diagnostic.Table,
+			Columns: diagnostic.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: diagnostic.FieldID,
+			},
+		},
+	}
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Diagnostic.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, diagnostic.FieldID)
+		for _, f := range fields {
+			if !diagnostic.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != diagnostic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Severity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: diagnostic.FieldSeverity,
+		})
+	}
+	if value, ok := duo.mutation.File(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldFile,
+		})
+	}
+	if duo.mutation.FileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: diagnostic.FieldFile,
+		})
+	}
+	if value, ok := duo.mutation.Line(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if value, ok := duo.mutation.AddedLine(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if duo.mutation.LineCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: diagnostic.FieldLine,
+		})
+	}
+	if value, ok := duo.mutation.Column(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if value, ok := duo.mutation.AddedColumn(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if duo.mutation.ColumnCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: diagnostic.FieldColumn,
+		})
+	}
+	if value, ok := duo.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldCode,
+		})
+	}
+	if duo.mutation.CodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: diagnostic.FieldCode,
+		})
+	}
+	if value, ok := duo.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: diagnostic.FieldMessage,
+		})
+	}
+	if value, ok := duo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: diagnostic.FieldCreatedAt,
+		})
+	}
+	if duo.mutation.SubmissionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   diagnostic.SubmissionTable,
+			Columns: []string{diagnostic.SubmissionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SubmissionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   diagnostic.SubmissionTable,
+			Columns: []string{diagnostic.SubmissionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Diagnostic{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{diagnostic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
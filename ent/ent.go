@@ -8,9 +8,21 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/lease"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
 
@@ -32,10 +44,22 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		submission.Table:   submission.ValidColumn,
-		subscription.Table: subscription.ValidColumn,
-		token.Table:        token.ValidColumn,
-		user.Table:         user.ValidColumn,
+		adminaction.Table:            adminaction.ValidColumn,
+		auditevent.Table:             auditevent.ValidColumn,
+		blob.Table:                   blob.ValidColumn,
+		datakey.Table:                datakey.ValidColumn,
+		diagnostic.Table:             diagnostic.ValidColumn,
+		lease.Table:                  lease.ValidColumn,
+		notification.Table:           notification.ValidColumn,
+		notificationpreference.Table: notificationpreference.ValidColumn,
+		pendingdeletion.Table:        pendingdeletion.ValidColumn,
+		resumableupload.Table:        resumableupload.ValidColumn,
+		sourcefile.Table:             sourcefile.ValidColumn,
+		submission.Table:             submission.ValidColumn,
+		subscription.Table:           subscription.ValidColumn,
+		token.Table:                  token.ValidColumn,
+		uploadsession.Table:          uploadsession.ValidColumn,
+		user.Table:                   user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
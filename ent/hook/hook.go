@@ -9,6 +9,149 @@ import (
 	"github.com/tereus-project/tereus-api/ent"
 )
 
+// The AdminActionFunc type is an adapter to allow the use of ordinary
+// function as AdminAction mutator.
+type AdminActionFunc func(context.Context, *ent.AdminActionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminActionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AdminActionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminActionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *ent.AuditEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BlobFunc type is an adapter to allow the use of ordinary
+// function as Blob mutator.
+type BlobFunc func(context.Context, *ent.BlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BlobMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlobMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The DataKeyFunc type is an adapter to allow the use of ordinary
+// function as DataKey mutator.
+type DataKeyFunc func(context.Context, *ent.DataKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.DataKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DataKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The DiagnosticFunc type is an adapter to allow the use of ordinary
+// function as Diagnostic mutator.
+type DiagnosticFunc func(context.Context, *ent.DiagnosticMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiagnosticFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.DiagnosticMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiagnosticMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LeaseFunc type is an adapter to allow the use of ordinary
+// function as Lease mutator.
+type LeaseFunc func(context.Context, *ent.LeaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.LeaseMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaseMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.NotificationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.NotificationPreferenceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PendingDeletionFunc type is an adapter to allow the use of ordinary
+// function as PendingDeletion mutator.
+type PendingDeletionFunc func(context.Context, *ent.PendingDeletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PendingDeletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PendingDeletionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PendingDeletionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ResumableUploadFunc type is an adapter to allow the use of ordinary
+// function as ResumableUpload mutator.
+type ResumableUploadFunc func(context.Context, *ent.ResumableUploadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumableUploadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ResumableUploadMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumableUploadMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SourceFileFunc type is an adapter to allow the use of ordinary
+// function as SourceFile mutator.
+type SourceFileFunc func(context.Context, *ent.SourceFileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SourceFileMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceFileMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The SubmissionFunc type is an adapter to allow the use of ordinary
 // function as Submission mutator.
 type SubmissionFunc func(context.Context, *ent.SubmissionMutation) (ent.Value, error)
@@ -48,6 +191,19 @@ func (f TokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return f(ctx, mv)
 }
 
+// The UploadSessionFunc type is an adapter to allow the use of ordinary
+// function as UploadSession mutator.
+type UploadSessionFunc func(context.Context, *ent.UploadSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UploadSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.UploadSessionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UploadSessionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,120 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/tereus-project/tereus-api/ent/lease"
+)
+
+// Lease is the model entity for the Lease schema.
+type Lease struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Holder holds the value of the "holder" field.
+	Holder string `json:"holder,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lease) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID, lease.FieldHolder:
+			values[i] = new(sql.NullString)
+		case lease.FieldExpiresAt, lease.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Lease", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lease fields.
+func (l *Lease) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lease.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				l.ID = value.String
+			}
+		case lease.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				l.Holder = value.String
+			}
+		case lease.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				l.ExpiresAt = value.Time
+			}
+		case lease.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				l.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Lease.
+// Note that you need to call Lease.Unwrap() before calling this method if this Lease
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Lease) Update() *LeaseUpdateOne {
+	return (&LeaseClient{config: l.config}).UpdateOne(l)
+}
+
+// Unwrap unwraps the Lease entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Lease) Unwrap() *Lease {
+	tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lease is not a transactional entity")
+	}
+	l.config.driver = tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Lease) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lease(")
+	builder.WriteString(fmt.Sprintf("id=%v", l.ID))
+	builder.WriteString(", holder=")
+	builder.WriteString(l.Holder)
+	builder.WriteString(", expires_at=")
+	builder.WriteString(l.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(l.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leases is a parsable slice of Lease.
+type Leases []*Lease
+
+func (l Leases) config(cfg config) {
+	for _i := range l {
+		l[_i].config = cfg
+	}
+}
@@ -0,0 +1,49 @@
+// Code generated by entc, DO NOT EDIT.
+
+package lease
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the lease type in the database.
+	Label = "lease"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHolder holds the string denoting the holder field in the database.
+	FieldHolder = "holder"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the lease in the database.
+	Table = "leases"
+)
+
+// Columns holds all SQL columns for lease fields.
+var Columns = []string{
+	FieldID,
+	FieldHolder,
+	FieldExpiresAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
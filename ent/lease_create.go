@@ -0,0 +1,670 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/lease"
+)
+
+// LeaseCreate is the builder for creating a Lease entity.
+type LeaseCreate struct {
+	config
+	mutation *LeaseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHolder sets the "holder" field.
+func (lc *LeaseCreate) SetHolder(s string) *LeaseCreate {
+	lc.mutation.SetHolder(s)
+	return lc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lc *LeaseCreate) SetExpiresAt(t time.Time) *LeaseCreate {
+	lc.mutation.SetExpiresAt(t)
+	return lc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lc *LeaseCreate) SetUpdatedAt(t time.Time) *LeaseCreate {
+	lc.mutation.SetUpdatedAt(t)
+	return lc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lc *LeaseCreate) SetNillableUpdatedAt(t *time.Time) *LeaseCreate {
+	if t != nil {
+		lc.SetUpdatedAt(*t)
+	}
+	return lc
+}
+
+// SetID sets the "id" field.
+func (lc *LeaseCreate) SetID(s string) *LeaseCreate {
+	lc.mutation.SetID(s)
+	return lc
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lc *LeaseCreate) Mutation() *LeaseMutation {
+	return lc.mutation
+}
+
+// Save creates the Lease in the database.
+func (lc *LeaseCreate) Save(ctx context.Context) (*Lease, error) {
+	var (
+		err  error
+		node *Lease
+	)
+	lc.defaults()
+	if len(lc.hooks) == 0 {
+		if err = lc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lc.check(); err != nil {
+				return nil, err
+			}
+			lc.mutation = mutation
+			if node, err = lc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lc.hooks) - 1; i >= 0; i-- {
+			if lc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LeaseCreate) SaveX(ctx context.Context) *Lease {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LeaseCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LeaseCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LeaseCreate) defaults() {
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		v := lease.DefaultUpdatedAt()
+		lc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LeaseCreate) check() error {
+	if _, ok := lc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "Lease.holder"`)}
+	}
+	if _, ok := lc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Lease.expires_at"`)}
+	}
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Lease.updated_at"`)}
+	}
+	if v, ok := lc.mutation.ID(); ok {
+		if err := lease.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Lease.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lc *LeaseCreate) sqlSave(ctx context.Context) (*Lease, error) {
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Lease.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (lc *LeaseCreate) createSpec() (*Lease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lease{config: lc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: lease.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: lease.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = lc.conflict
+	if id, ok := lc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lc.mutation.Holder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: lease.FieldHolder,
+		})
+		_node.Holder = value
+	}
+	if value, ok := lc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: lease.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := lc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: lease.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Lease.Create().
+//		SetHolder(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaseUpsert) {
+//			SetHolder(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (lc *LeaseCreate) OnConflict(opts ...sql.ConflictOption) *LeaseUpsertOne {
+	lc.conflict = opts
+	return &LeaseUpsertOne{
+		create: lc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Lease.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (lc *LeaseCreate) OnConflictColumns(columns ...string) *LeaseUpsertOne {
+	lc.conflict = append(lc.conflict, sql.ConflictColumns(columns...))
+	return &LeaseUpsertOne{
+		create: lc,
+	}
+}
+
+type (
+	// LeaseUpsertOne is the builder for "upsert"-ing
+	//  one Lease node.
+	LeaseUpsertOne struct {
+		create *LeaseCreate
+	}
+
+	// LeaseUpsert is the "OnConflict" setter.
+	LeaseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHolder sets the "holder" field.
+func (u *LeaseUpsert) SetHolder(v string) *LeaseUpsert {
+	u.Set(lease.FieldHolder, v)
+	return u
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *LeaseUpsert) UpdateHolder() *LeaseUpsert {
+	u.SetExcluded(lease.FieldHolder)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LeaseUpsert) SetExpiresAt(v time.Time) *LeaseUpsert {
+	u.Set(lease.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LeaseUpsert) UpdateExpiresAt() *LeaseUpsert {
+	u.SetExcluded(lease.FieldExpiresAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaseUpsert) SetUpdatedAt(v time.Time) *LeaseUpsert {
+	u.Set(lease.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaseUpsert) UpdateUpdatedAt() *LeaseUpsert {
+	u.SetExcluded(lease.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Lease.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lease.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *LeaseUpsertOne) UpdateNewValues() *LeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(lease.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Lease.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *LeaseUpsertOne) Ignore() *LeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaseUpsertOne) DoNothing() *LeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaseCreate.OnConflict
+// documentation for more info.
+func (u *LeaseUpsertOne) Update(set func(*LeaseUpsert)) *LeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHolder sets the "holder" field.
+func (u *LeaseUpsertOne) SetHolder(v string) *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetHolder(v)
+	})
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *LeaseUpsertOne) UpdateHolder() *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateHolder()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LeaseUpsertOne) SetExpiresAt(v time.Time) *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LeaseUpsertOne) UpdateExpiresAt() *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaseUpsertOne) SetUpdatedAt(v time.Time) *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaseUpsertOne) UpdateUpdatedAt() *LeaseUpsertOne {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaseUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeaseUpsertOne.ID is not supported by MySQL driver. Use LeaseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaseUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaseCreateBulk is the builder for creating many Lease entities in bulk.
+type LeaseCreateBulk struct {
+	config
+	builders []*LeaseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Lease entities in the database.
+func (lcb *LeaseCreateBulk) Save(ctx context.Context) ([]*Lease, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Lease, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) SaveX(ctx context.Context) []*Lease {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Lease.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaseUpsert) {
+//			SetHolder(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (lcb *LeaseCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaseUpsertBulk {
+	lcb.conflict = opts
+	return &LeaseUpsertBulk{
+		create: lcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Lease.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (lcb *LeaseCreateBulk) OnConflictColumns(columns ...string) *LeaseUpsertBulk {
+	lcb.conflict = append(lcb.conflict, sql.ConflictColumns(columns...))
+	return &LeaseUpsertBulk{
+		create: lcb,
+	}
+}
+
+// LeaseUpsertBulk is the builder for "upsert"-ing
+// a bulk of Lease nodes.
+type LeaseUpsertBulk struct {
+	create *LeaseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Lease.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lease.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *LeaseUpsertBulk) UpdateNewValues() *LeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(lease.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Lease.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *LeaseUpsertBulk) Ignore() *LeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaseUpsertBulk) DoNothing() *LeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaseCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaseUpsertBulk) Update(set func(*LeaseUpsert)) *LeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHolder sets the "holder" field.
+func (u *LeaseUpsertBulk) SetHolder(v string) *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetHolder(v)
+	})
+}
+
+// UpdateHolder sets the "holder" field to the value that was provided on create.
+func (u *LeaseUpsertBulk) UpdateHolder() *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateHolder()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LeaseUpsertBulk) SetExpiresAt(v time.Time) *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LeaseUpsertBulk) UpdateExpiresAt() *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaseUpsertBulk) SetUpdatedAt(v time.Time) *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaseUpsertBulk) UpdateUpdatedAt() *LeaseUpsertBulk {
+	return u.Update(func(s *LeaseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaseUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeaseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,943 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/lease"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// LeaseQuery is the builder for querying Lease entities.
+type LeaseQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Lease
+	modifiers  []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LeaseQuery builder.
+func (lq *LeaseQuery) Where(ps ...predicate.Lease) *LeaseQuery {
+	lq.predicates = append(lq.predicates, ps...)
+	return lq
+}
+
+// Limit adds a limit step to the query.
+func (lq *LeaseQuery) Limit(limit int) *LeaseQuery {
+	lq.limit = &limit
+	return lq
+}
+
+// Offset adds an offset step to the query.
+func (lq *LeaseQuery) Offset(offset int) *LeaseQuery {
+	lq.offset = &offset
+	return lq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lq *LeaseQuery) Unique(unique bool) *LeaseQuery {
+	lq.unique = &unique
+	return lq
+}
+
+// Order adds an order step to the query.
+func (lq *LeaseQuery) Order(o ...OrderFunc) *LeaseQuery {
+	lq.order = append(lq.order, o...)
+	return lq
+}
+
+// First returns the first Lease entity from the query.
+// Returns a *NotFoundError when no Lease was found.
+func (lq *LeaseQuery) First(ctx context.Context) (*Lease, error) {
+	nodes, err := lq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{lease.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lq *LeaseQuery) FirstX(ctx context.Context) *Lease {
+	node, err := lq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Lease ID from the query.
+// Returns a *NotFoundError when no Lease ID was found.
+func (lq *LeaseQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{lease.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lq *LeaseQuery) FirstIDX(ctx context.Context) string {
+	id, err := lq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Lease entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Lease entity is found.
+// Returns a *NotFoundError when no Lease entities are found.
+func (lq *LeaseQuery) Only(ctx context.Context) (*Lease, error) {
+	nodes, err := lq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{lease.Label}
+	default:
+		return nil, &NotSingularError{lease.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lq *LeaseQuery) OnlyX(ctx context.Context) *Lease {
+	node, err := lq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Lease ID in the query.
+// Returns a *NotSingularError when more than one Lease ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lq *LeaseQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = &NotSingularError{lease.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lq *LeaseQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Leases.
+func (lq *LeaseQuery) All(ctx context.Context) ([]*Lease, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return lq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lq *LeaseQuery) AllX(ctx context.Context) []*Lease {
+	nodes, err := lq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Lease IDs.
+func (lq *LeaseQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := lq.Select(lease.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lq *LeaseQuery) IDsX(ctx context.Context) []string {
+	ids, err := lq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lq *LeaseQuery) Count(ctx context.Context) (int, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return lq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lq *LeaseQuery) CountX(ctx context.Context) int {
+	count, err := lq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lq *LeaseQuery) Exist(ctx context.Context) (bool, error) {
+	if err := lq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return lq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lq *LeaseQuery) ExistX(ctx context.Context) bool {
+	exist, err := lq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LeaseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lq *LeaseQuery) Clone() *LeaseQuery {
+	if lq == nil {
+		return nil
+	}
+	return &LeaseQuery{
+		config:     lq.config,
+		limit:      lq.limit,
+		offset:     lq.offset,
+		order:      append([]OrderFunc{}, lq.order...),
+		predicates: append([]predicate.Lease{}, lq.predicates...),
+		// clone intermediate query.
+		sql:    lq.sql.Clone(),
+		path:   lq.path,
+		unique: lq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Holder string `json:"holder,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Lease.Query().
+//		GroupBy(lease.FieldHolder).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (lq *LeaseQuery) GroupBy(field string, fields ...string) *LeaseGroupBy {
+	group := &LeaseGroupBy{config: lq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return lq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Holder string `json:"holder,omitempty"`
+//	}
+//
+//	client.Lease.Query().
+//		Select(lease.FieldHolder).
+//		Scan(ctx, &v)
+//
+func (lq *LeaseQuery) Select(fields ...string) *LeaseSelect {
+	lq.fields = append(lq.fields, fields...)
+	return &LeaseSelect{LeaseQuery: lq}
+}
+
+func (lq *LeaseQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range lq.fields {
+		if !lease.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lq.path != nil {
+		prev, err := lq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lq.sql = prev
+	}
+	return nil
+}
+
+func (lq *LeaseQuery) sqlAll(ctx context.Context) ([]*Lease, error) {
+	var (
+		nodes = []*Lease{}
+		_spec = lq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Lease{config: lq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, lq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lq *LeaseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lq.querySpec()
+	if len(lq.modifiers) > 0 {
+		_spec.Modifiers = lq.modifiers
+	}
+	_spec.Node.Columns = lq.fields
+	if len(lq.fields) > 0 {
+		_spec.Unique = lq.unique != nil && *lq.unique
+	}
+	return sqlgraph.CountNodes(ctx, lq.driver, _spec)
+}
+
+func (lq *LeaseQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := lq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (lq *LeaseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   lease.Table,
+			Columns: lease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: lease.FieldID,
+			},
+		},
+		From:   lq.sql,
+		Unique: true,
+	}
+	if unique := lq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := lq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lease.FieldID)
+		for i := range fields {
+			if fields[i] != lease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lq *LeaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lq.driver.Dialect())
+	t1 := builder.Table(lease.Table)
+	columns := lq.fields
+	if len(columns) == 0 {
+		columns = lease.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lq.sql != nil {
+		selector = lq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lq.unique != nil && *lq.unique {
+		selector.Distinct()
+	}
+	for _, m := range lq.modifiers {
+		m(selector)
+	}
+	for _, p := range lq.predicates {
+		p(selector)
+	}
+	for _, p := range lq.order {
+		p(selector)
+	}
+	if offset := lq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (lq *LeaseQuery) Modify(modifiers ...func(s *sql.Selector)) *LeaseSelect {
+	lq.modifiers = append(lq.modifiers, modifiers...)
+	return lq.Select()
+}
+
+// LeaseGroupBy is the group-by builder for Lease entities.
+type LeaseGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lgb *LeaseGroupBy) Aggregate(fns ...AggregateFunc) *LeaseGroupBy {
+	lgb.fns = append(lgb.fns, fns...)
+	return lgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lgb *LeaseGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lgb.sql = query
+	return lgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lgb *LeaseGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := lgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("ent: LeaseGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lgb *LeaseGroupBy) StringsX(ctx context.Context) []string {
+	v, err := lgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = lgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (lgb *LeaseGroupBy) StringX(ctx context.Context) string {
+	v, err := lgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("ent: LeaseGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lgb *LeaseGroupBy) IntsX(ctx context.Context) []int {
+	v, err := lgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = lgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (lgb *LeaseGroupBy) IntX(ctx context.Context) int {
+	v, err := lgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("ent: LeaseGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lgb *LeaseGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := lgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = lgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (lgb *LeaseGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := lgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(lgb.fields) > 1 {
+		return nil, errors.New("ent: LeaseGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := lgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lgb *LeaseGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := lgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lgb *LeaseGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = lgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (lgb *LeaseGroupBy) BoolX(ctx context.Context) bool {
+	v, err := lgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lgb *LeaseGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lgb.fields {
+		if !lease.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lgb *LeaseGroupBy) sqlQuery() *sql.Selector {
+	selector := lgb.sql.Select()
+	aggregation := make([]string, 0, len(lgb.fns))
+	for _, fn := range lgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(lgb.fields)+len(lgb.fns))
+		for _, f := range lgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(lgb.fields...)...)
+}
+
+// LeaseSelect is the builder for selecting fields of Lease entities.
+type LeaseSelect struct {
+	*LeaseQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ls *LeaseSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ls.sql = ls.LeaseQuery.sqlQuery(ctx)
+	return ls.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ls *LeaseSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ls.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("ent: LeaseSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ls *LeaseSelect) StringsX(ctx context.Context) []string {
+	v, err := ls.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ls.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ls *LeaseSelect) StringX(ctx context.Context) string {
+	v, err := ls.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("ent: LeaseSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ls *LeaseSelect) IntsX(ctx context.Context) []int {
+	v, err := ls.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ls.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ls *LeaseSelect) IntX(ctx context.Context) int {
+	v, err := ls.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("ent: LeaseSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ls *LeaseSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ls.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ls.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ls *LeaseSelect) Float64X(ctx context.Context) float64 {
+	v, err := ls.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ls.fields) > 1 {
+		return nil, errors.New("ent: LeaseSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ls.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ls *LeaseSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ls.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ls *LeaseSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ls.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{lease.Label}
+	default:
+		err = fmt.Errorf("ent: LeaseSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ls *LeaseSelect) BoolX(ctx context.Context) bool {
+	v, err := ls.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ls *LeaseSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ls.sql.Query()
+	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ls *LeaseSelect) Modify(modifiers ...func(s *sql.Selector)) *LeaseSelect {
+	ls.modifiers = append(ls.modifiers, modifiers...)
+	return ls
+}
@@ -52,6 +52,16 @@ func (s *Schema) Create(ctx context.Context, opts ...schema.MigrateOption) error
 	return migrate.Create(ctx, Tables...)
 }
 
+// Diff creates a migration file containing the statements to resolve the diff
+// between the Ent schema and the connected database.
+func (s *Schema) Diff(ctx context.Context, opts ...schema.MigrateOption) error {
+	migrate, err := schema.NewMigrate(s.drv, opts...)
+	if err != nil {
+		return fmt.Errorf("ent/migrate: %w", err)
+	}
+	return migrate.Diff(ctx, Tables...)
+}
+
 // WriteTo writes the schema changes to w instead of running them against the database.
 //
 // 	if err := client.Schema.WriteTo(context.Background(), os.Stdout); err != nil {
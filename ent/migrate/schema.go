@@ -8,6 +8,267 @@ import (
 )
 
 var (
+	// AdminActionsColumns holds the columns for the "admin_actions" table.
+	AdminActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "admin_id", Type: field.TypeUUID},
+		{Name: "admin_email", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "target_submission_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminActionsTable holds the schema information for the "admin_actions" table.
+	AdminActionsTable = &schema.Table{
+		Name:       "admin_actions",
+		Columns:    AdminActionsColumns,
+		PrimaryKey: []*schema.Column{AdminActionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminaction_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminActionsColumns[7]},
+			},
+			{
+				Name:    "adminaction_target_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminActionsColumns[4], AdminActionsColumns[7]},
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "signup", "account_linked", "account_unlinked", "token_created", "submission_visibility_changed", "subscription_updated", "subscription_cancelled", "subscription_deleted", "account_deleted"}},
+		{Name: "actor_type", Type: field.TypeEnum, Enums: []string{"user", "admin", "stripe"}},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[8]},
+			},
+		},
+	}
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "encrypted", Type: field.TypeBool, Default: false},
+		{Name: "reference_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+	}
+	// DataKeysColumns holds the columns for the "data_keys" table.
+	DataKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "encrypted_key", Type: field.TypeBytes},
+		{Name: "master_key_id", Type: field.TypeString},
+		{Name: "active_user_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_data_keys", Type: field.TypeUUID},
+	}
+	// DataKeysTable holds the schema information for the "data_keys" table.
+	DataKeysTable = &schema.Table{
+		Name:       "data_keys",
+		Columns:    DataKeysColumns,
+		PrimaryKey: []*schema.Column{DataKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "data_keys_users_data_keys",
+				Columns:    []*schema.Column{DataKeysColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DiagnosticsColumns holds the columns for the "diagnostics" table.
+	DiagnosticsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "severity", Type: field.TypeEnum, Enums: []string{"error", "warning", "info"}},
+		{Name: "file", Type: field.TypeString, Nullable: true},
+		{Name: "line", Type: field.TypeInt, Nullable: true},
+		{Name: "column", Type: field.TypeInt, Nullable: true},
+		{Name: "code", Type: field.TypeString, Nullable: true},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "submission_diagnostics", Type: field.TypeUUID},
+	}
+	// DiagnosticsTable holds the schema information for the "diagnostics" table.
+	DiagnosticsTable = &schema.Table{
+		Name:       "diagnostics",
+		Columns:    DiagnosticsColumns,
+		PrimaryKey: []*schema.Column{DiagnosticsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "diagnostics_submissions_diagnostics",
+				Columns:    []*schema.Column{DiagnosticsColumns[8]},
+				RefColumns: []*schema.Column{SubmissionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// LeasesColumns holds the columns for the "leases" table.
+	LeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// LeasesTable holds the schema information for the "leases" table.
+	LeasesTable = &schema.Table{
+		Name:       "leases",
+		Columns:    LeasesColumns,
+		PrimaryKey: []*schema.Column{LeasesColumns[0]},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"retention_warning"}},
+		{Name: "title", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "submission_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_notifications", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "retention_warning_email", Type: field.TypeBool, Default: true},
+		{Name: "retention_warning_in_app", Type: field.TypeBool, Default: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_notification_preference", Type: field.TypeUUID, Unique: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_notification_preference",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PendingDeletionsColumns holds the columns for the "pending_deletions" table.
+	PendingDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "submission_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "scheduled"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verify_after", Type: field.TypeTime, Nullable: true},
+	}
+	// PendingDeletionsTable holds the schema information for the "pending_deletions" table.
+	PendingDeletionsTable = &schema.Table{
+		Name:       "pending_deletions",
+		Columns:    PendingDeletionsColumns,
+		PrimaryKey: []*schema.Column{PendingDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pendingdeletion_status_verify_after",
+				Unique:  false,
+				Columns: []*schema.Column{PendingDeletionsColumns[3], PendingDeletionsColumns[8]},
+			},
+		},
+	}
+	// ResumableUploadsColumns holds the columns for the "resumable_uploads" table.
+	ResumableUploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source_language", Type: field.TypeString},
+		{Name: "target_language", Type: field.TypeString},
+		{Name: "length", Type: field.TypeInt64},
+		{Name: "offset", Type: field.TypeInt64, Default: 0},
+		{Name: "chunks", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeString, Nullable: true},
+		{Name: "bypass_cache", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_resumable_uploads", Type: field.TypeUUID},
+	}
+	// ResumableUploadsTable holds the schema information for the "resumable_uploads" table.
+	ResumableUploadsTable = &schema.Table{
+		Name:       "resumable_uploads",
+		Columns:    ResumableUploadsColumns,
+		PrimaryKey: []*schema.Column{ResumableUploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resumable_uploads_users_resumable_uploads",
+				Columns:    []*schema.Column{ResumableUploadsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SourceFilesColumns holds the columns for the "source_files" table.
+	SourceFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "submission_id", Type: field.TypeUUID},
+		{Name: "path", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "blob_source_files", Type: field.TypeString},
+	}
+	// SourceFilesTable holds the schema information for the "source_files" table.
+	SourceFilesTable = &schema.Table{
+		Name:       "source_files",
+		Columns:    SourceFilesColumns,
+		PrimaryKey: []*schema.Column{SourceFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "source_files_blobs_source_files",
+				Columns:    []*schema.Column{SourceFilesColumns[4]},
+				RefColumns: []*schema.Column{BlobsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sourcefile_submission_id_path",
+				Unique:  true,
+				Columns: []*schema.Column{SourceFilesColumns[1], SourceFilesColumns[2]},
+			},
+		},
+	}
 	// SubmissionsColumns holds the columns for the "submissions" table.
 	SubmissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -15,7 +276,7 @@ var (
 		{Name: "target_language", Type: field.TypeString},
 		{Name: "is_inline", Type: field.TypeBool, Default: false},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "done", "failed", "cleaned"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "done", "failed", "cleaned", "cancelled"}, Default: "pending"},
 		{Name: "reason", Type: field.TypeString, Nullable: true},
 		{Name: "git_repo", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -24,6 +285,15 @@ var (
 		{Name: "submission_target_size_bytes", Type: field.TypeInt, Default: 0},
 		{Name: "processing_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "processing_finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "queue_lane", Type: field.TypeString, Default: "standard"},
+		{Name: "has_mapping", Type: field.TypeBool, Default: false},
+		{Name: "input_hash", Type: field.TypeString, Nullable: true},
+		{Name: "transpiler_version", Type: field.TypeString, Nullable: true},
+		{Name: "cache_hit", Type: field.TypeBool, Default: false},
+		{Name: "results_encrypted", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "retention_warned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "submission_reruns", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_submissions", Type: field.TypeUUID},
 	}
 	// SubmissionsTable holds the schema information for the "submissions" table.
@@ -32,13 +302,26 @@ var (
 		Columns:    SubmissionsColumns,
 		PrimaryKey: []*schema.Column{SubmissionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "submissions_submissions_reruns",
+				Columns:    []*schema.Column{SubmissionsColumns[22]},
+				RefColumns: []*schema.Column{SubmissionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "submissions_users_submissions",
-				Columns:    []*schema.Column{SubmissionsColumns[14]},
+				Columns:    []*schema.Column{SubmissionsColumns[23]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "submission_input_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SubmissionsColumns[16]},
+			},
+		},
 	}
 	// SubscriptionsColumns holds the columns for the "subscriptions" table.
 	SubscriptionsColumns = []*schema.Column{
@@ -48,6 +331,7 @@ var (
 		{Name: "tier", Type: field.TypeEnum, Enums: []string{"free", "pro", "enterprise"}, Default: "free"},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "cancelled", Type: field.TypeBool, Default: false},
+		{Name: "manual", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_subscription", Type: field.TypeUUID, Unique: true},
 	}
@@ -59,7 +343,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_users_subscription",
-				Columns:    []*schema.Column{SubscriptionsColumns[7]},
+				Columns:    []*schema.Column{SubscriptionsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -70,6 +354,9 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "impersonator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "read_only", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_tokens", Type: field.TypeUUID},
 	}
 	// TokensTable holds the schema information for the "tokens" table.
@@ -80,7 +367,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tokens_users_tokens",
-				Columns:    []*schema.Column{TokensColumns[3]},
+				Columns:    []*schema.Column{TokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UploadSessionsColumns holds the columns for the "upload_sessions" table.
+	UploadSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source_language", Type: field.TypeString},
+		{Name: "target_language", Type: field.TypeString},
+		{Name: "files", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_upload_sessions", Type: field.TypeUUID},
+	}
+	// UploadSessionsTable holds the schema information for the "upload_sessions" table.
+	UploadSessionsTable = &schema.Table{
+		Name:       "upload_sessions",
+		Columns:    UploadSessionsColumns,
+		PrimaryKey: []*schema.Column{UploadSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "upload_sessions_users_upload_sessions",
+				Columns:    []*schema.Column{UploadSessionsColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -97,6 +408,7 @@ var (
 		{Name: "gitlab_access_token", Type: field.TypeString, Nullable: true},
 		{Name: "gitlab_refresh_token", Type: field.TypeString, Nullable: true},
 		{Name: "gitlab_access_token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -107,15 +419,35 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AdminActionsTable,
+		AuditEventsTable,
+		BlobsTable,
+		DataKeysTable,
+		DiagnosticsTable,
+		LeasesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
+		PendingDeletionsTable,
+		ResumableUploadsTable,
+		SourceFilesTable,
 		SubmissionsTable,
 		SubscriptionsTable,
 		TokensTable,
+		UploadSessionsTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	SubmissionsTable.ForeignKeys[0].RefTable = UsersTable
+	DataKeysTable.ForeignKeys[0].RefTable = UsersTable
+	DiagnosticsTable.ForeignKeys[0].RefTable = SubmissionsTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	ResumableUploadsTable.ForeignKeys[0].RefTable = UsersTable
+	SourceFilesTable.ForeignKeys[0].RefTable = BlobsTable
+	SubmissionsTable.ForeignKeys[0].RefTable = SubmissionsTable
+	SubmissionsTable.ForeignKeys[1].RefTable = UsersTable
 	SubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = UsersTable
+	UploadSessionsTable.ForeignKeys[0].RefTable = UsersTable
 }
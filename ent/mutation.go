@@ -10,10 +10,23 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/lease"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
 	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/schema"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 
 	"entgo.io/ent"
@@ -28,52 +41,54 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeSubmission   = "Submission"
-	TypeSubscription = "Subscription"
-	TypeToken        = "Token"
-	TypeUser         = "User"
+	TypeAdminAction            = "AdminAction"
+	TypeAuditEvent             = "AuditEvent"
+	TypeBlob                   = "Blob"
+	TypeDataKey                = "DataKey"
+	TypeDiagnostic             = "Diagnostic"
+	TypeLease                  = "Lease"
+	TypeNotification           = "Notification"
+	TypeNotificationPreference = "NotificationPreference"
+	TypePendingDeletion        = "PendingDeletion"
+	TypeResumableUpload        = "ResumableUpload"
+	TypeSourceFile             = "SourceFile"
+	TypeSubmission             = "Submission"
+	TypeSubscription           = "Subscription"
+	TypeToken                  = "Token"
+	TypeUploadSession          = "UploadSession"
+	TypeUser                   = "User"
 )
 
-// SubmissionMutation represents an operation that mutates the Submission nodes in the graph.
-type SubmissionMutation struct {
+// AdminActionMutation represents an operation that mutates the AdminAction nodes in the graph.
+type AdminActionMutation struct {
 	config
-	op                              Op
-	typ                             string
-	id                              *uuid.UUID
-	source_language                 *string
-	target_language                 *string
-	is_inline                       *bool
-	is_public                       *bool
-	status                          *submission.Status
-	reason                          *string
-	git_repo                        *string
-	created_at                      *time.Time
-	share_id                        *string
-	submission_source_size_bytes    *int
-	addsubmission_source_size_bytes *int
-	submission_target_size_bytes    *int
-	addsubmission_target_size_bytes *int
-	processing_started_at           *time.Time
-	processing_finished_at          *time.Time
-	clearedFields                   map[string]struct{}
-	user                            *uuid.UUID
-	cleareduser                     bool
-	done                            bool
-	oldValue                        func(context.Context) (*Submission, error)
-	predicates                      []predicate.Submission
-}
-
-var _ ent.Mutation = (*SubmissionMutation)(nil)
-
-// submissionOption allows management of the mutation configuration using functional options.
-type submissionOption func(*SubmissionMutation)
-
-// newSubmissionMutation creates new mutation for the Submission entity.
-func newSubmissionMutation(c config, op Op, opts ...submissionOption) *SubmissionMutation {
-	m := &SubmissionMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	admin_id             *uuid.UUID
+	admin_email          *string
+	action               *string
+	target_user_id       *uuid.UUID
+	target_submission_id *uuid.UUID
+	metadata             *map[string]interface{}
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*AdminAction, error)
+	predicates           []predicate.AdminAction
+}
+
+var _ ent.Mutation = (*AdminActionMutation)(nil)
+
+// adminactionOption allows management of the mutation configuration using functional options.
+type adminactionOption func(*AdminActionMutation)
+
+// newAdminActionMutation creates new mutation for the AdminAction entity.
+func newAdminActionMutation(c config, op Op, opts ...adminactionOption) *AdminActionMutation {
+	m := &AdminActionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubmission,
+		typ:           TypeAdminAction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -82,20 +97,20 @@ func newSubmissionMutation(c config, op Op, opts ...submissionOption) *Submissio
 	return m
 }
 
-// withSubmissionID sets the ID field of the mutation.
-func withSubmissionID(id uuid.UUID) submissionOption {
-	return func(m *SubmissionMutation) {
+// withAdminActionID sets the ID field of the mutation.
+func withAdminActionID(id uuid.UUID) adminactionOption {
+	return func(m *AdminActionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Submission
+			value *AdminAction
 		)
-		m.oldValue = func(ctx context.Context) (*Submission, error) {
+		m.oldValue = func(ctx context.Context) (*AdminAction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Submission.Get(ctx, id)
+					value, err = m.Client().AdminAction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -104,10 +119,10 @@ func withSubmissionID(id uuid.UUID) submissionOption {
 	}
 }
 
-// withSubmission sets the old Submission of the mutation.
-func withSubmission(node *Submission) submissionOption {
-	return func(m *SubmissionMutation) {
-		m.oldValue = func(context.Context) (*Submission, error) {
+// withAdminAction sets the old AdminAction of the mutation.
+func withAdminAction(node *AdminAction) adminactionOption {
+	return func(m *AdminActionMutation) {
+		m.oldValue = func(context.Context) (*AdminAction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -116,7 +131,7 @@ func withSubmission(node *Submission) submissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubmissionMutation) Client() *Client {
+func (m AdminActionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -124,7 +139,7 @@ func (m SubmissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubmissionMutation) Tx() (*Tx, error) {
+func (m AdminActionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -134,14 +149,14 @@ func (m SubmissionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Submission entities.
-func (m *SubmissionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AdminAction entities.
+func (m *AdminActionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubmissionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AdminActionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -152,7 +167,7 @@ func (m *SubmissionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubmissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AdminActionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -161,297 +176,274 @@ func (m *SubmissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Submission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AdminAction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSourceLanguage sets the "source_language" field.
-func (m *SubmissionMutation) SetSourceLanguage(s string) {
-	m.source_language = &s
+// SetAdminID sets the "admin_id" field.
+func (m *AdminActionMutation) SetAdminID(u uuid.UUID) {
+	m.admin_id = &u
 }
 
-// SourceLanguage returns the value of the "source_language" field in the mutation.
-func (m *SubmissionMutation) SourceLanguage() (r string, exists bool) {
-	v := m.source_language
+// AdminID returns the value of the "admin_id" field in the mutation.
+func (m *AdminActionMutation) AdminID() (r uuid.UUID, exists bool) {
+	v := m.admin_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceLanguage returns the old "source_language" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldAdminID returns the old "admin_id" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldSourceLanguage(ctx context.Context) (v string, err error) {
+func (m *AdminActionMutation) OldAdminID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdminID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceLanguage requires an ID field in the mutation")
+		return v, errors.New("OldAdminID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdminID: %w", err)
 	}
-	return oldValue.SourceLanguage, nil
+	return oldValue.AdminID, nil
 }
 
-// ResetSourceLanguage resets all changes to the "source_language" field.
-func (m *SubmissionMutation) ResetSourceLanguage() {
-	m.source_language = nil
+// ResetAdminID resets all changes to the "admin_id" field.
+func (m *AdminActionMutation) ResetAdminID() {
+	m.admin_id = nil
 }
 
-// SetTargetLanguage sets the "target_language" field.
-func (m *SubmissionMutation) SetTargetLanguage(s string) {
-	m.target_language = &s
+// SetAdminEmail sets the "admin_email" field.
+func (m *AdminActionMutation) SetAdminEmail(s string) {
+	m.admin_email = &s
 }
 
-// TargetLanguage returns the value of the "target_language" field in the mutation.
-func (m *SubmissionMutation) TargetLanguage() (r string, exists bool) {
-	v := m.target_language
+// AdminEmail returns the value of the "admin_email" field in the mutation.
+func (m *AdminActionMutation) AdminEmail() (r string, exists bool) {
+	v := m.admin_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetLanguage returns the old "target_language" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldAdminEmail returns the old "admin_email" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldTargetLanguage(ctx context.Context) (v string, err error) {
+func (m *AdminActionMutation) OldAdminEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdminEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetLanguage requires an ID field in the mutation")
+		return v, errors.New("OldAdminEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdminEmail: %w", err)
 	}
-	return oldValue.TargetLanguage, nil
+	return oldValue.AdminEmail, nil
 }
 
-// ResetTargetLanguage resets all changes to the "target_language" field.
-func (m *SubmissionMutation) ResetTargetLanguage() {
-	m.target_language = nil
+// ResetAdminEmail resets all changes to the "admin_email" field.
+func (m *AdminActionMutation) ResetAdminEmail() {
+	m.admin_email = nil
 }
 
-// SetIsInline sets the "is_inline" field.
-func (m *SubmissionMutation) SetIsInline(b bool) {
-	m.is_inline = &b
+// SetAction sets the "action" field.
+func (m *AdminActionMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// IsInline returns the value of the "is_inline" field in the mutation.
-func (m *SubmissionMutation) IsInline() (r bool, exists bool) {
-	v := m.is_inline
+// Action returns the value of the "action" field in the mutation.
+func (m *AdminActionMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsInline returns the old "is_inline" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldIsInline(ctx context.Context) (v bool, err error) {
+func (m *AdminActionMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsInline is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsInline requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsInline: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.IsInline, nil
+	return oldValue.Action, nil
 }
 
-// ResetIsInline resets all changes to the "is_inline" field.
-func (m *SubmissionMutation) ResetIsInline() {
-	m.is_inline = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AdminActionMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetIsPublic sets the "is_public" field.
-func (m *SubmissionMutation) SetIsPublic(b bool) {
-	m.is_public = &b
+// SetTargetUserID sets the "target_user_id" field.
+func (m *AdminActionMutation) SetTargetUserID(u uuid.UUID) {
+	m.target_user_id = &u
 }
 
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *SubmissionMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *AdminActionMutation) TargetUserID() (r uuid.UUID, exists bool) {
+	v := m.target_user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublic returns the old "is_public" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetUserID returns the old "target_user_id" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+func (m *AdminActionMutation) OldTargetUserID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
 	}
-	return oldValue.IsPublic, nil
-}
-
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *SubmissionMutation) ResetIsPublic() {
-	m.is_public = nil
+	return oldValue.TargetUserID, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SubmissionMutation) SetStatus(s submission.Status) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *SubmissionMutation) Status() (r submission.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (m *AdminActionMutation) ClearTargetUserID() {
+	m.target_user_id = nil
+	m.clearedFields[adminaction.FieldTargetUserID] = struct{}{}
 }
 
-// OldStatus returns the old "status" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldStatus(ctx context.Context) (v submission.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
+// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
+func (m *AdminActionMutation) TargetUserIDCleared() bool {
+	_, ok := m.clearedFields[adminaction.FieldTargetUserID]
+	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SubmissionMutation) ResetStatus() {
-	m.status = nil
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *AdminActionMutation) ResetTargetUserID() {
+	m.target_user_id = nil
+	delete(m.clearedFields, adminaction.FieldTargetUserID)
 }
 
-// SetReason sets the "reason" field.
-func (m *SubmissionMutation) SetReason(s string) {
-	m.reason = &s
+// SetTargetSubmissionID sets the "target_submission_id" field.
+func (m *AdminActionMutation) SetTargetSubmissionID(u uuid.UUID) {
+	m.target_submission_id = &u
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *SubmissionMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// TargetSubmissionID returns the value of the "target_submission_id" field in the mutation.
+func (m *AdminActionMutation) TargetSubmissionID() (r uuid.UUID, exists bool) {
+	v := m.target_submission_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetSubmissionID returns the old "target_submission_id" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *AdminActionMutation) OldTargetSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetSubmissionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldTargetSubmissionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetSubmissionID: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.TargetSubmissionID, nil
 }
 
-// ClearReason clears the value of the "reason" field.
-func (m *SubmissionMutation) ClearReason() {
-	m.reason = nil
-	m.clearedFields[submission.FieldReason] = struct{}{}
+// ClearTargetSubmissionID clears the value of the "target_submission_id" field.
+func (m *AdminActionMutation) ClearTargetSubmissionID() {
+	m.target_submission_id = nil
+	m.clearedFields[adminaction.FieldTargetSubmissionID] = struct{}{}
 }
 
-// ReasonCleared returns if the "reason" field was cleared in this mutation.
-func (m *SubmissionMutation) ReasonCleared() bool {
-	_, ok := m.clearedFields[submission.FieldReason]
+// TargetSubmissionIDCleared returns if the "target_submission_id" field was cleared in this mutation.
+func (m *AdminActionMutation) TargetSubmissionIDCleared() bool {
+	_, ok := m.clearedFields[adminaction.FieldTargetSubmissionID]
 	return ok
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *SubmissionMutation) ResetReason() {
-	m.reason = nil
-	delete(m.clearedFields, submission.FieldReason)
+// ResetTargetSubmissionID resets all changes to the "target_submission_id" field.
+func (m *AdminActionMutation) ResetTargetSubmissionID() {
+	m.target_submission_id = nil
+	delete(m.clearedFields, adminaction.FieldTargetSubmissionID)
 }
 
-// SetGitRepo sets the "git_repo" field.
-func (m *SubmissionMutation) SetGitRepo(s string) {
-	m.git_repo = &s
+// SetMetadata sets the "metadata" field.
+func (m *AdminActionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
 }
 
-// GitRepo returns the value of the "git_repo" field in the mutation.
-func (m *SubmissionMutation) GitRepo() (r string, exists bool) {
-	v := m.git_repo
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AdminActionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGitRepo returns the old "git_repo" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldGitRepo(ctx context.Context) (v string, err error) {
+func (m *AdminActionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGitRepo is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGitRepo requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGitRepo: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.GitRepo, nil
+	return oldValue.Metadata, nil
 }
 
-// ClearGitRepo clears the value of the "git_repo" field.
-func (m *SubmissionMutation) ClearGitRepo() {
-	m.git_repo = nil
-	m.clearedFields[submission.FieldGitRepo] = struct{}{}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AdminActionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[adminaction.FieldMetadata] = struct{}{}
 }
 
-// GitRepoCleared returns if the "git_repo" field was cleared in this mutation.
-func (m *SubmissionMutation) GitRepoCleared() bool {
-	_, ok := m.clearedFields[submission.FieldGitRepo]
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AdminActionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[adminaction.FieldMetadata]
 	return ok
 }
 
-// ResetGitRepo resets all changes to the "git_repo" field.
-func (m *SubmissionMutation) ResetGitRepo() {
-	m.git_repo = nil
-	delete(m.clearedFields, submission.FieldGitRepo)
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AdminActionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, adminaction.FieldMetadata)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SubmissionMutation) SetCreatedAt(t time.Time) {
+func (m *AdminActionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SubmissionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AdminActionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -459,10 +451,10 @@ func (m *SubmissionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AdminAction entity.
+// If the AdminAction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AdminActionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -477,286 +469,9514 @@ func (m *SubmissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SubmissionMutation) ResetCreatedAt() {
+func (m *AdminActionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetShareID sets the "share_id" field.
-func (m *SubmissionMutation) SetShareID(s string) {
-	m.share_id = &s
+// Where appends a list predicates to the AdminActionMutation builder.
+func (m *AdminActionMutation) Where(ps ...predicate.AdminAction) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ShareID returns the value of the "share_id" field in the mutation.
-func (m *SubmissionMutation) ShareID() (r string, exists bool) {
-	v := m.share_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// Op returns the operation name.
+func (m *AdminActionMutation) Op() Op {
+	return m.op
 }
 
-// OldShareID returns the old "share_id" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldShareID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShareID is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (AdminAction).
+func (m *AdminActionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AdminActionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.admin_id != nil {
+		fields = append(fields, adminaction.FieldAdminID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShareID requires an ID field in the mutation")
+	if m.admin_email != nil {
+		fields = append(fields, adminaction.FieldAdminEmail)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShareID: %w", err)
+	if m.action != nil {
+		fields = append(fields, adminaction.FieldAction)
 	}
-	return oldValue.ShareID, nil
+	if m.target_user_id != nil {
+		fields = append(fields, adminaction.FieldTargetUserID)
+	}
+	if m.target_submission_id != nil {
+		fields = append(fields, adminaction.FieldTargetSubmissionID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, adminaction.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, adminaction.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearShareID clears the value of the "share_id" field.
-func (m *SubmissionMutation) ClearShareID() {
-	m.share_id = nil
-	m.clearedFields[submission.FieldShareID] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AdminActionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case adminaction.FieldAdminID:
+		return m.AdminID()
+	case adminaction.FieldAdminEmail:
+		return m.AdminEmail()
+	case adminaction.FieldAction:
+		return m.Action()
+	case adminaction.FieldTargetUserID:
+		return m.TargetUserID()
+	case adminaction.FieldTargetSubmissionID:
+		return m.TargetSubmissionID()
+	case adminaction.FieldMetadata:
+		return m.Metadata()
+	case adminaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// ShareIDCleared returns if the "share_id" field was cleared in this mutation.
-func (m *SubmissionMutation) ShareIDCleared() bool {
-	_, ok := m.clearedFields[submission.FieldShareID]
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AdminActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case adminaction.FieldAdminID:
+		return m.OldAdminID(ctx)
+	case adminaction.FieldAdminEmail:
+		return m.OldAdminEmail(ctx)
+	case adminaction.FieldAction:
+		return m.OldAction(ctx)
+	case adminaction.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case adminaction.FieldTargetSubmissionID:
+		return m.OldTargetSubmissionID(ctx)
+	case adminaction.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case adminaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AdminAction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AdminActionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case adminaction.FieldAdminID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdminID(v)
+		return nil
+	case adminaction.FieldAdminEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdminEmail(v)
+		return nil
+	case adminaction.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case adminaction.FieldTargetUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetUserID(v)
+		return nil
+	case adminaction.FieldTargetSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetSubmissionID(v)
+		return nil
+	case adminaction.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case adminaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AdminAction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AdminActionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AdminActionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AdminActionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AdminAction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AdminActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(adminaction.FieldTargetUserID) {
+		fields = append(fields, adminaction.FieldTargetUserID)
+	}
+	if m.FieldCleared(adminaction.FieldTargetSubmissionID) {
+		fields = append(fields, adminaction.FieldTargetSubmissionID)
+	}
+	if m.FieldCleared(adminaction.FieldMetadata) {
+		fields = append(fields, adminaction.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AdminActionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AdminActionMutation) ClearField(name string) error {
+	switch name {
+	case adminaction.FieldTargetUserID:
+		m.ClearTargetUserID()
+		return nil
+	case adminaction.FieldTargetSubmissionID:
+		m.ClearTargetSubmissionID()
+		return nil
+	case adminaction.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AdminAction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AdminActionMutation) ResetField(name string) error {
+	switch name {
+	case adminaction.FieldAdminID:
+		m.ResetAdminID()
+		return nil
+	case adminaction.FieldAdminEmail:
+		m.ResetAdminEmail()
+		return nil
+	case adminaction.FieldAction:
+		m.ResetAction()
+		return nil
+	case adminaction.FieldTargetUserID:
+		m.ResetTargetUserID()
+		return nil
+	case adminaction.FieldTargetSubmissionID:
+		m.ResetTargetSubmissionID()
+		return nil
+	case adminaction.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case adminaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AdminAction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AdminActionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AdminActionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AdminActionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AdminActionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AdminActionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AdminActionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AdminActionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AdminAction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AdminActionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AdminAction edge %s", name)
+}
+
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	_type         *auditevent.Type
+	actor_type    *auditevent.ActorType
+	actor_id      *uuid.UUID
+	ip            *string
+	user_agent    *string
+	metadata      *map[string]interface{}
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditEvent, error)
+	predicates    []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uuid.UUID) auditeventOption {
+	return func(m *AuditEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEvent
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AuditEventMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuditEventMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuditEventMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *AuditEventMutation) SetType(a auditevent.Type) {
+	m._type = &a
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *AuditEventMutation) GetType() (r auditevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldType(ctx context.Context) (v auditevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *AuditEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetActorType sets the "actor_type" field.
+func (m *AuditEventMutation) SetActorType(at auditevent.ActorType) {
+	m.actor_type = &at
+}
+
+// ActorType returns the value of the "actor_type" field in the mutation.
+func (m *AuditEventMutation) ActorType() (r auditevent.ActorType, exists bool) {
+	v := m.actor_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorType returns the old "actor_type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldActorType(ctx context.Context) (v auditevent.ActorType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorType: %w", err)
+	}
+	return oldValue.ActorType, nil
+}
+
+// ResetActorType resets all changes to the "actor_type" field.
+func (m *AuditEventMutation) ResetActorType() {
+	m.actor_type = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *AuditEventMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditEventMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditEventMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditevent.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditEventMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditEventMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditevent.FieldActorID)
+}
+
+// SetIP sets the "ip" field.
+func (m *AuditEventMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditEventMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *AuditEventMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditevent.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuditEventMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditEventMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditevent.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *AuditEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuditEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *AuditEventMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[auditevent.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *AuditEventMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuditEventMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, auditevent.FieldUserAgent)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AuditEventMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditEventMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditEventMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditevent.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditEventMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditEventMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditevent.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, auditevent.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, auditevent.FieldType)
+	}
+	if m.actor_type != nil {
+		fields = append(fields, auditevent.FieldActorType)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, auditevent.FieldActorID)
+	}
+	if m.ip != nil {
+		fields = append(fields, auditevent.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, auditevent.FieldUserAgent)
+	}
+	if m.metadata != nil {
+		fields = append(fields, auditevent.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldUserID:
+		return m.UserID()
+	case auditevent.FieldType:
+		return m.GetType()
+	case auditevent.FieldActorType:
+		return m.ActorType()
+	case auditevent.FieldActorID:
+		return m.ActorID()
+	case auditevent.FieldIP:
+		return m.IP()
+	case auditevent.FieldUserAgent:
+		return m.UserAgent()
+	case auditevent.FieldMetadata:
+		return m.Metadata()
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case auditevent.FieldType:
+		return m.OldType(ctx)
+	case auditevent.FieldActorType:
+		return m.OldActorType(ctx)
+	case auditevent.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditevent.FieldIP:
+		return m.OldIP(ctx)
+	case auditevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case auditevent.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case auditevent.FieldType:
+		v, ok := value.(auditevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case auditevent.FieldActorType:
+		v, ok := value.(auditevent.ActorType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorType(v)
+		return nil
+	case auditevent.FieldActorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case auditevent.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case auditevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case auditevent.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditevent.FieldActorID) {
+		fields = append(fields, auditevent.FieldActorID)
+	}
+	if m.FieldCleared(auditevent.FieldIP) {
+		fields = append(fields, auditevent.FieldIP)
+	}
+	if m.FieldCleared(auditevent.FieldUserAgent) {
+		fields = append(fields, auditevent.FieldUserAgent)
+	}
+	if m.FieldCleared(auditevent.FieldMetadata) {
+		fields = append(fields, auditevent.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEventMutation) ClearField(name string) error {
+	switch name {
+	case auditevent.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case auditevent.FieldIP:
+		m.ClearIP()
+		return nil
+	case auditevent.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case auditevent.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case auditevent.FieldType:
+		m.ResetType()
+		return nil
+	case auditevent.FieldActorType:
+		m.ResetActorType()
+		return nil
+	case auditevent.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case auditevent.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case auditevent.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
+}
+
+// BlobMutation represents an operation that mutates the Blob nodes in the graph.
+type BlobMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	size                *int64
+	addsize             *int64
+	encrypted           *bool
+	reference_count     *int
+	addreference_count  *int
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	source_files        map[int]struct{}
+	removedsource_files map[int]struct{}
+	clearedsource_files bool
+	done                bool
+	oldValue            func(context.Context) (*Blob, error)
+	predicates          []predicate.Blob
+}
+
+var _ ent.Mutation = (*BlobMutation)(nil)
+
+// blobOption allows management of the mutation configuration using functional options.
+type blobOption func(*BlobMutation)
+
+// newBlobMutation creates new mutation for the Blob entity.
+func newBlobMutation(c config, op Op, opts ...blobOption) *BlobMutation {
+	m := &BlobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlobID sets the ID field of the mutation.
+func withBlobID(id string) blobOption {
+	return func(m *BlobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Blob
+		)
+		m.oldValue = func(ctx context.Context) (*Blob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Blob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlob sets the old Blob of the mutation.
+func withBlob(node *Blob) blobOption {
+	return func(m *BlobMutation) {
+		m.oldValue = func(context.Context) (*Blob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Blob entities.
+func (m *BlobMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlobMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlobMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Blob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSize sets the "size" field.
+func (m *BlobMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *BlobMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *BlobMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BlobMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *BlobMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (m *BlobMutation) SetEncrypted(b bool) {
+	m.encrypted = &b
+}
+
+// Encrypted returns the value of the "encrypted" field in the mutation.
+func (m *BlobMutation) Encrypted() (r bool, exists bool) {
+	v := m.encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncrypted returns the old "encrypted" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncrypted: %w", err)
+	}
+	return oldValue.Encrypted, nil
+}
+
+// ResetEncrypted resets all changes to the "encrypted" field.
+func (m *BlobMutation) ResetEncrypted() {
+	m.encrypted = nil
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (m *BlobMutation) SetReferenceCount(i int) {
+	m.reference_count = &i
+	m.addreference_count = nil
+}
+
+// ReferenceCount returns the value of the "reference_count" field in the mutation.
+func (m *BlobMutation) ReferenceCount() (r int, exists bool) {
+	v := m.reference_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceCount returns the old "reference_count" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldReferenceCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceCount: %w", err)
+	}
+	return oldValue.ReferenceCount, nil
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (m *BlobMutation) AddReferenceCount(i int) {
+	if m.addreference_count != nil {
+		*m.addreference_count += i
+	} else {
+		m.addreference_count = &i
+	}
+}
+
+// AddedReferenceCount returns the value that was added to the "reference_count" field in this mutation.
+func (m *BlobMutation) AddedReferenceCount() (r int, exists bool) {
+	v := m.addreference_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReferenceCount resets all changes to the "reference_count" field.
+func (m *BlobMutation) ResetReferenceCount() {
+	m.reference_count = nil
+	m.addreference_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BlobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BlobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BlobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddSourceFileIDs adds the "source_files" edge to the SourceFile entity by ids.
+func (m *BlobMutation) AddSourceFileIDs(ids ...int) {
+	if m.source_files == nil {
+		m.source_files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.source_files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSourceFiles clears the "source_files" edge to the SourceFile entity.
+func (m *BlobMutation) ClearSourceFiles() {
+	m.clearedsource_files = true
+}
+
+// SourceFilesCleared reports if the "source_files" edge to the SourceFile entity was cleared.
+func (m *BlobMutation) SourceFilesCleared() bool {
+	return m.clearedsource_files
+}
+
+// RemoveSourceFileIDs removes the "source_files" edge to the SourceFile entity by IDs.
+func (m *BlobMutation) RemoveSourceFileIDs(ids ...int) {
+	if m.removedsource_files == nil {
+		m.removedsource_files = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.source_files, ids[i])
+		m.removedsource_files[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSourceFiles returns the removed IDs of the "source_files" edge to the SourceFile entity.
+func (m *BlobMutation) RemovedSourceFilesIDs() (ids []int) {
+	for id := range m.removedsource_files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SourceFilesIDs returns the "source_files" edge IDs in the mutation.
+func (m *BlobMutation) SourceFilesIDs() (ids []int) {
+	for id := range m.source_files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSourceFiles resets all changes to the "source_files" edge.
+func (m *BlobMutation) ResetSourceFiles() {
+	m.source_files = nil
+	m.clearedsource_files = false
+	m.removedsource_files = nil
+}
+
+// Where appends a list predicates to the BlobMutation builder.
+func (m *BlobMutation) Where(ps ...predicate.Blob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BlobMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Blob).
+func (m *BlobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlobMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.size != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	if m.encrypted != nil {
+		fields = append(fields, blob.FieldEncrypted)
+	}
+	if m.reference_count != nil {
+		fields = append(fields, blob.FieldReferenceCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, blob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, blob.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSize:
+		return m.Size()
+	case blob.FieldEncrypted:
+		return m.Encrypted()
+	case blob.FieldReferenceCount:
+		return m.ReferenceCount()
+	case blob.FieldCreatedAt:
+		return m.CreatedAt()
+	case blob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blob.FieldSize:
+		return m.OldSize(ctx)
+	case blob.FieldEncrypted:
+		return m.OldEncrypted(ctx)
+	case blob.FieldReferenceCount:
+		return m.OldReferenceCount(ctx)
+	case blob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case blob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Blob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case blob.FieldEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncrypted(v)
+		return nil
+	case blob.FieldReferenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceCount(v)
+		return nil
+	case blob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case blob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlobMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	if m.addreference_count != nil {
+		fields = append(fields, blob.FieldReferenceCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSize:
+		return m.AddedSize()
+	case blob.FieldReferenceCount:
+		return m.AddedReferenceCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case blob.FieldReferenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReferenceCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Blob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlobMutation) ResetField(name string) error {
+	switch name {
+	case blob.FieldSize:
+		m.ResetSize()
+		return nil
+	case blob.FieldEncrypted:
+		m.ResetEncrypted()
+		return nil
+	case blob.FieldReferenceCount:
+		m.ResetReferenceCount()
+		return nil
+	case blob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case blob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.source_files != nil {
+		edges = append(edges, blob.EdgeSourceFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blob.EdgeSourceFiles:
+		ids := make([]ent.Value, 0, len(m.source_files))
+		for id := range m.source_files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsource_files != nil {
+		edges = append(edges, blob.EdgeSourceFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case blob.EdgeSourceFiles:
+		ids := make([]ent.Value, 0, len(m.removedsource_files))
+		for id := range m.removedsource_files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsource_files {
+		edges = append(edges, blob.EdgeSourceFiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blob.EdgeSourceFiles:
+		return m.clearedsource_files
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlobMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Blob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlobMutation) ResetEdge(name string) error {
+	switch name {
+	case blob.EdgeSourceFiles:
+		m.ResetSourceFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Blob edge %s", name)
+}
+
+// DataKeyMutation represents an operation that mutates the DataKey nodes in the graph.
+type DataKeyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	encrypted_key  *[]byte
+	master_key_id  *string
+	active_user_id *uuid.UUID
+	created_at     *time.Time
+	rotated_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*DataKey, error)
+	predicates     []predicate.DataKey
+}
+
+var _ ent.Mutation = (*DataKeyMutation)(nil)
+
+// datakeyOption allows management of the mutation configuration using functional options.
+type datakeyOption func(*DataKeyMutation)
+
+// newDataKeyMutation creates new mutation for the DataKey entity.
+func newDataKeyMutation(c config, op Op, opts ...datakeyOption) *DataKeyMutation {
+	m := &DataKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDataKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDataKeyID sets the ID field of the mutation.
+func withDataKeyID(id uuid.UUID) datakeyOption {
+	return func(m *DataKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DataKey
+		)
+		m.oldValue = func(ctx context.Context) (*DataKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DataKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDataKey sets the old DataKey of the mutation.
+func withDataKey(node *DataKey) datakeyOption {
+	return func(m *DataKeyMutation) {
+		m.oldValue = func(context.Context) (*DataKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DataKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DataKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DataKey entities.
+func (m *DataKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DataKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DataKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DataKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (m *DataKeyMutation) SetEncryptedKey(b []byte) {
+	m.encrypted_key = &b
+}
+
+// EncryptedKey returns the value of the "encrypted_key" field in the mutation.
+func (m *DataKeyMutation) EncryptedKey() (r []byte, exists bool) {
+	v := m.encrypted_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedKey returns the old "encrypted_key" field's value of the DataKey entity.
+// If the DataKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataKeyMutation) OldEncryptedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKey: %w", err)
+	}
+	return oldValue.EncryptedKey, nil
+}
+
+// ResetEncryptedKey resets all changes to the "encrypted_key" field.
+func (m *DataKeyMutation) ResetEncryptedKey() {
+	m.encrypted_key = nil
+}
+
+// SetMasterKeyID sets the "master_key_id" field.
+func (m *DataKeyMutation) SetMasterKeyID(s string) {
+	m.master_key_id = &s
+}
+
+// MasterKeyID returns the value of the "master_key_id" field in the mutation.
+func (m *DataKeyMutation) MasterKeyID() (r string, exists bool) {
+	v := m.master_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMasterKeyID returns the old "master_key_id" field's value of the DataKey entity.
+// If the DataKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataKeyMutation) OldMasterKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMasterKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMasterKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMasterKeyID: %w", err)
+	}
+	return oldValue.MasterKeyID, nil
+}
+
+// ResetMasterKeyID resets all changes to the "master_key_id" field.
+func (m *DataKeyMutation) ResetMasterKeyID() {
+	m.master_key_id = nil
+}
+
+// SetActiveUserID sets the "active_user_id" field.
+func (m *DataKeyMutation) SetActiveUserID(u uuid.UUID) {
+	m.active_user_id = &u
+}
+
+// ActiveUserID returns the value of the "active_user_id" field in the mutation.
+func (m *DataKeyMutation) ActiveUserID() (r uuid.UUID, exists bool) {
+	v := m.active_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveUserID returns the old "active_user_id" field's value of the DataKey entity.
+// If the DataKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataKeyMutation) OldActiveUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveUserID: %w", err)
+	}
+	return oldValue.ActiveUserID, nil
+}
+
+// ClearActiveUserID clears the value of the "active_user_id" field.
+func (m *DataKeyMutation) ClearActiveUserID() {
+	m.active_user_id = nil
+	m.clearedFields[datakey.FieldActiveUserID] = struct{}{}
+}
+
+// ActiveUserIDCleared returns if the "active_user_id" field was cleared in this mutation.
+func (m *DataKeyMutation) ActiveUserIDCleared() bool {
+	_, ok := m.clearedFields[datakey.FieldActiveUserID]
+	return ok
+}
+
+// ResetActiveUserID resets all changes to the "active_user_id" field.
+func (m *DataKeyMutation) ResetActiveUserID() {
+	m.active_user_id = nil
+	delete(m.clearedFields, datakey.FieldActiveUserID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DataKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DataKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DataKey entity.
+// If the DataKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DataKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (m *DataKeyMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
+}
+
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *DataKeyMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotatedAt returns the old "rotated_at" field's value of the DataKey entity.
+// If the DataKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataKeyMutation) OldRotatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
+	}
+	return oldValue.RotatedAt, nil
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *DataKeyMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[datakey.FieldRotatedAt] = struct{}{}
+}
+
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *DataKeyMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[datakey.FieldRotatedAt]
+	return ok
+}
+
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *DataKeyMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, datakey.FieldRotatedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *DataKeyMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *DataKeyMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DataKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *DataKeyMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DataKeyMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DataKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DataKeyMutation builder.
+func (m *DataKeyMutation) Where(ps ...predicate.DataKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DataKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (DataKey).
+func (m *DataKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DataKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.encrypted_key != nil {
+		fields = append(fields, datakey.FieldEncryptedKey)
+	}
+	if m.master_key_id != nil {
+		fields = append(fields, datakey.FieldMasterKeyID)
+	}
+	if m.active_user_id != nil {
+		fields = append(fields, datakey.FieldActiveUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, datakey.FieldCreatedAt)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, datakey.FieldRotatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DataKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case datakey.FieldEncryptedKey:
+		return m.EncryptedKey()
+	case datakey.FieldMasterKeyID:
+		return m.MasterKeyID()
+	case datakey.FieldActiveUserID:
+		return m.ActiveUserID()
+	case datakey.FieldCreatedAt:
+		return m.CreatedAt()
+	case datakey.FieldRotatedAt:
+		return m.RotatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DataKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case datakey.FieldEncryptedKey:
+		return m.OldEncryptedKey(ctx)
+	case datakey.FieldMasterKeyID:
+		return m.OldMasterKeyID(ctx)
+	case datakey.FieldActiveUserID:
+		return m.OldActiveUserID(ctx)
+	case datakey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case datakey.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DataKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case datakey.FieldEncryptedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedKey(v)
+		return nil
+	case datakey.FieldMasterKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMasterKeyID(v)
+		return nil
+	case datakey.FieldActiveUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveUserID(v)
+		return nil
+	case datakey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case datakey.FieldRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DataKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DataKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DataKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DataKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DataKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(datakey.FieldActiveUserID) {
+		fields = append(fields, datakey.FieldActiveUserID)
+	}
+	if m.FieldCleared(datakey.FieldRotatedAt) {
+		fields = append(fields, datakey.FieldRotatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DataKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DataKeyMutation) ClearField(name string) error {
+	switch name {
+	case datakey.FieldActiveUserID:
+		m.ClearActiveUserID()
+		return nil
+	case datakey.FieldRotatedAt:
+		m.ClearRotatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DataKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DataKeyMutation) ResetField(name string) error {
+	switch name {
+	case datakey.FieldEncryptedKey:
+		m.ResetEncryptedKey()
+		return nil
+	case datakey.FieldMasterKeyID:
+		m.ResetMasterKeyID()
+		return nil
+	case datakey.FieldActiveUserID:
+		m.ResetActiveUserID()
+		return nil
+	case datakey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case datakey.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DataKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DataKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, datakey.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DataKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case datakey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DataKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DataKeyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DataKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, datakey.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DataKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case datakey.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DataKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case datakey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DataKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DataKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case datakey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DataKey edge %s", name)
+}
+
+// DiagnosticMutation represents an operation that mutates the Diagnostic nodes in the graph.
+type DiagnosticMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	severity          *diagnostic.Severity
+	file              *string
+	line              *int
+	addline           *int
+	column            *int
+	addcolumn         *int
+	code              *string
+	message           *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	submission        *uuid.UUID
+	clearedsubmission bool
+	done              bool
+	oldValue          func(context.Context) (*Diagnostic, error)
+	predicates        []predicate.Diagnostic
+}
+
+var _ ent.Mutation = (*DiagnosticMutation)(nil)
+
+// diagnosticOption allows management of the mutation configuration using functional options.
+type diagnosticOption func(*DiagnosticMutation)
+
+// newDiagnosticMutation creates new mutation for the Diagnostic entity.
+func newDiagnosticMutation(c config, op Op, opts ...diagnosticOption) *DiagnosticMutation {
+	m := &DiagnosticMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiagnostic,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiagnosticID sets the ID field of the mutation.
+func withDiagnosticID(id uuid.UUID) diagnosticOption {
+	return func(m *DiagnosticMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Diagnostic
+		)
+		m.oldValue = func(ctx context.Context) (*Diagnostic, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Diagnostic.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiagnostic sets the old Diagnostic of the mutation.
+func withDiagnostic(node *Diagnostic) diagnosticOption {
+	return func(m *DiagnosticMutation) {
+		m.oldValue = func(context.Context) (*Diagnostic, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiagnosticMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiagnosticMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Diagnostic entities.
+func (m *DiagnosticMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiagnosticMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiagnosticMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Diagnostic.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeverity sets the "severity" field.
+func (m *DiagnosticMutation) SetSeverity(d diagnostic.Severity) {
+	m.severity = &d
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *DiagnosticMutation) Severity() (r diagnostic.Severity, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldSeverity(ctx context.Context) (v diagnostic.Severity, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *DiagnosticMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetFile sets the "file" field.
+func (m *DiagnosticMutation) SetFile(s string) {
+	m.file = &s
+}
+
+// File returns the value of the "file" field in the mutation.
+func (m *DiagnosticMutation) File() (r string, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFile returns the old "file" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldFile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFile: %w", err)
+	}
+	return oldValue.File, nil
+}
+
+// ClearFile clears the value of the "file" field.
+func (m *DiagnosticMutation) ClearFile() {
+	m.file = nil
+	m.clearedFields[diagnostic.FieldFile] = struct{}{}
+}
+
+// FileCleared returns if the "file" field was cleared in this mutation.
+func (m *DiagnosticMutation) FileCleared() bool {
+	_, ok := m.clearedFields[diagnostic.FieldFile]
+	return ok
+}
+
+// ResetFile resets all changes to the "file" field.
+func (m *DiagnosticMutation) ResetFile() {
+	m.file = nil
+	delete(m.clearedFields, diagnostic.FieldFile)
+}
+
+// SetLine sets the "line" field.
+func (m *DiagnosticMutation) SetLine(i int) {
+	m.line = &i
+	m.addline = nil
+}
+
+// Line returns the value of the "line" field in the mutation.
+func (m *DiagnosticMutation) Line() (r int, exists bool) {
+	v := m.line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLine returns the old "line" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldLine(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLine: %w", err)
+	}
+	return oldValue.Line, nil
+}
+
+// AddLine adds i to the "line" field.
+func (m *DiagnosticMutation) AddLine(i int) {
+	if m.addline != nil {
+		*m.addline += i
+	} else {
+		m.addline = &i
+	}
+}
+
+// AddedLine returns the value that was added to the "line" field in this mutation.
+func (m *DiagnosticMutation) AddedLine() (r int, exists bool) {
+	v := m.addline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLine clears the value of the "line" field.
+func (m *DiagnosticMutation) ClearLine() {
+	m.line = nil
+	m.addline = nil
+	m.clearedFields[diagnostic.FieldLine] = struct{}{}
+}
+
+// LineCleared returns if the "line" field was cleared in this mutation.
+func (m *DiagnosticMutation) LineCleared() bool {
+	_, ok := m.clearedFields[diagnostic.FieldLine]
+	return ok
+}
+
+// ResetLine resets all changes to the "line" field.
+func (m *DiagnosticMutation) ResetLine() {
+	m.line = nil
+	m.addline = nil
+	delete(m.clearedFields, diagnostic.FieldLine)
+}
+
+// SetColumn sets the "column" field.
+func (m *DiagnosticMutation) SetColumn(i int) {
+	m.column = &i
+	m.addcolumn = nil
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *DiagnosticMutation) Column() (r int, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldColumn(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// AddColumn adds i to the "column" field.
+func (m *DiagnosticMutation) AddColumn(i int) {
+	if m.addcolumn != nil {
+		*m.addcolumn += i
+	} else {
+		m.addcolumn = &i
+	}
+}
+
+// AddedColumn returns the value that was added to the "column" field in this mutation.
+func (m *DiagnosticMutation) AddedColumn() (r int, exists bool) {
+	v := m.addcolumn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearColumn clears the value of the "column" field.
+func (m *DiagnosticMutation) ClearColumn() {
+	m.column = nil
+	m.addcolumn = nil
+	m.clearedFields[diagnostic.FieldColumn] = struct{}{}
+}
+
+// ColumnCleared returns if the "column" field was cleared in this mutation.
+func (m *DiagnosticMutation) ColumnCleared() bool {
+	_, ok := m.clearedFields[diagnostic.FieldColumn]
+	return ok
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *DiagnosticMutation) ResetColumn() {
+	m.column = nil
+	m.addcolumn = nil
+	delete(m.clearedFields, diagnostic.FieldColumn)
+}
+
+// SetCode sets the "code" field.
+func (m *DiagnosticMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *DiagnosticMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ClearCode clears the value of the "code" field.
+func (m *DiagnosticMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[diagnostic.FieldCode] = struct{}{}
+}
+
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *DiagnosticMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[diagnostic.FieldCode]
+	return ok
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *DiagnosticMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, diagnostic.FieldCode)
+}
+
+// SetMessage sets the "message" field.
+func (m *DiagnosticMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *DiagnosticMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *DiagnosticMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiagnosticMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiagnosticMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Diagnostic entity.
+// If the Diagnostic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiagnosticMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiagnosticMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSubmissionID sets the "submission" edge to the Submission entity by id.
+func (m *DiagnosticMutation) SetSubmissionID(id uuid.UUID) {
+	m.submission = &id
+}
+
+// ClearSubmission clears the "submission" edge to the Submission entity.
+func (m *DiagnosticMutation) ClearSubmission() {
+	m.clearedsubmission = true
+}
+
+// SubmissionCleared reports if the "submission" edge to the Submission entity was cleared.
+func (m *DiagnosticMutation) SubmissionCleared() bool {
+	return m.clearedsubmission
+}
+
+// SubmissionID returns the "submission" edge ID in the mutation.
+func (m *DiagnosticMutation) SubmissionID() (id uuid.UUID, exists bool) {
+	if m.submission != nil {
+		return *m.submission, true
+	}
+	return
+}
+
+// SubmissionIDs returns the "submission" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubmissionID instead. It exists only for internal usage by the builders.
+func (m *DiagnosticMutation) SubmissionIDs() (ids []uuid.UUID) {
+	if id := m.submission; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSubmission resets all changes to the "submission" edge.
+func (m *DiagnosticMutation) ResetSubmission() {
+	m.submission = nil
+	m.clearedsubmission = false
+}
+
+// Where appends a list predicates to the DiagnosticMutation builder.
+func (m *DiagnosticMutation) Where(ps ...predicate.Diagnostic) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DiagnosticMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Diagnostic).
+func (m *DiagnosticMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiagnosticMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.severity != nil {
+		fields = append(fields, diagnostic.FieldSeverity)
+	}
+	if m.file != nil {
+		fields = append(fields, diagnostic.FieldFile)
+	}
+	if m.line != nil {
+		fields = append(fields, diagnostic.FieldLine)
+	}
+	if m.column != nil {
+		fields = append(fields, diagnostic.FieldColumn)
+	}
+	if m.code != nil {
+		fields = append(fields, diagnostic.FieldCode)
+	}
+	if m.message != nil {
+		fields = append(fields, diagnostic.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, diagnostic.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiagnosticMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case diagnostic.FieldSeverity:
+		return m.Severity()
+	case diagnostic.FieldFile:
+		return m.File()
+	case diagnostic.FieldLine:
+		return m.Line()
+	case diagnostic.FieldColumn:
+		return m.Column()
+	case diagnostic.FieldCode:
+		return m.Code()
+	case diagnostic.FieldMessage:
+		return m.Message()
+	case diagnostic.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiagnosticMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case diagnostic.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case diagnostic.FieldFile:
+		return m.OldFile(ctx)
+	case diagnostic.FieldLine:
+		return m.OldLine(ctx)
+	case diagnostic.FieldColumn:
+		return m.OldColumn(ctx)
+	case diagnostic.FieldCode:
+		return m.OldCode(ctx)
+	case diagnostic.FieldMessage:
+		return m.OldMessage(ctx)
+	case diagnostic.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Diagnostic field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiagnosticMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case diagnostic.FieldSeverity:
+		v, ok := value.(diagnostic.Severity)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case diagnostic.FieldFile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFile(v)
+		return nil
+	case diagnostic.FieldLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLine(v)
+		return nil
+	case diagnostic.FieldColumn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case diagnostic.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case diagnostic.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case diagnostic.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiagnosticMutation) AddedFields() []string {
+	var fields []string
+	if m.addline != nil {
+		fields = append(fields, diagnostic.FieldLine)
+	}
+	if m.addcolumn != nil {
+		fields = append(fields, diagnostic.FieldColumn)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiagnosticMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case diagnostic.FieldLine:
+		return m.AddedLine()
+	case diagnostic.FieldColumn:
+		return m.AddedColumn()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiagnosticMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case diagnostic.FieldLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLine(v)
+		return nil
+	case diagnostic.FieldColumn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColumn(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiagnosticMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(diagnostic.FieldFile) {
+		fields = append(fields, diagnostic.FieldFile)
+	}
+	if m.FieldCleared(diagnostic.FieldLine) {
+		fields = append(fields, diagnostic.FieldLine)
+	}
+	if m.FieldCleared(diagnostic.FieldColumn) {
+		fields = append(fields, diagnostic.FieldColumn)
+	}
+	if m.FieldCleared(diagnostic.FieldCode) {
+		fields = append(fields, diagnostic.FieldCode)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiagnosticMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiagnosticMutation) ClearField(name string) error {
+	switch name {
+	case diagnostic.FieldFile:
+		m.ClearFile()
+		return nil
+	case diagnostic.FieldLine:
+		m.ClearLine()
+		return nil
+	case diagnostic.FieldColumn:
+		m.ClearColumn()
+		return nil
+	case diagnostic.FieldCode:
+		m.ClearCode()
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiagnosticMutation) ResetField(name string) error {
+	switch name {
+	case diagnostic.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case diagnostic.FieldFile:
+		m.ResetFile()
+		return nil
+	case diagnostic.FieldLine:
+		m.ResetLine()
+		return nil
+	case diagnostic.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case diagnostic.FieldCode:
+		m.ResetCode()
+		return nil
+	case diagnostic.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case diagnostic.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiagnosticMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.submission != nil {
+		edges = append(edges, diagnostic.EdgeSubmission)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiagnosticMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case diagnostic.EdgeSubmission:
+		if id := m.submission; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiagnosticMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiagnosticMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiagnosticMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubmission {
+		edges = append(edges, diagnostic.EdgeSubmission)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiagnosticMutation) EdgeCleared(name string) bool {
+	switch name {
+	case diagnostic.EdgeSubmission:
+		return m.clearedsubmission
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiagnosticMutation) ClearEdge(name string) error {
+	switch name {
+	case diagnostic.EdgeSubmission:
+		m.ClearSubmission()
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiagnosticMutation) ResetEdge(name string) error {
+	switch name {
+	case diagnostic.EdgeSubmission:
+		m.ResetSubmission()
+		return nil
+	}
+	return fmt.Errorf("unknown Diagnostic edge %s", name)
+}
+
+// LeaseMutation represents an operation that mutates the Lease nodes in the graph.
+type LeaseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	holder        *string
+	expires_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Lease, error)
+	predicates    []predicate.Lease
+}
+
+var _ ent.Mutation = (*LeaseMutation)(nil)
+
+// leaseOption allows management of the mutation configuration using functional options.
+type leaseOption func(*LeaseMutation)
+
+// newLeaseMutation creates new mutation for the Lease entity.
+func newLeaseMutation(c config, op Op, opts ...leaseOption) *LeaseMutation {
+	m := &LeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseID sets the ID field of the mutation.
+func withLeaseID(id string) leaseOption {
+	return func(m *LeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Lease
+		)
+		m.oldValue = func(ctx context.Context) (*Lease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Lease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLease sets the old Lease of the mutation.
+func withLease(node *Lease) leaseOption {
+	return func(m *LeaseMutation) {
+		m.oldValue = func(context.Context) (*Lease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Lease entities.
+func (m *LeaseMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Lease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHolder sets the "holder" field.
+func (m *LeaseMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *LeaseMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *LeaseMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LeaseMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LeaseMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LeaseMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LeaseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LeaseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LeaseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the LeaseMutation builder.
+func (m *LeaseMutation) Where(ps ...predicate.Lease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *LeaseMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Lease).
+func (m *LeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.holder != nil {
+		fields = append(fields, lease.FieldHolder)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, lease.FieldExpiresAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, lease.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldHolder:
+		return m.Holder()
+	case lease.FieldExpiresAt:
+		return m.ExpiresAt()
+	case lease.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lease.FieldHolder:
+		return m.OldHolder(ctx)
+	case lease.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case lease.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Lease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case lease.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case lease.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Lease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Lease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseMutation) ResetField(name string) error {
+	switch name {
+	case lease.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case lease.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case lease.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Lease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Lease edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	_type          *notification.Type
+	title          *string
+	message        *string
+	submission_ids *[]string
+	read_at        *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*Notification, error)
+	predicates     []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(n notification.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *NotificationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NotificationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NotificationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *NotificationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (m *NotificationMutation) SetSubmissionIds(s []string) {
+	m.submission_ids = &s
+}
+
+// SubmissionIds returns the value of the "submission_ids" field in the mutation.
+func (m *NotificationMutation) SubmissionIds() (r []string, exists bool) {
+	v := m.submission_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionIds returns the old "submission_ids" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldSubmissionIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionIds: %w", err)
+	}
+	return oldValue.SubmissionIds, nil
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (m *NotificationMutation) ClearSubmissionIds() {
+	m.submission_ids = nil
+	m.clearedFields[notification.FieldSubmissionIds] = struct{}{}
+}
+
+// SubmissionIdsCleared returns if the "submission_ids" field was cleared in this mutation.
+func (m *NotificationMutation) SubmissionIdsCleared() bool {
+	_, ok := m.clearedFields[notification.FieldSubmissionIds]
+	return ok
+}
+
+// ResetSubmissionIds resets all changes to the "submission_ids" field.
+func (m *NotificationMutation) ResetSubmissionIds() {
+	m.submission_ids = nil
+	delete(m.clearedFields, notification.FieldSubmissionIds)
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NotificationMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NotificationMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, notification.FieldTitle)
+	}
+	if m.message != nil {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m.submission_ids != nil {
+		fields = append(fields, notification.FieldSubmissionIds)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldTitle:
+		return m.Title()
+	case notification.FieldMessage:
+		return m.Message()
+	case notification.FieldSubmissionIds:
+		return m.SubmissionIds()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldTitle:
+		return m.OldTitle(ctx)
+	case notification.FieldMessage:
+		return m.OldMessage(ctx)
+	case notification.FieldSubmissionIds:
+		return m.OldSubmissionIds(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldType:
+		v, ok := value.(notification.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case notification.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notification.FieldSubmissionIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionIds(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldSubmissionIds) {
+		fields = append(fields, notification.FieldSubmissionIds)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldSubmissionIds:
+		m.ClearSubmissionIds()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case notification.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notification.FieldSubmissionIds:
+		m.ResetSubmissionIds()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notification.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notification.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	retention_warning_email  *bool
+	retention_warning_in_app *bool
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	user                     *uuid.UUID
+	cleareduser              bool
+	done                     bool
+	oldValue                 func(context.Context) (*NotificationPreference, error)
+	predicates               []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id uuid.UUID) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationPreference
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationPreference entities.
+func (m *NotificationPreferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationPreferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (m *NotificationPreferenceMutation) SetRetentionWarningEmail(b bool) {
+	m.retention_warning_email = &b
+}
+
+// RetentionWarningEmail returns the value of the "retention_warning_email" field in the mutation.
+func (m *NotificationPreferenceMutation) RetentionWarningEmail() (r bool, exists bool) {
+	v := m.retention_warning_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionWarningEmail returns the old "retention_warning_email" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldRetentionWarningEmail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionWarningEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionWarningEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionWarningEmail: %w", err)
+	}
+	return oldValue.RetentionWarningEmail, nil
+}
+
+// ResetRetentionWarningEmail resets all changes to the "retention_warning_email" field.
+func (m *NotificationPreferenceMutation) ResetRetentionWarningEmail() {
+	m.retention_warning_email = nil
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (m *NotificationPreferenceMutation) SetRetentionWarningInApp(b bool) {
+	m.retention_warning_in_app = &b
+}
+
+// RetentionWarningInApp returns the value of the "retention_warning_in_app" field in the mutation.
+func (m *NotificationPreferenceMutation) RetentionWarningInApp() (r bool, exists bool) {
+	v := m.retention_warning_in_app
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionWarningInApp returns the old "retention_warning_in_app" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldRetentionWarningInApp(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionWarningInApp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionWarningInApp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionWarningInApp: %w", err)
+	}
+	return oldValue.RetentionWarningInApp, nil
+}
+
+// ResetRetentionWarningInApp resets all changes to the "retention_warning_in_app" field.
+func (m *NotificationPreferenceMutation) ResetRetentionWarningInApp() {
+	m.retention_warning_in_app = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationPreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationPreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NotificationPreferenceMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationPreferenceMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationPreferenceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NotificationPreferenceMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationPreferenceMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationPreferenceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *NotificationPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.retention_warning_email != nil {
+		fields = append(fields, notificationpreference.FieldRetentionWarningEmail)
+	}
+	if m.retention_warning_in_app != nil {
+		fields = append(fields, notificationpreference.FieldRetentionWarningInApp)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationpreference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldRetentionWarningEmail:
+		return m.RetentionWarningEmail()
+	case notificationpreference.FieldRetentionWarningInApp:
+		return m.RetentionWarningInApp()
+	case notificationpreference.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationpreference.FieldRetentionWarningEmail:
+		return m.OldRetentionWarningEmail(ctx)
+	case notificationpreference.FieldRetentionWarningInApp:
+		return m.OldRetentionWarningInApp(ctx)
+	case notificationpreference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldRetentionWarningEmail:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionWarningEmail(v)
+		return nil
+	case notificationpreference.FieldRetentionWarningInApp:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionWarningInApp(v)
+		return nil
+	case notificationpreference.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationPreferenceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case notificationpreference.FieldRetentionWarningEmail:
+		m.ResetRetentionWarningEmail()
+		return nil
+	case notificationpreference.FieldRetentionWarningInApp:
+		m.ResetRetentionWarningInApp()
+		return nil
+	case notificationpreference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notificationpreference.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationpreference.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notificationpreference.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationpreference.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationpreference.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationpreference.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
+}
+
+// PendingDeletionMutation represents an operation that mutates the PendingDeletion nodes in the graph.
+type PendingDeletionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	prefix        *string
+	submission_id *uuid.UUID
+	status        *pendingdeletion.Status
+	attempts      *int
+	addattempts   *int
+	last_error    *string
+	created_at    *time.Time
+	scheduled_at  *time.Time
+	verify_after  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PendingDeletion, error)
+	predicates    []predicate.PendingDeletion
+}
+
+var _ ent.Mutation = (*PendingDeletionMutation)(nil)
+
+// pendingdeletionOption allows management of the mutation configuration using functional options.
+type pendingdeletionOption func(*PendingDeletionMutation)
+
+// newPendingDeletionMutation creates new mutation for the PendingDeletion entity.
+func newPendingDeletionMutation(c config, op Op, opts ...pendingdeletionOption) *PendingDeletionMutation {
+	m := &PendingDeletionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePendingDeletion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPendingDeletionID sets the ID field of the mutation.
+func withPendingDeletionID(id uuid.UUID) pendingdeletionOption {
+	return func(m *PendingDeletionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PendingDeletion
+		)
+		m.oldValue = func(ctx context.Context) (*PendingDeletion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PendingDeletion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPendingDeletion sets the old PendingDeletion of the mutation.
+func withPendingDeletion(node *PendingDeletion) pendingdeletionOption {
+	return func(m *PendingDeletionMutation) {
+		m.oldValue = func(context.Context) (*PendingDeletion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PendingDeletionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PendingDeletionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PendingDeletion entities.
+func (m *PendingDeletionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PendingDeletionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PendingDeletionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PendingDeletion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *PendingDeletionMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *PendingDeletionMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *PendingDeletionMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (m *PendingDeletionMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
+}
+
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *PendingDeletionMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionID returns the old "submission_id" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
+	}
+	return oldValue.SubmissionID, nil
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (m *PendingDeletionMutation) ClearSubmissionID() {
+	m.submission_id = nil
+	m.clearedFields[pendingdeletion.FieldSubmissionID] = struct{}{}
+}
+
+// SubmissionIDCleared returns if the "submission_id" field was cleared in this mutation.
+func (m *PendingDeletionMutation) SubmissionIDCleared() bool {
+	_, ok := m.clearedFields[pendingdeletion.FieldSubmissionID]
+	return ok
+}
+
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *PendingDeletionMutation) ResetSubmissionID() {
+	m.submission_id = nil
+	delete(m.clearedFields, pendingdeletion.FieldSubmissionID)
+}
+
+// SetStatus sets the "status" field.
+func (m *PendingDeletionMutation) SetStatus(pe pendingdeletion.Status) {
+	m.status = &pe
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PendingDeletionMutation) Status() (r pendingdeletion.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldStatus(ctx context.Context) (v pendingdeletion.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PendingDeletionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *PendingDeletionMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *PendingDeletionMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *PendingDeletionMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *PendingDeletionMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *PendingDeletionMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *PendingDeletionMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *PendingDeletionMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *PendingDeletionMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[pendingdeletion.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *PendingDeletionMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[pendingdeletion.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *PendingDeletionMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, pendingdeletion.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PendingDeletionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PendingDeletionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PendingDeletionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *PendingDeletionMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *PendingDeletionMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldScheduledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (m *PendingDeletionMutation) ClearScheduledAt() {
+	m.scheduled_at = nil
+	m.clearedFields[pendingdeletion.FieldScheduledAt] = struct{}{}
+}
+
+// ScheduledAtCleared returns if the "scheduled_at" field was cleared in this mutation.
+func (m *PendingDeletionMutation) ScheduledAtCleared() bool {
+	_, ok := m.clearedFields[pendingdeletion.FieldScheduledAt]
+	return ok
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *PendingDeletionMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+	delete(m.clearedFields, pendingdeletion.FieldScheduledAt)
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (m *PendingDeletionMutation) SetVerifyAfter(t time.Time) {
+	m.verify_after = &t
+}
+
+// VerifyAfter returns the value of the "verify_after" field in the mutation.
+func (m *PendingDeletionMutation) VerifyAfter() (r time.Time, exists bool) {
+	v := m.verify_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifyAfter returns the old "verify_after" field's value of the PendingDeletion entity.
+// If the PendingDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingDeletionMutation) OldVerifyAfter(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifyAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifyAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifyAfter: %w", err)
+	}
+	return oldValue.VerifyAfter, nil
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (m *PendingDeletionMutation) ClearVerifyAfter() {
+	m.verify_after = nil
+	m.clearedFields[pendingdeletion.FieldVerifyAfter] = struct{}{}
+}
+
+// VerifyAfterCleared returns if the "verify_after" field was cleared in this mutation.
+func (m *PendingDeletionMutation) VerifyAfterCleared() bool {
+	_, ok := m.clearedFields[pendingdeletion.FieldVerifyAfter]
+	return ok
+}
+
+// ResetVerifyAfter resets all changes to the "verify_after" field.
+func (m *PendingDeletionMutation) ResetVerifyAfter() {
+	m.verify_after = nil
+	delete(m.clearedFields, pendingdeletion.FieldVerifyAfter)
+}
+
+// Where appends a list predicates to the PendingDeletionMutation builder.
+func (m *PendingDeletionMutation) Where(ps ...predicate.PendingDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PendingDeletionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PendingDeletion).
+func (m *PendingDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PendingDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.prefix != nil {
+		fields = append(fields, pendingdeletion.FieldPrefix)
+	}
+	if m.submission_id != nil {
+		fields = append(fields, pendingdeletion.FieldSubmissionID)
+	}
+	if m.status != nil {
+		fields = append(fields, pendingdeletion.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, pendingdeletion.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, pendingdeletion.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pendingdeletion.FieldCreatedAt)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, pendingdeletion.FieldScheduledAt)
+	}
+	if m.verify_after != nil {
+		fields = append(fields, pendingdeletion.FieldVerifyAfter)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PendingDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pendingdeletion.FieldPrefix:
+		return m.Prefix()
+	case pendingdeletion.FieldSubmissionID:
+		return m.SubmissionID()
+	case pendingdeletion.FieldStatus:
+		return m.Status()
+	case pendingdeletion.FieldAttempts:
+		return m.Attempts()
+	case pendingdeletion.FieldLastError:
+		return m.LastError()
+	case pendingdeletion.FieldCreatedAt:
+		return m.CreatedAt()
+	case pendingdeletion.FieldScheduledAt:
+		return m.ScheduledAt()
+	case pendingdeletion.FieldVerifyAfter:
+		return m.VerifyAfter()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PendingDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pendingdeletion.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case pendingdeletion.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case pendingdeletion.FieldStatus:
+		return m.OldStatus(ctx)
+	case pendingdeletion.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case pendingdeletion.FieldLastError:
+		return m.OldLastError(ctx)
+	case pendingdeletion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pendingdeletion.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case pendingdeletion.FieldVerifyAfter:
+		return m.OldVerifyAfter(ctx)
+	}
+	return nil, fmt.Errorf("unknown PendingDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PendingDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pendingdeletion.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case pendingdeletion.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionID(v)
+		return nil
+	case pendingdeletion.FieldStatus:
+		v, ok := value.(pendingdeletion.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case pendingdeletion.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case pendingdeletion.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case pendingdeletion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pendingdeletion.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case pendingdeletion.FieldVerifyAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifyAfter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PendingDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PendingDeletionMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, pendingdeletion.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PendingDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pendingdeletion.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PendingDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pendingdeletion.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PendingDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PendingDeletionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pendingdeletion.FieldSubmissionID) {
+		fields = append(fields, pendingdeletion.FieldSubmissionID)
+	}
+	if m.FieldCleared(pendingdeletion.FieldLastError) {
+		fields = append(fields, pendingdeletion.FieldLastError)
+	}
+	if m.FieldCleared(pendingdeletion.FieldScheduledAt) {
+		fields = append(fields, pendingdeletion.FieldScheduledAt)
+	}
+	if m.FieldCleared(pendingdeletion.FieldVerifyAfter) {
+		fields = append(fields, pendingdeletion.FieldVerifyAfter)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PendingDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PendingDeletionMutation) ClearField(name string) error {
+	switch name {
+	case pendingdeletion.FieldSubmissionID:
+		m.ClearSubmissionID()
+		return nil
+	case pendingdeletion.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case pendingdeletion.FieldScheduledAt:
+		m.ClearScheduledAt()
+		return nil
+	case pendingdeletion.FieldVerifyAfter:
+		m.ClearVerifyAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown PendingDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PendingDeletionMutation) ResetField(name string) error {
+	switch name {
+	case pendingdeletion.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case pendingdeletion.FieldSubmissionID:
+		m.ResetSubmissionID()
+		return nil
+	case pendingdeletion.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case pendingdeletion.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case pendingdeletion.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case pendingdeletion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pendingdeletion.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case pendingdeletion.FieldVerifyAfter:
+		m.ResetVerifyAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown PendingDeletion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PendingDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PendingDeletionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PendingDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PendingDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PendingDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PendingDeletionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PendingDeletionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PendingDeletion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PendingDeletionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PendingDeletion edge %s", name)
+}
+
+// ResumableUploadMutation represents an operation that mutates the ResumableUpload nodes in the graph.
+type ResumableUploadMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	source_language *string
+	target_language *string
+	length          *int64
+	addlength       *int64
+	_offset         *int64
+	add_offset      *int64
+	chunks          *[]string
+	metadata        *string
+	bypass_cache    *bool
+	expires_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*ResumableUpload, error)
+	predicates      []predicate.ResumableUpload
+}
+
+var _ ent.Mutation = (*ResumableUploadMutation)(nil)
+
+// resumableuploadOption allows management of the mutation configuration using functional options.
+type resumableuploadOption func(*ResumableUploadMutation)
+
+// newResumableUploadMutation creates new mutation for the ResumableUpload entity.
+func newResumableUploadMutation(c config, op Op, opts ...resumableuploadOption) *ResumableUploadMutation {
+	m := &ResumableUploadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResumableUpload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResumableUploadID sets the ID field of the mutation.
+func withResumableUploadID(id uuid.UUID) resumableuploadOption {
+	return func(m *ResumableUploadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResumableUpload
+		)
+		m.oldValue = func(ctx context.Context) (*ResumableUpload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResumableUpload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResumableUpload sets the old ResumableUpload of the mutation.
+func withResumableUpload(node *ResumableUpload) resumableuploadOption {
+	return func(m *ResumableUploadMutation) {
+		m.oldValue = func(context.Context) (*ResumableUpload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResumableUploadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResumableUploadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ResumableUpload entities.
+func (m *ResumableUploadMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResumableUploadMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResumableUploadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResumableUpload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (m *ResumableUploadMutation) SetSourceLanguage(s string) {
+	m.source_language = &s
+}
+
+// SourceLanguage returns the value of the "source_language" field in the mutation.
+func (m *ResumableUploadMutation) SourceLanguage() (r string, exists bool) {
+	v := m.source_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceLanguage returns the old "source_language" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldSourceLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceLanguage: %w", err)
+	}
+	return oldValue.SourceLanguage, nil
+}
+
+// ResetSourceLanguage resets all changes to the "source_language" field.
+func (m *ResumableUploadMutation) ResetSourceLanguage() {
+	m.source_language = nil
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (m *ResumableUploadMutation) SetTargetLanguage(s string) {
+	m.target_language = &s
+}
+
+// TargetLanguage returns the value of the "target_language" field in the mutation.
+func (m *ResumableUploadMutation) TargetLanguage() (r string, exists bool) {
+	v := m.target_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetLanguage returns the old "target_language" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldTargetLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetLanguage: %w", err)
+	}
+	return oldValue.TargetLanguage, nil
+}
+
+// ResetTargetLanguage resets all changes to the "target_language" field.
+func (m *ResumableUploadMutation) ResetTargetLanguage() {
+	m.target_language = nil
+}
+
+// SetLength sets the "length" field.
+func (m *ResumableUploadMutation) SetLength(i int64) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *ResumableUploadMutation) Length() (r int64, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldLength(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *ResumableUploadMutation) AddLength(i int64) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *ResumableUploadMutation) AddedLength() (r int64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *ResumableUploadMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetOffset sets the "offset" field.
+func (m *ResumableUploadMutation) SetOffset(i int64) {
+	m._offset = &i
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *ResumableUploadMutation) Offset() (r int64, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldOffset(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds i to the "offset" field.
+func (m *ResumableUploadMutation) AddOffset(i int64) {
+	if m.add_offset != nil {
+		*m.add_offset += i
+	} else {
+		m.add_offset = &i
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *ResumableUploadMutation) AddedOffset() (r int64, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *ResumableUploadMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetChunks sets the "chunks" field.
+func (m *ResumableUploadMutation) SetChunks(s []string) {
+	m.chunks = &s
+}
+
+// Chunks returns the value of the "chunks" field in the mutation.
+func (m *ResumableUploadMutation) Chunks() (r []string, exists bool) {
+	v := m.chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunks returns the old "chunks" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldChunks(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunks: %w", err)
+	}
+	return oldValue.Chunks, nil
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (m *ResumableUploadMutation) ClearChunks() {
+	m.chunks = nil
+	m.clearedFields[resumableupload.FieldChunks] = struct{}{}
+}
+
+// ChunksCleared returns if the "chunks" field was cleared in this mutation.
+func (m *ResumableUploadMutation) ChunksCleared() bool {
+	_, ok := m.clearedFields[resumableupload.FieldChunks]
+	return ok
+}
+
+// ResetChunks resets all changes to the "chunks" field.
+func (m *ResumableUploadMutation) ResetChunks() {
+	m.chunks = nil
+	delete(m.clearedFields, resumableupload.FieldChunks)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ResumableUploadMutation) SetMetadata(s string) {
+	m.metadata = &s
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ResumableUploadMutation) Metadata() (r string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldMetadata(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ResumableUploadMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[resumableupload.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ResumableUploadMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[resumableupload.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ResumableUploadMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, resumableupload.FieldMetadata)
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (m *ResumableUploadMutation) SetBypassCache(b bool) {
+	m.bypass_cache = &b
+}
+
+// BypassCache returns the value of the "bypass_cache" field in the mutation.
+func (m *ResumableUploadMutation) BypassCache() (r bool, exists bool) {
+	v := m.bypass_cache
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBypassCache returns the old "bypass_cache" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldBypassCache(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBypassCache is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBypassCache requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBypassCache: %w", err)
+	}
+	return oldValue.BypassCache, nil
+}
+
+// ResetBypassCache resets all changes to the "bypass_cache" field.
+func (m *ResumableUploadMutation) ResetBypassCache() {
+	m.bypass_cache = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResumableUploadMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResumableUploadMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResumableUploadMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResumableUploadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResumableUploadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResumableUploadMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ResumableUploadMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ResumableUploadMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ResumableUploadMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ResumableUploadMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ResumableUploadMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ResumableUploadMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ResumableUploadMutation builder.
+func (m *ResumableUploadMutation) Where(ps ...predicate.ResumableUpload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ResumableUploadMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ResumableUpload).
+func (m *ResumableUploadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResumableUploadMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.source_language != nil {
+		fields = append(fields, resumableupload.FieldSourceLanguage)
+	}
+	if m.target_language != nil {
+		fields = append(fields, resumableupload.FieldTargetLanguage)
+	}
+	if m.length != nil {
+		fields = append(fields, resumableupload.FieldLength)
+	}
+	if m._offset != nil {
+		fields = append(fields, resumableupload.FieldOffset)
+	}
+	if m.chunks != nil {
+		fields = append(fields, resumableupload.FieldChunks)
+	}
+	if m.metadata != nil {
+		fields = append(fields, resumableupload.FieldMetadata)
+	}
+	if m.bypass_cache != nil {
+		fields = append(fields, resumableupload.FieldBypassCache)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, resumableupload.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, resumableupload.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResumableUploadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resumableupload.FieldSourceLanguage:
+		return m.SourceLanguage()
+	case resumableupload.FieldTargetLanguage:
+		return m.TargetLanguage()
+	case resumableupload.FieldLength:
+		return m.Length()
+	case resumableupload.FieldOffset:
+		return m.Offset()
+	case resumableupload.FieldChunks:
+		return m.Chunks()
+	case resumableupload.FieldMetadata:
+		return m.Metadata()
+	case resumableupload.FieldBypassCache:
+		return m.BypassCache()
+	case resumableupload.FieldExpiresAt:
+		return m.ExpiresAt()
+	case resumableupload.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResumableUploadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resumableupload.FieldSourceLanguage:
+		return m.OldSourceLanguage(ctx)
+	case resumableupload.FieldTargetLanguage:
+		return m.OldTargetLanguage(ctx)
+	case resumableupload.FieldLength:
+		return m.OldLength(ctx)
+	case resumableupload.FieldOffset:
+		return m.OldOffset(ctx)
+	case resumableupload.FieldChunks:
+		return m.OldChunks(ctx)
+	case resumableupload.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case resumableupload.FieldBypassCache:
+		return m.OldBypassCache(ctx)
+	case resumableupload.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case resumableupload.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumableUploadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resumableupload.FieldSourceLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceLanguage(v)
+		return nil
+	case resumableupload.FieldTargetLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetLanguage(v)
+		return nil
+	case resumableupload.FieldLength:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case resumableupload.FieldOffset:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case resumableupload.FieldChunks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunks(v)
+		return nil
+	case resumableupload.FieldMetadata:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case resumableupload.FieldBypassCache:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBypassCache(v)
+		return nil
+	case resumableupload.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case resumableupload.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResumableUploadMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, resumableupload.FieldLength)
+	}
+	if m.add_offset != nil {
+		fields = append(fields, resumableupload.FieldOffset)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResumableUploadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resumableupload.FieldLength:
+		return m.AddedLength()
+	case resumableupload.FieldOffset:
+		return m.AddedOffset()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumableUploadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resumableupload.FieldLength:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	case resumableupload.FieldOffset:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResumableUploadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resumableupload.FieldChunks) {
+		fields = append(fields, resumableupload.FieldChunks)
+	}
+	if m.FieldCleared(resumableupload.FieldMetadata) {
+		fields = append(fields, resumableupload.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResumableUploadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResumableUploadMutation) ClearField(name string) error {
+	switch name {
+	case resumableupload.FieldChunks:
+		m.ClearChunks()
+		return nil
+	case resumableupload.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResumableUploadMutation) ResetField(name string) error {
+	switch name {
+	case resumableupload.FieldSourceLanguage:
+		m.ResetSourceLanguage()
+		return nil
+	case resumableupload.FieldTargetLanguage:
+		m.ResetTargetLanguage()
+		return nil
+	case resumableupload.FieldLength:
+		m.ResetLength()
+		return nil
+	case resumableupload.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case resumableupload.FieldChunks:
+		m.ResetChunks()
+		return nil
+	case resumableupload.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case resumableupload.FieldBypassCache:
+		m.ResetBypassCache()
+		return nil
+	case resumableupload.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case resumableupload.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResumableUploadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, resumableupload.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResumableUploadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case resumableupload.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResumableUploadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResumableUploadMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResumableUploadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, resumableupload.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResumableUploadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case resumableupload.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResumableUploadMutation) ClearEdge(name string) error {
+	switch name {
+	case resumableupload.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResumableUploadMutation) ResetEdge(name string) error {
+	switch name {
+	case resumableupload.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload edge %s", name)
+}
+
+// SourceFileMutation represents an operation that mutates the SourceFile nodes in the graph.
+type SourceFileMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	submission_id *uuid.UUID
+	_path         *string
+	size          *int64
+	addsize       *int64
+	clearedFields map[string]struct{}
+	blob          *string
+	clearedblob   bool
+	done          bool
+	oldValue      func(context.Context) (*SourceFile, error)
+	predicates    []predicate.SourceFile
+}
+
+var _ ent.Mutation = (*SourceFileMutation)(nil)
+
+// sourcefileOption allows management of the mutation configuration using functional options.
+type sourcefileOption func(*SourceFileMutation)
+
+// newSourceFileMutation creates new mutation for the SourceFile entity.
+func newSourceFileMutation(c config, op Op, opts ...sourcefileOption) *SourceFileMutation {
+	m := &SourceFileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceFileID sets the ID field of the mutation.
+func withSourceFileID(id int) sourcefileOption {
+	return func(m *SourceFileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceFile
+		)
+		m.oldValue = func(ctx context.Context) (*SourceFile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceFile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceFile sets the old SourceFile of the mutation.
+func withSourceFile(node *SourceFile) sourcefileOption {
+	return func(m *SourceFileMutation) {
+		m.oldValue = func(context.Context) (*SourceFile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceFileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceFileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceFileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceFileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceFile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (m *SourceFileMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
+}
+
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *SourceFileMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionID returns the old "submission_id" field's value of the SourceFile entity.
+// If the SourceFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceFileMutation) OldSubmissionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
+	}
+	return oldValue.SubmissionID, nil
+}
+
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *SourceFileMutation) ResetSubmissionID() {
+	m.submission_id = nil
+}
+
+// SetPath sets the "path" field.
+func (m *SourceFileMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *SourceFileMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the SourceFile entity.
+// If the SourceFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceFileMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *SourceFileMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetSize sets the "size" field.
+func (m *SourceFileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *SourceFileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the SourceFile entity.
+// If the SourceFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceFileMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *SourceFileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *SourceFileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *SourceFileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by id.
+func (m *SourceFileMutation) SetBlobID(id string) {
+	m.blob = &id
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (m *SourceFileMutation) ClearBlob() {
+	m.clearedblob = true
+}
+
+// BlobCleared reports if the "blob" edge to the Blob entity was cleared.
+func (m *SourceFileMutation) BlobCleared() bool {
+	return m.clearedblob
+}
+
+// BlobID returns the "blob" edge ID in the mutation.
+func (m *SourceFileMutation) BlobID() (id string, exists bool) {
+	if m.blob != nil {
+		return *m.blob, true
+	}
+	return
+}
+
+// BlobIDs returns the "blob" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlobID instead. It exists only for internal usage by the builders.
+func (m *SourceFileMutation) BlobIDs() (ids []string) {
+	if id := m.blob; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBlob resets all changes to the "blob" edge.
+func (m *SourceFileMutation) ResetBlob() {
+	m.blob = nil
+	m.clearedblob = false
+}
+
+// Where appends a list predicates to the SourceFileMutation builder.
+func (m *SourceFileMutation) Where(ps ...predicate.SourceFile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SourceFileMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (SourceFile).
+func (m *SourceFileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SourceFileMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.submission_id != nil {
+		fields = append(fields, sourcefile.FieldSubmissionID)
+	}
+	if m._path != nil {
+		fields = append(fields, sourcefile.FieldPath)
+	}
+	if m.size != nil {
+		fields = append(fields, sourcefile.FieldSize)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SourceFileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sourcefile.FieldSubmissionID:
+		return m.SubmissionID()
+	case sourcefile.FieldPath:
+		return m.Path()
+	case sourcefile.FieldSize:
+		return m.Size()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SourceFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sourcefile.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case sourcefile.FieldPath:
+		return m.OldPath(ctx)
+	case sourcefile.FieldSize:
+		return m.OldSize(ctx)
+	}
+	return nil, fmt.Errorf("unknown SourceFile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceFileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sourcefile.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionID(v)
+		return nil
+	case sourcefile.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case sourcefile.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceFile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SourceFileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, sourcefile.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SourceFileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sourcefile.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SourceFileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sourcefile.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SourceFile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SourceFileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SourceFileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SourceFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SourceFile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SourceFileMutation) ResetField(name string) error {
+	switch name {
+	case sourcefile.FieldSubmissionID:
+		m.ResetSubmissionID()
+		return nil
+	case sourcefile.FieldPath:
+		m.ResetPath()
+		return nil
+	case sourcefile.FieldSize:
+		m.ResetSize()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceFile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SourceFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.blob != nil {
+		edges = append(edges, sourcefile.EdgeBlob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SourceFileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sourcefile.EdgeBlob:
+		if id := m.blob; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SourceFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SourceFileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SourceFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedblob {
+		edges = append(edges, sourcefile.EdgeBlob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SourceFileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sourcefile.EdgeBlob:
+		return m.clearedblob
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SourceFileMutation) ClearEdge(name string) error {
+	switch name {
+	case sourcefile.EdgeBlob:
+		m.ClearBlob()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceFile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceFileMutation) ResetEdge(name string) error {
+	switch name {
+	case sourcefile.EdgeBlob:
+		m.ResetBlob()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceFile edge %s", name)
+}
+
+// SubmissionMutation represents an operation that mutates the Submission nodes in the graph.
+type SubmissionMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uuid.UUID
+	source_language                 *string
+	target_language                 *string
+	is_inline                       *bool
+	is_public                       *bool
+	status                          *submission.Status
+	reason                          *string
+	git_repo                        *string
+	created_at                      *time.Time
+	share_id                        *string
+	submission_source_size_bytes    *int
+	addsubmission_source_size_bytes *int
+	submission_target_size_bytes    *int
+	addsubmission_target_size_bytes *int
+	processing_started_at           *time.Time
+	processing_finished_at          *time.Time
+	queue_lane                      *string
+	has_mapping                     *bool
+	input_hash                      *string
+	transpiler_version              *string
+	cache_hit                       *bool
+	results_encrypted               *bool
+	expires_at                      *time.Time
+	retention_warned_at             *time.Time
+	clearedFields                   map[string]struct{}
+	user                            *uuid.UUID
+	cleareduser                     bool
+	diagnostics                     map[uuid.UUID]struct{}
+	removeddiagnostics              map[uuid.UUID]struct{}
+	cleareddiagnostics              bool
+	parent                          *uuid.UUID
+	clearedparent                   bool
+	reruns                          map[uuid.UUID]struct{}
+	removedreruns                   map[uuid.UUID]struct{}
+	clearedreruns                   bool
+	done                            bool
+	oldValue                        func(context.Context) (*Submission, error)
+	predicates                      []predicate.Submission
+}
+
+var _ ent.Mutation = (*SubmissionMutation)(nil)
+
+// submissionOption allows management of the mutation configuration using functional options.
+type submissionOption func(*SubmissionMutation)
+
+// newSubmissionMutation creates new mutation for the Submission entity.
+func newSubmissionMutation(c config, op Op, opts ...submissionOption) *SubmissionMutation {
+	m := &SubmissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubmission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubmissionID sets the ID field of the mutation.
+func withSubmissionID(id uuid.UUID) submissionOption {
+	return func(m *SubmissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Submission
+		)
+		m.oldValue = func(ctx context.Context) (*Submission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Submission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubmission sets the old Submission of the mutation.
+func withSubmission(node *Submission) submissionOption {
+	return func(m *SubmissionMutation) {
+		m.oldValue = func(context.Context) (*Submission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubmissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubmissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Submission entities.
+func (m *SubmissionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubmissionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubmissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Submission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (m *SubmissionMutation) SetSourceLanguage(s string) {
+	m.source_language = &s
+}
+
+// SourceLanguage returns the value of the "source_language" field in the mutation.
+func (m *SubmissionMutation) SourceLanguage() (r string, exists bool) {
+	v := m.source_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceLanguage returns the old "source_language" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldSourceLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceLanguage: %w", err)
+	}
+	return oldValue.SourceLanguage, nil
+}
+
+// ResetSourceLanguage resets all changes to the "source_language" field.
+func (m *SubmissionMutation) ResetSourceLanguage() {
+	m.source_language = nil
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (m *SubmissionMutation) SetTargetLanguage(s string) {
+	m.target_language = &s
+}
+
+// TargetLanguage returns the value of the "target_language" field in the mutation.
+func (m *SubmissionMutation) TargetLanguage() (r string, exists bool) {
+	v := m.target_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetLanguage returns the old "target_language" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldTargetLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetLanguage: %w", err)
+	}
+	return oldValue.TargetLanguage, nil
+}
+
+// ResetTargetLanguage resets all changes to the "target_language" field.
+func (m *SubmissionMutation) ResetTargetLanguage() {
+	m.target_language = nil
+}
+
+// SetIsInline sets the "is_inline" field.
+func (m *SubmissionMutation) SetIsInline(b bool) {
+	m.is_inline = &b
+}
+
+// IsInline returns the value of the "is_inline" field in the mutation.
+func (m *SubmissionMutation) IsInline() (r bool, exists bool) {
+	v := m.is_inline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsInline returns the old "is_inline" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldIsInline(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsInline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsInline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsInline: %w", err)
+	}
+	return oldValue.IsInline, nil
+}
+
+// ResetIsInline resets all changes to the "is_inline" field.
+func (m *SubmissionMutation) ResetIsInline() {
+	m.is_inline = nil
+}
+
+// SetIsPublic sets the "is_public" field.
+func (m *SubmissionMutation) SetIsPublic(b bool) {
+	m.is_public = &b
+}
+
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *SubmissionMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "is_public" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *SubmissionMutation) ResetIsPublic() {
+	m.is_public = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SubmissionMutation) SetStatus(s submission.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SubmissionMutation) Status() (r submission.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldStatus(ctx context.Context) (v submission.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SubmissionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *SubmissionMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SubmissionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *SubmissionMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[submission.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *SubmissionMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[submission.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SubmissionMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, submission.FieldReason)
+}
+
+// SetGitRepo sets the "git_repo" field.
+func (m *SubmissionMutation) SetGitRepo(s string) {
+	m.git_repo = &s
+}
+
+// GitRepo returns the value of the "git_repo" field in the mutation.
+func (m *SubmissionMutation) GitRepo() (r string, exists bool) {
+	v := m.git_repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitRepo returns the old "git_repo" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldGitRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitRepo: %w", err)
+	}
+	return oldValue.GitRepo, nil
+}
+
+// ClearGitRepo clears the value of the "git_repo" field.
+func (m *SubmissionMutation) ClearGitRepo() {
+	m.git_repo = nil
+	m.clearedFields[submission.FieldGitRepo] = struct{}{}
+}
+
+// GitRepoCleared returns if the "git_repo" field was cleared in this mutation.
+func (m *SubmissionMutation) GitRepoCleared() bool {
+	_, ok := m.clearedFields[submission.FieldGitRepo]
+	return ok
+}
+
+// ResetGitRepo resets all changes to the "git_repo" field.
+func (m *SubmissionMutation) ResetGitRepo() {
+	m.git_repo = nil
+	delete(m.clearedFields, submission.FieldGitRepo)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SubmissionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubmissionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubmissionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetShareID sets the "share_id" field.
+func (m *SubmissionMutation) SetShareID(s string) {
+	m.share_id = &s
+}
+
+// ShareID returns the value of the "share_id" field in the mutation.
+func (m *SubmissionMutation) ShareID() (r string, exists bool) {
+	v := m.share_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareID returns the old "share_id" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldShareID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareID: %w", err)
+	}
+	return oldValue.ShareID, nil
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (m *SubmissionMutation) ClearShareID() {
+	m.share_id = nil
+	m.clearedFields[submission.FieldShareID] = struct{}{}
+}
+
+// ShareIDCleared returns if the "share_id" field was cleared in this mutation.
+func (m *SubmissionMutation) ShareIDCleared() bool {
+	_, ok := m.clearedFields[submission.FieldShareID]
+	return ok
+}
+
+// ResetShareID resets all changes to the "share_id" field.
+func (m *SubmissionMutation) ResetShareID() {
+	m.share_id = nil
+	delete(m.clearedFields, submission.FieldShareID)
+}
+
+// SetSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field.
+func (m *SubmissionMutation) SetSubmissionSourceSizeBytes(i int) {
+	m.submission_source_size_bytes = &i
+	m.addsubmission_source_size_bytes = nil
+}
+
+// SubmissionSourceSizeBytes returns the value of the "submission_source_size_bytes" field in the mutation.
+func (m *SubmissionMutation) SubmissionSourceSizeBytes() (r int, exists bool) {
+	v := m.submission_source_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionSourceSizeBytes returns the old "submission_source_size_bytes" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldSubmissionSourceSizeBytes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionSourceSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionSourceSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionSourceSizeBytes: %w", err)
+	}
+	return oldValue.SubmissionSourceSizeBytes, nil
+}
+
+// AddSubmissionSourceSizeBytes adds i to the "submission_source_size_bytes" field.
+func (m *SubmissionMutation) AddSubmissionSourceSizeBytes(i int) {
+	if m.addsubmission_source_size_bytes != nil {
+		*m.addsubmission_source_size_bytes += i
+	} else {
+		m.addsubmission_source_size_bytes = &i
+	}
+}
+
+// AddedSubmissionSourceSizeBytes returns the value that was added to the "submission_source_size_bytes" field in this mutation.
+func (m *SubmissionMutation) AddedSubmissionSourceSizeBytes() (r int, exists bool) {
+	v := m.addsubmission_source_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubmissionSourceSizeBytes resets all changes to the "submission_source_size_bytes" field.
+func (m *SubmissionMutation) ResetSubmissionSourceSizeBytes() {
+	m.submission_source_size_bytes = nil
+	m.addsubmission_source_size_bytes = nil
+}
+
+// SetSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field.
+func (m *SubmissionMutation) SetSubmissionTargetSizeBytes(i int) {
+	m.submission_target_size_bytes = &i
+	m.addsubmission_target_size_bytes = nil
+}
+
+// SubmissionTargetSizeBytes returns the value of the "submission_target_size_bytes" field in the mutation.
+func (m *SubmissionMutation) SubmissionTargetSizeBytes() (r int, exists bool) {
+	v := m.submission_target_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionTargetSizeBytes returns the old "submission_target_size_bytes" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldSubmissionTargetSizeBytes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionTargetSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionTargetSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionTargetSizeBytes: %w", err)
+	}
+	return oldValue.SubmissionTargetSizeBytes, nil
+}
+
+// AddSubmissionTargetSizeBytes adds i to the "submission_target_size_bytes" field.
+func (m *SubmissionMutation) AddSubmissionTargetSizeBytes(i int) {
+	if m.addsubmission_target_size_bytes != nil {
+		*m.addsubmission_target_size_bytes += i
+	} else {
+		m.addsubmission_target_size_bytes = &i
+	}
+}
+
+// AddedSubmissionTargetSizeBytes returns the value that was added to the "submission_target_size_bytes" field in this mutation.
+func (m *SubmissionMutation) AddedSubmissionTargetSizeBytes() (r int, exists bool) {
+	v := m.addsubmission_target_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubmissionTargetSizeBytes resets all changes to the "submission_target_size_bytes" field.
+func (m *SubmissionMutation) ResetSubmissionTargetSizeBytes() {
+	m.submission_target_size_bytes = nil
+	m.addsubmission_target_size_bytes = nil
+}
+
+// SetProcessingStartedAt sets the "processing_started_at" field.
+func (m *SubmissionMutation) SetProcessingStartedAt(t time.Time) {
+	m.processing_started_at = &t
+}
+
+// ProcessingStartedAt returns the value of the "processing_started_at" field in the mutation.
+func (m *SubmissionMutation) ProcessingStartedAt() (r time.Time, exists bool) {
+	v := m.processing_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessingStartedAt returns the old "processing_started_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldProcessingStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessingStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessingStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessingStartedAt: %w", err)
+	}
+	return oldValue.ProcessingStartedAt, nil
+}
+
+// ClearProcessingStartedAt clears the value of the "processing_started_at" field.
+func (m *SubmissionMutation) ClearProcessingStartedAt() {
+	m.processing_started_at = nil
+	m.clearedFields[submission.FieldProcessingStartedAt] = struct{}{}
+}
+
+// ProcessingStartedAtCleared returns if the "processing_started_at" field was cleared in this mutation.
+func (m *SubmissionMutation) ProcessingStartedAtCleared() bool {
+	_, ok := m.clearedFields[submission.FieldProcessingStartedAt]
+	return ok
+}
+
+// ResetProcessingStartedAt resets all changes to the "processing_started_at" field.
+func (m *SubmissionMutation) ResetProcessingStartedAt() {
+	m.processing_started_at = nil
+	delete(m.clearedFields, submission.FieldProcessingStartedAt)
+}
+
+// SetProcessingFinishedAt sets the "processing_finished_at" field.
+func (m *SubmissionMutation) SetProcessingFinishedAt(t time.Time) {
+	m.processing_finished_at = &t
+}
+
+// ProcessingFinishedAt returns the value of the "processing_finished_at" field in the mutation.
+func (m *SubmissionMutation) ProcessingFinishedAt() (r time.Time, exists bool) {
+	v := m.processing_finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessingFinishedAt returns the old "processing_finished_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldProcessingFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessingFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessingFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessingFinishedAt: %w", err)
+	}
+	return oldValue.ProcessingFinishedAt, nil
+}
+
+// ClearProcessingFinishedAt clears the value of the "processing_finished_at" field.
+func (m *SubmissionMutation) ClearProcessingFinishedAt() {
+	m.processing_finished_at = nil
+	m.clearedFields[submission.FieldProcessingFinishedAt] = struct{}{}
+}
+
+// ProcessingFinishedAtCleared returns if the "processing_finished_at" field was cleared in this mutation.
+func (m *SubmissionMutation) ProcessingFinishedAtCleared() bool {
+	_, ok := m.clearedFields[submission.FieldProcessingFinishedAt]
+	return ok
+}
+
+// ResetProcessingFinishedAt resets all changes to the "processing_finished_at" field.
+func (m *SubmissionMutation) ResetProcessingFinishedAt() {
+	m.processing_finished_at = nil
+	delete(m.clearedFields, submission.FieldProcessingFinishedAt)
+}
+
+// SetQueueLane sets the "queue_lane" field.
+func (m *SubmissionMutation) SetQueueLane(s string) {
+	m.queue_lane = &s
+}
+
+// QueueLane returns the value of the "queue_lane" field in the mutation.
+func (m *SubmissionMutation) QueueLane() (r string, exists bool) {
+	v := m.queue_lane
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueueLane returns the old "queue_lane" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldQueueLane(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueueLane is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueueLane requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueueLane: %w", err)
+	}
+	return oldValue.QueueLane, nil
+}
+
+// ResetQueueLane resets all changes to the "queue_lane" field.
+func (m *SubmissionMutation) ResetQueueLane() {
+	m.queue_lane = nil
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (m *SubmissionMutation) SetHasMapping(b bool) {
+	m.has_mapping = &b
+}
+
+// HasMapping returns the value of the "has_mapping" field in the mutation.
+func (m *SubmissionMutation) HasMapping() (r bool, exists bool) {
+	v := m.has_mapping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasMapping returns the old "has_mapping" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldHasMapping(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasMapping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasMapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasMapping: %w", err)
+	}
+	return oldValue.HasMapping, nil
+}
+
+// ResetHasMapping resets all changes to the "has_mapping" field.
+func (m *SubmissionMutation) ResetHasMapping() {
+	m.has_mapping = nil
+}
+
+// SetInputHash sets the "input_hash" field.
+func (m *SubmissionMutation) SetInputHash(s string) {
+	m.input_hash = &s
+}
+
+// InputHash returns the value of the "input_hash" field in the mutation.
+func (m *SubmissionMutation) InputHash() (r string, exists bool) {
+	v := m.input_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputHash returns the old "input_hash" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldInputHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputHash: %w", err)
+	}
+	return oldValue.InputHash, nil
+}
+
+// ClearInputHash clears the value of the "input_hash" field.
+func (m *SubmissionMutation) ClearInputHash() {
+	m.input_hash = nil
+	m.clearedFields[submission.FieldInputHash] = struct{}{}
+}
+
+// InputHashCleared returns if the "input_hash" field was cleared in this mutation.
+func (m *SubmissionMutation) InputHashCleared() bool {
+	_, ok := m.clearedFields[submission.FieldInputHash]
+	return ok
+}
+
+// ResetInputHash resets all changes to the "input_hash" field.
+func (m *SubmissionMutation) ResetInputHash() {
+	m.input_hash = nil
+	delete(m.clearedFields, submission.FieldInputHash)
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (m *SubmissionMutation) SetTranspilerVersion(s string) {
+	m.transpiler_version = &s
+}
+
+// TranspilerVersion returns the value of the "transpiler_version" field in the mutation.
+func (m *SubmissionMutation) TranspilerVersion() (r string, exists bool) {
+	v := m.transpiler_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTranspilerVersion returns the old "transpiler_version" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldTranspilerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranspilerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranspilerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranspilerVersion: %w", err)
+	}
+	return oldValue.TranspilerVersion, nil
+}
+
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (m *SubmissionMutation) ClearTranspilerVersion() {
+	m.transpiler_version = nil
+	m.clearedFields[submission.FieldTranspilerVersion] = struct{}{}
+}
+
+// TranspilerVersionCleared returns if the "transpiler_version" field was cleared in this mutation.
+func (m *SubmissionMutation) TranspilerVersionCleared() bool {
+	_, ok := m.clearedFields[submission.FieldTranspilerVersion]
+	return ok
+}
+
+// ResetTranspilerVersion resets all changes to the "transpiler_version" field.
+func (m *SubmissionMutation) ResetTranspilerVersion() {
+	m.transpiler_version = nil
+	delete(m.clearedFields, submission.FieldTranspilerVersion)
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (m *SubmissionMutation) SetCacheHit(b bool) {
+	m.cache_hit = &b
+}
+
+// CacheHit returns the value of the "cache_hit" field in the mutation.
+func (m *SubmissionMutation) CacheHit() (r bool, exists bool) {
+	v := m.cache_hit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheHit returns the old "cache_hit" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldCacheHit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheHit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheHit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheHit: %w", err)
+	}
+	return oldValue.CacheHit, nil
+}
+
+// ResetCacheHit resets all changes to the "cache_hit" field.
+func (m *SubmissionMutation) ResetCacheHit() {
+	m.cache_hit = nil
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (m *SubmissionMutation) SetResultsEncrypted(b bool) {
+	m.results_encrypted = &b
+}
+
+// ResultsEncrypted returns the value of the "results_encrypted" field in the mutation.
+func (m *SubmissionMutation) ResultsEncrypted() (r bool, exists bool) {
+	v := m.results_encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultsEncrypted returns the old "results_encrypted" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldResultsEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultsEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultsEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultsEncrypted: %w", err)
+	}
+	return oldValue.ResultsEncrypted, nil
+}
+
+// ResetResultsEncrypted resets all changes to the "results_encrypted" field.
+func (m *SubmissionMutation) ResetResultsEncrypted() {
+	m.results_encrypted = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SubmissionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SubmissionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SubmissionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[submission.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SubmissionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[submission.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SubmissionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, submission.FieldExpiresAt)
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (m *SubmissionMutation) SetRetentionWarnedAt(t time.Time) {
+	m.retention_warned_at = &t
+}
+
+// RetentionWarnedAt returns the value of the "retention_warned_at" field in the mutation.
+func (m *SubmissionMutation) RetentionWarnedAt() (r time.Time, exists bool) {
+	v := m.retention_warned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionWarnedAt returns the old "retention_warned_at" field's value of the Submission entity.
+// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubmissionMutation) OldRetentionWarnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionWarnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionWarnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionWarnedAt: %w", err)
+	}
+	return oldValue.RetentionWarnedAt, nil
+}
+
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (m *SubmissionMutation) ClearRetentionWarnedAt() {
+	m.retention_warned_at = nil
+	m.clearedFields[submission.FieldRetentionWarnedAt] = struct{}{}
+}
+
+// RetentionWarnedAtCleared returns if the "retention_warned_at" field was cleared in this mutation.
+func (m *SubmissionMutation) RetentionWarnedAtCleared() bool {
+	_, ok := m.clearedFields[submission.FieldRetentionWarnedAt]
+	return ok
+}
+
+// ResetRetentionWarnedAt resets all changes to the "retention_warned_at" field.
+func (m *SubmissionMutation) ResetRetentionWarnedAt() {
+	m.retention_warned_at = nil
+	delete(m.clearedFields, submission.FieldRetentionWarnedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SubmissionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SubmissionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SubmissionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SubmissionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SubmissionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SubmissionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddDiagnosticIDs adds the "diagnostics" edge to the Diagnostic entity by ids.
+func (m *SubmissionMutation) AddDiagnosticIDs(ids ...uuid.UUID) {
+	if m.diagnostics == nil {
+		m.diagnostics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.diagnostics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDiagnostics clears the "diagnostics" edge to the Diagnostic entity.
+func (m *SubmissionMutation) ClearDiagnostics() {
+	m.cleareddiagnostics = true
+}
+
+// DiagnosticsCleared reports if the "diagnostics" edge to the Diagnostic entity was cleared.
+func (m *SubmissionMutation) DiagnosticsCleared() bool {
+	return m.cleareddiagnostics
+}
+
+// RemoveDiagnosticIDs removes the "diagnostics" edge to the Diagnostic entity by IDs.
+func (m *SubmissionMutation) RemoveDiagnosticIDs(ids ...uuid.UUID) {
+	if m.removeddiagnostics == nil {
+		m.removeddiagnostics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.diagnostics, ids[i])
+		m.removeddiagnostics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDiagnostics returns the removed IDs of the "diagnostics" edge to the Diagnostic entity.
+func (m *SubmissionMutation) RemovedDiagnosticsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddiagnostics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DiagnosticsIDs returns the "diagnostics" edge IDs in the mutation.
+func (m *SubmissionMutation) DiagnosticsIDs() (ids []uuid.UUID) {
+	for id := range m.diagnostics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDiagnostics resets all changes to the "diagnostics" edge.
+func (m *SubmissionMutation) ResetDiagnostics() {
+	m.diagnostics = nil
+	m.cleareddiagnostics = false
+	m.removeddiagnostics = nil
+}
+
+// SetParentID sets the "parent" edge to the Submission entity by id.
+func (m *SubmissionMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Submission entity.
+func (m *SubmissionMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Submission entity was cleared.
+func (m *SubmissionMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *SubmissionMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *SubmissionMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *SubmissionMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddRerunIDs adds the "reruns" edge to the Submission entity by ids.
+func (m *SubmissionMutation) AddRerunIDs(ids ...uuid.UUID) {
+	if m.reruns == nil {
+		m.reruns = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reruns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReruns clears the "reruns" edge to the Submission entity.
+func (m *SubmissionMutation) ClearReruns() {
+	m.clearedreruns = true
+}
+
+// RerunsCleared reports if the "reruns" edge to the Submission entity was cleared.
+func (m *SubmissionMutation) RerunsCleared() bool {
+	return m.clearedreruns
+}
+
+// RemoveRerunIDs removes the "reruns" edge to the Submission entity by IDs.
+func (m *SubmissionMutation) RemoveRerunIDs(ids ...uuid.UUID) {
+	if m.removedreruns == nil {
+		m.removedreruns = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reruns, ids[i])
+		m.removedreruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReruns returns the removed IDs of the "reruns" edge to the Submission entity.
+func (m *SubmissionMutation) RemovedRerunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RerunsIDs returns the "reruns" edge IDs in the mutation.
+func (m *SubmissionMutation) RerunsIDs() (ids []uuid.UUID) {
+	for id := range m.reruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReruns resets all changes to the "reruns" edge.
+func (m *SubmissionMutation) ResetReruns() {
+	m.reruns = nil
+	m.clearedreruns = false
+	m.removedreruns = nil
+}
+
+// Where appends a list predicates to the SubmissionMutation builder.
+func (m *SubmissionMutation) Where(ps ...predicate.Submission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SubmissionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Submission).
+func (m *SubmissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SubmissionMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.source_language != nil {
+		fields = append(fields, submission.FieldSourceLanguage)
+	}
+	if m.target_language != nil {
+		fields = append(fields, submission.FieldTargetLanguage)
+	}
+	if m.is_inline != nil {
+		fields = append(fields, submission.FieldIsInline)
+	}
+	if m.is_public != nil {
+		fields = append(fields, submission.FieldIsPublic)
+	}
+	if m.status != nil {
+		fields = append(fields, submission.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, submission.FieldReason)
+	}
+	if m.git_repo != nil {
+		fields = append(fields, submission.FieldGitRepo)
+	}
+	if m.created_at != nil {
+		fields = append(fields, submission.FieldCreatedAt)
+	}
+	if m.share_id != nil {
+		fields = append(fields, submission.FieldShareID)
+	}
+	if m.submission_source_size_bytes != nil {
+		fields = append(fields, submission.FieldSubmissionSourceSizeBytes)
+	}
+	if m.submission_target_size_bytes != nil {
+		fields = append(fields, submission.FieldSubmissionTargetSizeBytes)
+	}
+	if m.processing_started_at != nil {
+		fields = append(fields, submission.FieldProcessingStartedAt)
+	}
+	if m.processing_finished_at != nil {
+		fields = append(fields, submission.FieldProcessingFinishedAt)
+	}
+	if m.queue_lane != nil {
+		fields = append(fields, submission.FieldQueueLane)
+	}
+	if m.has_mapping != nil {
+		fields = append(fields, submission.FieldHasMapping)
+	}
+	if m.input_hash != nil {
+		fields = append(fields, submission.FieldInputHash)
+	}
+	if m.transpiler_version != nil {
+		fields = append(fields, submission.FieldTranspilerVersion)
+	}
+	if m.cache_hit != nil {
+		fields = append(fields, submission.FieldCacheHit)
+	}
+	if m.results_encrypted != nil {
+		fields = append(fields, submission.FieldResultsEncrypted)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, submission.FieldExpiresAt)
+	}
+	if m.retention_warned_at != nil {
+		fields = append(fields, submission.FieldRetentionWarnedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case submission.FieldSourceLanguage:
+		return m.SourceLanguage()
+	case submission.FieldTargetLanguage:
+		return m.TargetLanguage()
+	case submission.FieldIsInline:
+		return m.IsInline()
+	case submission.FieldIsPublic:
+		return m.IsPublic()
+	case submission.FieldStatus:
+		return m.Status()
+	case submission.FieldReason:
+		return m.Reason()
+	case submission.FieldGitRepo:
+		return m.GitRepo()
+	case submission.FieldCreatedAt:
+		return m.CreatedAt()
+	case submission.FieldShareID:
+		return m.ShareID()
+	case submission.FieldSubmissionSourceSizeBytes:
+		return m.SubmissionSourceSizeBytes()
+	case submission.FieldSubmissionTargetSizeBytes:
+		return m.SubmissionTargetSizeBytes()
+	case submission.FieldProcessingStartedAt:
+		return m.ProcessingStartedAt()
+	case submission.FieldProcessingFinishedAt:
+		return m.ProcessingFinishedAt()
+	case submission.FieldQueueLane:
+		return m.QueueLane()
+	case submission.FieldHasMapping:
+		return m.HasMapping()
+	case submission.FieldInputHash:
+		return m.InputHash()
+	case submission.FieldTranspilerVersion:
+		return m.TranspilerVersion()
+	case submission.FieldCacheHit:
+		return m.CacheHit()
+	case submission.FieldResultsEncrypted:
+		return m.ResultsEncrypted()
+	case submission.FieldExpiresAt:
+		return m.ExpiresAt()
+	case submission.FieldRetentionWarnedAt:
+		return m.RetentionWarnedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SubmissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case submission.FieldSourceLanguage:
+		return m.OldSourceLanguage(ctx)
+	case submission.FieldTargetLanguage:
+		return m.OldTargetLanguage(ctx)
+	case submission.FieldIsInline:
+		return m.OldIsInline(ctx)
+	case submission.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case submission.FieldStatus:
+		return m.OldStatus(ctx)
+	case submission.FieldReason:
+		return m.OldReason(ctx)
+	case submission.FieldGitRepo:
+		return m.OldGitRepo(ctx)
+	case submission.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case submission.FieldShareID:
+		return m.OldShareID(ctx)
+	case submission.FieldSubmissionSourceSizeBytes:
+		return m.OldSubmissionSourceSizeBytes(ctx)
+	case submission.FieldSubmissionTargetSizeBytes:
+		return m.OldSubmissionTargetSizeBytes(ctx)
+	case submission.FieldProcessingStartedAt:
+		return m.OldProcessingStartedAt(ctx)
+	case submission.FieldProcessingFinishedAt:
+		return m.OldProcessingFinishedAt(ctx)
+	case submission.FieldQueueLane:
+		return m.OldQueueLane(ctx)
+	case submission.FieldHasMapping:
+		return m.OldHasMapping(ctx)
+	case submission.FieldInputHash:
+		return m.OldInputHash(ctx)
+	case submission.FieldTranspilerVersion:
+		return m.OldTranspilerVersion(ctx)
+	case submission.FieldCacheHit:
+		return m.OldCacheHit(ctx)
+	case submission.FieldResultsEncrypted:
+		return m.OldResultsEncrypted(ctx)
+	case submission.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case submission.FieldRetentionWarnedAt:
+		return m.OldRetentionWarnedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Submission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubmissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case submission.FieldSourceLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceLanguage(v)
+		return nil
+	case submission.FieldTargetLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetLanguage(v)
+		return nil
+	case submission.FieldIsInline:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsInline(v)
+		return nil
+	case submission.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case submission.FieldStatus:
+		v, ok := value.(submission.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case submission.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case submission.FieldGitRepo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitRepo(v)
+		return nil
+	case submission.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case submission.FieldShareID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareID(v)
+		return nil
+	case submission.FieldSubmissionSourceSizeBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionSourceSizeBytes(v)
+		return nil
+	case submission.FieldSubmissionTargetSizeBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionTargetSizeBytes(v)
+		return nil
+	case submission.FieldProcessingStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessingStartedAt(v)
+		return nil
+	case submission.FieldProcessingFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessingFinishedAt(v)
+		return nil
+	case submission.FieldQueueLane:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueueLane(v)
+		return nil
+	case submission.FieldHasMapping:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasMapping(v)
+		return nil
+	case submission.FieldInputHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputHash(v)
+		return nil
+	case submission.FieldTranspilerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranspilerVersion(v)
+		return nil
+	case submission.FieldCacheHit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheHit(v)
+		return nil
+	case submission.FieldResultsEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultsEncrypted(v)
+		return nil
+	case submission.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case submission.FieldRetentionWarnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionWarnedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Submission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SubmissionMutation) AddedFields() []string {
+	var fields []string
+	if m.addsubmission_source_size_bytes != nil {
+		fields = append(fields, submission.FieldSubmissionSourceSizeBytes)
+	}
+	if m.addsubmission_target_size_bytes != nil {
+		fields = append(fields, submission.FieldSubmissionTargetSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SubmissionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case submission.FieldSubmissionSourceSizeBytes:
+		return m.AddedSubmissionSourceSizeBytes()
+	case submission.FieldSubmissionTargetSizeBytes:
+		return m.AddedSubmissionTargetSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SubmissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case submission.FieldSubmissionSourceSizeBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubmissionSourceSizeBytes(v)
+		return nil
+	case submission.FieldSubmissionTargetSizeBytes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubmissionTargetSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Submission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SubmissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(submission.FieldReason) {
+		fields = append(fields, submission.FieldReason)
+	}
+	if m.FieldCleared(submission.FieldGitRepo) {
+		fields = append(fields, submission.FieldGitRepo)
+	}
+	if m.FieldCleared(submission.FieldShareID) {
+		fields = append(fields, submission.FieldShareID)
+	}
+	if m.FieldCleared(submission.FieldProcessingStartedAt) {
+		fields = append(fields, submission.FieldProcessingStartedAt)
+	}
+	if m.FieldCleared(submission.FieldProcessingFinishedAt) {
+		fields = append(fields, submission.FieldProcessingFinishedAt)
+	}
+	if m.FieldCleared(submission.FieldInputHash) {
+		fields = append(fields, submission.FieldInputHash)
+	}
+	if m.FieldCleared(submission.FieldTranspilerVersion) {
+		fields = append(fields, submission.FieldTranspilerVersion)
+	}
+	if m.FieldCleared(submission.FieldExpiresAt) {
+		fields = append(fields, submission.FieldExpiresAt)
+	}
+	if m.FieldCleared(submission.FieldRetentionWarnedAt) {
+		fields = append(fields, submission.FieldRetentionWarnedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SubmissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SubmissionMutation) ClearField(name string) error {
+	switch name {
+	case submission.FieldReason:
+		m.ClearReason()
+		return nil
+	case submission.FieldGitRepo:
+		m.ClearGitRepo()
+		return nil
+	case submission.FieldShareID:
+		m.ClearShareID()
+		return nil
+	case submission.FieldProcessingStartedAt:
+		m.ClearProcessingStartedAt()
+		return nil
+	case submission.FieldProcessingFinishedAt:
+		m.ClearProcessingFinishedAt()
+		return nil
+	case submission.FieldInputHash:
+		m.ClearInputHash()
+		return nil
+	case submission.FieldTranspilerVersion:
+		m.ClearTranspilerVersion()
+		return nil
+	case submission.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case submission.FieldRetentionWarnedAt:
+		m.ClearRetentionWarnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SubmissionMutation) ResetField(name string) error {
+	switch name {
+	case submission.FieldSourceLanguage:
+		m.ResetSourceLanguage()
+		return nil
+	case submission.FieldTargetLanguage:
+		m.ResetTargetLanguage()
+		return nil
+	case submission.FieldIsInline:
+		m.ResetIsInline()
+		return nil
+	case submission.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case submission.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case submission.FieldReason:
+		m.ResetReason()
+		return nil
+	case submission.FieldGitRepo:
+		m.ResetGitRepo()
+		return nil
+	case submission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case submission.FieldShareID:
+		m.ResetShareID()
+		return nil
+	case submission.FieldSubmissionSourceSizeBytes:
+		m.ResetSubmissionSourceSizeBytes()
+		return nil
+	case submission.FieldSubmissionTargetSizeBytes:
+		m.ResetSubmissionTargetSizeBytes()
+		return nil
+	case submission.FieldProcessingStartedAt:
+		m.ResetProcessingStartedAt()
+		return nil
+	case submission.FieldProcessingFinishedAt:
+		m.ResetProcessingFinishedAt()
+		return nil
+	case submission.FieldQueueLane:
+		m.ResetQueueLane()
+		return nil
+	case submission.FieldHasMapping:
+		m.ResetHasMapping()
+		return nil
+	case submission.FieldInputHash:
+		m.ResetInputHash()
+		return nil
+	case submission.FieldTranspilerVersion:
+		m.ResetTranspilerVersion()
+		return nil
+	case submission.FieldCacheHit:
+		m.ResetCacheHit()
+		return nil
+	case submission.FieldResultsEncrypted:
+		m.ResetResultsEncrypted()
+		return nil
+	case submission.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case submission.FieldRetentionWarnedAt:
+		m.ResetRetentionWarnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SubmissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, submission.EdgeUser)
+	}
+	if m.diagnostics != nil {
+		edges = append(edges, submission.EdgeDiagnostics)
+	}
+	if m.parent != nil {
+		edges = append(edges, submission.EdgeParent)
+	}
+	if m.reruns != nil {
+		edges = append(edges, submission.EdgeReruns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case submission.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case submission.EdgeDiagnostics:
+		ids := make([]ent.Value, 0, len(m.diagnostics))
+		for id := range m.diagnostics {
+			ids = append(ids, id)
+		}
+		return ids
+	case submission.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case submission.EdgeReruns:
+		ids := make([]ent.Value, 0, len(m.reruns))
+		for id := range m.reruns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SubmissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removeddiagnostics != nil {
+		edges = append(edges, submission.EdgeDiagnostics)
+	}
+	if m.removedreruns != nil {
+		edges = append(edges, submission.EdgeReruns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SubmissionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case submission.EdgeDiagnostics:
+		ids := make([]ent.Value, 0, len(m.removeddiagnostics))
+		for id := range m.removeddiagnostics {
+			ids = append(ids, id)
+		}
+		return ids
+	case submission.EdgeReruns:
+		ids := make([]ent.Value, 0, len(m.removedreruns))
+		for id := range m.removedreruns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SubmissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, submission.EdgeUser)
+	}
+	if m.cleareddiagnostics {
+		edges = append(edges, submission.EdgeDiagnostics)
+	}
+	if m.clearedparent {
+		edges = append(edges, submission.EdgeParent)
+	}
+	if m.clearedreruns {
+		edges = append(edges, submission.EdgeReruns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SubmissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case submission.EdgeUser:
+		return m.cleareduser
+	case submission.EdgeDiagnostics:
+		return m.cleareddiagnostics
+	case submission.EdgeParent:
+		return m.clearedparent
+	case submission.EdgeReruns:
+		return m.clearedreruns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SubmissionMutation) ClearEdge(name string) error {
+	switch name {
+	case submission.EdgeUser:
+		m.ClearUser()
+		return nil
+	case submission.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SubmissionMutation) ResetEdge(name string) error {
+	switch name {
+	case submission.EdgeUser:
+		m.ResetUser()
+		return nil
+	case submission.EdgeDiagnostics:
+		m.ResetDiagnostics()
+		return nil
+	case submission.EdgeParent:
+		m.ResetParent()
+		return nil
+	case submission.EdgeReruns:
+		m.ResetReruns()
+		return nil
+	}
+	return fmt.Errorf("unknown Submission edge %s", name)
+}
+
+// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
+type SubscriptionMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	stripe_customer_id     *string
+	stripe_subscription_id *string
+	tier                   *subscription.Tier
+	expires_at             *time.Time
+	cancelled              *bool
+	manual                 *bool
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	done                   bool
+	oldValue               func(context.Context) (*Subscription, error)
+	predicates             []predicate.Subscription
+}
+
+var _ ent.Mutation = (*SubscriptionMutation)(nil)
+
+// subscriptionOption allows management of the mutation configuration using functional options.
+type subscriptionOption func(*SubscriptionMutation)
+
+// newSubscriptionMutation creates new mutation for the Subscription entity.
+func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
+	m := &SubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubscriptionID sets the ID field of the mutation.
+func withSubscriptionID(id uuid.UUID) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Subscription
+		)
+		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Subscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubscription sets the old Subscription of the mutation.
+func withSubscription(node *Subscription) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		m.oldValue = func(context.Context) (*Subscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Subscription entities.
+func (m *SubscriptionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStripeCustomerID sets the "stripe_customer_id" field.
+func (m *SubscriptionMutation) SetStripeCustomerID(s string) {
+	m.stripe_customer_id = &s
+}
+
+// StripeCustomerID returns the value of the "stripe_customer_id" field in the mutation.
+func (m *SubscriptionMutation) StripeCustomerID() (r string, exists bool) {
+	v := m.stripe_customer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStripeCustomerID returns the old "stripe_customer_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldStripeCustomerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripeCustomerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripeCustomerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripeCustomerID: %w", err)
+	}
+	return oldValue.StripeCustomerID, nil
+}
+
+// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
+func (m *SubscriptionMutation) ClearStripeCustomerID() {
+	m.stripe_customer_id = nil
+	m.clearedFields[subscription.FieldStripeCustomerID] = struct{}{}
+}
+
+// StripeCustomerIDCleared returns if the "stripe_customer_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) StripeCustomerIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStripeCustomerID]
 	return ok
 }
 
-// ResetShareID resets all changes to the "share_id" field.
-func (m *SubmissionMutation) ResetShareID() {
-	m.share_id = nil
-	delete(m.clearedFields, submission.FieldShareID)
+// ResetStripeCustomerID resets all changes to the "stripe_customer_id" field.
+func (m *SubscriptionMutation) ResetStripeCustomerID() {
+	m.stripe_customer_id = nil
+	delete(m.clearedFields, subscription.FieldStripeCustomerID)
 }
 
-// SetSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field.
-func (m *SubmissionMutation) SetSubmissionSourceSizeBytes(i int) {
-	m.submission_source_size_bytes = &i
-	m.addsubmission_source_size_bytes = nil
+// SetStripeSubscriptionID sets the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) SetStripeSubscriptionID(s string) {
+	m.stripe_subscription_id = &s
 }
 
-// SubmissionSourceSizeBytes returns the value of the "submission_source_size_bytes" field in the mutation.
-func (m *SubmissionMutation) SubmissionSourceSizeBytes() (r int, exists bool) {
-	v := m.submission_source_size_bytes
+// StripeSubscriptionID returns the value of the "stripe_subscription_id" field in the mutation.
+func (m *SubscriptionMutation) StripeSubscriptionID() (r string, exists bool) {
+	v := m.stripe_subscription_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubmissionSourceSizeBytes returns the old "submission_source_size_bytes" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldStripeSubscriptionID returns the old "stripe_subscription_id" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldSubmissionSourceSizeBytes(ctx context.Context) (v int, err error) {
+func (m *SubscriptionMutation) OldStripeSubscriptionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubmissionSourceSizeBytes is only allowed on UpdateOne operations")
+		return v, errors.New("OldStripeSubscriptionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubmissionSourceSizeBytes requires an ID field in the mutation")
+		return v, errors.New("OldStripeSubscriptionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubmissionSourceSizeBytes: %w", err)
+		return v, fmt.Errorf("querying old value for OldStripeSubscriptionID: %w", err)
 	}
-	return oldValue.SubmissionSourceSizeBytes, nil
+	return oldValue.StripeSubscriptionID, nil
 }
 
-// AddSubmissionSourceSizeBytes adds i to the "submission_source_size_bytes" field.
-func (m *SubmissionMutation) AddSubmissionSourceSizeBytes(i int) {
-	if m.addsubmission_source_size_bytes != nil {
-		*m.addsubmission_source_size_bytes += i
-	} else {
-		m.addsubmission_source_size_bytes = &i
-	}
+// ClearStripeSubscriptionID clears the value of the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) ClearStripeSubscriptionID() {
+	m.stripe_subscription_id = nil
+	m.clearedFields[subscription.FieldStripeSubscriptionID] = struct{}{}
 }
 
-// AddedSubmissionSourceSizeBytes returns the value that was added to the "submission_source_size_bytes" field in this mutation.
-func (m *SubmissionMutation) AddedSubmissionSourceSizeBytes() (r int, exists bool) {
-	v := m.addsubmission_source_size_bytes
-	if v == nil {
-		return
-	}
-	return *v, true
+// StripeSubscriptionIDCleared returns if the "stripe_subscription_id" field was cleared in this mutation.
+func (m *SubscriptionMutation) StripeSubscriptionIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldStripeSubscriptionID]
+	return ok
 }
 
-// ResetSubmissionSourceSizeBytes resets all changes to the "submission_source_size_bytes" field.
-func (m *SubmissionMutation) ResetSubmissionSourceSizeBytes() {
-	m.submission_source_size_bytes = nil
-	m.addsubmission_source_size_bytes = nil
+// ResetStripeSubscriptionID resets all changes to the "stripe_subscription_id" field.
+func (m *SubscriptionMutation) ResetStripeSubscriptionID() {
+	m.stripe_subscription_id = nil
+	delete(m.clearedFields, subscription.FieldStripeSubscriptionID)
 }
 
-// SetSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field.
-func (m *SubmissionMutation) SetSubmissionTargetSizeBytes(i int) {
-	m.submission_target_size_bytes = &i
-	m.addsubmission_target_size_bytes = nil
+// SetTier sets the "tier" field.
+func (m *SubscriptionMutation) SetTier(s subscription.Tier) {
+	m.tier = &s
 }
 
-// SubmissionTargetSizeBytes returns the value of the "submission_target_size_bytes" field in the mutation.
-func (m *SubmissionMutation) SubmissionTargetSizeBytes() (r int, exists bool) {
-	v := m.submission_target_size_bytes
+// Tier returns the value of the "tier" field in the mutation.
+func (m *SubscriptionMutation) Tier() (r subscription.Tier, exists bool) {
+	v := m.tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubmissionTargetSizeBytes returns the old "submission_target_size_bytes" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldTier returns the old "tier" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldSubmissionTargetSizeBytes(ctx context.Context) (v int, err error) {
+func (m *SubscriptionMutation) OldTier(ctx context.Context) (v subscription.Tier, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubmissionTargetSizeBytes is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubmissionTargetSizeBytes requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubmissionTargetSizeBytes: %w", err)
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return oldValue.SubmissionTargetSizeBytes, nil
+	return oldValue.Tier, nil
 }
 
-// AddSubmissionTargetSizeBytes adds i to the "submission_target_size_bytes" field.
-func (m *SubmissionMutation) AddSubmissionTargetSizeBytes(i int) {
-	if m.addsubmission_target_size_bytes != nil {
-		*m.addsubmission_target_size_bytes += i
-	} else {
-		m.addsubmission_target_size_bytes = &i
-	}
+// ResetTier resets all changes to the "tier" field.
+func (m *SubscriptionMutation) ResetTier() {
+	m.tier = nil
 }
 
-// AddedSubmissionTargetSizeBytes returns the value that was added to the "submission_target_size_bytes" field in this mutation.
-func (m *SubmissionMutation) AddedSubmissionTargetSizeBytes() (r int, exists bool) {
-	v := m.addsubmission_target_size_bytes
+// SetExpiresAt sets the "expires_at" field.
+func (m *SubscriptionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSubmissionTargetSizeBytes resets all changes to the "submission_target_size_bytes" field.
-func (m *SubmissionMutation) ResetSubmissionTargetSizeBytes() {
-	m.submission_target_size_bytes = nil
-	m.addsubmission_target_size_bytes = nil
+// OldExpiresAt returns the old "expires_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// SetProcessingStartedAt sets the "processing_started_at" field.
-func (m *SubmissionMutation) SetProcessingStartedAt(t time.Time) {
-	m.processing_started_at = &t
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SubscriptionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[subscription.FieldExpiresAt] = struct{}{}
 }
 
-// ProcessingStartedAt returns the value of the "processing_started_at" field in the mutation.
-func (m *SubmissionMutation) ProcessingStartedAt() (r time.Time, exists bool) {
-	v := m.processing_started_at
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SubscriptionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SubscriptionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, subscription.FieldExpiresAt)
+}
+
+// SetCancelled sets the "cancelled" field.
+func (m *SubscriptionMutation) SetCancelled(b bool) {
+	m.cancelled = &b
+}
+
+// Cancelled returns the value of the "cancelled" field in the mutation.
+func (m *SubscriptionMutation) Cancelled() (r bool, exists bool) {
+	v := m.cancelled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessingStartedAt returns the old "processing_started_at" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldCancelled returns the old "cancelled" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldProcessingStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SubscriptionMutation) OldCancelled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessingStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCancelled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessingStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldCancelled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessingStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCancelled: %w", err)
 	}
-	return oldValue.ProcessingStartedAt, nil
-}
-
-// ClearProcessingStartedAt clears the value of the "processing_started_at" field.
-func (m *SubmissionMutation) ClearProcessingStartedAt() {
-	m.processing_started_at = nil
-	m.clearedFields[submission.FieldProcessingStartedAt] = struct{}{}
-}
-
-// ProcessingStartedAtCleared returns if the "processing_started_at" field was cleared in this mutation.
-func (m *SubmissionMutation) ProcessingStartedAtCleared() bool {
-	_, ok := m.clearedFields[submission.FieldProcessingStartedAt]
-	return ok
+	return oldValue.Cancelled, nil
 }
 
-// ResetProcessingStartedAt resets all changes to the "processing_started_at" field.
-func (m *SubmissionMutation) ResetProcessingStartedAt() {
-	m.processing_started_at = nil
-	delete(m.clearedFields, submission.FieldProcessingStartedAt)
+// ResetCancelled resets all changes to the "cancelled" field.
+func (m *SubscriptionMutation) ResetCancelled() {
+	m.cancelled = nil
 }
 
-// SetProcessingFinishedAt sets the "processing_finished_at" field.
-func (m *SubmissionMutation) SetProcessingFinishedAt(t time.Time) {
-	m.processing_finished_at = &t
+// SetManual sets the "manual" field.
+func (m *SubscriptionMutation) SetManual(b bool) {
+	m.manual = &b
 }
 
-// ProcessingFinishedAt returns the value of the "processing_finished_at" field in the mutation.
-func (m *SubmissionMutation) ProcessingFinishedAt() (r time.Time, exists bool) {
-	v := m.processing_finished_at
+// Manual returns the value of the "manual" field in the mutation.
+func (m *SubscriptionMutation) Manual() (r bool, exists bool) {
+	v := m.manual
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessingFinishedAt returns the old "processing_finished_at" field's value of the Submission entity.
-// If the Submission object wasn't provided to the builder, the object is fetched from the database.
+// OldManual returns the old "manual" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubmissionMutation) OldProcessingFinishedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SubscriptionMutation) OldManual(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessingFinishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldManual is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessingFinishedAt requires an ID field in the mutation")
+		return v, errors.New("OldManual requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessingFinishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldManual: %w", err)
 	}
-	return oldValue.ProcessingFinishedAt, nil
+	return oldValue.Manual, nil
 }
 
-// ClearProcessingFinishedAt clears the value of the "processing_finished_at" field.
-func (m *SubmissionMutation) ClearProcessingFinishedAt() {
-	m.processing_finished_at = nil
-	m.clearedFields[submission.FieldProcessingFinishedAt] = struct{}{}
+// ResetManual resets all changes to the "manual" field.
+func (m *SubscriptionMutation) ResetManual() {
+	m.manual = nil
 }
 
-// ProcessingFinishedAtCleared returns if the "processing_finished_at" field was cleared in this mutation.
-func (m *SubmissionMutation) ProcessingFinishedAtCleared() bool {
-	_, ok := m.clearedFields[submission.FieldProcessingFinishedAt]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetProcessingFinishedAt resets all changes to the "processing_finished_at" field.
-func (m *SubmissionMutation) ResetProcessingFinishedAt() {
-	m.processing_finished_at = nil
-	delete(m.clearedFields, submission.FieldProcessingFinishedAt)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
+// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *SubmissionMutation) SetUserID(id uuid.UUID) {
+func (m *SubscriptionMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *SubmissionMutation) ClearUser() {
+func (m *SubscriptionMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SubmissionMutation) UserCleared() bool {
+func (m *SubscriptionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *SubmissionMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -766,7 +9986,7 @@ func (m *SubmissionMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *SubmissionMutation) UserIDs() (ids []uuid.UUID) {
+func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -774,69 +9994,51 @@ func (m *SubmissionMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *SubmissionMutation) ResetUser() {
+func (m *SubscriptionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the SubmissionMutation builder.
-func (m *SubmissionMutation) Where(ps ...predicate.Submission) {
+// Where appends a list predicates to the SubscriptionMutation builder.
+func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *SubmissionMutation) Op() Op {
+func (m *SubscriptionMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Submission).
-func (m *SubmissionMutation) Type() string {
+// Type returns the node type of this mutation (Subscription).
+func (m *SubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubmissionMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.source_language != nil {
-		fields = append(fields, submission.FieldSourceLanguage)
-	}
-	if m.target_language != nil {
-		fields = append(fields, submission.FieldTargetLanguage)
+func (m *SubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.stripe_customer_id != nil {
+		fields = append(fields, subscription.FieldStripeCustomerID)
 	}
-	if m.is_inline != nil {
-		fields = append(fields, submission.FieldIsInline)
+	if m.stripe_subscription_id != nil {
+		fields = append(fields, subscription.FieldStripeSubscriptionID)
 	}
-	if m.is_public != nil {
-		fields = append(fields, submission.FieldIsPublic)
+	if m.tier != nil {
+		fields = append(fields, subscription.FieldTier)
 	}
-	if m.status != nil {
-		fields = append(fields, submission.FieldStatus)
+	if m.expires_at != nil {
+		fields = append(fields, subscription.FieldExpiresAt)
 	}
-	if m.reason != nil {
-		fields = append(fields, submission.FieldReason)
+	if m.cancelled != nil {
+		fields = append(fields, subscription.FieldCancelled)
 	}
-	if m.git_repo != nil {
-		fields = append(fields, submission.FieldGitRepo)
+	if m.manual != nil {
+		fields = append(fields, subscription.FieldManual)
 	}
 	if m.created_at != nil {
-		fields = append(fields, submission.FieldCreatedAt)
-	}
-	if m.share_id != nil {
-		fields = append(fields, submission.FieldShareID)
-	}
-	if m.submission_source_size_bytes != nil {
-		fields = append(fields, submission.FieldSubmissionSourceSizeBytes)
-	}
-	if m.submission_target_size_bytes != nil {
-		fields = append(fields, submission.FieldSubmissionTargetSizeBytes)
-	}
-	if m.processing_started_at != nil {
-		fields = append(fields, submission.FieldProcessingStartedAt)
-	}
-	if m.processing_finished_at != nil {
-		fields = append(fields, submission.FieldProcessingFinishedAt)
+		fields = append(fields, subscription.FieldCreatedAt)
 	}
 	return fields
 }
@@ -844,34 +10046,22 @@ func (m *SubmissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
+func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case submission.FieldSourceLanguage:
-		return m.SourceLanguage()
-	case submission.FieldTargetLanguage:
-		return m.TargetLanguage()
-	case submission.FieldIsInline:
-		return m.IsInline()
-	case submission.FieldIsPublic:
-		return m.IsPublic()
-	case submission.FieldStatus:
-		return m.Status()
-	case submission.FieldReason:
-		return m.Reason()
-	case submission.FieldGitRepo:
-		return m.GitRepo()
-	case submission.FieldCreatedAt:
+	case subscription.FieldStripeCustomerID:
+		return m.StripeCustomerID()
+	case subscription.FieldStripeSubscriptionID:
+		return m.StripeSubscriptionID()
+	case subscription.FieldTier:
+		return m.Tier()
+	case subscription.FieldExpiresAt:
+		return m.ExpiresAt()
+	case subscription.FieldCancelled:
+		return m.Cancelled()
+	case subscription.FieldManual:
+		return m.Manual()
+	case subscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case submission.FieldShareID:
-		return m.ShareID()
-	case submission.FieldSubmissionSourceSizeBytes:
-		return m.SubmissionSourceSizeBytes()
-	case submission.FieldSubmissionTargetSizeBytes:
-		return m.SubmissionTargetSizeBytes()
-	case submission.FieldProcessingStartedAt:
-		return m.ProcessingStartedAt()
-	case submission.FieldProcessingFinishedAt:
-		return m.ProcessingFinishedAt()
 	}
 	return nil, false
 }
@@ -879,300 +10069,189 @@ func (m *SubmissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubmissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case submission.FieldSourceLanguage:
-		return m.OldSourceLanguage(ctx)
-	case submission.FieldTargetLanguage:
-		return m.OldTargetLanguage(ctx)
-	case submission.FieldIsInline:
-		return m.OldIsInline(ctx)
-	case submission.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case submission.FieldStatus:
-		return m.OldStatus(ctx)
-	case submission.FieldReason:
-		return m.OldReason(ctx)
-	case submission.FieldGitRepo:
-		return m.OldGitRepo(ctx)
-	case submission.FieldCreatedAt:
+	case subscription.FieldStripeCustomerID:
+		return m.OldStripeCustomerID(ctx)
+	case subscription.FieldStripeSubscriptionID:
+		return m.OldStripeSubscriptionID(ctx)
+	case subscription.FieldTier:
+		return m.OldTier(ctx)
+	case subscription.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case subscription.FieldCancelled:
+		return m.OldCancelled(ctx)
+	case subscription.FieldManual:
+		return m.OldManual(ctx)
+	case subscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case submission.FieldShareID:
-		return m.OldShareID(ctx)
-	case submission.FieldSubmissionSourceSizeBytes:
-		return m.OldSubmissionSourceSizeBytes(ctx)
-	case submission.FieldSubmissionTargetSizeBytes:
-		return m.OldSubmissionTargetSizeBytes(ctx)
-	case submission.FieldProcessingStartedAt:
-		return m.OldProcessingStartedAt(ctx)
-	case submission.FieldProcessingFinishedAt:
-		return m.OldProcessingFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Submission field %s", name)
+	return nil, fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubmissionMutation) SetField(name string, value ent.Value) error {
+func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case submission.FieldSourceLanguage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceLanguage(v)
-		return nil
-	case submission.FieldTargetLanguage:
+	case subscription.FieldStripeCustomerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetLanguage(v)
-		return nil
-	case submission.FieldIsInline:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsInline(v)
-		return nil
-	case submission.FieldIsPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublic(v)
-		return nil
-	case submission.FieldStatus:
-		v, ok := value.(submission.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetStripeCustomerID(v)
 		return nil
-	case submission.FieldReason:
+	case subscription.FieldStripeSubscriptionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetStripeSubscriptionID(v)
 		return nil
-	case submission.FieldGitRepo:
-		v, ok := value.(string)
+	case subscription.FieldTier:
+		v, ok := value.(subscription.Tier)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGitRepo(v)
+		m.SetTier(v)
 		return nil
-	case submission.FieldCreatedAt:
+	case subscription.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case submission.FieldShareID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetShareID(v)
-		return nil
-	case submission.FieldSubmissionSourceSizeBytes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubmissionSourceSizeBytes(v)
+		m.SetExpiresAt(v)
 		return nil
-	case submission.FieldSubmissionTargetSizeBytes:
-		v, ok := value.(int)
+	case subscription.FieldCancelled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubmissionTargetSizeBytes(v)
+		m.SetCancelled(v)
 		return nil
-	case submission.FieldProcessingStartedAt:
-		v, ok := value.(time.Time)
+	case subscription.FieldManual:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessingStartedAt(v)
+		m.SetManual(v)
 		return nil
-	case submission.FieldProcessingFinishedAt:
+	case subscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessingFinishedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Submission field %s", name)
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubmissionMutation) AddedFields() []string {
-	var fields []string
-	if m.addsubmission_source_size_bytes != nil {
-		fields = append(fields, submission.FieldSubmissionSourceSizeBytes)
-	}
-	if m.addsubmission_target_size_bytes != nil {
-		fields = append(fields, submission.FieldSubmissionTargetSizeBytes)
-	}
-	return fields
+func (m *SubscriptionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubmissionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case submission.FieldSubmissionSourceSizeBytes:
-		return m.AddedSubmissionSourceSizeBytes()
-	case submission.FieldSubmissionTargetSizeBytes:
-		return m.AddedSubmissionTargetSizeBytes()
-	}
+func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubmissionMutation) AddField(name string, value ent.Value) error {
+func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case submission.FieldSubmissionSourceSizeBytes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubmissionSourceSizeBytes(v)
-		return nil
-	case submission.FieldSubmissionTargetSizeBytes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubmissionTargetSizeBytes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Submission numeric field %s", name)
+	return fmt.Errorf("unknown Subscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubmissionMutation) ClearedFields() []string {
+func (m *SubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(submission.FieldReason) {
-		fields = append(fields, submission.FieldReason)
-	}
-	if m.FieldCleared(submission.FieldGitRepo) {
-		fields = append(fields, submission.FieldGitRepo)
-	}
-	if m.FieldCleared(submission.FieldShareID) {
-		fields = append(fields, submission.FieldShareID)
+	if m.FieldCleared(subscription.FieldStripeCustomerID) {
+		fields = append(fields, subscription.FieldStripeCustomerID)
 	}
-	if m.FieldCleared(submission.FieldProcessingStartedAt) {
-		fields = append(fields, submission.FieldProcessingStartedAt)
+	if m.FieldCleared(subscription.FieldStripeSubscriptionID) {
+		fields = append(fields, subscription.FieldStripeSubscriptionID)
 	}
-	if m.FieldCleared(submission.FieldProcessingFinishedAt) {
-		fields = append(fields, submission.FieldProcessingFinishedAt)
+	if m.FieldCleared(subscription.FieldExpiresAt) {
+		fields = append(fields, subscription.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubmissionMutation) FieldCleared(name string) bool {
+func (m *SubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubmissionMutation) ClearField(name string) error {
+func (m *SubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case submission.FieldReason:
-		m.ClearReason()
-		return nil
-	case submission.FieldGitRepo:
-		m.ClearGitRepo()
-		return nil
-	case submission.FieldShareID:
-		m.ClearShareID()
+	case subscription.FieldStripeCustomerID:
+		m.ClearStripeCustomerID()
 		return nil
-	case submission.FieldProcessingStartedAt:
-		m.ClearProcessingStartedAt()
+	case subscription.FieldStripeSubscriptionID:
+		m.ClearStripeSubscriptionID()
 		return nil
-	case submission.FieldProcessingFinishedAt:
-		m.ClearProcessingFinishedAt()
+	case subscription.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission nullable field %s", name)
+	return fmt.Errorf("unknown Subscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubmissionMutation) ResetField(name string) error {
+func (m *SubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case submission.FieldSourceLanguage:
-		m.ResetSourceLanguage()
-		return nil
-	case submission.FieldTargetLanguage:
-		m.ResetTargetLanguage()
+	case subscription.FieldStripeCustomerID:
+		m.ResetStripeCustomerID()
 		return nil
-	case submission.FieldIsInline:
-		m.ResetIsInline()
+	case subscription.FieldStripeSubscriptionID:
+		m.ResetStripeSubscriptionID()
 		return nil
-	case submission.FieldIsPublic:
-		m.ResetIsPublic()
+	case subscription.FieldTier:
+		m.ResetTier()
 		return nil
-	case submission.FieldStatus:
-		m.ResetStatus()
+	case subscription.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case submission.FieldReason:
-		m.ResetReason()
+	case subscription.FieldCancelled:
+		m.ResetCancelled()
 		return nil
-	case submission.FieldGitRepo:
-		m.ResetGitRepo()
+	case subscription.FieldManual:
+		m.ResetManual()
 		return nil
-	case submission.FieldCreatedAt:
+	case subscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case submission.FieldShareID:
-		m.ResetShareID()
-		return nil
-	case submission.FieldSubmissionSourceSizeBytes:
-		m.ResetSubmissionSourceSizeBytes()
-		return nil
-	case submission.FieldSubmissionTargetSizeBytes:
-		m.ResetSubmissionTargetSizeBytes()
-		return nil
-	case submission.FieldProcessingStartedAt:
-		m.ResetProcessingStartedAt()
-		return nil
-	case submission.FieldProcessingFinishedAt:
-		m.ResetProcessingFinishedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Submission field %s", name)
+	return fmt.Errorf("unknown Subscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubmissionMutation) AddedEdges() []string {
+func (m *SubscriptionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, submission.EdgeUser)
+		edges = append(edges, subscription.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
+func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case submission.EdgeUser:
+	case subscription.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1181,33 +10260,33 @@ func (m *SubmissionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubmissionMutation) RemovedEdges() []string {
+func (m *SubscriptionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubmissionMutation) RemovedIDs(name string) []ent.Value {
+func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubmissionMutation) ClearedEdges() []string {
+func (m *SubscriptionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, submission.EdgeUser)
+		edges = append(edges, subscription.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubmissionMutation) EdgeCleared(name string) bool {
+func (m *SubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case submission.EdgeUser:
+	case subscription.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -1215,57 +10294,56 @@ func (m *SubmissionMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubmissionMutation) ClearEdge(name string) error {
+func (m *SubscriptionMutation) ClearEdge(name string) error {
 	switch name {
-	case submission.EdgeUser:
+	case subscription.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission unique edge %s", name)
+	return fmt.Errorf("unknown Subscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubmissionMutation) ResetEdge(name string) error {
+func (m *SubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case submission.EdgeUser:
+	case subscription.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Submission edge %s", name)
+	return fmt.Errorf("unknown Subscription edge %s", name)
 }
 
-// SubscriptionMutation represents an operation that mutates the Subscription nodes in the graph.
-type SubscriptionMutation struct {
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	stripe_customer_id     *string
-	stripe_subscription_id *string
-	tier                   *subscription.Tier
-	expires_at             *time.Time
-	cancelled              *bool
-	created_at             *time.Time
-	clearedFields          map[string]struct{}
-	user                   *uuid.UUID
-	cleareduser            bool
-	done                   bool
-	oldValue               func(context.Context) (*Subscription, error)
-	predicates             []predicate.Subscription
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	is_active       *bool
+	created_at      *time.Time
+	impersonator_id *uuid.UUID
+	read_only       *bool
+	expires_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*Token, error)
+	predicates      []predicate.Token
 }
 
-var _ ent.Mutation = (*SubscriptionMutation)(nil)
+var _ ent.Mutation = (*TokenMutation)(nil)
 
-// subscriptionOption allows management of the mutation configuration using functional options.
-type subscriptionOption func(*SubscriptionMutation)
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
 
-// newSubscriptionMutation creates new mutation for the Subscription entity.
-func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
-	m := &SubscriptionMutation{
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSubscription,
+		typ:           TypeToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1274,20 +10352,20 @@ func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *Subsc
 	return m
 }
 
-// withSubscriptionID sets the ID field of the mutation.
-func withSubscriptionID(id uuid.UUID) subscriptionOption {
-	return func(m *SubscriptionMutation) {
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id uuid.UUID) tokenOption {
+	return func(m *TokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Subscription
+			value *Token
 		)
-		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+		m.oldValue = func(ctx context.Context) (*Token, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Subscription.Get(ctx, id)
+					value, err = m.Client().Token.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1296,10 +10374,10 @@ func withSubscriptionID(id uuid.UUID) subscriptionOption {
 	}
 }
 
-// withSubscription sets the old Subscription of the mutation.
-func withSubscription(node *Subscription) subscriptionOption {
-	return func(m *SubscriptionMutation) {
-		m.oldValue = func(context.Context) (*Subscription, error) {
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1308,7 +10386,7 @@ func withSubscription(node *Subscription) subscriptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SubscriptionMutation) Client() *Client {
+func (m TokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1316,7 +10394,7 @@ func (m SubscriptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SubscriptionMutation) Tx() (*Tx, error) {
+func (m TokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1326,14 +10404,14 @@ func (m SubscriptionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Subscription entities.
-func (m *SubscriptionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1344,7 +10422,7 @@ func (m *SubscriptionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1353,284 +10431,235 @@ func (m *SubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Subscription.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStripeCustomerID sets the "stripe_customer_id" field.
-func (m *SubscriptionMutation) SetStripeCustomerID(s string) {
-	m.stripe_customer_id = &s
-}
-
-// StripeCustomerID returns the value of the "stripe_customer_id" field in the mutation.
-func (m *SubscriptionMutation) StripeCustomerID() (r string, exists bool) {
-	v := m.stripe_customer_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStripeCustomerID returns the old "stripe_customer_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStripeCustomerID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeCustomerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeCustomerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeCustomerID: %w", err)
-	}
-	return oldValue.StripeCustomerID, nil
-}
-
-// ClearStripeCustomerID clears the value of the "stripe_customer_id" field.
-func (m *SubscriptionMutation) ClearStripeCustomerID() {
-	m.stripe_customer_id = nil
-	m.clearedFields[subscription.FieldStripeCustomerID] = struct{}{}
-}
-
-// StripeCustomerIDCleared returns if the "stripe_customer_id" field was cleared in this mutation.
-func (m *SubscriptionMutation) StripeCustomerIDCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldStripeCustomerID]
-	return ok
-}
-
-// ResetStripeCustomerID resets all changes to the "stripe_customer_id" field.
-func (m *SubscriptionMutation) ResetStripeCustomerID() {
-	m.stripe_customer_id = nil
-	delete(m.clearedFields, subscription.FieldStripeCustomerID)
-}
-
-// SetStripeSubscriptionID sets the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) SetStripeSubscriptionID(s string) {
-	m.stripe_subscription_id = &s
+// SetIsActive sets the "is_active" field.
+func (m *TokenMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// StripeSubscriptionID returns the value of the "stripe_subscription_id" field in the mutation.
-func (m *SubscriptionMutation) StripeSubscriptionID() (r string, exists bool) {
-	v := m.stripe_subscription_id
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *TokenMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStripeSubscriptionID returns the old "stripe_subscription_id" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldStripeSubscriptionID(ctx context.Context) (v string, err error) {
+func (m *TokenMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStripeSubscriptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStripeSubscriptionID requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStripeSubscriptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.StripeSubscriptionID, nil
-}
-
-// ClearStripeSubscriptionID clears the value of the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) ClearStripeSubscriptionID() {
-	m.stripe_subscription_id = nil
-	m.clearedFields[subscription.FieldStripeSubscriptionID] = struct{}{}
-}
-
-// StripeSubscriptionIDCleared returns if the "stripe_subscription_id" field was cleared in this mutation.
-func (m *SubscriptionMutation) StripeSubscriptionIDCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldStripeSubscriptionID]
-	return ok
+	return oldValue.IsActive, nil
 }
 
-// ResetStripeSubscriptionID resets all changes to the "stripe_subscription_id" field.
-func (m *SubscriptionMutation) ResetStripeSubscriptionID() {
-	m.stripe_subscription_id = nil
-	delete(m.clearedFields, subscription.FieldStripeSubscriptionID)
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *TokenMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetTier sets the "tier" field.
-func (m *SubscriptionMutation) SetTier(s subscription.Tier) {
-	m.tier = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *SubscriptionMutation) Tier() (r subscription.Tier, exists bool) {
-	v := m.tier
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTier returns the old "tier" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldTier(ctx context.Context) (v subscription.Tier, err error) {
+func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Tier, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *SubscriptionMutation) ResetTier() {
-	m.tier = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *SubscriptionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetImpersonatorID sets the "impersonator_id" field.
+func (m *TokenMutation) SetImpersonatorID(u uuid.UUID) {
+	m.impersonator_id = &u
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *SubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// ImpersonatorID returns the value of the "impersonator_id" field in the mutation.
+func (m *TokenMutation) ImpersonatorID() (r uuid.UUID, exists bool) {
+	v := m.impersonator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldImpersonatorID returns the old "impersonator_id" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *TokenMutation) OldImpersonatorID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldImpersonatorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldImpersonatorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldImpersonatorID: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.ImpersonatorID, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *SubscriptionMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[subscription.FieldExpiresAt] = struct{}{}
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (m *TokenMutation) ClearImpersonatorID() {
+	m.impersonator_id = nil
+	m.clearedFields[token.FieldImpersonatorID] = struct{}{}
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *SubscriptionMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[subscription.FieldExpiresAt]
+// ImpersonatorIDCleared returns if the "impersonator_id" field was cleared in this mutation.
+func (m *TokenMutation) ImpersonatorIDCleared() bool {
+	_, ok := m.clearedFields[token.FieldImpersonatorID]
 	return ok
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *SubscriptionMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, subscription.FieldExpiresAt)
+// ResetImpersonatorID resets all changes to the "impersonator_id" field.
+func (m *TokenMutation) ResetImpersonatorID() {
+	m.impersonator_id = nil
+	delete(m.clearedFields, token.FieldImpersonatorID)
 }
 
-// SetCancelled sets the "cancelled" field.
-func (m *SubscriptionMutation) SetCancelled(b bool) {
-	m.cancelled = &b
+// SetReadOnly sets the "read_only" field.
+func (m *TokenMutation) SetReadOnly(b bool) {
+	m.read_only = &b
 }
 
-// Cancelled returns the value of the "cancelled" field in the mutation.
-func (m *SubscriptionMutation) Cancelled() (r bool, exists bool) {
-	v := m.cancelled
+// ReadOnly returns the value of the "read_only" field in the mutation.
+func (m *TokenMutation) ReadOnly() (r bool, exists bool) {
+	v := m.read_only
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCancelled returns the old "cancelled" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldReadOnly returns the old "read_only" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCancelled(ctx context.Context) (v bool, err error) {
+func (m *TokenMutation) OldReadOnly(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCancelled is only allowed on UpdateOne operations")
+		return v, errors.New("OldReadOnly is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCancelled requires an ID field in the mutation")
+		return v, errors.New("OldReadOnly requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCancelled: %w", err)
+		return v, fmt.Errorf("querying old value for OldReadOnly: %w", err)
 	}
-	return oldValue.Cancelled, nil
+	return oldValue.ReadOnly, nil
 }
 
-// ResetCancelled resets all changes to the "cancelled" field.
-func (m *SubscriptionMutation) ResetCancelled() {
-	m.cancelled = nil
+// ResetReadOnly resets all changes to the "read_only" field.
+func (m *TokenMutation) ResetReadOnly() {
+	m.read_only = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *TokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Subscription entity.
-// If the Subscription object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SubscriptionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[token.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, token.FieldExpiresAt)
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *SubscriptionMutation) SetUserID(id uuid.UUID) {
+func (m *TokenMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *SubscriptionMutation) ClearUser() {
+func (m *TokenMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SubscriptionMutation) UserCleared() bool {
+func (m *TokenMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *TokenMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -1640,7 +10669,7 @@ func (m *SubscriptionMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
+func (m *TokenMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1648,48 +10677,45 @@ func (m *SubscriptionMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *SubscriptionMutation) ResetUser() {
+func (m *TokenMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the SubscriptionMutation builder.
-func (m *SubscriptionMutation) Where(ps ...predicate.Subscription) {
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *SubscriptionMutation) Op() Op {
+func (m *TokenMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Subscription).
-func (m *SubscriptionMutation) Type() string {
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SubscriptionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.stripe_customer_id != nil {
-		fields = append(fields, subscription.FieldStripeCustomerID)
-	}
-	if m.stripe_subscription_id != nil {
-		fields = append(fields, subscription.FieldStripeSubscriptionID)
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.is_active != nil {
+		fields = append(fields, token.FieldIsActive)
 	}
-	if m.tier != nil {
-		fields = append(fields, subscription.FieldTier)
+	if m.created_at != nil {
+		fields = append(fields, token.FieldCreatedAt)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, subscription.FieldExpiresAt)
+	if m.impersonator_id != nil {
+		fields = append(fields, token.FieldImpersonatorID)
 	}
-	if m.cancelled != nil {
-		fields = append(fields, subscription.FieldCancelled)
+	if m.read_only != nil {
+		fields = append(fields, token.FieldReadOnly)
 	}
-	if m.created_at != nil {
-		fields = append(fields, subscription.FieldCreatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, token.FieldExpiresAt)
 	}
 	return fields
 }
@@ -1697,20 +10723,18 @@ func (m *SubscriptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case subscription.FieldStripeCustomerID:
-		return m.StripeCustomerID()
-	case subscription.FieldStripeSubscriptionID:
-		return m.StripeSubscriptionID()
-	case subscription.FieldTier:
-		return m.Tier()
-	case subscription.FieldExpiresAt:
-		return m.ExpiresAt()
-	case subscription.FieldCancelled:
-		return m.Cancelled()
-	case subscription.FieldCreatedAt:
+	case token.FieldIsActive:
+		return m.IsActive()
+	case token.FieldCreatedAt:
 		return m.CreatedAt()
+	case token.FieldImpersonatorID:
+		return m.ImpersonatorID()
+	case token.FieldReadOnly:
+		return m.ReadOnly()
+	case token.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1718,177 +10742,159 @@ func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case subscription.FieldStripeCustomerID:
-		return m.OldStripeCustomerID(ctx)
-	case subscription.FieldStripeSubscriptionID:
-		return m.OldStripeSubscriptionID(ctx)
-	case subscription.FieldTier:
-		return m.OldTier(ctx)
-	case subscription.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case subscription.FieldCancelled:
-		return m.OldCancelled(ctx)
-	case subscription.FieldCreatedAt:
+	case token.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case token.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case token.FieldImpersonatorID:
+		return m.OldImpersonatorID(ctx)
+	case token.FieldReadOnly:
+		return m.OldReadOnly(ctx)
+	case token.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Subscription field %s", name)
+	return nil, fmt.Errorf("unknown Token field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case subscription.FieldStripeCustomerID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStripeCustomerID(v)
-		return nil
-	case subscription.FieldStripeSubscriptionID:
-		v, ok := value.(string)
+	case token.FieldIsActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStripeSubscriptionID(v)
+		m.SetIsActive(v)
 		return nil
-	case subscription.FieldTier:
-		v, ok := value.(subscription.Tier)
+	case token.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTier(v)
+		m.SetCreatedAt(v)
 		return nil
-	case subscription.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case token.FieldImpersonatorID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetImpersonatorID(v)
 		return nil
-	case subscription.FieldCancelled:
+	case token.FieldReadOnly:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCancelled(v)
+		m.SetReadOnly(v)
 		return nil
-	case subscription.FieldCreatedAt:
+	case token.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SubscriptionMutation) AddedFields() []string {
+func (m *TokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Subscription numeric field %s", name)
+	return fmt.Errorf("unknown Token numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SubscriptionMutation) ClearedFields() []string {
+func (m *TokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(subscription.FieldStripeCustomerID) {
-		fields = append(fields, subscription.FieldStripeCustomerID)
-	}
-	if m.FieldCleared(subscription.FieldStripeSubscriptionID) {
-		fields = append(fields, subscription.FieldStripeSubscriptionID)
+	if m.FieldCleared(token.FieldImpersonatorID) {
+		fields = append(fields, token.FieldImpersonatorID)
 	}
-	if m.FieldCleared(subscription.FieldExpiresAt) {
-		fields = append(fields, subscription.FieldExpiresAt)
+	if m.FieldCleared(token.FieldExpiresAt) {
+		fields = append(fields, token.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SubscriptionMutation) FieldCleared(name string) bool {
+func (m *TokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ClearField(name string) error {
+func (m *TokenMutation) ClearField(name string) error {
 	switch name {
-	case subscription.FieldStripeCustomerID:
-		m.ClearStripeCustomerID()
-		return nil
-	case subscription.FieldStripeSubscriptionID:
-		m.ClearStripeSubscriptionID()
+	case token.FieldImpersonatorID:
+		m.ClearImpersonatorID()
 		return nil
-	case subscription.FieldExpiresAt:
+	case token.FieldExpiresAt:
 		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription nullable field %s", name)
+	return fmt.Errorf("unknown Token nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SubscriptionMutation) ResetField(name string) error {
+func (m *TokenMutation) ResetField(name string) error {
 	switch name {
-	case subscription.FieldStripeCustomerID:
-		m.ResetStripeCustomerID()
-		return nil
-	case subscription.FieldStripeSubscriptionID:
-		m.ResetStripeSubscriptionID()
+	case token.FieldIsActive:
+		m.ResetIsActive()
 		return nil
-	case subscription.FieldTier:
-		m.ResetTier()
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case subscription.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case token.FieldImpersonatorID:
+		m.ResetImpersonatorID()
 		return nil
-	case subscription.FieldCancelled:
-		m.ResetCancelled()
+	case token.FieldReadOnly:
+		m.ResetReadOnly()
 		return nil
-	case subscription.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case token.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription field %s", name)
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SubscriptionMutation) AddedEdges() []string {
+func (m *TokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, subscription.EdgeUser)
+		edges = append(edges, token.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case subscription.EdgeUser:
+	case token.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1897,33 +10903,33 @@ func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SubscriptionMutation) RemovedEdges() []string {
+func (m *TokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SubscriptionMutation) ClearedEdges() []string {
+func (m *TokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, subscription.EdgeUser)
+		edges = append(edges, token.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+func (m *TokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case subscription.EdgeUser:
+	case token.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -1931,53 +10937,56 @@ func (m *SubscriptionMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SubscriptionMutation) ClearEdge(name string) error {
+func (m *TokenMutation) ClearEdge(name string) error {
 	switch name {
-	case subscription.EdgeUser:
+	case token.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription unique edge %s", name)
+	return fmt.Errorf("unknown Token unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SubscriptionMutation) ResetEdge(name string) error {
+func (m *TokenMutation) ResetEdge(name string) error {
 	switch name {
-	case subscription.EdgeUser:
+	case token.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Subscription edge %s", name)
+	return fmt.Errorf("unknown Token edge %s", name)
 }
 
-// TokenMutation represents an operation that mutates the Token nodes in the graph.
-type TokenMutation struct {
+// UploadSessionMutation represents an operation that mutates the UploadSession nodes in the graph.
+type UploadSessionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	is_active     *bool
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Token, error)
-	predicates    []predicate.Token
-}
-
-var _ ent.Mutation = (*TokenMutation)(nil)
-
-// tokenOption allows management of the mutation configuration using functional options.
-type tokenOption func(*TokenMutation)
-
-// newTokenMutation creates new mutation for the Token entity.
-func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
-	m := &TokenMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	source_language *string
+	target_language *string
+	files           *[]schema.UploadSessionFile
+	expires_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*UploadSession, error)
+	predicates      []predicate.UploadSession
+}
+
+var _ ent.Mutation = (*UploadSessionMutation)(nil)
+
+// uploadsessionOption allows management of the mutation configuration using functional options.
+type uploadsessionOption func(*UploadSessionMutation)
+
+// newUploadSessionMutation creates new mutation for the UploadSession entity.
+func newUploadSessionMutation(c config, op Op, opts ...uploadsessionOption) *UploadSessionMutation {
+	m := &UploadSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypeUploadSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1986,20 +10995,20 @@ func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
 	return m
 }
 
-// withTokenID sets the ID field of the mutation.
-func withTokenID(id uuid.UUID) tokenOption {
-	return func(m *TokenMutation) {
+// withUploadSessionID sets the ID field of the mutation.
+func withUploadSessionID(id uuid.UUID) uploadsessionOption {
+	return func(m *UploadSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Token
+			value *UploadSession
 		)
-		m.oldValue = func(ctx context.Context) (*Token, error) {
+		m.oldValue = func(ctx context.Context) (*UploadSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Token.Get(ctx, id)
+					value, err = m.Client().UploadSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2008,10 +11017,10 @@ func withTokenID(id uuid.UUID) tokenOption {
 	}
 }
 
-// withToken sets the old Token of the mutation.
-func withToken(node *Token) tokenOption {
-	return func(m *TokenMutation) {
-		m.oldValue = func(context.Context) (*Token, error) {
+// withUploadSession sets the old UploadSession of the mutation.
+func withUploadSession(node *UploadSession) uploadsessionOption {
+	return func(m *UploadSessionMutation) {
+		m.oldValue = func(context.Context) (*UploadSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2020,7 +11029,7 @@ func withToken(node *Token) tokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m UploadSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2028,7 +11037,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m UploadSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2038,14 +11047,14 @@ func (m TokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Token entities.
-func (m *TokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of UploadSession entities.
+func (m *UploadSessionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UploadSessionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2056,7 +11065,7 @@ func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UploadSessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2065,55 +11074,163 @@ func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UploadSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *TokenMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// SetSourceLanguage sets the "source_language" field.
+func (m *UploadSessionMutation) SetSourceLanguage(s string) {
+	m.source_language = &s
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *TokenMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// SourceLanguage returns the value of the "source_language" field in the mutation.
+func (m *UploadSessionMutation) SourceLanguage() (r string, exists bool) {
+	v := m.source_language
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceLanguage returns the old "source_language" field's value of the UploadSession entity.
+// If the UploadSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *UploadSessionMutation) OldSourceLanguage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceLanguage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldSourceLanguage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceLanguage: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.SourceLanguage, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *TokenMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetSourceLanguage resets all changes to the "source_language" field.
+func (m *UploadSessionMutation) ResetSourceLanguage() {
+	m.source_language = nil
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (m *UploadSessionMutation) SetTargetLanguage(s string) {
+	m.target_language = &s
+}
+
+// TargetLanguage returns the value of the "target_language" field in the mutation.
+func (m *UploadSessionMutation) TargetLanguage() (r string, exists bool) {
+	v := m.target_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetLanguage returns the old "target_language" field's value of the UploadSession entity.
+// If the UploadSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UploadSessionMutation) OldTargetLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetLanguage: %w", err)
+	}
+	return oldValue.TargetLanguage, nil
+}
+
+// ResetTargetLanguage resets all changes to the "target_language" field.
+func (m *UploadSessionMutation) ResetTargetLanguage() {
+	m.target_language = nil
+}
+
+// SetFiles sets the "files" field.
+func (m *UploadSessionMutation) SetFiles(ssf []schema.UploadSessionFile) {
+	m.files = &ssf
+}
+
+// Files returns the value of the "files" field in the mutation.
+func (m *UploadSessionMutation) Files() (r []schema.UploadSessionFile, exists bool) {
+	v := m.files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiles returns the old "files" field's value of the UploadSession entity.
+// If the UploadSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UploadSessionMutation) OldFiles(ctx context.Context) (v []schema.UploadSessionFile, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiles: %w", err)
+	}
+	return oldValue.Files, nil
+}
+
+// ResetFiles resets all changes to the "files" field.
+func (m *UploadSessionMutation) ResetFiles() {
+	m.files = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *UploadSessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UploadSessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the UploadSession entity.
+// If the UploadSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UploadSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UploadSessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TokenMutation) SetCreatedAt(t time.Time) {
+func (m *UploadSessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UploadSessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2121,10 +11238,10 @@ func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UploadSession entity.
+// If the UploadSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UploadSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2139,27 +11256,27 @@ func (m *TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TokenMutation) ResetCreatedAt() {
+func (m *UploadSessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *TokenMutation) SetUserID(id uuid.UUID) {
+func (m *UploadSessionMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *TokenMutation) ClearUser() {
+func (m *UploadSessionMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TokenMutation) UserCleared() bool {
+func (m *UploadSessionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *TokenMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *UploadSessionMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -2169,7 +11286,7 @@ func (m *TokenMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) UserIDs() (ids []uuid.UUID) {
+func (m *UploadSessionMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2177,36 +11294,45 @@ func (m *TokenMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *TokenMutation) ResetUser() {
+func (m *UploadSessionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the TokenMutation builder.
-func (m *TokenMutation) Where(ps ...predicate.Token) {
+// Where appends a list predicates to the UploadSessionMutation builder.
+func (m *UploadSessionMutation) Where(ps ...predicate.UploadSession) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *UploadSessionMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (UploadSession).
+func (m *UploadSessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.is_active != nil {
-		fields = append(fields, token.FieldIsActive)
+func (m *UploadSessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.source_language != nil {
+		fields = append(fields, uploadsession.FieldSourceLanguage)
+	}
+	if m.target_language != nil {
+		fields = append(fields, uploadsession.FieldTargetLanguage)
+	}
+	if m.files != nil {
+		fields = append(fields, uploadsession.FieldFiles)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, uploadsession.FieldExpiresAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, token.FieldCreatedAt)
+		fields = append(fields, uploadsession.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2214,11 +11340,17 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *UploadSessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldIsActive:
-		return m.IsActive()
-	case token.FieldCreatedAt:
+	case uploadsession.FieldSourceLanguage:
+		return m.SourceLanguage()
+	case uploadsession.FieldTargetLanguage:
+		return m.TargetLanguage()
+	case uploadsession.FieldFiles:
+		return m.Files()
+	case uploadsession.FieldExpiresAt:
+		return m.ExpiresAt()
+	case uploadsession.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2227,29 +11359,56 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UploadSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case token.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case token.FieldCreatedAt:
+	case uploadsession.FieldSourceLanguage:
+		return m.OldSourceLanguage(ctx)
+	case uploadsession.FieldTargetLanguage:
+		return m.OldTargetLanguage(ctx)
+	case uploadsession.FieldFiles:
+		return m.OldFiles(ctx)
+	case uploadsession.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case uploadsession.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Token field %s", name)
+	return nil, fmt.Errorf("unknown UploadSession field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *UploadSessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldIsActive:
-		v, ok := value.(bool)
+	case uploadsession.FieldSourceLanguage:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetSourceLanguage(v)
 		return nil
-	case token.FieldCreatedAt:
+	case uploadsession.FieldTargetLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetLanguage(v)
+		return nil
+	case uploadsession.FieldFiles:
+		v, ok := value.([]schema.UploadSessionFile)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFiles(v)
+		return nil
+	case uploadsession.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case uploadsession.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2257,78 +11416,87 @@ func (m *TokenMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown UploadSession field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMutation) AddedFields() []string {
+func (m *UploadSessionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UploadSessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *UploadSessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown UploadSession numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMutation) ClearedFields() []string {
+func (m *UploadSessionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *UploadSessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Token nullable field %s", name)
+func (m *UploadSessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UploadSession nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *UploadSessionMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldIsActive:
-		m.ResetIsActive()
+	case uploadsession.FieldSourceLanguage:
+		m.ResetSourceLanguage()
 		return nil
-	case token.FieldCreatedAt:
+	case uploadsession.FieldTargetLanguage:
+		m.ResetTargetLanguage()
+		return nil
+	case uploadsession.FieldFiles:
+		m.ResetFiles()
+		return nil
+	case uploadsession.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case uploadsession.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown UploadSession field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMutation) AddedEdges() []string {
+func (m *UploadSessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, token.EdgeUser)
+		edges = append(edges, uploadsession.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *UploadSessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case token.EdgeUser:
+	case uploadsession.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2337,33 +11505,33 @@ func (m *TokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMutation) RemovedEdges() []string {
+func (m *UploadSessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *UploadSessionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMutation) ClearedEdges() []string {
+func (m *UploadSessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, token.EdgeUser)
+		edges = append(edges, uploadsession.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *UploadSessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case token.EdgeUser:
+	case uploadsession.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -2371,24 +11539,24 @@ func (m *TokenMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
+func (m *UploadSessionMutation) ClearEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
+	case uploadsession.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Token unique edge %s", name)
+	return fmt.Errorf("unknown UploadSession unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
+func (m *UploadSessionMutation) ResetEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
+	case uploadsession.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Token edge %s", name)
+	return fmt.Errorf("unknown UploadSession edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -2407,6 +11575,7 @@ type UserMutation struct {
 	gitlab_access_token            *string
 	gitlab_refresh_token           *string
 	gitlab_access_token_expires_at *time.Time
+	role                           *user.Role
 	created_at                     *time.Time
 	clearedFields                  map[string]struct{}
 	tokens                         map[uuid.UUID]struct{}
@@ -2415,6 +11584,20 @@ type UserMutation struct {
 	submissions                    map[uuid.UUID]struct{}
 	removedsubmissions             map[uuid.UUID]struct{}
 	clearedsubmissions             bool
+	upload_sessions                map[uuid.UUID]struct{}
+	removedupload_sessions         map[uuid.UUID]struct{}
+	clearedupload_sessions         bool
+	resumable_uploads              map[uuid.UUID]struct{}
+	removedresumable_uploads       map[uuid.UUID]struct{}
+	clearedresumable_uploads       bool
+	data_keys                      map[uuid.UUID]struct{}
+	removeddata_keys               map[uuid.UUID]struct{}
+	cleareddata_keys               bool
+	notifications                  map[uuid.UUID]struct{}
+	removednotifications           map[uuid.UUID]struct{}
+	clearednotifications           bool
+	notification_preference        *uuid.UUID
+	clearednotification_preference bool
 	subscription                   *uuid.UUID
 	clearedsubscription            bool
 	done                           bool
@@ -2947,6 +12130,42 @@ func (m *UserMutation) ResetGitlabAccessTokenExpiresAt() {
 	delete(m.clearedFields, user.FieldGitlabAccessTokenExpiresAt)
 }
 
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -3091,6 +12310,261 @@ func (m *UserMutation) ResetSubmissions() {
 	m.removedsubmissions = nil
 }
 
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by ids.
+func (m *UserMutation) AddUploadSessionIDs(ids ...uuid.UUID) {
+	if m.upload_sessions == nil {
+		m.upload_sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.upload_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUploadSessions clears the "upload_sessions" edge to the UploadSession entity.
+func (m *UserMutation) ClearUploadSessions() {
+	m.clearedupload_sessions = true
+}
+
+// UploadSessionsCleared reports if the "upload_sessions" edge to the UploadSession entity was cleared.
+func (m *UserMutation) UploadSessionsCleared() bool {
+	return m.clearedupload_sessions
+}
+
+// RemoveUploadSessionIDs removes the "upload_sessions" edge to the UploadSession entity by IDs.
+func (m *UserMutation) RemoveUploadSessionIDs(ids ...uuid.UUID) {
+	if m.removedupload_sessions == nil {
+		m.removedupload_sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.upload_sessions, ids[i])
+		m.removedupload_sessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUploadSessions returns the removed IDs of the "upload_sessions" edge to the UploadSession entity.
+func (m *UserMutation) RemovedUploadSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedupload_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UploadSessionsIDs returns the "upload_sessions" edge IDs in the mutation.
+func (m *UserMutation) UploadSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.upload_sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUploadSessions resets all changes to the "upload_sessions" edge.
+func (m *UserMutation) ResetUploadSessions() {
+	m.upload_sessions = nil
+	m.clearedupload_sessions = false
+	m.removedupload_sessions = nil
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by ids.
+func (m *UserMutation) AddResumableUploadIDs(ids ...uuid.UUID) {
+	if m.resumable_uploads == nil {
+		m.resumable_uploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.resumable_uploads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResumableUploads clears the "resumable_uploads" edge to the ResumableUpload entity.
+func (m *UserMutation) ClearResumableUploads() {
+	m.clearedresumable_uploads = true
+}
+
+// ResumableUploadsCleared reports if the "resumable_uploads" edge to the ResumableUpload entity was cleared.
+func (m *UserMutation) ResumableUploadsCleared() bool {
+	return m.clearedresumable_uploads
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (m *UserMutation) RemoveResumableUploadIDs(ids ...uuid.UUID) {
+	if m.removedresumable_uploads == nil {
+		m.removedresumable_uploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.resumable_uploads, ids[i])
+		m.removedresumable_uploads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResumableUploads returns the removed IDs of the "resumable_uploads" edge to the ResumableUpload entity.
+func (m *UserMutation) RemovedResumableUploadsIDs() (ids []uuid.UUID) {
+	for id := range m.removedresumable_uploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResumableUploadsIDs returns the "resumable_uploads" edge IDs in the mutation.
+func (m *UserMutation) ResumableUploadsIDs() (ids []uuid.UUID) {
+	for id := range m.resumable_uploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResumableUploads resets all changes to the "resumable_uploads" edge.
+func (m *UserMutation) ResetResumableUploads() {
+	m.resumable_uploads = nil
+	m.clearedresumable_uploads = false
+	m.removedresumable_uploads = nil
+}
+
+// AddDataKeyIDs adds the "data_keys" edge to the DataKey entity by ids.
+func (m *UserMutation) AddDataKeyIDs(ids ...uuid.UUID) {
+	if m.data_keys == nil {
+		m.data_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.data_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDataKeys clears the "data_keys" edge to the DataKey entity.
+func (m *UserMutation) ClearDataKeys() {
+	m.cleareddata_keys = true
+}
+
+// DataKeysCleared reports if the "data_keys" edge to the DataKey entity was cleared.
+func (m *UserMutation) DataKeysCleared() bool {
+	return m.cleareddata_keys
+}
+
+// RemoveDataKeyIDs removes the "data_keys" edge to the DataKey entity by IDs.
+func (m *UserMutation) RemoveDataKeyIDs(ids ...uuid.UUID) {
+	if m.removeddata_keys == nil {
+		m.removeddata_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.data_keys, ids[i])
+		m.removeddata_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDataKeys returns the removed IDs of the "data_keys" edge to the DataKey entity.
+func (m *UserMutation) RemovedDataKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removeddata_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DataKeysIDs returns the "data_keys" edge IDs in the mutation.
+func (m *UserMutation) DataKeysIDs() (ids []uuid.UUID) {
+	for id := range m.data_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDataKeys resets all changes to the "data_keys" edge.
+func (m *UserMutation) ResetDataKeys() {
+	m.data_keys = nil
+	m.cleareddata_keys = false
+	m.removeddata_keys = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by id.
+func (m *UserMutation) SetNotificationPreferenceID(id uuid.UUID) {
+	m.notification_preference = &id
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (m *UserMutation) ClearNotificationPreference() {
+	m.clearednotification_preference = true
+}
+
+// NotificationPreferenceCleared reports if the "notification_preference" edge to the NotificationPreference entity was cleared.
+func (m *UserMutation) NotificationPreferenceCleared() bool {
+	return m.clearednotification_preference
+}
+
+// NotificationPreferenceID returns the "notification_preference" edge ID in the mutation.
+func (m *UserMutation) NotificationPreferenceID() (id uuid.UUID, exists bool) {
+	if m.notification_preference != nil {
+		return *m.notification_preference, true
+	}
+	return
+}
+
+// NotificationPreferenceIDs returns the "notification_preference" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NotificationPreferenceID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) NotificationPreferenceIDs() (ids []uuid.UUID) {
+	if id := m.notification_preference; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNotificationPreference resets all changes to the "notification_preference" edge.
+func (m *UserMutation) ResetNotificationPreference() {
+	m.notification_preference = nil
+	m.clearednotification_preference = false
+}
+
 // SetSubscriptionID sets the "subscription" edge to the Subscription entity by id.
 func (m *UserMutation) SetSubscriptionID(id uuid.UUID) {
 	m.subscription = &id
@@ -3149,7 +12623,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
@@ -3174,6 +12648,9 @@ func (m *UserMutation) Fields() []string {
 	if m.gitlab_access_token_expires_at != nil {
 		fields = append(fields, user.FieldGitlabAccessTokenExpiresAt)
 	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -3201,6 +12678,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.GitlabRefreshToken()
 	case user.FieldGitlabAccessTokenExpiresAt:
 		return m.GitlabAccessTokenExpiresAt()
+	case user.FieldRole:
+		return m.Role()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -3228,6 +12707,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldGitlabRefreshToken(ctx)
 	case user.FieldGitlabAccessTokenExpiresAt:
 		return m.OldGitlabAccessTokenExpiresAt(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -3295,6 +12776,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGitlabAccessTokenExpiresAt(v)
 		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -3447,6 +12935,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldGitlabAccessTokenExpiresAt:
 		m.ResetGitlabAccessTokenExpiresAt()
 		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -3456,13 +12947,28 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 8)
 	if m.tokens != nil {
 		edges = append(edges, user.EdgeTokens)
 	}
 	if m.submissions != nil {
 		edges = append(edges, user.EdgeSubmissions)
 	}
+	if m.upload_sessions != nil {
+		edges = append(edges, user.EdgeUploadSessions)
+	}
+	if m.resumable_uploads != nil {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.data_keys != nil {
+		edges = append(edges, user.EdgeDataKeys)
+	}
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.notification_preference != nil {
+		edges = append(edges, user.EdgeNotificationPreference)
+	}
 	if m.subscription != nil {
 		edges = append(edges, user.EdgeSubscription)
 	}
@@ -3485,6 +12991,34 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUploadSessions:
+		ids := make([]ent.Value, 0, len(m.upload_sessions))
+		for id := range m.upload_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeResumableUploads:
+		ids := make([]ent.Value, 0, len(m.resumable_uploads))
+		for id := range m.resumable_uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDataKeys:
+		ids := make([]ent.Value, 0, len(m.data_keys))
+		for id := range m.data_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationPreference:
+		if id := m.notification_preference; id != nil {
+			return []ent.Value{*id}
+		}
 	case user.EdgeSubscription:
 		if id := m.subscription; id != nil {
 			return []ent.Value{*id}
@@ -3495,13 +13029,25 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 8)
 	if m.removedtokens != nil {
 		edges = append(edges, user.EdgeTokens)
 	}
 	if m.removedsubmissions != nil {
 		edges = append(edges, user.EdgeSubmissions)
 	}
+	if m.removedupload_sessions != nil {
+		edges = append(edges, user.EdgeUploadSessions)
+	}
+	if m.removedresumable_uploads != nil {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.removeddata_keys != nil {
+		edges = append(edges, user.EdgeDataKeys)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
 	return edges
 }
 
@@ -3521,19 +13067,58 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeUploadSessions:
+		ids := make([]ent.Value, 0, len(m.removedupload_sessions))
+		for id := range m.removedupload_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeResumableUploads:
+		ids := make([]ent.Value, 0, len(m.removedresumable_uploads))
+		for id := range m.removedresumable_uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDataKeys:
+		ids := make([]ent.Value, 0, len(m.removeddata_keys))
+		for id := range m.removeddata_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+	edges := make([]string, 0, 8)
 	if m.clearedtokens {
 		edges = append(edges, user.EdgeTokens)
 	}
 	if m.clearedsubmissions {
 		edges = append(edges, user.EdgeSubmissions)
 	}
+	if m.clearedupload_sessions {
+		edges = append(edges, user.EdgeUploadSessions)
+	}
+	if m.clearedresumable_uploads {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.cleareddata_keys {
+		edges = append(edges, user.EdgeDataKeys)
+	}
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.clearednotification_preference {
+		edges = append(edges, user.EdgeNotificationPreference)
+	}
 	if m.clearedsubscription {
 		edges = append(edges, user.EdgeSubscription)
 	}
@@ -3548,6 +13133,16 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedtokens
 	case user.EdgeSubmissions:
 		return m.clearedsubmissions
+	case user.EdgeUploadSessions:
+		return m.clearedupload_sessions
+	case user.EdgeResumableUploads:
+		return m.clearedresumable_uploads
+	case user.EdgeDataKeys:
+		return m.cleareddata_keys
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgeNotificationPreference:
+		return m.clearednotification_preference
 	case user.EdgeSubscription:
 		return m.clearedsubscription
 	}
@@ -3558,6 +13153,9 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeNotificationPreference:
+		m.ClearNotificationPreference()
+		return nil
 	case user.EdgeSubscription:
 		m.ClearSubscription()
 		return nil
@@ -3575,6 +13173,21 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeSubmissions:
 		m.ResetSubmissions()
 		return nil
+	case user.EdgeUploadSessions:
+		m.ResetUploadSessions()
+		return nil
+	case user.EdgeResumableUploads:
+		m.ResetResumableUploads()
+		return nil
+	case user.EdgeDataKeys:
+		m.ResetDataKeys()
+		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgeNotificationPreference:
+		m.ResetNotificationPreference()
+		return nil
 	case user.EdgeSubscription:
 		m.ResetSubscription()
 		return nil
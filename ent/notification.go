@@ -0,0 +1,203 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// Notification is the model entity for the Notification schema.
+type Notification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type notification.Type `json:"type,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// SubmissionIds holds the value of the "submission_ids" field.
+	SubmissionIds []string `json:"submission_ids,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationQuery when eager-loading is set.
+	Edges              NotificationEdges `json:"edges"`
+	user_notifications *uuid.UUID
+}
+
+// NotificationEdges holds the relations/edges for other nodes in the graph.
+type NotificationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notification) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldSubmissionIds:
+			values[i] = new([]byte)
+		case notification.FieldType, notification.FieldTitle, notification.FieldMessage:
+			values[i] = new(sql.NullString)
+		case notification.FieldReadAt, notification.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notification.FieldID:
+			values[i] = new(uuid.UUID)
+		case notification.ForeignKeys[0]: // user_notifications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Notification", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notification fields.
+func (n *Notification) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				n.ID = *value
+			}
+		case notification.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				n.Type = notification.Type(value.String)
+			}
+		case notification.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				n.Title = value.String
+			}
+		case notification.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				n.Message = value.String
+			}
+		case notification.FieldSubmissionIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.SubmissionIds); err != nil {
+					return fmt.Errorf("unmarshal field submission_ids: %w", err)
+				}
+			}
+		case notification.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				n.ReadAt = new(time.Time)
+				*n.ReadAt = value.Time
+			}
+		case notification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case notification.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notifications", values[i])
+			} else if value.Valid {
+				n.user_notifications = new(uuid.UUID)
+				*n.user_notifications = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Notification entity.
+func (n *Notification) QueryUser() *UserQuery {
+	return (&NotificationClient{config: n.config}).QueryUser(n)
+}
+
+// Update returns a builder for updating this Notification.
+// Note that you need to call Notification.Unwrap() before calling this method if this Notification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Notification) Update() *NotificationUpdateOne {
+	return (&NotificationClient{config: n.config}).UpdateOne(n)
+}
+
+// Unwrap unwraps the Notification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Notification) Unwrap() *Notification {
+	tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notification is not a transactional entity")
+	}
+	n.config.driver = tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Notification) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notification(")
+	builder.WriteString(fmt.Sprintf("id=%v", n.ID))
+	builder.WriteString(", type=")
+	builder.WriteString(fmt.Sprintf("%v", n.Type))
+	builder.WriteString(", title=")
+	builder.WriteString(n.Title)
+	builder.WriteString(", message=")
+	builder.WriteString(n.Message)
+	builder.WriteString(", submission_ids=")
+	builder.WriteString(fmt.Sprintf("%v", n.SubmissionIds))
+	if v := n.ReadAt; v != nil {
+		builder.WriteString(", read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notifications is a parsable slice of Notification.
+type Notifications []*Notification
+
+func (n Notifications) config(cfg config) {
+	for _i := range n {
+		n[_i].config = cfg
+	}
+}
@@ -0,0 +1,931 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (nc *NotificationCreate) SetType(n notification.Type) *NotificationCreate {
+	nc.mutation.SetType(n)
+	return nc
+}
+
+// SetTitle sets the "title" field.
+func (nc *NotificationCreate) SetTitle(s string) *NotificationCreate {
+	nc.mutation.SetTitle(s)
+	return nc
+}
+
+// SetMessage sets the "message" field.
+func (nc *NotificationCreate) SetMessage(s string) *NotificationCreate {
+	nc.mutation.SetMessage(s)
+	return nc
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (nc *NotificationCreate) SetSubmissionIds(s []string) *NotificationCreate {
+	nc.mutation.SetSubmissionIds(s)
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCreatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetID sets the "id" field.
+func (nc *NotificationCreate) SetID(u uuid.UUID) *NotificationCreate {
+	nc.mutation.SetID(u)
+	return nc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableID(u *uuid.UUID) *NotificationCreate {
+	if u != nil {
+		nc.SetID(*u)
+	}
+	return nc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nc *NotificationCreate) SetUserID(id uuid.UUID) *NotificationCreate {
+	nc.mutation.SetUserID(id)
+	return nc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nc *NotificationCreate) SetUser(u *User) *NotificationCreate {
+	return nc.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	var (
+		err  error
+		node *Notification
+	)
+	nc.defaults()
+	if len(nc.hooks) == 0 {
+		if err = nc.check(); err != nil {
+			return nil, err
+		}
+		node, err = nc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nc.check(); err != nil {
+				return nil, err
+			}
+			nc.mutation = mutation
+			if node, err = nc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nc.hooks) - 1; i >= 0; i-- {
+			if nc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() {
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := notification.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nc.mutation.ID(); !ok {
+		v := notification.DefaultID()
+		nc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Notification.type"`)}
+	}
+	if v, ok := nc.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Notification.title"`)}
+	}
+	if _, ok := nc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "Notification.message"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notification.created_at"`)}
+	}
+	if _, ok := nc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Notification.user"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: notification.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notification.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = nc.conflict
+	if id, ok := nc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: notification.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := nc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := nc.mutation.Message(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldMessage,
+		})
+		_node.Message = value
+	}
+	if value, ok := nc.mutation.SubmissionIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldSubmissionIds,
+		})
+		_node.SubmissionIds = value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+		_node.ReadAt = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := nc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notifications = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (nc *NotificationCreate) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertOne {
+	nc.conflict = opts
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (nc *NotificationCreate) OnConflictColumns(columns ...string) *NotificationUpsertOne {
+	nc.conflict = append(nc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+type (
+	// NotificationUpsertOne is the builder for "upsert"-ing
+	//  one Notification node.
+	NotificationUpsertOne struct {
+		create *NotificationCreate
+	}
+
+	// NotificationUpsert is the "OnConflict" setter.
+	NotificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *NotificationUpsert) SetType(v notification.Type) *NotificationUpsert {
+	u.Set(notification.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateType() *NotificationUpsert {
+	u.SetExcluded(notification.FieldType)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsert) SetTitle(v string) *NotificationUpsert {
+	u.Set(notification.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateTitle() *NotificationUpsert {
+	u.SetExcluded(notification.FieldTitle)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *NotificationUpsert) SetMessage(v string) *NotificationUpsert {
+	u.Set(notification.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateMessage() *NotificationUpsert {
+	u.SetExcluded(notification.FieldMessage)
+	return u
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (u *NotificationUpsert) SetSubmissionIds(v []string) *NotificationUpsert {
+	u.Set(notification.FieldSubmissionIds, v)
+	return u
+}
+
+// UpdateSubmissionIds sets the "submission_ids" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateSubmissionIds() *NotificationUpsert {
+	u.SetExcluded(notification.FieldSubmissionIds)
+	return u
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (u *NotificationUpsert) ClearSubmissionIds() *NotificationUpsert {
+	u.SetNull(notification.FieldSubmissionIds)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsert) SetReadAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateReadAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsert) ClearReadAt() *NotificationUpsert {
+	u.SetNull(notification.FieldReadAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *NotificationUpsert) SetCreatedAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateCreatedAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *NotificationUpsertOne) UpdateNewValues() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notification.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Notification.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *NotificationUpsertOne) Ignore() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertOne) DoNothing() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreate.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertOne) Update(set func(*NotificationUpsert)) *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertOne) SetType(v notification.Type) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateType() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsertOne) SetTitle(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateTitle() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *NotificationUpsertOne) SetMessage(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateMessage() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (u *NotificationUpsertOne) SetSubmissionIds(v []string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetSubmissionIds(v)
+	})
+}
+
+// UpdateSubmissionIds sets the "submission_ids" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateSubmissionIds() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateSubmissionIds()
+	})
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (u *NotificationUpsertOne) ClearSubmissionIds() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearSubmissionIds()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertOne) SetReadAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertOne) ClearReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *NotificationUpsertOne) SetCreatedAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateCreatedAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationUpsertOne.ID is not supported by MySQL driver. Use NotificationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	builders []*NotificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (ncb *NotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertBulk {
+	ncb.conflict = opts
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ncb *NotificationCreateBulk) OnConflictColumns(columns ...string) *NotificationUpsertBulk {
+	ncb.conflict = append(ncb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// NotificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Notification nodes.
+type NotificationUpsertBulk struct {
+	create *NotificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *NotificationUpsertBulk) UpdateNewValues() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notification.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *NotificationUpsertBulk) Ignore() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertBulk) DoNothing() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertBulk) Update(set func(*NotificationUpsert)) *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertBulk) SetType(v notification.Type) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateType() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsertBulk) SetTitle(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateTitle() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *NotificationUpsertBulk) SetMessage(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateMessage() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (u *NotificationUpsertBulk) SetSubmissionIds(v []string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetSubmissionIds(v)
+	})
+}
+
+// UpdateSubmissionIds sets the "submission_ids" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateSubmissionIds() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateSubmissionIds()
+	})
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (u *NotificationUpsertBulk) ClearSubmissionIds() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearSubmissionIds()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertBulk) SetReadAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertBulk) ClearReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *NotificationUpsertBulk) SetCreatedAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateCreatedAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetType sets the "type" field.
+func (nu *NotificationUpdate) SetType(n notification.Type) *NotificationUpdate {
+	nu.mutation.SetType(n)
+	return nu
+}
+
+// SetTitle sets the "title" field.
+func (nu *NotificationUpdate) SetTitle(s string) *NotificationUpdate {
+	nu.mutation.SetTitle(s)
+	return nu
+}
+
+// SetMessage sets the "message" field.
+func (nu *NotificationUpdate) SetMessage(s string) *NotificationUpdate {
+	nu.mutation.SetMessage(s)
+	return nu
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (nu *NotificationUpdate) SetSubmissionIds(s []string) *NotificationUpdate {
+	nu.mutation.SetSubmissionIds(s)
+	return nu
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (nu *NotificationUpdate) ClearSubmissionIds() *NotificationUpdate {
+	nu.mutation.ClearSubmissionIds()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nu *NotificationUpdate) SetCreatedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetCreatedAt(t)
+	return nu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableCreatedAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetCreatedAt(*t)
+	}
+	return nu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nu *NotificationUpdate) SetUserID(id uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetUserID(id)
+	return nu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nu *NotificationUpdate) SetUser(u *User) *NotificationUpdate {
+	return nu.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nu *NotificationUpdate) ClearUser() *NotificationUpdate {
+	nu.mutation.ClearUser()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nu.hooks) == 0 {
+		if err = nu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = nu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nu.check(); err != nil {
+				return 0, err
+			}
+			nu.mutation = mutation
+			affected, err = nu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nu.hooks) - 1; i >= 0; i-- {
+			if nu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if _, ok := nu.mutation.UserID(); nu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notification.Table,
+			Columns: notification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notification.FieldID,
+			},
+		},
+	}
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: notification.FieldType,
+		})
+	}
+	if value, ok := nu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTitle,
+		})
+	}
+	if value, ok := nu.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldMessage,
+		})
+	}
+	if value, ok := nu.mutation.SubmissionIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldSubmissionIds,
+		})
+	}
+	if nu.mutation.SubmissionIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldSubmissionIds,
+		})
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if value, ok := nu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldCreatedAt,
+		})
+	}
+	if nu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(n notification.Type) *NotificationUpdateOne {
+	nuo.mutation.SetType(n)
+	return nuo
+}
+
+// SetTitle sets the "title" field.
+func (nuo *NotificationUpdateOne) SetTitle(s string) *NotificationUpdateOne {
+	nuo.mutation.SetTitle(s)
+	return nuo
+}
+
+// SetMessage sets the "message" field.
+func (nuo *NotificationUpdateOne) SetMessage(s string) *NotificationUpdateOne {
+	nuo.mutation.SetMessage(s)
+	return nuo
+}
+
+// SetSubmissionIds sets the "submission_ids" field.
+func (nuo *NotificationUpdateOne) SetSubmissionIds(s []string) *NotificationUpdateOne {
+	nuo.mutation.SetSubmissionIds(s)
+	return nuo
+}
+
+// ClearSubmissionIds clears the value of the "submission_ids" field.
+func (nuo *NotificationUpdateOne) ClearSubmissionIds() *NotificationUpdateOne {
+	nuo.mutation.ClearSubmissionIds()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nuo *NotificationUpdateOne) SetCreatedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetCreatedAt(t)
+	return nuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableCreatedAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetCreatedAt(*t)
+	}
+	return nuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nuo *NotificationUpdateOne) SetUserID(id uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetUserID(id)
+	return nuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetUser(u *User) *NotificationUpdateOne {
+	return nuo.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearUser() *NotificationUpdateOne {
+	nuo.mutation.ClearUser()
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	var (
+		err  error
+		node *Notification
+	)
+	if len(nuo.hooks) == 0 {
+		if err = nuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = nuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nuo.check(); err != nil {
+				return nil, err
+			}
+			nuo.mutation = mutation
+			node, err = nuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nuo.hooks) - 1; i >= 0; i-- {
+			if nuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if _, ok := nuo.mutation.UserID(); nuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notification.Table,
+			Columns: notification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notification.FieldID,
+			},
+		},
+	}
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: notification.FieldType,
+		})
+	}
+	if value, ok := nuo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldTitle,
+		})
+	}
+	if value, ok := nuo.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: notification.FieldMessage,
+		})
+	}
+	if value, ok := nuo.mutation.SubmissionIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: notification.FieldSubmissionIds,
+		})
+	}
+	if nuo.mutation.SubmissionIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: notification.FieldSubmissionIds,
+		})
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: notification.FieldReadAt,
+		})
+	}
+	if value, ok := nuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notification.FieldCreatedAt,
+		})
+	}
+	if nuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,165 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// NotificationPreference is the model entity for the NotificationPreference schema.
+type NotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// RetentionWarningEmail holds the value of the "retention_warning_email" field.
+	RetentionWarningEmail bool `json:"retention_warning_email,omitempty"`
+	// RetentionWarningInApp holds the value of the "retention_warning_in_app" field.
+	RetentionWarningInApp bool `json:"retention_warning_in_app,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationPreferenceQuery when eager-loading is set.
+	Edges                        NotificationPreferenceEdges `json:"edges"`
+	user_notification_preference *uuid.UUID
+}
+
+// NotificationPreferenceEdges holds the relations/edges for other nodes in the graph.
+type NotificationPreferenceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationPreferenceEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreference) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldRetentionWarningEmail, notificationpreference.FieldRetentionWarningInApp:
+			values[i] = new(sql.NullBool)
+		case notificationpreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationpreference.FieldID:
+			values[i] = new(uuid.UUID)
+		case notificationpreference.ForeignKeys[0]: // user_notification_preference
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type NotificationPreference", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreference fields.
+func (np *NotificationPreference) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				np.ID = *value
+			}
+		case notificationpreference.FieldRetentionWarningEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_warning_email", values[i])
+			} else if value.Valid {
+				np.RetentionWarningEmail = value.Bool
+			}
+		case notificationpreference.FieldRetentionWarningInApp:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_warning_in_app", values[i])
+			} else if value.Valid {
+				np.RetentionWarningInApp = value.Bool
+			}
+		case notificationpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				np.UpdatedAt = value.Time
+			}
+		case notificationpreference.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notification_preference", values[i])
+			} else if value.Valid {
+				np.user_notification_preference = new(uuid.UUID)
+				*np.user_notification_preference = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the NotificationPreference entity.
+func (np *NotificationPreference) QueryUser() *UserQuery {
+	return (&NotificationPreferenceClient{config: np.config}).QueryUser(np)
+}
+
+// Update returns a builder for updating this NotificationPreference.
+// Note that you need to call NotificationPreference.Unwrap() before calling this method if this NotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NotificationPreference) Update() *NotificationPreferenceUpdateOne {
+	return (&NotificationPreferenceClient{config: np.config}).UpdateOne(np)
+}
+
+// Unwrap unwraps the NotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NotificationPreference) Unwrap() *NotificationPreference {
+	tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreference is not a transactional entity")
+	}
+	np.config.driver = tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v", np.ID))
+	builder.WriteString(", retention_warning_email=")
+	builder.WriteString(fmt.Sprintf("%v", np.RetentionWarningEmail))
+	builder.WriteString(", retention_warning_in_app=")
+	builder.WriteString(fmt.Sprintf("%v", np.RetentionWarningInApp))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(np.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferences is a parsable slice of NotificationPreference.
+type NotificationPreferences []*NotificationPreference
+
+func (np NotificationPreferences) config(cfg config) {
+	for _i := range np {
+		np[_i].config = cfg
+	}
+}
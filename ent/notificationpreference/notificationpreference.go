@@ -0,0 +1,75 @@
+// Code generated by entc, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRetentionWarningEmail holds the string denoting the retention_warning_email field in the database.
+	FieldRetentionWarningEmail = "retention_warning_email"
+	// FieldRetentionWarningInApp holds the string denoting the retention_warning_in_app field in the database.
+	FieldRetentionWarningInApp = "retention_warning_in_app"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "notification_preferences"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "notification_preferences"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_notification_preference"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldRetentionWarningEmail,
+	FieldRetentionWarningInApp,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "notification_preferences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_notification_preference",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRetentionWarningEmail holds the default value on creation for the "retention_warning_email" field.
+	DefaultRetentionWarningEmail bool
+	// DefaultRetentionWarningInApp holds the default value on creation for the "retention_warning_in_app" field.
+	DefaultRetentionWarningInApp bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,280 @@
+// Code generated by entc, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// RetentionWarningEmail applies equality check predicate on the "retention_warning_email" field. It's identical to RetentionWarningEmailEQ.
+func RetentionWarningEmail(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarningEmail), v))
+	})
+}
+
+// RetentionWarningInApp applies equality check predicate on the "retention_warning_in_app" field. It's identical to RetentionWarningInAppEQ.
+func RetentionWarningInApp(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarningInApp), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// RetentionWarningEmailEQ applies the EQ predicate on the "retention_warning_email" field.
+func RetentionWarningEmailEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarningEmail), v))
+	})
+}
+
+// RetentionWarningEmailNEQ applies the NEQ predicate on the "retention_warning_email" field.
+func RetentionWarningEmailNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetentionWarningEmail), v))
+	})
+}
+
+// RetentionWarningInAppEQ applies the EQ predicate on the "retention_warning_in_app" field.
+func RetentionWarningInAppEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarningInApp), v))
+	})
+}
+
+// RetentionWarningInAppNEQ applies the NEQ predicate on the "retention_warning_in_app" field.
+func RetentionWarningInAppNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetentionWarningInApp), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationPreference {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationPreference {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
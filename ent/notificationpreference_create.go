@@ -0,0 +1,737 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (npc *NotificationPreferenceCreate) SetRetentionWarningEmail(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetRetentionWarningEmail(b)
+	return npc
+}
+
+// SetNillableRetentionWarningEmail sets the "retention_warning_email" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableRetentionWarningEmail(b *bool) *NotificationPreferenceCreate {
+	if b != nil {
+		npc.SetRetentionWarningEmail(*b)
+	}
+	return npc
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (npc *NotificationPreferenceCreate) SetRetentionWarningInApp(b bool) *NotificationPreferenceCreate {
+	npc.mutation.SetRetentionWarningInApp(b)
+	return npc
+}
+
+// SetNillableRetentionWarningInApp sets the "retention_warning_in_app" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableRetentionWarningInApp(b *bool) *NotificationPreferenceCreate {
+	if b != nil {
+		npc.SetRetentionWarningInApp(*b)
+	}
+	return npc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npc *NotificationPreferenceCreate) SetUpdatedAt(t time.Time) *NotificationPreferenceCreate {
+	npc.mutation.SetUpdatedAt(t)
+	return npc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableUpdatedAt(t *time.Time) *NotificationPreferenceCreate {
+	if t != nil {
+		npc.SetUpdatedAt(*t)
+	}
+	return npc
+}
+
+// SetID sets the "id" field.
+func (npc *NotificationPreferenceCreate) SetID(u uuid.UUID) *NotificationPreferenceCreate {
+	npc.mutation.SetID(u)
+	return npc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (npc *NotificationPreferenceCreate) SetNillableID(u *uuid.UUID) *NotificationPreferenceCreate {
+	if u != nil {
+		npc.SetID(*u)
+	}
+	return npc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npc *NotificationPreferenceCreate) SetUserID(id uuid.UUID) *NotificationPreferenceCreate {
+	npc.mutation.SetUserID(id)
+	return npc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npc *NotificationPreferenceCreate) SetUser(u *User) *NotificationPreferenceCreate {
+	return npc.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npc *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return npc.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (npc *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	var (
+		err  error
+		node *NotificationPreference
+	)
+	npc.defaults()
+	if len(npc.hooks) == 0 {
+		if err = npc.check(); err != nil {
+			return nil, err
+		}
+		node, err = npc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = npc.check(); err != nil {
+				return nil, err
+			}
+			npc.mutation = mutation
+			if node, err = npc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(npc.hooks) - 1; i >= 0; i-- {
+			if npc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npc *NotificationPreferenceCreate) defaults() {
+	if _, ok := npc.mutation.RetentionWarningEmail(); !ok {
+		v := notificationpreference.DefaultRetentionWarningEmail
+		npc.mutation.SetRetentionWarningEmail(v)
+	}
+	if _, ok := npc.mutation.RetentionWarningInApp(); !ok {
+		v := notificationpreference.DefaultRetentionWarningInApp
+		npc.mutation.SetRetentionWarningInApp(v)
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.DefaultUpdatedAt()
+		npc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := npc.mutation.ID(); !ok {
+		v := notificationpreference.DefaultID()
+		npc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NotificationPreferenceCreate) check() error {
+	if _, ok := npc.mutation.RetentionWarningEmail(); !ok {
+		return &ValidationError{Name: "retention_warning_email", err: errors.New(`ent: missing required field "NotificationPreference.retention_warning_email"`)}
+	}
+	if _, ok := npc.mutation.RetentionWarningInApp(); !ok {
+		return &ValidationError{Name: "retention_warning_in_app", err: errors.New(`ent: missing required field "NotificationPreference.retention_warning_in_app"`)}
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationPreference.updated_at"`)}
+	}
+	if _, ok := npc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationPreference.user"`)}
+	}
+	return nil
+}
+
+func (npc *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (npc *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: npc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: notificationpreference.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notificationpreference.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = npc.conflict
+	if id, ok := npc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := npc.mutation.RetentionWarningEmail(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningEmail,
+		})
+		_node.RetentionWarningEmail = value
+	}
+	if value, ok := npc.mutation.RetentionWarningInApp(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningInApp,
+		})
+		_node.RetentionWarningInApp = value
+	}
+	if value, ok := npc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notificationpreference.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := npc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notification_preference = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.Create().
+//		SetRetentionWarningEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetRetentionWarningEmail(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (npc *NotificationPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertOne {
+	npc.conflict = opts
+	return &NotificationPreferenceUpsertOne{
+		create: npc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (npc *NotificationPreferenceCreate) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertOne {
+	npc.conflict = append(npc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertOne{
+		create: npc,
+	}
+}
+
+type (
+	// NotificationPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one NotificationPreference node.
+	NotificationPreferenceUpsertOne struct {
+		create *NotificationPreferenceCreate
+	}
+
+	// NotificationPreferenceUpsert is the "OnConflict" setter.
+	NotificationPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (u *NotificationPreferenceUpsert) SetRetentionWarningEmail(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldRetentionWarningEmail, v)
+	return u
+}
+
+// UpdateRetentionWarningEmail sets the "retention_warning_email" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateRetentionWarningEmail() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldRetentionWarningEmail)
+	return u
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (u *NotificationPreferenceUpsert) SetRetentionWarningInApp(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldRetentionWarningInApp, v)
+	return u
+}
+
+// UpdateRetentionWarningInApp sets the "retention_warning_in_app" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateRetentionWarningInApp() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldRetentionWarningInApp)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsert) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUpdatedAt() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *NotificationPreferenceUpsertOne) UpdateNewValues() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationpreference.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.NotificationPreference.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *NotificationPreferenceUpsertOne) Ignore() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertOne) DoNothing() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertOne) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (u *NotificationPreferenceUpsertOne) SetRetentionWarningEmail(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetRetentionWarningEmail(v)
+	})
+}
+
+// UpdateRetentionWarningEmail sets the "retention_warning_email" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateRetentionWarningEmail() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateRetentionWarningEmail()
+	})
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (u *NotificationPreferenceUpsertOne) SetRetentionWarningInApp(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetRetentionWarningInApp(v)
+	})
+}
+
+// UpdateRetentionWarningInApp sets the "retention_warning_in_app" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateRetentionWarningInApp() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateRetentionWarningInApp()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsertOne) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUpdatedAt() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationPreferenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationPreferenceUpsertOne.ID is not supported by MySQL driver. Use NotificationPreferenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	builders []*NotificationPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (npcb *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NotificationPreference, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = npcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetRetentionWarningEmail(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (npcb *NotificationPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertBulk {
+	npcb.conflict = opts
+	return &NotificationPreferenceUpsertBulk{
+		create: npcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (npcb *NotificationPreferenceCreateBulk) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertBulk {
+	npcb.conflict = append(npcb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertBulk{
+		create: npcb,
+	}
+}
+
+// NotificationPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationPreference nodes.
+type NotificationPreferenceUpsertBulk struct {
+	create *NotificationPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *NotificationPreferenceUpsertBulk) UpdateNewValues() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationpreference.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *NotificationPreferenceUpsertBulk) Ignore() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertBulk) DoNothing() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertBulk) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (u *NotificationPreferenceUpsertBulk) SetRetentionWarningEmail(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetRetentionWarningEmail(v)
+	})
+}
+
+// UpdateRetentionWarningEmail sets the "retention_warning_email" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateRetentionWarningEmail() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateRetentionWarningEmail()
+	})
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (u *NotificationPreferenceUpsertBulk) SetRetentionWarningInApp(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetRetentionWarningInApp(v)
+	})
+}
+
+// UpdateRetentionWarningInApp sets the "retention_warning_in_app" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateRetentionWarningInApp() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateRetentionWarningInApp()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsertBulk) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUpdatedAt() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
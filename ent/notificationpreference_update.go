@@ -0,0 +1,502 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npu *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (npu *NotificationPreferenceUpdate) SetRetentionWarningEmail(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetRetentionWarningEmail(b)
+	return npu
+}
+
+// SetNillableRetentionWarningEmail sets the "retention_warning_email" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableRetentionWarningEmail(b *bool) *NotificationPreferenceUpdate {
+	if b != nil {
+		npu.SetRetentionWarningEmail(*b)
+	}
+	return npu
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (npu *NotificationPreferenceUpdate) SetRetentionWarningInApp(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetRetentionWarningInApp(b)
+	return npu
+}
+
+// SetNillableRetentionWarningInApp sets the "retention_warning_in_app" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableRetentionWarningInApp(b *bool) *NotificationPreferenceUpdate {
+	if b != nil {
+		npu.SetRetentionWarningInApp(*b)
+	}
+	return npu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npu *NotificationPreferenceUpdate) SetUpdatedAt(t time.Time) *NotificationPreferenceUpdate {
+	npu.mutation.SetUpdatedAt(t)
+	return npu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npu *NotificationPreferenceUpdate) SetUserID(id uuid.UUID) *NotificationPreferenceUpdate {
+	npu.mutation.SetUserID(id)
+	return npu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npu *NotificationPreferenceUpdate) SetUser(u *User) *NotificationPreferenceUpdate {
+	return npu.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npu *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return npu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npu *NotificationPreferenceUpdate) ClearUser() *NotificationPreferenceUpdate {
+	npu.mutation.ClearUser()
+	return npu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	npu.defaults()
+	if len(npu.hooks) == 0 {
+		if err = npu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = npu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = npu.check(); err != nil {
+				return 0, err
+			}
+			npu.mutation = mutation
+			affected, err = npu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(npu.hooks) - 1; i >= 0; i-- {
+			if npu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npu *NotificationPreferenceUpdate) defaults() {
+	if _, ok := npu.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		npu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npu *NotificationPreferenceUpdate) check() error {
+	if _, ok := npu.mutation.UserID(); npu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (npu *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notificationpreference.FieldID,
+			},
+		},
+	}
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.RetentionWarningEmail(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningEmail,
+		})
+	}
+	if value, ok := npu.mutation.RetentionWarningInApp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningInApp,
+		})
+	}
+	if value, ok := npu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notificationpreference.FieldUpdatedAt,
+		})
+	}
+	if npu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetRetentionWarningEmail sets the "retention_warning_email" field.
+func (npuo *NotificationPreferenceUpdateOne) SetRetentionWarningEmail(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetRetentionWarningEmail(b)
+	return npuo
+}
+
+// SetNillableRetentionWarningEmail sets the "retention_warning_email" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableRetentionWarningEmail(b *bool) *NotificationPreferenceUpdateOne {
+	if b != nil {
+		npuo.SetRetentionWarningEmail(*b)
+	}
+	return npuo
+}
+
+// SetRetentionWarningInApp sets the "retention_warning_in_app" field.
+func (npuo *NotificationPreferenceUpdateOne) SetRetentionWarningInApp(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetRetentionWarningInApp(b)
+	return npuo
+}
+
+// SetNillableRetentionWarningInApp sets the "retention_warning_in_app" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableRetentionWarningInApp(b *bool) *NotificationPreferenceUpdateOne {
+	if b != nil {
+		npuo.SetRetentionWarningInApp(*b)
+	}
+	return npuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npuo *NotificationPreferenceUpdateOne) SetUpdatedAt(t time.Time) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetUpdatedAt(t)
+	return npuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npuo *NotificationPreferenceUpdateOne) SetUserID(id uuid.UUID) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetUserID(id)
+	return npuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npuo *NotificationPreferenceUpdateOne) SetUser(u *User) *NotificationPreferenceUpdateOne {
+	return npuo.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npuo *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return npuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npuo *NotificationPreferenceUpdateOne) ClearUser() *NotificationPreferenceUpdateOne {
+	npuo.mutation.ClearUser()
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (npuo *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	var (
+		err  error
+		node *NotificationPreference
+	)
+	npuo.defaults()
+	if len(npuo.hooks) == 0 {
+		if err = npuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = npuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = npuo.check(); err != nil {
+				return nil, err
+			}
+			npuo.mutation = mutation
+			node, err = npuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(npuo.hooks) - 1; i >= 0; i-- {
+			if npuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = npuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, npuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npuo *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := npuo.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		npuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npuo *NotificationPreferenceUpdateOne) check() error {
+	if _, ok := npuo.mutation.UserID(); npuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (npuo *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   notificationpreference.Table,
+			Columns: notificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: notificationpreference.FieldID,
+			},
+		},
+	}
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.RetentionWarningEmail(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningEmail,
+		})
+	}
+	if value, ok := npuo.mutation.RetentionWarningInApp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: notificationpreference.FieldRetentionWarningInApp,
+		})
+	}
+	if value, ok := npuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: notificationpreference.FieldUpdatedAt,
+		})
+	}
+	if npuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationPreference{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
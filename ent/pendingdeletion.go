@@ -0,0 +1,186 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+)
+
+// PendingDeletion is the model entity for the PendingDeletion schema.
+type PendingDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Prefix holds the value of the "prefix" field.
+	Prefix string `json:"prefix,omitempty"`
+	// SubmissionID holds the value of the "submission_id" field.
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status pendingdeletion.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ScheduledAt holds the value of the "scheduled_at" field.
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	// VerifyAfter holds the value of the "verify_after" field.
+	VerifyAfter *time.Time `json:"verify_after,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PendingDeletion) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pendingdeletion.FieldSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case pendingdeletion.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case pendingdeletion.FieldPrefix, pendingdeletion.FieldStatus, pendingdeletion.FieldLastError:
+			values[i] = new(sql.NullString)
+		case pendingdeletion.FieldCreatedAt, pendingdeletion.FieldScheduledAt, pendingdeletion.FieldVerifyAfter:
+			values[i] = new(sql.NullTime)
+		case pendingdeletion.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PendingDeletion", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PendingDeletion fields.
+func (pd *PendingDeletion) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pendingdeletion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pd.ID = *value
+			}
+		case pendingdeletion.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				pd.Prefix = value.String
+			}
+		case pendingdeletion.FieldSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value.Valid {
+				pd.SubmissionID = new(uuid.UUID)
+				*pd.SubmissionID = *value.S.(*uuid.UUID)
+			}
+		case pendingdeletion.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pd.Status = pendingdeletion.Status(value.String)
+			}
+		case pendingdeletion.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				pd.Attempts = int(value.Int64)
+			}
+		case pendingdeletion.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				pd.LastError = value.String
+			}
+		case pendingdeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pd.CreatedAt = value.Time
+			}
+		case pendingdeletion.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				pd.ScheduledAt = new(time.Time)
+				*pd.ScheduledAt = value.Time
+			}
+		case pendingdeletion.FieldVerifyAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verify_after", values[i])
+			} else if value.Valid {
+				pd.VerifyAfter = new(time.Time)
+				*pd.VerifyAfter = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PendingDeletion.
+// Note that you need to call PendingDeletion.Unwrap() before calling this method if this PendingDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pd *PendingDeletion) Update() *PendingDeletionUpdateOne {
+	return (&PendingDeletionClient{config: pd.config}).UpdateOne(pd)
+}
+
+// Unwrap unwraps the PendingDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pd *PendingDeletion) Unwrap() *PendingDeletion {
+	tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PendingDeletion is not a transactional entity")
+	}
+	pd.config.driver = tx.drv
+	return pd
+}
+
+// String implements the fmt.Stringer.
+func (pd *PendingDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("PendingDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v", pd.ID))
+	builder.WriteString(", prefix=")
+	builder.WriteString(pd.Prefix)
+	if v := pd.SubmissionID; v != nil {
+		builder.WriteString(", submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Status))
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", pd.Attempts))
+	builder.WriteString(", last_error=")
+	builder.WriteString(pd.LastError)
+	builder.WriteString(", created_at=")
+	builder.WriteString(pd.CreatedAt.Format(time.ANSIC))
+	if v := pd.ScheduledAt; v != nil {
+		builder.WriteString(", scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := pd.VerifyAfter; v != nil {
+		builder.WriteString(", verify_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PendingDeletions is a parsable slice of PendingDeletion.
+type PendingDeletions []*PendingDeletion
+
+func (pd PendingDeletions) config(cfg config) {
+	for _i := range pd {
+		pd[_i].config = cfg
+	}
+}
@@ -0,0 +1,93 @@
+// Code generated by entc, DO NOT EDIT.
+
+package pendingdeletion
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the pendingdeletion type in the database.
+	Label = "pending_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
+	FieldScheduledAt = "scheduled_at"
+	// FieldVerifyAfter holds the string denoting the verify_after field in the database.
+	FieldVerifyAfter = "verify_after"
+	// Table holds the table name of the pendingdeletion in the database.
+	Table = "pending_deletions"
+)
+
+// Columns holds all SQL columns for pendingdeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldPrefix,
+	FieldSubmissionID,
+	FieldStatus,
+	FieldAttempts,
+	FieldLastError,
+	FieldCreatedAt,
+	FieldScheduledAt,
+	FieldVerifyAfter,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusScheduled Status = "scheduled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusScheduled:
+		return nil
+	default:
+		return fmt.Errorf("pendingdeletion: invalid enum value for status field: %q", s)
+	}
+}
@@ -0,0 +1,881 @@
+// Code generated by entc, DO NOT EDIT.
+
+package pendingdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// SubmissionID applies equality check predicate on the "submission_id" field. It's identical to SubmissionIDEQ.
+func SubmissionID(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubmissionID), v))
+	})
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScheduledAt), v))
+	})
+}
+
+// VerifyAfter applies equality check predicate on the "verify_after" field. It's identical to VerifyAfterEQ.
+func VerifyAfter(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPrefix), v))
+	})
+}
+
+// SubmissionIDEQ applies the EQ predicate on the "submission_id" field.
+func SubmissionIDEQ(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDNEQ applies the NEQ predicate on the "submission_id" field.
+func SubmissionIDNEQ(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDIn applies the In predicate on the "submission_id" field.
+func SubmissionIDIn(vs ...uuid.UUID) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubmissionID), v...))
+	})
+}
+
+// SubmissionIDNotIn applies the NotIn predicate on the "submission_id" field.
+func SubmissionIDNotIn(vs ...uuid.UUID) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubmissionID), v...))
+	})
+}
+
+// SubmissionIDGT applies the GT predicate on the "submission_id" field.
+func SubmissionIDGT(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDGTE applies the GTE predicate on the "submission_id" field.
+func SubmissionIDGTE(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDLT applies the LT predicate on the "submission_id" field.
+func SubmissionIDLT(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDLTE applies the LTE predicate on the "submission_id" field.
+func SubmissionIDLTE(v uuid.UUID) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubmissionID), v))
+	})
+}
+
+// SubmissionIDIsNil applies the IsNil predicate on the "submission_id" field.
+func SubmissionIDIsNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSubmissionID)))
+	})
+}
+
+// SubmissionIDNotNil applies the NotNil predicate on the "submission_id" field.
+func SubmissionIDNotNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSubmissionID)))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttempts), v...))
+	})
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempts), v))
+	})
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempts), v))
+	})
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLastError), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldScheduledAt), v...))
+	})
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldScheduledAt), v...))
+	})
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldScheduledAt), v))
+	})
+}
+
+// ScheduledAtIsNil applies the IsNil predicate on the "scheduled_at" field.
+func ScheduledAtIsNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScheduledAt)))
+	})
+}
+
+// ScheduledAtNotNil applies the NotNil predicate on the "scheduled_at" field.
+func ScheduledAtNotNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScheduledAt)))
+	})
+}
+
+// VerifyAfterEQ applies the EQ predicate on the "verify_after" field.
+func VerifyAfterEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterNEQ applies the NEQ predicate on the "verify_after" field.
+func VerifyAfterNEQ(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterIn applies the In predicate on the "verify_after" field.
+func VerifyAfterIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVerifyAfter), v...))
+	})
+}
+
+// VerifyAfterNotIn applies the NotIn predicate on the "verify_after" field.
+func VerifyAfterNotIn(vs ...time.Time) predicate.PendingDeletion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVerifyAfter), v...))
+	})
+}
+
+// VerifyAfterGT applies the GT predicate on the "verify_after" field.
+func VerifyAfterGT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterGTE applies the GTE predicate on the "verify_after" field.
+func VerifyAfterGTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterLT applies the LT predicate on the "verify_after" field.
+func VerifyAfterLT(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterLTE applies the LTE predicate on the "verify_after" field.
+func VerifyAfterLTE(v time.Time) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVerifyAfter), v))
+	})
+}
+
+// VerifyAfterIsNil applies the IsNil predicate on the "verify_after" field.
+func VerifyAfterIsNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldVerifyAfter)))
+	})
+}
+
+// VerifyAfterNotNil applies the NotNil predicate on the "verify_after" field.
+func VerifyAfterNotNil() predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldVerifyAfter)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PendingDeletion) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PendingDeletion) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PendingDeletion) predicate.PendingDeletion {
+	return predicate.PendingDeletion(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
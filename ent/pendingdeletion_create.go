@@ -0,0 +1,1112 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+)
+
+// PendingDeletionCreate is the builder for creating a PendingDeletion entity.
+type PendingDeletionCreate struct {
+	config
+	mutation *PendingDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPrefix sets the "prefix" field.
+func (pdc *PendingDeletionCreate) SetPrefix(s string) *PendingDeletionCreate {
+	pdc.mutation.SetPrefix(s)
+	return pdc
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (pdc *PendingDeletionCreate) SetSubmissionID(u uuid.UUID) *PendingDeletionCreate {
+	pdc.mutation.SetSubmissionID(u)
+	return pdc
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableSubmissionID(u *uuid.UUID) *PendingDeletionCreate {
+	if u != nil {
+		pdc.SetSubmissionID(*u)
+	}
+	return pdc
+}
+
+// SetStatus sets the "status" field.
+func (pdc *PendingDeletionCreate) SetStatus(pe pendingdeletion.Status) *PendingDeletionCreate {
+	pdc.mutation.SetStatus(pe)
+	return pdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableStatus(pe *pendingdeletion.Status) *PendingDeletionCreate {
+	if pe != nil {
+		pdc.SetStatus(*pe)
+	}
+	return pdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (pdc *PendingDeletionCreate) SetAttempts(i int) *PendingDeletionCreate {
+	pdc.mutation.SetAttempts(i)
+	return pdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableAttempts(i *int) *PendingDeletionCreate {
+	if i != nil {
+		pdc.SetAttempts(*i)
+	}
+	return pdc
+}
+
+// SetLastError sets the "last_error" field.
+func (pdc *PendingDeletionCreate) SetLastError(s string) *PendingDeletionCreate {
+	pdc.mutation.SetLastError(s)
+	return pdc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableLastError(s *string) *PendingDeletionCreate {
+	if s != nil {
+		pdc.SetLastError(*s)
+	}
+	return pdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pdc *PendingDeletionCreate) SetCreatedAt(t time.Time) *PendingDeletionCreate {
+	pdc.mutation.SetCreatedAt(t)
+	return pdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableCreatedAt(t *time.Time) *PendingDeletionCreate {
+	if t != nil {
+		pdc.SetCreatedAt(*t)
+	}
+	return pdc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pdc *PendingDeletionCreate) SetScheduledAt(t time.Time) *PendingDeletionCreate {
+	pdc.mutation.SetScheduledAt(t)
+	return pdc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableScheduledAt(t *time.Time) *PendingDeletionCreate {
+	if t != nil {
+		pdc.SetScheduledAt(*t)
+	}
+	return pdc
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (pdc *PendingDeletionCreate) SetVerifyAfter(t time.Time) *PendingDeletionCreate {
+	pdc.mutation.SetVerifyAfter(t)
+	return pdc
+}
+
+// SetNillableVerifyAfter sets the "verify_after" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableVerifyAfter(t *time.Time) *PendingDeletionCreate {
+	if t != nil {
+		pdc.SetVerifyAfter(*t)
+	}
+	return pdc
+}
+
+// SetID sets the "id" field.
+func (pdc *PendingDeletionCreate) SetID(u uuid.UUID) *PendingDeletionCreate {
+	pdc.mutation.SetID(u)
+	return pdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pdc *PendingDeletionCreate) SetNillableID(u *uuid.UUID) *PendingDeletionCreate {
+	if u != nil {
+		pdc.SetID(*u)
+	}
+	return pdc
+}
+
+// Mutation returns the PendingDeletionMutation object of the builder.
+func (pdc *PendingDeletionCreate) Mutation() *PendingDeletionMutation {
+	return pdc.mutation
+}
+
+// Save creates the PendingDeletion in the database.
+func (pdc *PendingDeletionCreate) Save(ctx context.Context) (*PendingDeletion, error) {
+	var (
+		err  error
+		node *PendingDeletion
+	)
+	pdc.defaults()
+	if len(pdc.hooks) == 0 {
+		if err = pdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PendingDeletionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pdc.check(); err != nil {
+				return nil, err
+			}
+			pdc.mutation = mutation
+			if node, err = pdc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pdc.hooks) - 1; i >= 0; i-- {
+			if pdc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pdc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pdc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pdc *PendingDeletionCreate) SaveX(ctx context.Context) *PendingDeletion {
+	v, err := pdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdc *PendingDeletionCreate) Exec(ctx context.Context) error {
+	_, err := pdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdc *PendingDeletionCreate) ExecX(ctx context.Context) {
+	if err := pdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pdc *PendingDeletionCreate) defaults() {
+	if _, ok := pdc.mutation.Status(); !ok {
+		v := pendingdeletion.DefaultStatus
+		pdc.mutation.SetStatus(v)
+	}
+	if _, ok := pdc.mutation.Attempts(); !ok {
+		v := pendingdeletion.DefaultAttempts
+		pdc.mutation.SetAttempts(v)
+	}
+	if _, ok := pdc.mutation.CreatedAt(); !ok {
+		v := pendingdeletion.DefaultCreatedAt()
+		pdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pdc.mutation.ID(); !ok {
+		v := pendingdeletion.DefaultID()
+		pdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdc *PendingDeletionCreate) check() error {
+	if _, ok := pdc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "PendingDeletion.prefix"`)}
+	}
+	if _, ok := pdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PendingDeletion.status"`)}
+	}
+	if v, ok := pdc.mutation.Status(); ok {
+		if err := pendingdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PendingDeletion.status": %w`, err)}
+		}
+	}
+	if _, ok := pdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "PendingDeletion.attempts"`)}
+	}
+	if _, ok := pdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PendingDeletion.created_at"`)}
+	}
+	return nil
+}
+
+func (pdc *PendingDeletionCreate) sqlSave(ctx context.Context) (*PendingDeletion, error) {
+	_node, _spec := pdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (pdc *PendingDeletionCreate) createSpec() (*PendingDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PendingDeletion{config: pdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: pendingdeletion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: pendingdeletion.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = pdc.conflict
+	if id, ok := pdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pdc.mutation.Prefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldPrefix,
+		})
+		_node.Prefix = value
+	}
+	if value, ok := pdc.mutation.SubmissionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: pendingdeletion.FieldSubmissionID,
+		})
+		_node.SubmissionID = &value
+	}
+	if value, ok := pdc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pendingdeletion.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := pdc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pendingdeletion.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := pdc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldLastError,
+		})
+		_node.LastError = value
+	}
+	if value, ok := pdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pdc.mutation.ScheduledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldScheduledAt,
+		})
+		_node.ScheduledAt = &value
+	}
+	if value, ok := pdc.mutation.VerifyAfter(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldVerifyAfter,
+		})
+		_node.VerifyAfter = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingDeletion.Create().
+//		SetPrefix(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingDeletionUpsert) {
+//			SetPrefix(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (pdc *PendingDeletionCreate) OnConflict(opts ...sql.ConflictOption) *PendingDeletionUpsertOne {
+	pdc.conflict = opts
+	return &PendingDeletionUpsertOne{
+		create: pdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (pdc *PendingDeletionCreate) OnConflictColumns(columns ...string) *PendingDeletionUpsertOne {
+	pdc.conflict = append(pdc.conflict, sql.ConflictColumns(columns...))
+	return &PendingDeletionUpsertOne{
+		create: pdc,
+	}
+}
+
+type (
+	// PendingDeletionUpsertOne is the builder for "upsert"-ing
+	//  one PendingDeletion node.
+	PendingDeletionUpsertOne struct {
+		create *PendingDeletionCreate
+	}
+
+	// PendingDeletionUpsert is the "OnConflict" setter.
+	PendingDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPrefix sets the "prefix" field.
+func (u *PendingDeletionUpsert) SetPrefix(v string) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldPrefix, v)
+	return u
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdatePrefix() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldPrefix)
+	return u
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *PendingDeletionUpsert) SetSubmissionID(v uuid.UUID) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldSubmissionID, v)
+	return u
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateSubmissionID() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldSubmissionID)
+	return u
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (u *PendingDeletionUpsert) ClearSubmissionID() *PendingDeletionUpsert {
+	u.SetNull(pendingdeletion.FieldSubmissionID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PendingDeletionUpsert) SetStatus(v pendingdeletion.Status) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateStatus() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PendingDeletionUpsert) SetAttempts(v int) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateAttempts() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PendingDeletionUpsert) AddAttempts(v int) *PendingDeletionUpsert {
+	u.Add(pendingdeletion.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PendingDeletionUpsert) SetLastError(v string) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateLastError() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PendingDeletionUpsert) ClearLastError() *PendingDeletionUpsert {
+	u.SetNull(pendingdeletion.FieldLastError)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingDeletionUpsert) SetCreatedAt(v time.Time) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateCreatedAt() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldCreatedAt)
+	return u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *PendingDeletionUpsert) SetScheduledAt(v time.Time) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldScheduledAt, v)
+	return u
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateScheduledAt() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldScheduledAt)
+	return u
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *PendingDeletionUpsert) ClearScheduledAt() *PendingDeletionUpsert {
+	u.SetNull(pendingdeletion.FieldScheduledAt)
+	return u
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (u *PendingDeletionUpsert) SetVerifyAfter(v time.Time) *PendingDeletionUpsert {
+	u.Set(pendingdeletion.FieldVerifyAfter, v)
+	return u
+}
+
+// UpdateVerifyAfter sets the "verify_after" field to the value that was provided on create.
+func (u *PendingDeletionUpsert) UpdateVerifyAfter() *PendingDeletionUpsert {
+	u.SetExcluded(pendingdeletion.FieldVerifyAfter)
+	return u
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (u *PendingDeletionUpsert) ClearVerifyAfter() *PendingDeletionUpsert {
+	u.SetNull(pendingdeletion.FieldVerifyAfter)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PendingDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pendingdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *PendingDeletionUpsertOne) UpdateNewValues() *PendingDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pendingdeletion.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.PendingDeletion.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *PendingDeletionUpsertOne) Ignore() *PendingDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingDeletionUpsertOne) DoNothing() *PendingDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingDeletionCreate.OnConflict
+// documentation for more info.
+func (u *PendingDeletionUpsertOne) Update(set func(*PendingDeletionUpsert)) *PendingDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *PendingDeletionUpsertOne) SetPrefix(v string) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdatePrefix() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *PendingDeletionUpsertOne) SetSubmissionID(v uuid.UUID) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetSubmissionID(v)
+	})
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateSubmissionID() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateSubmissionID()
+	})
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (u *PendingDeletionUpsertOne) ClearSubmissionID() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearSubmissionID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PendingDeletionUpsertOne) SetStatus(v pendingdeletion.Status) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateStatus() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PendingDeletionUpsertOne) SetAttempts(v int) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PendingDeletionUpsertOne) AddAttempts(v int) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateAttempts() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PendingDeletionUpsertOne) SetLastError(v string) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateLastError() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PendingDeletionUpsertOne) ClearLastError() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingDeletionUpsertOne) SetCreatedAt(v time.Time) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateCreatedAt() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *PendingDeletionUpsertOne) SetScheduledAt(v time.Time) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateScheduledAt() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *PendingDeletionUpsertOne) ClearScheduledAt() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearScheduledAt()
+	})
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (u *PendingDeletionUpsertOne) SetVerifyAfter(v time.Time) *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetVerifyAfter(v)
+	})
+}
+
+// UpdateVerifyAfter sets the "verify_after" field to the value that was provided on create.
+func (u *PendingDeletionUpsertOne) UpdateVerifyAfter() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateVerifyAfter()
+	})
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (u *PendingDeletionUpsertOne) ClearVerifyAfter() *PendingDeletionUpsertOne {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearVerifyAfter()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PendingDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PendingDeletionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PendingDeletionUpsertOne.ID is not supported by MySQL driver. Use PendingDeletionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PendingDeletionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PendingDeletionCreateBulk is the builder for creating many PendingDeletion entities in bulk.
+type PendingDeletionCreateBulk struct {
+	config
+	builders []*PendingDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PendingDeletion entities in the database.
+func (pdcb *PendingDeletionCreateBulk) Save(ctx context.Context) ([]*PendingDeletion, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pdcb.builders))
+	nodes := make([]*PendingDeletion, len(pdcb.builders))
+	mutators := make([]Mutator, len(pdcb.builders))
+	for i := range pdcb.builders {
+		func(i int, root context.Context) {
+			builder := pdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PendingDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdcb *PendingDeletionCreateBulk) SaveX(ctx context.Context) []*PendingDeletion {
+	v, err := pdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pdcb *PendingDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdcb *PendingDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := pdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingDeletionUpsert) {
+//			SetPrefix(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (pdcb *PendingDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PendingDeletionUpsertBulk {
+	pdcb.conflict = opts
+	return &PendingDeletionUpsertBulk{
+		create: pdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (pdcb *PendingDeletionCreateBulk) OnConflictColumns(columns ...string) *PendingDeletionUpsertBulk {
+	pdcb.conflict = append(pdcb.conflict, sql.ConflictColumns(columns...))
+	return &PendingDeletionUpsertBulk{
+		create: pdcb,
+	}
+}
+
+// PendingDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PendingDeletion nodes.
+type PendingDeletionUpsertBulk struct {
+	create *PendingDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PendingDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pendingdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *PendingDeletionUpsertBulk) UpdateNewValues() *PendingDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pendingdeletion.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PendingDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *PendingDeletionUpsertBulk) Ignore() *PendingDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingDeletionUpsertBulk) DoNothing() *PendingDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PendingDeletionUpsertBulk) Update(set func(*PendingDeletionUpsert)) *PendingDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *PendingDeletionUpsertBulk) SetPrefix(v string) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdatePrefix() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *PendingDeletionUpsertBulk) SetSubmissionID(v uuid.UUID) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetSubmissionID(v)
+	})
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateSubmissionID() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateSubmissionID()
+	})
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (u *PendingDeletionUpsertBulk) ClearSubmissionID() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearSubmissionID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PendingDeletionUpsertBulk) SetStatus(v pendingdeletion.Status) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateStatus() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *PendingDeletionUpsertBulk) SetAttempts(v int) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *PendingDeletionUpsertBulk) AddAttempts(v int) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateAttempts() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *PendingDeletionUpsertBulk) SetLastError(v string) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateLastError() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *PendingDeletionUpsertBulk) ClearLastError() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingDeletionUpsertBulk) SetCreatedAt(v time.Time) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateCreatedAt() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *PendingDeletionUpsertBulk) SetScheduledAt(v time.Time) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateScheduledAt() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *PendingDeletionUpsertBulk) ClearScheduledAt() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearScheduledAt()
+	})
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (u *PendingDeletionUpsertBulk) SetVerifyAfter(v time.Time) *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.SetVerifyAfter(v)
+	})
+}
+
+// UpdateVerifyAfter sets the "verify_after" field to the value that was provided on create.
+func (u *PendingDeletionUpsertBulk) UpdateVerifyAfter() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.UpdateVerifyAfter()
+	})
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (u *PendingDeletionUpsertBulk) ClearVerifyAfter() *PendingDeletionUpsertBulk {
+	return u.Update(func(s *PendingDeletionUpsert) {
+		s.ClearVerifyAfter()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingDeletionUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PendingDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PendingDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
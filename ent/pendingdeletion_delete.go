@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// PendingDeletionDelete is the builder for deleting a PendingDeletion entity.
+type PendingDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *PendingDeletionMutation
+}
+
+// Where appends a list predicates to the PendingDeletionDelete builder.
+func (pdd *PendingDeletionDelete) Where(ps ...predicate.PendingDeletion) *PendingDeletionDelete {
+	pdd.mutation.Where(ps...)
+	return pdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pdd *PendingDeletionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pdd.hooks) == 0 {
+		affected, err = pdd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PendingDeletionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pdd.mutation = mutation
+			affected, err = pdd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pdd.hooks) - 1; i >= 0; i-- {
+			if pdd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pdd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pdd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdd *PendingDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := pdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pdd *PendingDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: pendingdeletion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: pendingdeletion.FieldID,
+			},
+		},
+	}
+	if ps := pdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, pdd.driver, _spec)
+}
+
+// PendingDeletionDeleteOne is the builder for deleting a single PendingDeletion entity.
+type PendingDeletionDeleteOne struct {
+	pdd *PendingDeletionDelete
+}
+
+// Exec executes the deletion query.
+func (pddo *PendingDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := pddo.pdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pendingdeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pddo *PendingDeletionDeleteOne) ExecX(ctx context.Context) {
+	pddo.pdd.ExecX(ctx)
+}
@@ -0,0 +1,944 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// PendingDeletionQuery is the builder for querying PendingDeletion entities.
+type PendingDeletionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PendingDeletion
+	modifiers  []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PendingDeletionQuery builder.
+func (pdq *PendingDeletionQuery) Where(ps ...predicate.PendingDeletion) *PendingDeletionQuery {
+	pdq.predicates = append(pdq.predicates, ps...)
+	return pdq
+}
+
+// Limit adds a limit step to the query.
+func (pdq *PendingDeletionQuery) Limit(limit int) *PendingDeletionQuery {
+	pdq.limit = &limit
+	return pdq
+}
+
+// Offset adds an offset step to the query.
+func (pdq *PendingDeletionQuery) Offset(offset int) *PendingDeletionQuery {
+	pdq.offset = &offset
+	return pdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pdq *PendingDeletionQuery) Unique(unique bool) *PendingDeletionQuery {
+	pdq.unique = &unique
+	return pdq
+}
+
+// Order adds an order step to the query.
+func (pdq *PendingDeletionQuery) Order(o ...OrderFunc) *PendingDeletionQuery {
+	pdq.order = append(pdq.order, o...)
+	return pdq
+}
+
+// First returns the first PendingDeletion entity from the query.
+// Returns a *NotFoundError when no PendingDeletion was found.
+func (pdq *PendingDeletionQuery) First(ctx context.Context) (*PendingDeletion, error) {
+	nodes, err := pdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pendingdeletion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) FirstX(ctx context.Context) *PendingDeletion {
+	node, err := pdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PendingDeletion ID from the query.
+// Returns a *NotFoundError when no PendingDeletion ID was found.
+func (pdq *PendingDeletionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pendingdeletion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PendingDeletion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PendingDeletion entity is found.
+// Returns a *NotFoundError when no PendingDeletion entities are found.
+func (pdq *PendingDeletionQuery) Only(ctx context.Context) (*PendingDeletion, error) {
+	nodes, err := pdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pendingdeletion.Label}
+	default:
+		return nil, &NotSingularError{pendingdeletion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) OnlyX(ctx context.Context) *PendingDeletion {
+	node, err := pdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PendingDeletion ID in the query.
+// Returns a *NotSingularError when more than one PendingDeletion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pdq *PendingDeletionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = &NotSingularError{pendingdeletion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PendingDeletions.
+func (pdq *PendingDeletionQuery) All(ctx context.Context) ([]*PendingDeletion, error) {
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) AllX(ctx context.Context) []*PendingDeletion {
+	nodes, err := pdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PendingDeletion IDs.
+func (pdq *PendingDeletionQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := pdq.Select(pendingdeletion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pdq *PendingDeletionQuery) Count(ctx context.Context) (int, error) {
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) CountX(ctx context.Context) int {
+	count, err := pdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pdq *PendingDeletionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pdq *PendingDeletionQuery) ExistX(ctx context.Context) bool {
+	exist, err := pdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PendingDeletionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pdq *PendingDeletionQuery) Clone() *PendingDeletionQuery {
+	if pdq == nil {
+		return nil
+	}
+	return &PendingDeletionQuery{
+		config:     pdq.config,
+		limit:      pdq.limit,
+		offset:     pdq.offset,
+		order:      append([]OrderFunc{}, pdq.order...),
+		predicates: append([]predicate.PendingDeletion{}, pdq.predicates...),
+		// clone intermediate query.
+		sql:    pdq.sql.Clone(),
+		path:   pdq.path,
+		unique: pdq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Prefix string `json:"prefix,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PendingDeletion.Query().
+//		GroupBy(pendingdeletion.FieldPrefix).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (pdq *PendingDeletionQuery) GroupBy(field string, fields ...string) *PendingDeletionGroupBy {
+	group := &PendingDeletionGroupBy{config: pdq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pdq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Prefix string `json:"prefix,omitempty"`
+//	}
+//
+//	client.PendingDeletion.Query().
+//		Select(pendingdeletion.FieldPrefix).
+//		Scan(ctx, &v)
+//
+func (pdq *PendingDeletionQuery) Select(fields ...string) *PendingDeletionSelect {
+	pdq.fields = append(pdq.fields, fields...)
+	return &PendingDeletionSelect{PendingDeletionQuery: pdq}
+}
+
+func (pdq *PendingDeletionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pdq.fields {
+		if !pendingdeletion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pdq.path != nil {
+		prev, err := pdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pdq.sql = prev
+	}
+	return nil
+}
+
+func (pdq *PendingDeletionQuery) sqlAll(ctx context.Context) ([]*PendingDeletion, error) {
+	var (
+		nodes = []*PendingDeletion{}
+		_spec = pdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PendingDeletion{config: pdq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if len(pdq.modifiers) > 0 {
+		_spec.Modifiers = pdq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, pdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pdq *PendingDeletionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pdq.querySpec()
+	if len(pdq.modifiers) > 0 {
+		_spec.Modifiers = pdq.modifiers
+	}
+	_spec.Node.Columns = pdq.fields
+	if len(pdq.fields) > 0 {
+		_spec.Unique = pdq.unique != nil && *pdq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pdq.driver, _spec)
+}
+
+func (pdq *PendingDeletionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pdq *PendingDeletionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pendingdeletion.Table,
+			Columns: pendingdeletion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: pendingdeletion.FieldID,
+			},
+		},
+		From:   pdq.sql,
+		Unique: true,
+	}
+	if unique := pdq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pendingdeletion.FieldID)
+		for i := range fields {
+			if fields[i] != pendingdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pdq *PendingDeletionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pdq.driver.Dialect())
+	t1 := builder.Table(pendingdeletion.Table)
+	columns := pdq.fields
+	if len(columns) == 0 {
+		columns = pendingdeletion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pdq.sql != nil {
+		selector = pdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pdq.unique != nil && *pdq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pdq.modifiers {
+		m(selector)
+	}
+	for _, p := range pdq.predicates {
+		p(selector)
+	}
+	for _, p := range pdq.order {
+		p(selector)
+	}
+	if offset := pdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pdq *PendingDeletionQuery) Modify(modifiers ...func(s *sql.Selector)) *PendingDeletionSelect {
+	pdq.modifiers = append(pdq.modifiers, modifiers...)
+	return pdq.Select()
+}
+
+// PendingDeletionGroupBy is the group-by builder for PendingDeletion entities.
+type PendingDeletionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pdgb *PendingDeletionGroupBy) Aggregate(fns ...AggregateFunc) *PendingDeletionGroupBy {
+	pdgb.fns = append(pdgb.fns, fns...)
+	return pdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pdgb *PendingDeletionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pdgb.sql = query
+	return pdgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := pdgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(pdgb.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := pdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := pdgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = pdgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) StringX(ctx context.Context) string {
+	v, err := pdgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(pdgb.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := pdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := pdgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = pdgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) IntX(ctx context.Context) int {
+	v, err := pdgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(pdgb.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := pdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := pdgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = pdgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := pdgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(pdgb.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := pdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := pdgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (pdgb *PendingDeletionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = pdgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (pdgb *PendingDeletionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := pdgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pdgb *PendingDeletionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pdgb.fields {
+		if !pendingdeletion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pdgb *PendingDeletionGroupBy) sqlQuery() *sql.Selector {
+	selector := pdgb.sql.Select()
+	aggregation := make([]string, 0, len(pdgb.fns))
+	for _, fn := range pdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pdgb.fields)+len(pdgb.fns))
+		for _, f := range pdgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pdgb.fields...)...)
+}
+
+// PendingDeletionSelect is the builder for selecting fields of PendingDeletion entities.
+type PendingDeletionSelect struct {
+	*PendingDeletionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pds *PendingDeletionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pds.sql = pds.PendingDeletionQuery.sqlQuery(ctx)
+	return pds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (pds *PendingDeletionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := pds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(pds.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := pds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (pds *PendingDeletionSelect) StringsX(ctx context.Context) []string {
+	v, err := pds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = pds.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (pds *PendingDeletionSelect) StringX(ctx context.Context) string {
+	v, err := pds.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(pds.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := pds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (pds *PendingDeletionSelect) IntsX(ctx context.Context) []int {
+	v, err := pds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = pds.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (pds *PendingDeletionSelect) IntX(ctx context.Context) int {
+	v, err := pds.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(pds.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := pds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (pds *PendingDeletionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := pds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = pds.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (pds *PendingDeletionSelect) Float64X(ctx context.Context) float64 {
+	v, err := pds.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(pds.fields) > 1 {
+		return nil, errors.New("ent: PendingDeletionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := pds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (pds *PendingDeletionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := pds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (pds *PendingDeletionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = pds.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{pendingdeletion.Label}
+	default:
+		err = fmt.Errorf("ent: PendingDeletionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (pds *PendingDeletionSelect) BoolX(ctx context.Context) bool {
+	v, err := pds.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (pds *PendingDeletionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pds.sql.Query()
+	if err := pds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pds *PendingDeletionSelect) Modify(modifiers ...func(s *sql.Selector)) *PendingDeletionSelect {
+	pds.modifiers = append(pds.modifiers, modifiers...)
+	return pds
+}
@@ -0,0 +1,717 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// PendingDeletionUpdate is the builder for updating PendingDeletion entities.
+type PendingDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PendingDeletionMutation
+}
+
+// Where appends a list predicates to the PendingDeletionUpdate builder.
+func (pdu *PendingDeletionUpdate) Where(ps ...predicate.PendingDeletion) *PendingDeletionUpdate {
+	pdu.mutation.Where(ps...)
+	return pdu
+}
+
+// SetPrefix sets the "prefix" field.
+func (pdu *PendingDeletionUpdate) SetPrefix(s string) *PendingDeletionUpdate {
+	pdu.mutation.SetPrefix(s)
+	return pdu
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (pdu *PendingDeletionUpdate) SetSubmissionID(u uuid.UUID) *PendingDeletionUpdate {
+	pdu.mutation.SetSubmissionID(u)
+	return pdu
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableSubmissionID(u *uuid.UUID) *PendingDeletionUpdate {
+	if u != nil {
+		pdu.SetSubmissionID(*u)
+	}
+	return pdu
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (pdu *PendingDeletionUpdate) ClearSubmissionID() *PendingDeletionUpdate {
+	pdu.mutation.ClearSubmissionID()
+	return pdu
+}
+
+// SetStatus sets the "status" field.
+func (pdu *PendingDeletionUpdate) SetStatus(pe pendingdeletion.Status) *PendingDeletionUpdate {
+	pdu.mutation.SetStatus(pe)
+	return pdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableStatus(pe *pendingdeletion.Status) *PendingDeletionUpdate {
+	if pe != nil {
+		pdu.SetStatus(*pe)
+	}
+	return pdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (pdu *PendingDeletionUpdate) SetAttempts(i int) *PendingDeletionUpdate {
+	pdu.mutation.ResetAttempts()
+	pdu.mutation.SetAttempts(i)
+	return pdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableAttempts(i *int) *PendingDeletionUpdate {
+	if i != nil {
+		pdu.SetAttempts(*i)
+	}
+	return pdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pdu *PendingDeletionUpdate) AddAttempts(i int) *PendingDeletionUpdate {
+	pdu.mutation.AddAttempts(i)
+	return pdu
+}
+
+// SetLastError sets the "last_error" field.
+func (pdu *PendingDeletionUpdate) SetLastError(s string) *PendingDeletionUpdate {
+	pdu.mutation.SetLastError(s)
+	return pdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableLastError(s *string) *PendingDeletionUpdate {
+	if s != nil {
+		pdu.SetLastError(*s)
+	}
+	return pdu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (pdu *PendingDeletionUpdate) ClearLastError() *PendingDeletionUpdate {
+	pdu.mutation.ClearLastError()
+	return pdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pdu *PendingDeletionUpdate) SetCreatedAt(t time.Time) *PendingDeletionUpdate {
+	pdu.mutation.SetCreatedAt(t)
+	return pdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableCreatedAt(t *time.Time) *PendingDeletionUpdate {
+	if t != nil {
+		pdu.SetCreatedAt(*t)
+	}
+	return pdu
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pdu *PendingDeletionUpdate) SetScheduledAt(t time.Time) *PendingDeletionUpdate {
+	pdu.mutation.SetScheduledAt(t)
+	return pdu
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableScheduledAt(t *time.Time) *PendingDeletionUpdate {
+	if t != nil {
+		pdu.SetScheduledAt(*t)
+	}
+	return pdu
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (pdu *PendingDeletionUpdate) ClearScheduledAt() *PendingDeletionUpdate {
+	pdu.mutation.ClearScheduledAt()
+	return pdu
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (pdu *PendingDeletionUpdate) SetVerifyAfter(t time.Time) *PendingDeletionUpdate {
+	pdu.mutation.SetVerifyAfter(t)
+	return pdu
+}
+
+// SetNillableVerifyAfter sets the "verify_after" field if the given value is not nil.
+func (pdu *PendingDeletionUpdate) SetNillableVerifyAfter(t *time.Time) *PendingDeletionUpdate {
+	if t != nil {
+		pdu.SetVerifyAfter(*t)
+	}
+	return pdu
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (pdu *PendingDeletionUpdate) ClearVerifyAfter() *PendingDeletionUpdate {
+	pdu.mutation.ClearVerifyAfter()
+	return pdu
+}
+
+// Mutation returns the PendingDeletionMutation object of the builder.
+func (pdu *PendingDeletionUpdate) Mutation() *PendingDeletionMutation {
+	return pdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pdu *PendingDeletionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pdu.hooks) == 0 {
+		if err = pdu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PendingDeletionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pdu.check(); err != nil {
+				return 0, err
+			}
+			pdu.mutation = mutation
+			affected, err = pdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pdu.hooks) - 1; i >= 0; i-- {
+			if pdu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pdu *PendingDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pdu *PendingDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := pdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdu *PendingDeletionUpdate) ExecX(ctx context.Context) {
+	if err := pdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pdu *PendingDeletionUpdate) check() error {
+	if v, ok := pdu.mutation.Status(); ok {
+		if err := pendingdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PendingDeletion.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pdu *PendingDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pendingdeletion.Table,
+			Columns: pendingdeletion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: pendingdeletion.FieldID,
+			},
+		},
+	}
+	if ps := pdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pdu.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldPrefix,
+		})
+	}
+	if value, ok := pdu.mutation.SubmissionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: pendingdeletion.FieldSubmissionID,
+		})
+	}
+	if pdu.mutation.SubmissionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: pendingdeletion.FieldSubmissionID,
+		})
+	}
+	if value, ok := pdu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pendingdeletion.FieldStatus,
+		})
+	}
+	if value, ok := pdu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pendingdeletion.FieldAttempts,
+		})
+	}
+	if value, ok := pdu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pendingdeletion.FieldAttempts,
+		})
+	}
+	if value, ok := pdu.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldLastError,
+		})
+	}
+	if pdu.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pendingdeletion.FieldLastError,
+		})
+	}
+	if value, ok := pdu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldCreatedAt,
+		})
+	}
+	if value, ok := pdu.mutation.ScheduledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldScheduledAt,
+		})
+	}
+	if pdu.mutation.ScheduledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pendingdeletion.FieldScheduledAt,
+		})
+	}
+	if value, ok := pdu.mutation.VerifyAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldVerifyAfter,
+		})
+	}
+	if pdu.mutation.VerifyAfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pendingdeletion.FieldVerifyAfter,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PendingDeletionUpdateOne is the builder for updating a single PendingDeletion entity.
+type PendingDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PendingDeletionMutation
+}
+
+// SetPrefix sets the "prefix" field.
+func (pduo *PendingDeletionUpdateOne) SetPrefix(s string) *PendingDeletionUpdateOne {
+	pduo.mutation.SetPrefix(s)
+	return pduo
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (pduo *PendingDeletionUpdateOne) SetSubmissionID(u uuid.UUID) *PendingDeletionUpdateOne {
+	pduo.mutation.SetSubmissionID(u)
+	return pduo
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableSubmissionID(u *uuid.UUID) *PendingDeletionUpdateOne {
+	if u != nil {
+		pduo.SetSubmissionID(*u)
+	}
+	return pduo
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (pduo *PendingDeletionUpdateOne) ClearSubmissionID() *PendingDeletionUpdateOne {
+	pduo.mutation.ClearSubmissionID()
+	return pduo
+}
+
+// SetStatus sets the "status" field.
+func (pduo *PendingDeletionUpdateOne) SetStatus(pe pendingdeletion.Status) *PendingDeletionUpdateOne {
+	pduo.mutation.SetStatus(pe)
+	return pduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableStatus(pe *pendingdeletion.Status) *PendingDeletionUpdateOne {
+	if pe != nil {
+		pduo.SetStatus(*pe)
+	}
+	return pduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (pduo *PendingDeletionUpdateOne) SetAttempts(i int) *PendingDeletionUpdateOne {
+	pduo.mutation.ResetAttempts()
+	pduo.mutation.SetAttempts(i)
+	return pduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableAttempts(i *int) *PendingDeletionUpdateOne {
+	if i != nil {
+		pduo.SetAttempts(*i)
+	}
+	return pduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (pduo *PendingDeletionUpdateOne) AddAttempts(i int) *PendingDeletionUpdateOne {
+	pduo.mutation.AddAttempts(i)
+	return pduo
+}
+
+// SetLastError sets the "last_error" field.
+func (pduo *PendingDeletionUpdateOne) SetLastError(s string) *PendingDeletionUpdateOne {
+	pduo.mutation.SetLastError(s)
+	return pduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableLastError(s *string) *PendingDeletionUpdateOne {
+	if s != nil {
+		pduo.SetLastError(*s)
+	}
+	return pduo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (pduo *PendingDeletionUpdateOne) ClearLastError() *PendingDeletionUpdateOne {
+	pduo.mutation.ClearLastError()
+	return pduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pduo *PendingDeletionUpdateOne) SetCreatedAt(t time.Time) *PendingDeletionUpdateOne {
+	pduo.mutation.SetCreatedAt(t)
+	return pduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableCreatedAt(t *time.Time) *PendingDeletionUpdateOne {
+	if t != nil {
+		pduo.SetCreatedAt(*t)
+	}
+	return pduo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (pduo *PendingDeletionUpdateOne) SetScheduledAt(t time.Time) *PendingDeletionUpdateOne {
+	pduo.mutation.SetScheduledAt(t)
+	return pduo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableScheduledAt(t *time.Time) *PendingDeletionUpdateOne {
+	if t != nil {
+		pduo.SetScheduledAt(*t)
+	}
+	return pduo
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (pduo *PendingDeletionUpdateOne) ClearScheduledAt() *PendingDeletionUpdateOne {
+	pduo.mutation.ClearScheduledAt()
+	return pduo
+}
+
+// SetVerifyAfter sets the "verify_after" field.
+func (pduo *PendingDeletionUpdateOne) SetVerifyAfter(t time.Time) *PendingDeletionUpdateOne {
+	pduo.mutation.SetVerifyAfter(t)
+	return pduo
+}
+
+// SetNillableVerifyAfter sets the "verify_after" field if the given value is not nil.
+func (pduo *PendingDeletionUpdateOne) SetNillableVerifyAfter(t *time.Time) *PendingDeletionUpdateOne {
+	if t != nil {
+		pduo.SetVerifyAfter(*t)
+	}
+	return pduo
+}
+
+// ClearVerifyAfter clears the value of the "verify_after" field.
+func (pduo *PendingDeletionUpdateOne) ClearVerifyAfter() *PendingDeletionUpdateOne {
+	pduo.mutation.ClearVerifyAfter()
+	return pduo
+}
+
+// Mutation returns the PendingDeletionMutation object of the builder.
+func (pduo *PendingDeletionUpdateOne) Mutation() *PendingDeletionMutation {
+	return pduo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pduo *PendingDeletionUpdateOne) Select(field string, fields ...string) *PendingDeletionUpdateOne {
+	pduo.fields = append([]string{field}, fields...)
+	return pduo
+}
+
+// Save executes the query and returns the updated PendingDeletion entity.
+func (pduo *PendingDeletionUpdateOne) Save(ctx context.Context) (*PendingDeletion, error) {
+	var (
+		err  error
+		node *PendingDeletion
+	)
+	if len(pduo.hooks) == 0 {
+		if err = pduo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PendingDeletionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pduo.check(); err != nil {
+				return nil, err
+			}
+			pduo.mutation = mutation
+			node, err = pduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pduo.hooks) - 1; i >= 0; i-- {
+			if pduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pduo *PendingDeletionUpdateOne) SaveX(ctx context.Context) *PendingDeletion {
+	node, err := pduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pduo *PendingDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pduo *PendingDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := pduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pduo *PendingDeletionUpdateOne) check() error {
+	if v, ok := pduo.mutation.Status(); ok {
+		if err := pendingdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PendingDeletion.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pduo *PendingDeletionUpdateOne) sqlSave(ctx context.Context) (_node *PendingDeletion, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pendingdeletion.Table,
+			Columns: pendingdeletion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: pendingdeletion.FieldID,
+			},
+		},
+	}
+	id, ok := pduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PendingDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pendingdeletion.FieldID)
+		for _, f := range fields {
+			if !pendingdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pendingdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pduo.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldPrefix,
+		})
+	}
+	if value, ok := pduo.mutation.SubmissionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: pendingdeletion.FieldSubmissionID,
+		})
+	}
+	if pduo.mutation.SubmissionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: pendingdeletion.FieldSubmissionID,
+		})
+	}
+	if value, ok := pduo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: pendingdeletion.FieldStatus,
+		})
+	}
+	if value, ok := pduo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pendingdeletion.FieldAttempts,
+		})
+	}
+	if value, ok := pduo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pendingdeletion.FieldAttempts,
+		})
+	}
+	if value, ok := pduo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pendingdeletion.FieldLastError,
+		})
+	}
+	if pduo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pendingdeletion.FieldLastError,
+		})
+	}
+	if value, ok := pduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldCreatedAt,
+		})
+	}
+	if value, ok := pduo.mutation.ScheduledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldScheduledAt,
+		})
+	}
+	if pduo.mutation.ScheduledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pendingdeletion.FieldScheduledAt,
+		})
+	}
+	if value, ok := pduo.mutation.VerifyAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pendingdeletion.FieldVerifyAfter,
+		})
+	}
+	if pduo.mutation.VerifyAfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: pendingdeletion.FieldVerifyAfter,
+		})
+	}
+	_node = &PendingDeletion{config: pduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -6,6 +6,39 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AdminAction is the predicate function for adminaction builders.
+type AdminAction func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
+// DataKey is the predicate function for datakey builders.
+type DataKey func(*sql.Selector)
+
+// Diagnostic is the predicate function for diagnostic builders.
+type Diagnostic func(*sql.Selector)
+
+// Lease is the predicate function for lease builders.
+type Lease func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// PendingDeletion is the predicate function for pendingdeletion builders.
+type PendingDeletion func(*sql.Selector)
+
+// ResumableUpload is the predicate function for resumableupload builders.
+type ResumableUpload func(*sql.Selector)
+
+// SourceFile is the predicate function for sourcefile builders.
+type SourceFile func(*sql.Selector)
+
 // Submission is the predicate function for submission builders.
 type Submission func(*sql.Selector)
 
@@ -15,5 +48,8 @@ type Subscription func(*sql.Selector)
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
+// UploadSession is the predicate function for uploadsession builders.
+type UploadSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,234 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// ResumableUpload is the model entity for the ResumableUpload schema.
+type ResumableUpload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SourceLanguage holds the value of the "source_language" field.
+	SourceLanguage string `json:"source_language,omitempty"`
+	// TargetLanguage holds the value of the "target_language" field.
+	TargetLanguage string `json:"target_language,omitempty"`
+	// Length holds the value of the "length" field.
+	Length int64 `json:"length,omitempty"`
+	// Offset holds the value of the "offset" field.
+	Offset int64 `json:"offset,omitempty"`
+	// Chunks holds the value of the "chunks" field.
+	Chunks []string `json:"chunks,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata string `json:"metadata,omitempty"`
+	// BypassCache holds the value of the "bypass_cache" field.
+	BypassCache bool `json:"bypass_cache,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumableUploadQuery when eager-loading is set.
+	Edges                  ResumableUploadEdges `json:"edges"`
+	user_resumable_uploads *uuid.UUID
+}
+
+// ResumableUploadEdges holds the relations/edges for other nodes in the graph.
+type ResumableUploadEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumableUploadEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumableUpload) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldChunks:
+			values[i] = new([]byte)
+		case resumableupload.FieldBypassCache:
+			values[i] = new(sql.NullBool)
+		case resumableupload.FieldLength, resumableupload.FieldOffset:
+			values[i] = new(sql.NullInt64)
+		case resumableupload.FieldSourceLanguage, resumableupload.FieldTargetLanguage, resumableupload.FieldMetadata:
+			values[i] = new(sql.NullString)
+		case resumableupload.FieldExpiresAt, resumableupload.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case resumableupload.FieldID:
+			values[i] = new(uuid.UUID)
+		case resumableupload.ForeignKeys[0]: // user_resumable_uploads
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ResumableUpload", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumableUpload fields.
+func (ru *ResumableUpload) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ru.ID = *value
+			}
+		case resumableupload.FieldSourceLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_language", values[i])
+			} else if value.Valid {
+				ru.SourceLanguage = value.String
+			}
+		case resumableupload.FieldTargetLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_language", values[i])
+			} else if value.Valid {
+				ru.TargetLanguage = value.String
+			}
+		case resumableupload.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				ru.Length = value.Int64
+			}
+		case resumableupload.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				ru.Offset = value.Int64
+			}
+		case resumableupload.FieldChunks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field chunks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ru.Chunks); err != nil {
+					return fmt.Errorf("unmarshal field chunks: %w", err)
+				}
+			}
+		case resumableupload.FieldMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value.Valid {
+				ru.Metadata = value.String
+			}
+		case resumableupload.FieldBypassCache:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bypass_cache", values[i])
+			} else if value.Valid {
+				ru.BypassCache = value.Bool
+			}
+		case resumableupload.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ru.ExpiresAt = value.Time
+			}
+		case resumableupload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ru.CreatedAt = value.Time
+			}
+		case resumableupload.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_resumable_uploads", values[i])
+			} else if value.Valid {
+				ru.user_resumable_uploads = new(uuid.UUID)
+				*ru.user_resumable_uploads = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the ResumableUpload entity.
+func (ru *ResumableUpload) QueryUser() *UserQuery {
+	return (&ResumableUploadClient{config: ru.config}).QueryUser(ru)
+}
+
+// Update returns a builder for updating this ResumableUpload.
+// Note that you need to call ResumableUpload.Unwrap() before calling this method if this ResumableUpload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ru *ResumableUpload) Update() *ResumableUploadUpdateOne {
+	return (&ResumableUploadClient{config: ru.config}).UpdateOne(ru)
+}
+
+// Unwrap unwraps the ResumableUpload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ru *ResumableUpload) Unwrap() *ResumableUpload {
+	tx, ok := ru.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResumableUpload is not a transactional entity")
+	}
+	ru.config.driver = tx.drv
+	return ru
+}
+
+// String implements the fmt.Stringer.
+func (ru *ResumableUpload) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumableUpload(")
+	builder.WriteString(fmt.Sprintf("id=%v", ru.ID))
+	builder.WriteString(", source_language=")
+	builder.WriteString(ru.SourceLanguage)
+	builder.WriteString(", target_language=")
+	builder.WriteString(ru.TargetLanguage)
+	builder.WriteString(", length=")
+	builder.WriteString(fmt.Sprintf("%v", ru.Length))
+	builder.WriteString(", offset=")
+	builder.WriteString(fmt.Sprintf("%v", ru.Offset))
+	builder.WriteString(", chunks=")
+	builder.WriteString(fmt.Sprintf("%v", ru.Chunks))
+	builder.WriteString(", metadata=")
+	builder.WriteString(ru.Metadata)
+	builder.WriteString(", bypass_cache=")
+	builder.WriteString(fmt.Sprintf("%v", ru.BypassCache))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(ru.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ru.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumableUploads is a parsable slice of ResumableUpload.
+type ResumableUploads []*ResumableUpload
+
+func (ru ResumableUploads) config(cfg config) {
+	for _i := range ru {
+		ru[_i].config = cfg
+	}
+}
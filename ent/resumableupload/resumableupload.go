@@ -0,0 +1,91 @@
+// Code generated by entc, DO NOT EDIT.
+
+package resumableupload
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the resumableupload type in the database.
+	Label = "resumable_upload"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceLanguage holds the string denoting the source_language field in the database.
+	FieldSourceLanguage = "source_language"
+	// FieldTargetLanguage holds the string denoting the target_language field in the database.
+	FieldTargetLanguage = "target_language"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldOffset holds the string denoting the offset field in the database.
+	FieldOffset = "offset"
+	// FieldChunks holds the string denoting the chunks field in the database.
+	FieldChunks = "chunks"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldBypassCache holds the string denoting the bypass_cache field in the database.
+	FieldBypassCache = "bypass_cache"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the resumableupload in the database.
+	Table = "resumable_uploads"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "resumable_uploads"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_resumable_uploads"
+)
+
+// Columns holds all SQL columns for resumableupload fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceLanguage,
+	FieldTargetLanguage,
+	FieldLength,
+	FieldOffset,
+	FieldChunks,
+	FieldMetadata,
+	FieldBypassCache,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "resumable_uploads"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_resumable_uploads",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOffset holds the default value on creation for the "offset" field.
+	DefaultOffset int64
+	// DefaultBypassCache holds the default value on creation for the "bypass_cache" field.
+	DefaultBypassCache bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,890 @@
+// Code generated by entc, DO NOT EDIT.
+
+package resumableupload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// SourceLanguage applies equality check predicate on the "source_language" field. It's identical to SourceLanguageEQ.
+func SourceLanguage(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// TargetLanguage applies equality check predicate on the "target_language" field. It's identical to TargetLanguageEQ.
+func TargetLanguage(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLength), v))
+	})
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOffset), v))
+	})
+}
+
+// Metadata applies equality check predicate on the "metadata" field. It's identical to MetadataEQ.
+func Metadata(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMetadata), v))
+	})
+}
+
+// BypassCache applies equality check predicate on the "bypass_cache" field. It's identical to BypassCacheEQ.
+func BypassCache(v bool) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBypassCache), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// SourceLanguageEQ applies the EQ predicate on the "source_language" field.
+func SourceLanguageEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageNEQ applies the NEQ predicate on the "source_language" field.
+func SourceLanguageNEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageIn applies the In predicate on the "source_language" field.
+func SourceLanguageIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSourceLanguage), v...))
+	})
+}
+
+// SourceLanguageNotIn applies the NotIn predicate on the "source_language" field.
+func SourceLanguageNotIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSourceLanguage), v...))
+	})
+}
+
+// SourceLanguageGT applies the GT predicate on the "source_language" field.
+func SourceLanguageGT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageGTE applies the GTE predicate on the "source_language" field.
+func SourceLanguageGTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageLT applies the LT predicate on the "source_language" field.
+func SourceLanguageLT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageLTE applies the LTE predicate on the "source_language" field.
+func SourceLanguageLTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageContains applies the Contains predicate on the "source_language" field.
+func SourceLanguageContains(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageHasPrefix applies the HasPrefix predicate on the "source_language" field.
+func SourceLanguageHasPrefix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageHasSuffix applies the HasSuffix predicate on the "source_language" field.
+func SourceLanguageHasSuffix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageEqualFold applies the EqualFold predicate on the "source_language" field.
+func SourceLanguageEqualFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// SourceLanguageContainsFold applies the ContainsFold predicate on the "source_language" field.
+func SourceLanguageContainsFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSourceLanguage), v))
+	})
+}
+
+// TargetLanguageEQ applies the EQ predicate on the "target_language" field.
+func TargetLanguageEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageNEQ applies the NEQ predicate on the "target_language" field.
+func TargetLanguageNEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageIn applies the In predicate on the "target_language" field.
+func TargetLanguageIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTargetLanguage), v...))
+	})
+}
+
+// TargetLanguageNotIn applies the NotIn predicate on the "target_language" field.
+func TargetLanguageNotIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTargetLanguage), v...))
+	})
+}
+
+// TargetLanguageGT applies the GT predicate on the "target_language" field.
+func TargetLanguageGT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageGTE applies the GTE predicate on the "target_language" field.
+func TargetLanguageGTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageLT applies the LT predicate on the "target_language" field.
+func TargetLanguageLT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageLTE applies the LTE predicate on the "target_language" field.
+func TargetLanguageLTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageContains applies the Contains predicate on the "target_language" field.
+func TargetLanguageContains(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageHasPrefix applies the HasPrefix predicate on the "target_language" field.
+func TargetLanguageHasPrefix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageHasSuffix applies the HasSuffix predicate on the "target_language" field.
+func TargetLanguageHasSuffix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageEqualFold applies the EqualFold predicate on the "target_language" field.
+func TargetLanguageEqualFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// TargetLanguageContainsFold applies the ContainsFold predicate on the "target_language" field.
+func TargetLanguageContainsFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTargetLanguage), v))
+	})
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLength), v))
+	})
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLength), v))
+	})
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int64) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLength), v...))
+	})
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int64) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLength), v...))
+	})
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLength), v))
+	})
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLength), v))
+	})
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLength), v))
+	})
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLength), v))
+	})
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOffset), v))
+	})
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOffset), v))
+	})
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...int64) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOffset), v...))
+	})
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...int64) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOffset), v...))
+	})
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOffset), v))
+	})
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOffset), v))
+	})
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOffset), v))
+	})
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOffset), v))
+	})
+}
+
+// ChunksIsNil applies the IsNil predicate on the "chunks" field.
+func ChunksIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldChunks)))
+	})
+}
+
+// ChunksNotNil applies the NotNil predicate on the "chunks" field.
+func ChunksNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldChunks)))
+	})
+}
+
+// MetadataEQ applies the EQ predicate on the "metadata" field.
+func MetadataEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataNEQ applies the NEQ predicate on the "metadata" field.
+func MetadataNEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataIn applies the In predicate on the "metadata" field.
+func MetadataIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMetadata), v...))
+	})
+}
+
+// MetadataNotIn applies the NotIn predicate on the "metadata" field.
+func MetadataNotIn(vs ...string) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMetadata), v...))
+	})
+}
+
+// MetadataGT applies the GT predicate on the "metadata" field.
+func MetadataGT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataGTE applies the GTE predicate on the "metadata" field.
+func MetadataGTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataLT applies the LT predicate on the "metadata" field.
+func MetadataLT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataLTE applies the LTE predicate on the "metadata" field.
+func MetadataLTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataContains applies the Contains predicate on the "metadata" field.
+func MetadataContains(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataHasPrefix applies the HasPrefix predicate on the "metadata" field.
+func MetadataHasPrefix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataHasSuffix applies the HasSuffix predicate on the "metadata" field.
+func MetadataHasSuffix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMetadata)))
+	})
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMetadata)))
+	})
+}
+
+// MetadataEqualFold applies the EqualFold predicate on the "metadata" field.
+func MetadataEqualFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMetadata), v))
+	})
+}
+
+// MetadataContainsFold applies the ContainsFold predicate on the "metadata" field.
+func MetadataContainsFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMetadata), v))
+	})
+}
+
+// BypassCacheEQ applies the EQ predicate on the "bypass_cache" field.
+func BypassCacheEQ(v bool) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBypassCache), v))
+	})
+}
+
+// BypassCacheNEQ applies the NEQ predicate on the "bypass_cache" field.
+func BypassCacheNEQ(v bool) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBypassCache), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResumableUpload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
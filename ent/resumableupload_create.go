@@ -0,0 +1,1161 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// ResumableUploadCreate is the builder for creating a ResumableUpload entity.
+type ResumableUploadCreate struct {
+	config
+	mutation *ResumableUploadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (ruc *ResumableUploadCreate) SetSourceLanguage(s string) *ResumableUploadCreate {
+	ruc.mutation.SetSourceLanguage(s)
+	return ruc
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (ruc *ResumableUploadCreate) SetTargetLanguage(s string) *ResumableUploadCreate {
+	ruc.mutation.SetTargetLanguage(s)
+	return ruc
+}
+
+// SetLength sets the "length" field.
+func (ruc *ResumableUploadCreate) SetLength(i int64) *ResumableUploadCreate {
+	ruc.mutation.SetLength(i)
+	return ruc
+}
+
+// SetOffset sets the "offset" field.
+func (ruc *ResumableUploadCreate) SetOffset(i int64) *ResumableUploadCreate {
+	ruc.mutation.SetOffset(i)
+	return ruc
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableOffset(i *int64) *ResumableUploadCreate {
+	if i != nil {
+		ruc.SetOffset(*i)
+	}
+	return ruc
+}
+
+// SetChunks sets the "chunks" field.
+func (ruc *ResumableUploadCreate) SetChunks(s []string) *ResumableUploadCreate {
+	ruc.mutation.SetChunks(s)
+	return ruc
+}
+
+// SetMetadata sets the "metadata" field.
+func (ruc *ResumableUploadCreate) SetMetadata(s string) *ResumableUploadCreate {
+	ruc.mutation.SetMetadata(s)
+	return ruc
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableMetadata(s *string) *ResumableUploadCreate {
+	if s != nil {
+		ruc.SetMetadata(*s)
+	}
+	return ruc
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (ruc *ResumableUploadCreate) SetBypassCache(b bool) *ResumableUploadCreate {
+	ruc.mutation.SetBypassCache(b)
+	return ruc
+}
+
+// SetNillableBypassCache sets the "bypass_cache" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableBypassCache(b *bool) *ResumableUploadCreate {
+	if b != nil {
+		ruc.SetBypassCache(*b)
+	}
+	return ruc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruc *ResumableUploadCreate) SetExpiresAt(t time.Time) *ResumableUploadCreate {
+	ruc.mutation.SetExpiresAt(t)
+	return ruc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruc *ResumableUploadCreate) SetCreatedAt(t time.Time) *ResumableUploadCreate {
+	ruc.mutation.SetCreatedAt(t)
+	return ruc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableCreatedAt(t *time.Time) *ResumableUploadCreate {
+	if t != nil {
+		ruc.SetCreatedAt(*t)
+	}
+	return ruc
+}
+
+// SetID sets the "id" field.
+func (ruc *ResumableUploadCreate) SetID(u uuid.UUID) *ResumableUploadCreate {
+	ruc.mutation.SetID(u)
+	return ruc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableID(u *uuid.UUID) *ResumableUploadCreate {
+	if u != nil {
+		ruc.SetID(*u)
+	}
+	return ruc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruc *ResumableUploadCreate) SetUserID(id uuid.UUID) *ResumableUploadCreate {
+	ruc.mutation.SetUserID(id)
+	return ruc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruc *ResumableUploadCreate) SetUser(u *User) *ResumableUploadCreate {
+	return ruc.SetUserID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruc *ResumableUploadCreate) Mutation() *ResumableUploadMutation {
+	return ruc.mutation
+}
+
+// Save creates the ResumableUpload in the database.
+func (ruc *ResumableUploadCreate) Save(ctx context.Context) (*ResumableUpload, error) {
+	var (
+		err  error
+		node *ResumableUpload
+	)
+	ruc.defaults()
+	if len(ruc.hooks) == 0 {
+		if err = ruc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ResumableUploadMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruc.check(); err != nil {
+				return nil, err
+			}
+			ruc.mutation = mutation
+			if node, err = ruc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruc.hooks) - 1; i >= 0; i-- {
+			if ruc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ruc *ResumableUploadCreate) SaveX(ctx context.Context) *ResumableUpload {
+	v, err := ruc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ruc *ResumableUploadCreate) Exec(ctx context.Context) error {
+	_, err := ruc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruc *ResumableUploadCreate) ExecX(ctx context.Context) {
+	if err := ruc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruc *ResumableUploadCreate) defaults() {
+	if _, ok := ruc.mutation.Offset(); !ok {
+		v := resumableupload.DefaultOffset
+		ruc.mutation.SetOffset(v)
+	}
+	if _, ok := ruc.mutation.BypassCache(); !ok {
+		v := resumableupload.DefaultBypassCache
+		ruc.mutation.SetBypassCache(v)
+	}
+	if _, ok := ruc.mutation.CreatedAt(); !ok {
+		v := resumableupload.DefaultCreatedAt()
+		ruc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ruc.mutation.ID(); !ok {
+		v := resumableupload.DefaultID()
+		ruc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruc *ResumableUploadCreate) check() error {
+	if _, ok := ruc.mutation.SourceLanguage(); !ok {
+		return &ValidationError{Name: "source_language", err: errors.New(`ent: missing required field "ResumableUpload.source_language"`)}
+	}
+	if _, ok := ruc.mutation.TargetLanguage(); !ok {
+		return &ValidationError{Name: "target_language", err: errors.New(`ent: missing required field "ResumableUpload.target_language"`)}
+	}
+	if _, ok := ruc.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "ResumableUpload.length"`)}
+	}
+	if _, ok := ruc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "ResumableUpload.offset"`)}
+	}
+	if _, ok := ruc.mutation.BypassCache(); !ok {
+		return &ValidationError{Name: "bypass_cache", err: errors.New(`ent: missing required field "ResumableUpload.bypass_cache"`)}
+	}
+	if _, ok := ruc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ResumableUpload.expires_at"`)}
+	}
+	if _, ok := ruc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumableUpload.created_at"`)}
+	}
+	if _, ok := ruc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ResumableUpload.user"`)}
+	}
+	return nil
+}
+
+func (ruc *ResumableUploadCreate) sqlSave(ctx context.Context) (*ResumableUpload, error) {
+	_node, _spec := ruc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ruc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (ruc *ResumableUploadCreate) createSpec() (*ResumableUpload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumableUpload{config: ruc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: resumableupload.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: resumableupload.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = ruc.conflict
+	if id, ok := ruc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ruc.mutation.SourceLanguage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldSourceLanguage,
+		})
+		_node.SourceLanguage = value
+	}
+	if value, ok := ruc.mutation.TargetLanguage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldTargetLanguage,
+		})
+		_node.TargetLanguage = value
+	}
+	if value, ok := ruc.mutation.Length(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldLength,
+		})
+		_node.Length = value
+	}
+	if value, ok := ruc.mutation.Offset(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldOffset,
+		})
+		_node.Offset = value
+	}
+	if value, ok := ruc.mutation.Chunks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: resumableupload.FieldChunks,
+		})
+		_node.Chunks = value
+	}
+	if value, ok := ruc.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := ruc.mutation.BypassCache(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: resumableupload.FieldBypassCache,
+		})
+		_node.BypassCache = value
+	}
+	if value, ok := ruc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := ruc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := ruc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.UserTable,
+			Columns: []string{resumableupload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_resumable_uploads = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumableUpload.Create().
+//		SetSourceLanguage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumableUploadUpsert) {
+//			SetSourceLanguage(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (ruc *ResumableUploadCreate) OnConflict(opts ...sql.ConflictOption) *ResumableUploadUpsertOne {
+	ruc.conflict = opts
+	return &ResumableUploadUpsertOne{
+		create: ruc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumableUpload.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ruc *ResumableUploadCreate) OnConflictColumns(columns ...string) *ResumableUploadUpsertOne {
+	ruc.conflict = append(ruc.conflict, sql.ConflictColumns(columns...))
+	return &ResumableUploadUpsertOne{
+		create: ruc,
+	}
+}
+
+type (
+	// ResumableUploadUpsertOne is the builder for "upsert"-ing
+	//  one ResumableUpload node.
+	ResumableUploadUpsertOne struct {
+		create *ResumableUploadCreate
+	}
+
+	// ResumableUploadUpsert is the "OnConflict" setter.
+	ResumableUploadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *ResumableUploadUpsert) SetSourceLanguage(v string) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldSourceLanguage, v)
+	return u
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateSourceLanguage() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldSourceLanguage)
+	return u
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *ResumableUploadUpsert) SetTargetLanguage(v string) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldTargetLanguage, v)
+	return u
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateTargetLanguage() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldTargetLanguage)
+	return u
+}
+
+// SetLength sets the "length" field.
+func (u *ResumableUploadUpsert) SetLength(v int64) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldLength, v)
+	return u
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateLength() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldLength)
+	return u
+}
+
+// AddLength adds v to the "length" field.
+func (u *ResumableUploadUpsert) AddLength(v int64) *ResumableUploadUpsert {
+	u.Add(resumableupload.FieldLength, v)
+	return u
+}
+
+// SetOffset sets the "offset" field.
+func (u *ResumableUploadUpsert) SetOffset(v int64) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldOffset, v)
+	return u
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateOffset() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldOffset)
+	return u
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *ResumableUploadUpsert) AddOffset(v int64) *ResumableUploadUpsert {
+	u.Add(resumableupload.FieldOffset, v)
+	return u
+}
+
+// SetChunks sets the "chunks" field.
+func (u *ResumableUploadUpsert) SetChunks(v []string) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldChunks, v)
+	return u
+}
+
+// UpdateChunks sets the "chunks" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateChunks() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldChunks)
+	return u
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (u *ResumableUploadUpsert) ClearChunks() *ResumableUploadUpsert {
+	u.SetNull(resumableupload.FieldChunks)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ResumableUploadUpsert) SetMetadata(v string) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateMetadata() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ResumableUploadUpsert) ClearMetadata() *ResumableUploadUpsert {
+	u.SetNull(resumableupload.FieldMetadata)
+	return u
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (u *ResumableUploadUpsert) SetBypassCache(v bool) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldBypassCache, v)
+	return u
+}
+
+// UpdateBypassCache sets the "bypass_cache" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateBypassCache() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldBypassCache)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResumableUploadUpsert) SetExpiresAt(v time.Time) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateExpiresAt() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumableUploadUpsert) SetCreatedAt(v time.Time) *ResumableUploadUpsert {
+	u.Set(resumableupload.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsert) UpdateCreatedAt() *ResumableUploadUpsert {
+	u.SetExcluded(resumableupload.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResumableUpload.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumableupload.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *ResumableUploadUpsertOne) UpdateNewValues() *ResumableUploadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resumableupload.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.ResumableUpload.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *ResumableUploadUpsertOne) Ignore() *ResumableUploadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumableUploadUpsertOne) DoNothing() *ResumableUploadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumableUploadCreate.OnConflict
+// documentation for more info.
+func (u *ResumableUploadUpsertOne) Update(set func(*ResumableUploadUpsert)) *ResumableUploadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumableUploadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *ResumableUploadUpsertOne) SetSourceLanguage(v string) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetSourceLanguage(v)
+	})
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateSourceLanguage() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateSourceLanguage()
+	})
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *ResumableUploadUpsertOne) SetTargetLanguage(v string) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetTargetLanguage(v)
+	})
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateTargetLanguage() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateTargetLanguage()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *ResumableUploadUpsertOne) SetLength(v int64) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *ResumableUploadUpsertOne) AddLength(v int64) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateLength() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *ResumableUploadUpsertOne) SetOffset(v int64) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *ResumableUploadUpsertOne) AddOffset(v int64) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateOffset() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// SetChunks sets the "chunks" field.
+func (u *ResumableUploadUpsertOne) SetChunks(v []string) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetChunks(v)
+	})
+}
+
+// UpdateChunks sets the "chunks" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateChunks() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateChunks()
+	})
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (u *ResumableUploadUpsertOne) ClearChunks() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.ClearChunks()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ResumableUploadUpsertOne) SetMetadata(v string) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateMetadata() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ResumableUploadUpsertOne) ClearMetadata() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (u *ResumableUploadUpsertOne) SetBypassCache(v bool) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetBypassCache(v)
+	})
+}
+
+// UpdateBypassCache sets the "bypass_cache" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateBypassCache() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateBypassCache()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResumableUploadUpsertOne) SetExpiresAt(v time.Time) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateExpiresAt() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumableUploadUpsertOne) SetCreatedAt(v time.Time) *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsertOne) UpdateCreatedAt() *ResumableUploadUpsertOne {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumableUploadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResumableUploadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumableUploadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResumableUploadUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ResumableUploadUpsertOne.ID is not supported by MySQL driver. Use ResumableUploadUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResumableUploadUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResumableUploadCreateBulk is the builder for creating many ResumableUpload entities in bulk.
+type ResumableUploadCreateBulk struct {
+	config
+	builders []*ResumableUploadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResumableUpload entities in the database.
+func (rucb *ResumableUploadCreateBulk) Save(ctx context.Context) ([]*ResumableUpload, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rucb.builders))
+	nodes := make([]*ResumableUpload, len(rucb.builders))
+	mutators := make([]Mutator, len(rucb.builders))
+	for i := range rucb.builders {
+		func(i int, root context.Context) {
+			builder := rucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumableUploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rucb *ResumableUploadCreateBulk) SaveX(ctx context.Context) []*ResumableUpload {
+	v, err := rucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rucb *ResumableUploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := rucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rucb *ResumableUploadCreateBulk) ExecX(ctx context.Context) {
+	if err := rucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResumableUpload.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResumableUploadUpsert) {
+//			SetSourceLanguage(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (rucb *ResumableUploadCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResumableUploadUpsertBulk {
+	rucb.conflict = opts
+	return &ResumableUploadUpsertBulk{
+		create: rucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResumableUpload.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (rucb *ResumableUploadCreateBulk) OnConflictColumns(columns ...string) *ResumableUploadUpsertBulk {
+	rucb.conflict = append(rucb.conflict, sql.ConflictColumns(columns...))
+	return &ResumableUploadUpsertBulk{
+		create: rucb,
+	}
+}
+
+// ResumableUploadUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResumableUpload nodes.
+type ResumableUploadUpsertBulk struct {
+	create *ResumableUploadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResumableUpload.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resumableupload.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *ResumableUploadUpsertBulk) UpdateNewValues() *ResumableUploadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resumableupload.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResumableUpload.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *ResumableUploadUpsertBulk) Ignore() *ResumableUploadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResumableUploadUpsertBulk) DoNothing() *ResumableUploadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResumableUploadCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResumableUploadUpsertBulk) Update(set func(*ResumableUploadUpsert)) *ResumableUploadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResumableUploadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *ResumableUploadUpsertBulk) SetSourceLanguage(v string) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetSourceLanguage(v)
+	})
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateSourceLanguage() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateSourceLanguage()
+	})
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *ResumableUploadUpsertBulk) SetTargetLanguage(v string) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetTargetLanguage(v)
+	})
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateTargetLanguage() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateTargetLanguage()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *ResumableUploadUpsertBulk) SetLength(v int64) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *ResumableUploadUpsertBulk) AddLength(v int64) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateLength() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *ResumableUploadUpsertBulk) SetOffset(v int64) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *ResumableUploadUpsertBulk) AddOffset(v int64) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateOffset() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// SetChunks sets the "chunks" field.
+func (u *ResumableUploadUpsertBulk) SetChunks(v []string) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetChunks(v)
+	})
+}
+
+// UpdateChunks sets the "chunks" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateChunks() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateChunks()
+	})
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (u *ResumableUploadUpsertBulk) ClearChunks() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.ClearChunks()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *ResumableUploadUpsertBulk) SetMetadata(v string) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateMetadata() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *ResumableUploadUpsertBulk) ClearMetadata() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (u *ResumableUploadUpsertBulk) SetBypassCache(v bool) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetBypassCache(v)
+	})
+}
+
+// UpdateBypassCache sets the "bypass_cache" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateBypassCache() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateBypassCache()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResumableUploadUpsertBulk) SetExpiresAt(v time.Time) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateExpiresAt() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResumableUploadUpsertBulk) SetCreatedAt(v time.Time) *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResumableUploadUpsertBulk) UpdateCreatedAt() *ResumableUploadUpsertBulk {
+	return u.Update(func(s *ResumableUploadUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResumableUploadUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResumableUploadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResumableUploadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResumableUploadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
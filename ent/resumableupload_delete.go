@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+)
+
+// ResumableUploadDelete is the builder for deleting a ResumableUpload entity.
+type ResumableUploadDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// Where appends a list predicates to the ResumableUploadDelete builder.
+func (rud *ResumableUploadDelete) Where(ps ...predicate.ResumableUpload) *ResumableUploadDelete {
+	rud.mutation.Where(ps...)
+	return rud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rud *ResumableUploadDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rud.hooks) == 0 {
+		affected, err = rud.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ResumableUploadMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rud.mutation = mutation
+			affected, err = rud.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rud.hooks) - 1; i >= 0; i-- {
+			if rud.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rud.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rud.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rud *ResumableUploadDelete) ExecX(ctx context.Context) int {
+	n, err := rud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rud *ResumableUploadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: resumableupload.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: resumableupload.FieldID,
+			},
+		},
+	}
+	if ps := rud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, rud.driver, _spec)
+}
+
+// ResumableUploadDeleteOne is the builder for deleting a single ResumableUpload entity.
+type ResumableUploadDeleteOne struct {
+	rud *ResumableUploadDelete
+}
+
+// Exec executes the deletion query.
+func (rudo *ResumableUploadDeleteOne) Exec(ctx context.Context) error {
+	n, err := rudo.rud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resumableupload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rudo *ResumableUploadDeleteOne) ExecX(ctx context.Context) {
+	rudo.rud.ExecX(ctx)
+}
@@ -0,0 +1,1023 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// ResumableUploadQuery is the builder for querying ResumableUpload entities.
+type ResumableUploadQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ResumableUpload
+	// eager-loading edges.
+	withUser  *UserQuery
+	withFKs   bool
+	modifiers []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResumableUploadQuery builder.
+func (ruq *ResumableUploadQuery) Where(ps ...predicate.ResumableUpload) *ResumableUploadQuery {
+	ruq.predicates = append(ruq.predicates, ps...)
+	return ruq
+}
+
+// Limit adds a limit step to the query.
+func (ruq *ResumableUploadQuery) Limit(limit int) *ResumableUploadQuery {
+	ruq.limit = &limit
+	return ruq
+}
+
+// Offset adds an offset step to the query.
+func (ruq *ResumableUploadQuery) Offset(offset int) *ResumableUploadQuery {
+	ruq.offset = &offset
+	return ruq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ruq *ResumableUploadQuery) Unique(unique bool) *ResumableUploadQuery {
+	ruq.unique = &unique
+	return ruq
+}
+
+// Order adds an order step to the query.
+func (ruq *ResumableUploadQuery) Order(o ...OrderFunc) *ResumableUploadQuery {
+	ruq.order = append(ruq.order, o...)
+	return ruq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ruq *ResumableUploadQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: ruq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ruq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ruq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumableupload.Table, resumableupload.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumableupload.UserTable, resumableupload.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ruq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResumableUpload entity from the query.
+// Returns a *NotFoundError when no ResumableUpload was found.
+func (ruq *ResumableUploadQuery) First(ctx context.Context) (*ResumableUpload, error) {
+	nodes, err := ruq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resumableupload.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) FirstX(ctx context.Context) *ResumableUpload {
+	node, err := ruq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResumableUpload ID from the query.
+// Returns a *NotFoundError when no ResumableUpload ID was found.
+func (ruq *ResumableUploadQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ruq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resumableupload.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ruq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResumableUpload entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResumableUpload entity is found.
+// Returns a *NotFoundError when no ResumableUpload entities are found.
+func (ruq *ResumableUploadQuery) Only(ctx context.Context) (*ResumableUpload, error) {
+	nodes, err := ruq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resumableupload.Label}
+	default:
+		return nil, &NotSingularError{resumableupload.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) OnlyX(ctx context.Context) *ResumableUpload {
+	node, err := ruq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResumableUpload ID in the query.
+// Returns a *NotSingularError when more than one ResumableUpload ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ruq *ResumableUploadQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ruq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = &NotSingularError{resumableupload.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ruq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResumableUploads.
+func (ruq *ResumableUploadQuery) All(ctx context.Context) ([]*ResumableUpload, error) {
+	if err := ruq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ruq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) AllX(ctx context.Context) []*ResumableUpload {
+	nodes, err := ruq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResumableUpload IDs.
+func (ruq *ResumableUploadQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := ruq.Select(resumableupload.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ruq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ruq *ResumableUploadQuery) Count(ctx context.Context) (int, error) {
+	if err := ruq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ruq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) CountX(ctx context.Context) int {
+	count, err := ruq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ruq *ResumableUploadQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ruq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ruq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) ExistX(ctx context.Context) bool {
+	exist, err := ruq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResumableUploadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ruq *ResumableUploadQuery) Clone() *ResumableUploadQuery {
+	if ruq == nil {
+		return nil
+	}
+	return &ResumableUploadQuery{
+		config:     ruq.config,
+		limit:      ruq.limit,
+		offset:     ruq.offset,
+		order:      append([]OrderFunc{}, ruq.order...),
+		predicates: append([]predicate.ResumableUpload{}, ruq.predicates...),
+		withUser:   ruq.withUser.Clone(),
+		// clone intermediate query.
+		sql:    ruq.sql.Clone(),
+		path:   ruq.path,
+		unique: ruq.unique,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ruq *ResumableUploadQuery) WithUser(opts ...func(*UserQuery)) *ResumableUploadQuery {
+	query := &UserQuery{config: ruq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ruq.withUser = query
+	return ruq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SourceLanguage string `json:"source_language,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResumableUpload.Query().
+//		GroupBy(resumableupload.FieldSourceLanguage).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ruq *ResumableUploadQuery) GroupBy(field string, fields ...string) *ResumableUploadGroupBy {
+	group := &ResumableUploadGroupBy{config: ruq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ruq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ruq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SourceLanguage string `json:"source_language,omitempty"`
+//	}
+//
+//	client.ResumableUpload.Query().
+//		Select(resumableupload.FieldSourceLanguage).
+//		Scan(ctx, &v)
+//
+func (ruq *ResumableUploadQuery) Select(fields ...string) *ResumableUploadSelect {
+	ruq.fields = append(ruq.fields, fields...)
+	return &ResumableUploadSelect{ResumableUploadQuery: ruq}
+}
+
+func (ruq *ResumableUploadQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ruq.fields {
+		if !resumableupload.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ruq.path != nil {
+		prev, err := ruq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ruq.sql = prev
+	}
+	return nil
+}
+
+func (ruq *ResumableUploadQuery) sqlAll(ctx context.Context) ([]*ResumableUpload, error) {
+	var (
+		nodes       = []*ResumableUpload{}
+		withFKs     = ruq.withFKs
+		_spec       = ruq.querySpec()
+		loadedTypes = [1]bool{
+			ruq.withUser != nil,
+		}
+	)
+	if ruq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ResumableUpload{config: ruq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ruq.modifiers) > 0 {
+		_spec.Modifiers = ruq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, ruq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ruq.withUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*ResumableUpload)
+		for i := range nodes {
+			if nodes[i].user_resumable_uploads == nil {
+				continue
+			}
+			fk := *nodes[i].user_resumable_uploads
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_resumable_uploads" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ruq *ResumableUploadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ruq.querySpec()
+	if len(ruq.modifiers) > 0 {
+		_spec.Modifiers = ruq.modifiers
+	}
+	_spec.Node.Columns = ruq.fields
+	if len(ruq.fields) > 0 {
+		_spec.Unique = ruq.unique != nil && *ruq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ruq.driver, _spec)
+}
+
+func (ruq *ResumableUploadQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ruq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ruq *ResumableUploadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   resumableupload.Table,
+			Columns: resumableupload.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: resumableupload.FieldID,
+			},
+		},
+		From:   ruq.sql,
+		Unique: true,
+	}
+	if unique := ruq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ruq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.FieldID)
+		for i := range fields {
+			if fields[i] != resumableupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ruq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ruq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ruq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ruq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ruq *ResumableUploadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ruq.driver.Dialect())
+	t1 := builder.Table(resumableupload.Table)
+	columns := ruq.fields
+	if len(columns) == 0 {
+		columns = resumableupload.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ruq.sql != nil {
+		selector = ruq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ruq.unique != nil && *ruq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ruq.modifiers {
+		m(selector)
+	}
+	for _, p := range ruq.predicates {
+		p(selector)
+	}
+	for _, p := range ruq.order {
+		p(selector)
+	}
+	if offset := ruq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ruq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ruq *ResumableUploadQuery) Modify(modifiers ...func(s *sql.Selector)) *ResumableUploadSelect {
+	ruq.modifiers = append(ruq.modifiers, modifiers...)
+	return ruq.Select()
+}
+
+// ResumableUploadGroupBy is the group-by builder for ResumableUpload entities.
+type ResumableUploadGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rugb *ResumableUploadGroupBy) Aggregate(fns ...AggregateFunc) *ResumableUploadGroupBy {
+	rugb.fns = append(rugb.fns, fns...)
+	return rugb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rugb *ResumableUploadGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rugb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rugb.sql = query
+	return rugb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := rugb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(rugb.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := rugb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) StringsX(ctx context.Context) []string {
+	v, err := rugb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rugb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) StringX(ctx context.Context) string {
+	v, err := rugb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(rugb.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := rugb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) IntsX(ctx context.Context) []int {
+	v, err := rugb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rugb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) IntX(ctx context.Context) int {
+	v, err := rugb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rugb.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := rugb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := rugb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rugb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := rugb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(rugb.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := rugb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := rugb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rugb *ResumableUploadGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rugb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rugb *ResumableUploadGroupBy) BoolX(ctx context.Context) bool {
+	v, err := rugb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rugb *ResumableUploadGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rugb.fields {
+		if !resumableupload.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rugb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rugb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rugb *ResumableUploadGroupBy) sqlQuery() *sql.Selector {
+	selector := rugb.sql.Select()
+	aggregation := make([]string, 0, len(rugb.fns))
+	for _, fn := range rugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rugb.fields)+len(rugb.fns))
+		for _, f := range rugb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rugb.fields...)...)
+}
+
+// ResumableUploadSelect is the builder for selecting fields of ResumableUpload entities.
+type ResumableUploadSelect struct {
+	*ResumableUploadQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rus *ResumableUploadSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rus.sql = rus.ResumableUploadQuery.sqlQuery(ctx)
+	return rus.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rus *ResumableUploadSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := rus.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(rus.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := rus.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rus *ResumableUploadSelect) StringsX(ctx context.Context) []string {
+	v, err := rus.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rus.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rus *ResumableUploadSelect) StringX(ctx context.Context) string {
+	v, err := rus.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(rus.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := rus.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rus *ResumableUploadSelect) IntsX(ctx context.Context) []int {
+	v, err := rus.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rus.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rus *ResumableUploadSelect) IntX(ctx context.Context) int {
+	v, err := rus.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rus.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := rus.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rus *ResumableUploadSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := rus.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rus.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rus *ResumableUploadSelect) Float64X(ctx context.Context) float64 {
+	v, err := rus.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(rus.fields) > 1 {
+		return nil, errors.New("ent: ResumableUploadSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := rus.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rus *ResumableUploadSelect) BoolsX(ctx context.Context) []bool {
+	v, err := rus.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (rus *ResumableUploadSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rus.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = fmt.Errorf("ent: ResumableUploadSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rus *ResumableUploadSelect) BoolX(ctx context.Context) bool {
+	v, err := rus.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rus *ResumableUploadSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rus.sql.Query()
+	if err := rus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rus *ResumableUploadSelect) Modify(modifiers ...func(s *sql.Selector)) *ResumableUploadSelect {
+	rus.modifiers = append(rus.modifiers, modifiers...)
+	return rus
+}
@@ -0,0 +1,776 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// ResumableUploadUpdate is the builder for updating ResumableUpload entities.
+type ResumableUploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// Where appends a list predicates to the ResumableUploadUpdate builder.
+func (ruu *ResumableUploadUpdate) Where(ps ...predicate.ResumableUpload) *ResumableUploadUpdate {
+	ruu.mutation.Where(ps...)
+	return ruu
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (ruu *ResumableUploadUpdate) SetSourceLanguage(s string) *ResumableUploadUpdate {
+	ruu.mutation.SetSourceLanguage(s)
+	return ruu
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (ruu *ResumableUploadUpdate) SetTargetLanguage(s string) *ResumableUploadUpdate {
+	ruu.mutation.SetTargetLanguage(s)
+	return ruu
+}
+
+// SetLength sets the "length" field.
+func (ruu *ResumableUploadUpdate) SetLength(i int64) *ResumableUploadUpdate {
+	ruu.mutation.ResetLength()
+	ruu.mutation.SetLength(i)
+	return ruu
+}
+
+// AddLength adds i to the "length" field.
+func (ruu *ResumableUploadUpdate) AddLength(i int64) *ResumableUploadUpdate {
+	ruu.mutation.AddLength(i)
+	return ruu
+}
+
+// SetOffset sets the "offset" field.
+func (ruu *ResumableUploadUpdate) SetOffset(i int64) *ResumableUploadUpdate {
+	ruu.mutation.ResetOffset()
+	ruu.mutation.SetOffset(i)
+	return ruu
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableOffset(i *int64) *ResumableUploadUpdate {
+	if i != nil {
+		ruu.SetOffset(*i)
+	}
+	return ruu
+}
+
+// AddOffset adds i to the "offset" field.
+func (ruu *ResumableUploadUpdate) AddOffset(i int64) *ResumableUploadUpdate {
+	ruu.mutation.AddOffset(i)
+	return ruu
+}
+
+// SetChunks sets the "chunks" field.
+func (ruu *ResumableUploadUpdate) SetChunks(s []string) *ResumableUploadUpdate {
+	ruu.mutation.SetChunks(s)
+	return ruu
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (ruu *ResumableUploadUpdate) ClearChunks() *ResumableUploadUpdate {
+	ruu.mutation.ClearChunks()
+	return ruu
+}
+
+// SetMetadata sets the "metadata" field.
+func (ruu *ResumableUploadUpdate) SetMetadata(s string) *ResumableUploadUpdate {
+	ruu.mutation.SetMetadata(s)
+	return ruu
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableMetadata(s *string) *ResumableUploadUpdate {
+	if s != nil {
+		ruu.SetMetadata(*s)
+	}
+	return ruu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ruu *ResumableUploadUpdate) ClearMetadata() *ResumableUploadUpdate {
+	ruu.mutation.ClearMetadata()
+	return ruu
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (ruu *ResumableUploadUpdate) SetBypassCache(b bool) *ResumableUploadUpdate {
+	ruu.mutation.SetBypassCache(b)
+	return ruu
+}
+
+// SetNillableBypassCache sets the "bypass_cache" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableBypassCache(b *bool) *ResumableUploadUpdate {
+	if b != nil {
+		ruu.SetBypassCache(*b)
+	}
+	return ruu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruu *ResumableUploadUpdate) SetExpiresAt(t time.Time) *ResumableUploadUpdate {
+	ruu.mutation.SetExpiresAt(t)
+	return ruu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruu *ResumableUploadUpdate) SetCreatedAt(t time.Time) *ResumableUploadUpdate {
+	ruu.mutation.SetCreatedAt(t)
+	return ruu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableCreatedAt(t *time.Time) *ResumableUploadUpdate {
+	if t != nil {
+		ruu.SetCreatedAt(*t)
+	}
+	return ruu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruu *ResumableUploadUpdate) SetUserID(id uuid.UUID) *ResumableUploadUpdate {
+	ruu.mutation.SetUserID(id)
+	return ruu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruu *ResumableUploadUpdate) SetUser(u *User) *ResumableUploadUpdate {
+	return ruu.SetUserID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruu *ResumableUploadUpdate) Mutation() *ResumableUploadMutation {
+	return ruu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruu *ResumableUploadUpdate) ClearUser() *ResumableUploadUpdate {
+	ruu.mutation.ClearUser()
+	return ruu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ruu *ResumableUploadUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ruu.hooks) == 0 {
+		if err = ruu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ruu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ResumableUploadMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruu.check(); err != nil {
+				return 0, err
+			}
+			ruu.mutation = mutation
+			affected, err = ruu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ruu.hooks) - 1; i >= 0; i-- {
+			if ruu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruu *ResumableUploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := ruu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ruu *ResumableUploadUpdate) Exec(ctx context.Context) error {
+	_, err := ruu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruu *ResumableUploadUpdate) ExecX(ctx context.Context) {
+	if err := ruu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruu *ResumableUploadUpdate) check() error {
+	if _, ok := ruu.mutation.UserID(); ruu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ResumableUpload.user"`)
+	}
+	return nil
+}
+
+func (ruu *ResumableUploadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   resumableupload.Table,
+			Columns: resumableupload.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: resumableupload.FieldID,
+			},
+		},
+	}
+	if ps := ruu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruu.mutation.SourceLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldSourceLanguage,
+		})
+	}
+	if value, ok := ruu.mutation.TargetLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldTargetLanguage,
+		})
+	}
+	if value, ok := ruu.mutation.Length(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldLength,
+		})
+	}
+	if value, ok := ruu.mutation.AddedLength(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldLength,
+		})
+	}
+	if value, ok := ruu.mutation.Offset(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldOffset,
+		})
+	}
+	if value, ok := ruu.mutation.AddedOffset(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldOffset,
+		})
+	}
+	if value, ok := ruu.mutation.Chunks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: resumableupload.FieldChunks,
+		})
+	}
+	if ruu.mutation.ChunksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: resumableupload.FieldChunks,
+		})
+	}
+	if value, ok := ruu.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldMetadata,
+		})
+	}
+	if ruu.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resumableupload.FieldMetadata,
+		})
+	}
+	if value, ok := ruu.mutation.BypassCache(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: resumableupload.FieldBypassCache,
+		})
+	}
+	if value, ok := ruu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldExpiresAt,
+		})
+	}
+	if value, ok := ruu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldCreatedAt,
+		})
+	}
+	if ruu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.UserTable,
+			Columns: []string{resumableupload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.UserTable,
+			Columns: []string{resumableupload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ruu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ResumableUploadUpdateOne is the builder for updating a single ResumableUpload entity.
+type ResumableUploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (ruuo *ResumableUploadUpdateOne) SetSourceLanguage(s string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetSourceLanguage(s)
+	return ruuo
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (ruuo *ResumableUploadUpdateOne) SetTargetLanguage(s string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetTargetLanguage(s)
+	return ruuo
+}
+
+// SetLength sets the "length" field.
+func (ruuo *ResumableUploadUpdateOne) SetLength(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.ResetLength()
+	ruuo.mutation.SetLength(i)
+	return ruuo
+}
+
+// AddLength adds i to the "length" field.
+func (ruuo *ResumableUploadUpdateOne) AddLength(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.AddLength(i)
+	return ruuo
+}
+
+// SetOffset sets the "offset" field.
+func (ruuo *ResumableUploadUpdateOne) SetOffset(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.ResetOffset()
+	ruuo.mutation.SetOffset(i)
+	return ruuo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableOffset(i *int64) *ResumableUploadUpdateOne {
+	if i != nil {
+		ruuo.SetOffset(*i)
+	}
+	return ruuo
+}
+
+// AddOffset adds i to the "offset" field.
+func (ruuo *ResumableUploadUpdateOne) AddOffset(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.AddOffset(i)
+	return ruuo
+}
+
+// SetChunks sets the "chunks" field.
+func (ruuo *ResumableUploadUpdateOne) SetChunks(s []string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetChunks(s)
+	return ruuo
+}
+
+// ClearChunks clears the value of the "chunks" field.
+func (ruuo *ResumableUploadUpdateOne) ClearChunks() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearChunks()
+	return ruuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (ruuo *ResumableUploadUpdateOne) SetMetadata(s string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetMetadata(s)
+	return ruuo
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableMetadata(s *string) *ResumableUploadUpdateOne {
+	if s != nil {
+		ruuo.SetMetadata(*s)
+	}
+	return ruuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ruuo *ResumableUploadUpdateOne) ClearMetadata() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearMetadata()
+	return ruuo
+}
+
+// SetBypassCache sets the "bypass_cache" field.
+func (ruuo *ResumableUploadUpdateOne) SetBypassCache(b bool) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetBypassCache(b)
+	return ruuo
+}
+
+// SetNillableBypassCache sets the "bypass_cache" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableBypassCache(b *bool) *ResumableUploadUpdateOne {
+	if b != nil {
+		ruuo.SetBypassCache(*b)
+	}
+	return ruuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruuo *ResumableUploadUpdateOne) SetExpiresAt(t time.Time) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetExpiresAt(t)
+	return ruuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruuo *ResumableUploadUpdateOne) SetCreatedAt(t time.Time) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetCreatedAt(t)
+	return ruuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableCreatedAt(t *time.Time) *ResumableUploadUpdateOne {
+	if t != nil {
+		ruuo.SetCreatedAt(*t)
+	}
+	return ruuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruuo *ResumableUploadUpdateOne) SetUserID(id uuid.UUID) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetUserID(id)
+	return ruuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruuo *ResumableUploadUpdateOne) SetUser(u *User) *ResumableUploadUpdateOne {
+	return ruuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruuo *ResumableUploadUpdateOne) Mutation() *ResumableUploadMutation {
+	return ruuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruuo *ResumableUploadUpdateOne) ClearUser() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearUser()
+	return ruuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruuo *ResumableUploadUpdateOne) Select(field string, fields ...string) *ResumableUploadUpdateOne {
+	ruuo.fields = append([]string{field}, fields...)
+	return ruuo
+}
+
+// Save executes the query and returns the updated ResumableUpload entity.
+func (ruuo *ResumableUploadUpdateOne) Save(ctx context.Context) (*ResumableUpload, error) {
+	var (
+		err  error
+		node *ResumableUpload
+	)
+	if len(ruuo.hooks) == 0 {
+		if err = ruuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ResumableUploadMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruuo.check(); err != nil {
+				return nil, err
+			}
+			ruuo.mutation = mutation
+			node, err = ruuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruuo.hooks) - 1; i >= 0; i-- {
+			if ruuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruuo *ResumableUploadUpdateOne) SaveX(ctx context.Context) *ResumableUpload {
+	node, err := ruuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruuo *ResumableUploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruuo *ResumableUploadUpdateOne) ExecX(ctx context.Context) {
+	if err := ruuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruuo *ResumableUploadUpdateOne) check() error {
+	if _, ok := ruuo.mutation.UserID(); ruuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ResumableUpload.user"`)
+	}
+	return nil
+}
+
+func (ruuo *ResumableUploadUpdateOne) sqlSave(ctx context.Context) (_node *ResumableUpload, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   resumableupload.Table,
+			Columns: resumableupload.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: resumableupload.FieldID,
+			},
+		},
+	}
+	id, ok := ruuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResumableUpload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.FieldID)
+		for _, f := range fields {
+			if !resumableupload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resumableupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruuo.mutation.SourceLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldSourceLanguage,
+		})
+	}
+	if value, ok := ruuo.mutation.TargetLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldTargetLanguage,
+		})
+	}
+	if value, ok := ruuo.mutation.Length(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldLength,
+		})
+	}
+	if value, ok := ruuo.mutation.AddedLength(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldLength,
+		})
+	}
+	if value, ok := ruuo.mutation.Offset(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldOffset,
+		})
+	}
+	if value, ok := ruuo.mutation.AddedOffset(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: resumableupload.FieldOffset,
+		})
+	}
+	if value, ok := ruuo.mutation.Chunks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: resumableupload.FieldChunks,
+		})
+	}
+	if ruuo.mutation.ChunksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: resumableupload.FieldChunks,
+		})
+	}
+	if value, ok := ruuo.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: resumableupload.FieldMetadata,
+		})
+	}
+	if ruuo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: resumableupload.FieldMetadata,
+		})
+	}
+	if value, ok := ruuo.mutation.BypassCache(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: resumableupload.FieldBypassCache,
+		})
+	}
+	if value, ok := ruuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldExpiresAt,
+		})
+	}
+	if value, ok := ruuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: resumableupload.FieldCreatedAt,
+		})
+	}
+	if ruuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.UserTable,
+			Columns: []string{resumableupload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.UserTable,
+			Columns: []string{resumableupload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResumableUpload{config: ruuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
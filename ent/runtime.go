@@ -6,10 +6,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/lease"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
 	"github.com/tereus-project/tereus-api/ent/schema"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
 
@@ -17,6 +28,140 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	adminactionFields := schema.AdminAction{}.Fields()
+	_ = adminactionFields
+	// adminactionDescCreatedAt is the schema descriptor for created_at field.
+	adminactionDescCreatedAt := adminactionFields[7].Descriptor()
+	// adminaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminaction.DefaultCreatedAt = adminactionDescCreatedAt.Default.(func() time.Time)
+	// adminactionDescID is the schema descriptor for id field.
+	adminactionDescID := adminactionFields[0].Descriptor()
+	// adminaction.DefaultID holds the default value on creation for the id field.
+	adminaction.DefaultID = adminactionDescID.Default.(func() uuid.UUID)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[8].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescEncrypted is the schema descriptor for encrypted field.
+	blobDescEncrypted := blobFields[2].Descriptor()
+	// blob.DefaultEncrypted holds the default value on creation for the encrypted field.
+	blob.DefaultEncrypted = blobDescEncrypted.Default.(bool)
+	// blobDescReferenceCount is the schema descriptor for reference_count field.
+	blobDescReferenceCount := blobFields[3].Descriptor()
+	// blob.DefaultReferenceCount holds the default value on creation for the reference_count field.
+	blob.DefaultReferenceCount = blobDescReferenceCount.Default.(int)
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[4].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	// blobDescUpdatedAt is the schema descriptor for updated_at field.
+	blobDescUpdatedAt := blobFields[5].Descriptor()
+	// blob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blob.DefaultUpdatedAt = blobDescUpdatedAt.Default.(func() time.Time)
+	// blob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blob.UpdateDefaultUpdatedAt = blobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	datakeyFields := schema.DataKey{}.Fields()
+	_ = datakeyFields
+	// datakeyDescCreatedAt is the schema descriptor for created_at field.
+	datakeyDescCreatedAt := datakeyFields[4].Descriptor()
+	// datakey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	datakey.DefaultCreatedAt = datakeyDescCreatedAt.Default.(func() time.Time)
+	// datakeyDescID is the schema descriptor for id field.
+	datakeyDescID := datakeyFields[0].Descriptor()
+	// datakey.DefaultID holds the default value on creation for the id field.
+	datakey.DefaultID = datakeyDescID.Default.(func() uuid.UUID)
+	diagnosticFields := schema.Diagnostic{}.Fields()
+	_ = diagnosticFields
+	// diagnosticDescCreatedAt is the schema descriptor for created_at field.
+	diagnosticDescCreatedAt := diagnosticFields[7].Descriptor()
+	// diagnostic.DefaultCreatedAt holds the default value on creation for the created_at field.
+	diagnostic.DefaultCreatedAt = diagnosticDescCreatedAt.Default.(func() time.Time)
+	// diagnosticDescID is the schema descriptor for id field.
+	diagnosticDescID := diagnosticFields[0].Descriptor()
+	// diagnostic.DefaultID holds the default value on creation for the id field.
+	diagnostic.DefaultID = diagnosticDescID.Default.(func() uuid.UUID)
+	leaseFields := schema.Lease{}.Fields()
+	_ = leaseFields
+	// leaseDescUpdatedAt is the schema descriptor for updated_at field.
+	leaseDescUpdatedAt := leaseFields[3].Descriptor()
+	// lease.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	lease.DefaultUpdatedAt = leaseDescUpdatedAt.Default.(func() time.Time)
+	// lease.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	lease.UpdateDefaultUpdatedAt = leaseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// leaseDescID is the schema descriptor for id field.
+	leaseDescID := leaseFields[0].Descriptor()
+	// lease.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	lease.IDValidator = leaseDescID.Validators[0].(func(string) error)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescRetentionWarningEmail is the schema descriptor for retention_warning_email field.
+	notificationpreferenceDescRetentionWarningEmail := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.DefaultRetentionWarningEmail holds the default value on creation for the retention_warning_email field.
+	notificationpreference.DefaultRetentionWarningEmail = notificationpreferenceDescRetentionWarningEmail.Default.(bool)
+	// notificationpreferenceDescRetentionWarningInApp is the schema descriptor for retention_warning_in_app field.
+	notificationpreferenceDescRetentionWarningInApp := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultRetentionWarningInApp holds the default value on creation for the retention_warning_in_app field.
+	notificationpreference.DefaultRetentionWarningInApp = notificationpreferenceDescRetentionWarningInApp.Default.(bool)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() uuid.UUID)
+	pendingdeletionFields := schema.PendingDeletion{}.Fields()
+	_ = pendingdeletionFields
+	// pendingdeletionDescAttempts is the schema descriptor for attempts field.
+	pendingdeletionDescAttempts := pendingdeletionFields[4].Descriptor()
+	// pendingdeletion.DefaultAttempts holds the default value on creation for the attempts field.
+	pendingdeletion.DefaultAttempts = pendingdeletionDescAttempts.Default.(int)
+	// pendingdeletionDescCreatedAt is the schema descriptor for created_at field.
+	pendingdeletionDescCreatedAt := pendingdeletionFields[6].Descriptor()
+	// pendingdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pendingdeletion.DefaultCreatedAt = pendingdeletionDescCreatedAt.Default.(func() time.Time)
+	// pendingdeletionDescID is the schema descriptor for id field.
+	pendingdeletionDescID := pendingdeletionFields[0].Descriptor()
+	// pendingdeletion.DefaultID holds the default value on creation for the id field.
+	pendingdeletion.DefaultID = pendingdeletionDescID.Default.(func() uuid.UUID)
+	resumableuploadFields := schema.ResumableUpload{}.Fields()
+	_ = resumableuploadFields
+	// resumableuploadDescOffset is the schema descriptor for offset field.
+	resumableuploadDescOffset := resumableuploadFields[4].Descriptor()
+	// resumableupload.DefaultOffset holds the default value on creation for the offset field.
+	resumableupload.DefaultOffset = resumableuploadDescOffset.Default.(int64)
+	// resumableuploadDescBypassCache is the schema descriptor for bypass_cache field.
+	resumableuploadDescBypassCache := resumableuploadFields[7].Descriptor()
+	// resumableupload.DefaultBypassCache holds the default value on creation for the bypass_cache field.
+	resumableupload.DefaultBypassCache = resumableuploadDescBypassCache.Default.(bool)
+	// resumableuploadDescCreatedAt is the schema descriptor for created_at field.
+	resumableuploadDescCreatedAt := resumableuploadFields[9].Descriptor()
+	// resumableupload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumableupload.DefaultCreatedAt = resumableuploadDescCreatedAt.Default.(func() time.Time)
+	// resumableuploadDescID is the schema descriptor for id field.
+	resumableuploadDescID := resumableuploadFields[0].Descriptor()
+	// resumableupload.DefaultID holds the default value on creation for the id field.
+	resumableupload.DefaultID = resumableuploadDescID.Default.(func() uuid.UUID)
 	submissionFields := schema.Submission{}.Fields()
 	_ = submissionFields
 	// submissionDescIsInline is the schema descriptor for is_inline field.
@@ -39,6 +184,22 @@ func init() {
 	submissionDescSubmissionTargetSizeBytes := submissionFields[11].Descriptor()
 	// submission.DefaultSubmissionTargetSizeBytes holds the default value on creation for the submission_target_size_bytes field.
 	submission.DefaultSubmissionTargetSizeBytes = submissionDescSubmissionTargetSizeBytes.Default.(int)
+	// submissionDescQueueLane is the schema descriptor for queue_lane field.
+	submissionDescQueueLane := submissionFields[14].Descriptor()
+	// submission.DefaultQueueLane holds the default value on creation for the queue_lane field.
+	submission.DefaultQueueLane = submissionDescQueueLane.Default.(string)
+	// submissionDescHasMapping is the schema descriptor for has_mapping field.
+	submissionDescHasMapping := submissionFields[15].Descriptor()
+	// submission.DefaultHasMapping holds the default value on creation for the has_mapping field.
+	submission.DefaultHasMapping = submissionDescHasMapping.Default.(bool)
+	// submissionDescCacheHit is the schema descriptor for cache_hit field.
+	submissionDescCacheHit := submissionFields[18].Descriptor()
+	// submission.DefaultCacheHit holds the default value on creation for the cache_hit field.
+	submission.DefaultCacheHit = submissionDescCacheHit.Default.(bool)
+	// submissionDescResultsEncrypted is the schema descriptor for results_encrypted field.
+	submissionDescResultsEncrypted := submissionFields[19].Descriptor()
+	// submission.DefaultResultsEncrypted holds the default value on creation for the results_encrypted field.
+	submission.DefaultResultsEncrypted = submissionDescResultsEncrypted.Default.(bool)
 	// submissionDescID is the schema descriptor for id field.
 	submissionDescID := submissionFields[0].Descriptor()
 	// submission.DefaultID holds the default value on creation for the id field.
@@ -49,8 +210,12 @@ func init() {
 	subscriptionDescCancelled := subscriptionFields[5].Descriptor()
 	// subscription.DefaultCancelled holds the default value on creation for the cancelled field.
 	subscription.DefaultCancelled = subscriptionDescCancelled.Default.(bool)
+	// subscriptionDescManual is the schema descriptor for manual field.
+	subscriptionDescManual := subscriptionFields[6].Descriptor()
+	// subscription.DefaultManual holds the default value on creation for the manual field.
+	subscription.DefaultManual = subscriptionDescManual.Default.(bool)
 	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionDescCreatedAt := subscriptionFields[6].Descriptor()
+	subscriptionDescCreatedAt := subscriptionFields[7].Descriptor()
 	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	// subscriptionDescID is the schema descriptor for id field.
@@ -67,14 +232,28 @@ func init() {
 	tokenDescCreatedAt := tokenFields[2].Descriptor()
 	// token.DefaultCreatedAt holds the default value on creation for the created_at field.
 	token.DefaultCreatedAt = tokenDescCreatedAt.Default.(func() time.Time)
+	// tokenDescReadOnly is the schema descriptor for read_only field.
+	tokenDescReadOnly := tokenFields[4].Descriptor()
+	// token.DefaultReadOnly holds the default value on creation for the read_only field.
+	token.DefaultReadOnly = tokenDescReadOnly.Default.(bool)
 	// tokenDescID is the schema descriptor for id field.
 	tokenDescID := tokenFields[0].Descriptor()
 	// token.DefaultID holds the default value on creation for the id field.
 	token.DefaultID = tokenDescID.Default.(func() uuid.UUID)
+	uploadsessionFields := schema.UploadSession{}.Fields()
+	_ = uploadsessionFields
+	// uploadsessionDescCreatedAt is the schema descriptor for created_at field.
+	uploadsessionDescCreatedAt := uploadsessionFields[5].Descriptor()
+	// uploadsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	uploadsession.DefaultCreatedAt = uploadsessionDescCreatedAt.Default.(func() time.Time)
+	// uploadsessionDescID is the schema descriptor for id field.
+	uploadsessionDescID := uploadsessionFields[0].Descriptor()
+	// uploadsession.DefaultID holds the default value on creation for the id field.
+	uploadsession.DefaultID = uploadsessionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[9].Descriptor()
+	userDescCreatedAt := userFields[10].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AdminAction holds the schema definition for the AdminAction entity.
+// It records an action of an admin through the /admin routes, the records are
+// never updated nor deleted.
+type AdminAction struct {
+	ent.Schema
+}
+
+// Fields of the AdminAction.
+func (AdminAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		// Not edges, the records outlive the users and submissions
+		field.UUID("admin_id", uuid.UUID{}).Immutable(),
+		field.String("admin_email").Immutable(),
+		field.String("action").Immutable(),
+		field.UUID("target_user_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("target_submission_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.JSON("metadata", map[string]interface{}{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AdminAction.
+func (AdminAction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("target_user_id", "created_at"),
+	}
+}
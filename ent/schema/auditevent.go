@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// It records a security or billing relevant change to the account of a user,
+// the events are only ever created.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		// Not an edge so that the events outlive the deletion of the account
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Enum("type").
+			Values(
+				"login",
+				"signup",
+				"account_linked",
+				"account_unlinked",
+				"token_created",
+				"submission_visibility_changed",
+				"subscription_updated",
+				"subscription_cancelled",
+				"subscription_deleted",
+				"account_deleted",
+			).
+			Immutable(),
+		// Who caused the event, the user themselves, an admin or a Stripe webhook
+		field.Enum("actor_type").Values("user", "admin", "stripe").Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("ip").Optional().Immutable(),
+		field.String("user_agent").Optional().Immutable(),
+		field.JSON("metadata", map[string]interface{}{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
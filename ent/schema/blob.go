@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds the schema definition for the Blob entity.
+// A blob is a source file content stored once in the object storage whatever
+// the number of submissions referencing it.
+type Blob struct {
+	ent.Schema
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		// Hex encoded SHA-256 of the content
+		field.String("id").Immutable(),
+		// Plaintext size
+		field.Int64("size"),
+		field.Bool("encrypted").Default(false),
+		field.Int("reference_count").Default(0),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Blob.
+func (Blob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("source_files", SourceFile.Type),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DataKey holds the schema definition for the DataKey entity.
+// A data key encrypts the stored objects of a user, it is itself stored
+// encrypted by the master key of the API.
+type DataKey struct {
+	ent.Schema
+}
+
+// Fields of the DataKey.
+func (DataKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Bytes("encrypted_key").Sensitive(),
+		// Identifies the master key the data key is encrypted with
+		field.String("master_key_id"),
+		// Set to the ID of the user while the key encrypts their new objects,
+		// unique so that concurrent requests can't create two active keys.
+		// The other keys are kept to decrypt the objects they encrypted
+		field.UUID("active_user_id", uuid.UUID{}).Optional().Nillable().Unique(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("rotated_at").Optional(),
+	}
+}
+
+// Edges of the DataKey.
+func (DataKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("data_keys").
+			Unique().
+			Required(),
+	}
+}
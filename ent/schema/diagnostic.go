@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Diagnostic holds the schema definition for the Diagnostic entity.
+type Diagnostic struct {
+	ent.Schema
+}
+
+// Fields of the Diagnostic.
+func (Diagnostic) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("severity").Values("error", "warning", "info"),
+		field.String("file").Optional(),
+		field.Int("line").Optional(),
+		field.Int("column").Optional(),
+		field.String("code").Optional(),
+		field.Text("message"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Diagnostic.
+func (Diagnostic) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("submission", Submission.Type).
+			Ref("diagnostics").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Lease holds the schema definition for the Lease entity.
+// A lease is held by a single API process at a time to elect the one running
+// a periodic worker.
+type Lease struct {
+	ent.Schema
+}
+
+// Fields of the Lease.
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		// Name of the elected role, such as the worker name
+		field.String("id").NotEmpty().Immutable(),
+		field.String("holder"),
+		field.Time("expires_at"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// Notifications are shown to the user in the app.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("type").Values("retention_warning"),
+		field.String("title"),
+		field.Text("message"),
+		// Submissions the notification is about
+		field.JSON("submission_ids", []string{}).Optional(),
+		field.Time("read_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Unique().
+			Required(),
+	}
+}
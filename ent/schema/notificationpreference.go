@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+// Users without preferences get the defaults.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Bool("retention_warning_email").Default(true),
+		field.Bool("retention_warning_in_app").Default(true),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NotificationPreference.
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notification_preference").
+			Unique().
+			Required(),
+	}
+}
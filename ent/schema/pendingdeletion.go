@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PendingDeletion holds the schema definition for the PendingDeletion entity.
+// It tracks the objects under a prefix from their scheduling for deletion by
+// the storage backend until their removal is verified.
+type PendingDeletion struct {
+	ent.Schema
+}
+
+// Fields of the PendingDeletion.
+func (PendingDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("prefix"),
+		// Not an edge, the submission may be deleted before its objects
+		field.UUID("submission_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("status").Values("pending", "scheduled").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Text("last_error").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("scheduled_at").Optional().Nillable(),
+		// When the removal is checked next
+		field.Time("verify_after").Optional().Nillable(),
+	}
+}
+
+// Indexes of the PendingDeletion.
+func (PendingDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "verify_after"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ResumableUpload holds the schema definition for the ResumableUpload entity.
+type ResumableUpload struct {
+	ent.Schema
+}
+
+// Fields of the ResumableUpload.
+func (ResumableUpload) Fields() []ent.Field {
+	return []ent.Field{
+		// Also used as the ID of the submission created once the upload is complete
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("source_language"),
+		field.String("target_language"),
+		field.Int64("length"),
+		field.Int64("offset").Default(0),
+		// Names of the stored chunks accepted so far, in offset order
+		field.Strings("chunks").Optional(),
+		field.String("metadata").Optional(),
+		field.Bool("bypass_cache").Default(false),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the ResumableUpload.
+func (ResumableUpload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("resumable_uploads").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SourceFile holds the schema definition for the SourceFile entity.
+// The source files of a submission form its manifest.
+type SourceFile struct {
+	ent.Schema
+}
+
+// Fields of the SourceFile.
+func (SourceFile) Fields() []ent.Field {
+	return []ent.Field{
+		// Not an edge as the manifest is written before the submission is saved
+		field.UUID("submission_id", uuid.UUID{}).Immutable(),
+		field.String("path").Immutable(),
+		field.Int64("size").Immutable(),
+	}
+}
+
+// Edges of the SourceFile.
+func (SourceFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blob", Blob.Type).
+			Ref("source_files").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the SourceFile.
+func (SourceFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("submission_id", "path").Unique(),
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -22,7 +24,7 @@ func (Submission) Fields() []ent.Field {
 		field.String("target_language"),
 		field.Bool("is_inline").Default(false),
 		field.Bool("is_public").Default(false),
-		field.Enum("status").Values("pending", "processing", "done", "failed", "cleaned").Default("pending"),
+		field.Enum("status").Values("pending", "processing", "done", "failed", "cleaned", "cancelled").Default("pending"),
 		field.String("reason").Optional(),
 		field.String("git_repo").Optional(),
 		field.Time("created_at").Default(time.Now),
@@ -31,6 +33,20 @@ func (Submission) Fields() []ent.Field {
 		field.Int("submission_target_size_bytes").Default(0),
 		field.Time("processing_started_at").Optional(),
 		field.Time("processing_finished_at").Optional(),
+		field.String("queue_lane").Default("standard"),
+		field.Bool("has_mapping").Default(false),
+		// Hash of the sources, the language pair and the transpiler version,
+		// only set when the version of the transpiler is known
+		field.String("input_hash").Optional(),
+		field.String("transpiler_version").Optional(),
+		// Set when the results were copied from a previous submission
+		field.Bool("cache_hit").Default(false),
+		// Set once the results written by the transpiler are encrypted
+		field.Bool("results_encrypted").Default(false),
+		// Chosen by the user, overrides the retention policy of their tier
+		field.Time("expires_at").Optional().Nillable(),
+		// Set once the owner was warned that the submission will be cleaned
+		field.Time("retention_warned_at").Optional().Nillable(),
 	}
 }
 
@@ -41,5 +57,19 @@ func (Submission) Edges() []ent.Edge {
 			Ref("submissions").
 			Unique().
 			Required(),
+		edge.To("diagnostics", Diagnostic.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("reruns", Submission.Type).
+			From("parent").
+			Unique(),
+	}
+}
+
+// Indexes of the Submission.
+func (Submission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("input_hash"),
 	}
 }
@@ -23,6 +23,8 @@ func (Subscription) Fields() []ent.Field {
 		field.Enum("tier").Values("free", "pro", "enterprise").Default("free"),
 		field.Time("expires_at").Optional(),
 		field.Bool("cancelled").Default(false),
+		// Set by an admin instead of being paid through Stripe
+		field.Bool("manual").Default(false),
 		field.Time("created_at").Default(time.Now),
 	}
 }
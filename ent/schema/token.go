@@ -20,6 +20,11 @@ func (Token) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.Bool("is_active").Default(true),
 		field.Time("created_at").Default(time.Now),
+		// Only set on the impersonation tokens, they can't be used for
+		// anything but reading and expire
+		field.UUID("impersonator_id", uuid.UUID{}).Optional().Nillable(),
+		field.Bool("read_only").Default(false),
+		field.Time("expires_at").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadSessionFile is a file the client uploads directly to the object
+// storage during an upload session.
+type UploadSessionFile struct {
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	// Set when the file is uploaded in several parts
+	UploadID  string `json:"upload_id,omitempty"`
+	PartCount int    `json:"part_count,omitempty"`
+}
+
+// UploadSession holds the schema definition for the UploadSession entity.
+type UploadSession struct {
+	ent.Schema
+}
+
+// Fields of the UploadSession.
+func (UploadSession) Fields() []ent.Field {
+	return []ent.Field{
+		// Also used as the ID of the submission created when finalizing
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("source_language"),
+		field.String("target_language"),
+		field.JSON("files", []UploadSessionFile{}),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the UploadSession.
+func (UploadSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("upload_sessions").
+			Unique().
+			Required(),
+	}
+}
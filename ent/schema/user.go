@@ -30,6 +30,9 @@ func (User) Fields() []ent.Field {
 		field.String("gitlab_refresh_token").Optional(),
 		field.Time("gitlab_access_token_expires_at").Optional(),
 
+		// Admins can reach the /admin routes
+		field.Enum("role").Values("user", "admin").Default("user"),
+
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -45,6 +48,27 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("upload_sessions", UploadSession.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("resumable_uploads", ResumableUpload.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("data_keys", DataKey.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("notification_preference", NotificationPreference.Type).
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 		edge.To("subscription", Subscription.Type).
 			Unique().
 			Annotations(entsql.Annotation{
@@ -0,0 +1,164 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// SourceFile is the model entity for the SourceFile schema.
+type SourceFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SubmissionID holds the value of the "submission_id" field.
+	SubmissionID uuid.UUID `json:"submission_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SourceFileQuery when eager-loading is set.
+	Edges             SourceFileEdges `json:"edges"`
+	blob_source_files *string
+}
+
+// SourceFileEdges holds the relations/edges for other nodes in the graph.
+type SourceFileEdges struct {
+	// Blob holds the value of the blob edge.
+	Blob *Blob `json:"blob,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BlobOrErr returns the Blob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SourceFileEdges) BlobOrErr() (*Blob, error) {
+	if e.loadedTypes[0] {
+		if e.Blob == nil {
+			// The edge blob was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: blob.Label}
+		}
+		return e.Blob, nil
+	}
+	return nil, &NotLoadedError{edge: "blob"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SourceFile) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sourcefile.FieldID, sourcefile.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case sourcefile.FieldPath:
+			values[i] = new(sql.NullString)
+		case sourcefile.FieldSubmissionID:
+			values[i] = new(uuid.UUID)
+		case sourcefile.ForeignKeys[0]: // blob_source_files
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SourceFile", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SourceFile fields.
+func (sf *SourceFile) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sourcefile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sf.ID = int(value.Int64)
+		case sourcefile.FieldSubmissionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value != nil {
+				sf.SubmissionID = *value
+			}
+		case sourcefile.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				sf.Path = value.String
+			}
+		case sourcefile.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				sf.Size = value.Int64
+			}
+		case sourcefile.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_source_files", values[i])
+			} else if value.Valid {
+				sf.blob_source_files = new(string)
+				*sf.blob_source_files = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryBlob queries the "blob" edge of the SourceFile entity.
+func (sf *SourceFile) QueryBlob() *BlobQuery {
+	return (&SourceFileClient{config: sf.config}).QueryBlob(sf)
+}
+
+// Update returns a builder for updating this SourceFile.
+// Note that you need to call SourceFile.Unwrap() before calling this method if this SourceFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sf *SourceFile) Update() *SourceFileUpdateOne {
+	return (&SourceFileClient{config: sf.config}).UpdateOne(sf)
+}
+
+// Unwrap unwraps the SourceFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sf *SourceFile) Unwrap() *SourceFile {
+	tx, ok := sf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SourceFile is not a transactional entity")
+	}
+	sf.config.driver = tx.drv
+	return sf
+}
+
+// String implements the fmt.Stringer.
+func (sf *SourceFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("SourceFile(")
+	builder.WriteString(fmt.Sprintf("id=%v", sf.ID))
+	builder.WriteString(", submission_id=")
+	builder.WriteString(fmt.Sprintf("%v", sf.SubmissionID))
+	builder.WriteString(", path=")
+	builder.WriteString(sf.Path)
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", sf.Size))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SourceFiles is a parsable slice of SourceFile.
+type SourceFiles []*SourceFile
+
+func (sf SourceFiles) config(cfg config) {
+	for _i := range sf {
+		sf[_i].config = cfg
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by entc, DO NOT EDIT.
+
+package sourcefile
+
+const (
+	// Label holds the string label denoting the sourcefile type in the database.
+	Label = "source_file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// EdgeBlob holds the string denoting the blob edge name in mutations.
+	EdgeBlob = "blob"
+	// Table holds the table name of the sourcefile in the database.
+	Table = "source_files"
+	// BlobTable is the table that holds the blob relation/edge.
+	BlobTable = "source_files"
+	// BlobInverseTable is the table name for the Blob entity.
+	// It exists in this package in order to avoid circular dependency with the "blob" package.
+	BlobInverseTable = "blobs"
+	// BlobColumn is the table column denoting the blob relation/edge.
+	BlobColumn = "blob_source_files"
+)
+
+// Columns holds all SQL columns for sourcefile fields.
+var Columns = []string{
+	FieldID,
+	FieldSubmissionID,
+	FieldPath,
+	FieldSize,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "source_files"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"blob_source_files",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,690 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// SourceFileCreate is the builder for creating a SourceFile entity.
+type SourceFileCreate struct {
+	config
+	mutation *SourceFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (sfc *SourceFileCreate) SetSubmissionID(u uuid.UUID) *SourceFileCreate {
+	sfc.mutation.SetSubmissionID(u)
+	return sfc
+}
+
+// SetPath sets the "path" field.
+func (sfc *SourceFileCreate) SetPath(s string) *SourceFileCreate {
+	sfc.mutation.SetPath(s)
+	return sfc
+}
+
+// SetSize sets the "size" field.
+func (sfc *SourceFileCreate) SetSize(i int64) *SourceFileCreate {
+	sfc.mutation.SetSize(i)
+	return sfc
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (sfc *SourceFileCreate) SetBlobID(id string) *SourceFileCreate {
+	sfc.mutation.SetBlobID(id)
+	return sfc
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (sfc *SourceFileCreate) SetBlob(b *Blob) *SourceFileCreate {
+	return sfc.SetBlobID(b.ID)
+}
+
+// Mutation returns the SourceFileMutation object of the builder.
+func (sfc *SourceFileCreate) Mutation() *SourceFileMutation {
+	return sfc.mutation
+}
+
+// Save creates the SourceFile in the database.
+func (sfc *SourceFileCreate) Save(ctx context.Context) (*SourceFile, error) {
+	var (
+		err  error
+		node *SourceFile
+	)
+	if len(sfc.hooks) == 0 {
+		if err = sfc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sfc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SourceFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sfc.check(); err != nil {
+				return nil, err
+			}
+			sfc.mutation = mutation
+			if node, err = sfc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sfc.hooks) - 1; i >= 0; i-- {
+			if sfc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sfc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sfc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sfc *SourceFileCreate) SaveX(ctx context.Context) *SourceFile {
+	v, err := sfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sfc *SourceFileCreate) Exec(ctx context.Context) error {
+	_, err := sfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sfc *SourceFileCreate) ExecX(ctx context.Context) {
+	if err := sfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sfc *SourceFileCreate) check() error {
+	if _, ok := sfc.mutation.SubmissionID(); !ok {
+		return &ValidationError{Name: "submission_id", err: errors.New(`ent: missing required field "SourceFile.submission_id"`)}
+	}
+	if _, ok := sfc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "SourceFile.path"`)}
+	}
+	if _, ok := sfc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "SourceFile.size"`)}
+	}
+	if _, ok := sfc.mutation.BlobID(); !ok {
+		return &ValidationError{Name: "blob", err: errors.New(`ent: missing required edge "SourceFile.blob"`)}
+	}
+	return nil
+}
+
+func (sfc *SourceFileCreate) sqlSave(ctx context.Context) (*SourceFile, error) {
+	_node, _spec := sfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sfc *SourceFileCreate) createSpec() (*SourceFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SourceFile{config: sfc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sourcefile.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sourcefile.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = sfc.conflict
+	if value, ok := sfc.mutation.SubmissionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: sourcefile.FieldSubmissionID,
+		})
+		_node.SubmissionID = value
+	}
+	if value, ok := sfc.mutation.Path(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sourcefile.FieldPath,
+		})
+		_node.Path = value
+	}
+	if value, ok := sfc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: sourcefile.FieldSize,
+		})
+		_node.Size = value
+	}
+	if nodes := sfc.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcefile.BlobTable,
+			Columns: []string{sourcefile.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blob_source_files = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SourceFile.Create().
+//		SetSubmissionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceFileUpsert) {
+//			SetSubmissionID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (sfc *SourceFileCreate) OnConflict(opts ...sql.ConflictOption) *SourceFileUpsertOne {
+	sfc.conflict = opts
+	return &SourceFileUpsertOne{
+		create: sfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SourceFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (sfc *SourceFileCreate) OnConflictColumns(columns ...string) *SourceFileUpsertOne {
+	sfc.conflict = append(sfc.conflict, sql.ConflictColumns(columns...))
+	return &SourceFileUpsertOne{
+		create: sfc,
+	}
+}
+
+type (
+	// SourceFileUpsertOne is the builder for "upsert"-ing
+	//  one SourceFile node.
+	SourceFileUpsertOne struct {
+		create *SourceFileCreate
+	}
+
+	// SourceFileUpsert is the "OnConflict" setter.
+	SourceFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *SourceFileUpsert) SetSubmissionID(v uuid.UUID) *SourceFileUpsert {
+	u.Set(sourcefile.FieldSubmissionID, v)
+	return u
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *SourceFileUpsert) UpdateSubmissionID() *SourceFileUpsert {
+	u.SetExcluded(sourcefile.FieldSubmissionID)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *SourceFileUpsert) SetPath(v string) *SourceFileUpsert {
+	u.Set(sourcefile.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SourceFileUpsert) UpdatePath() *SourceFileUpsert {
+	u.SetExcluded(sourcefile.FieldPath)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *SourceFileUpsert) SetSize(v int64) *SourceFileUpsert {
+	u.Set(sourcefile.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SourceFileUpsert) UpdateSize() *SourceFileUpsert {
+	u.SetExcluded(sourcefile.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *SourceFileUpsert) AddSize(v int64) *SourceFileUpsert {
+	u.Add(sourcefile.FieldSize, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SourceFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *SourceFileUpsertOne) UpdateNewValues() *SourceFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.SubmissionID(); exists {
+			s.SetIgnore(sourcefile.FieldSubmissionID)
+		}
+		if _, exists := u.create.mutation.Path(); exists {
+			s.SetIgnore(sourcefile.FieldPath)
+		}
+		if _, exists := u.create.mutation.Size(); exists {
+			s.SetIgnore(sourcefile.FieldSize)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.SourceFile.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *SourceFileUpsertOne) Ignore() *SourceFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceFileUpsertOne) DoNothing() *SourceFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceFileCreate.OnConflict
+// documentation for more info.
+func (u *SourceFileUpsertOne) Update(set func(*SourceFileUpsert)) *SourceFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *SourceFileUpsertOne) SetSubmissionID(v uuid.UUID) *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetSubmissionID(v)
+	})
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *SourceFileUpsertOne) UpdateSubmissionID() *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdateSubmissionID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *SourceFileUpsertOne) SetPath(v string) *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SourceFileUpsertOne) UpdatePath() *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *SourceFileUpsertOne) SetSize(v int64) *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *SourceFileUpsertOne) AddSize(v int64) *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SourceFileUpsertOne) UpdateSize() *SourceFileUpsertOne {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SourceFileUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SourceFileUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SourceFileCreateBulk is the builder for creating many SourceFile entities in bulk.
+type SourceFileCreateBulk struct {
+	config
+	builders []*SourceFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SourceFile entities in the database.
+func (sfcb *SourceFileCreateBulk) Save(ctx context.Context) ([]*SourceFile, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sfcb.builders))
+	nodes := make([]*SourceFile, len(sfcb.builders))
+	mutators := make([]Mutator, len(sfcb.builders))
+	for i := range sfcb.builders {
+		func(i int, root context.Context) {
+			builder := sfcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SourceFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sfcb *SourceFileCreateBulk) SaveX(ctx context.Context) []*SourceFile {
+	v, err := sfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sfcb *SourceFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := sfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sfcb *SourceFileCreateBulk) ExecX(ctx context.Context) {
+	if err := sfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SourceFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SourceFileUpsert) {
+//			SetSubmissionID(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (sfcb *SourceFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *SourceFileUpsertBulk {
+	sfcb.conflict = opts
+	return &SourceFileUpsertBulk{
+		create: sfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SourceFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (sfcb *SourceFileCreateBulk) OnConflictColumns(columns ...string) *SourceFileUpsertBulk {
+	sfcb.conflict = append(sfcb.conflict, sql.ConflictColumns(columns...))
+	return &SourceFileUpsertBulk{
+		create: sfcb,
+	}
+}
+
+// SourceFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of SourceFile nodes.
+type SourceFileUpsertBulk struct {
+	create *SourceFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SourceFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *SourceFileUpsertBulk) UpdateNewValues() *SourceFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.SubmissionID(); exists {
+				s.SetIgnore(sourcefile.FieldSubmissionID)
+			}
+			if _, exists := b.mutation.Path(); exists {
+				s.SetIgnore(sourcefile.FieldPath)
+			}
+			if _, exists := b.mutation.Size(); exists {
+				s.SetIgnore(sourcefile.FieldSize)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SourceFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *SourceFileUpsertBulk) Ignore() *SourceFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SourceFileUpsertBulk) DoNothing() *SourceFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SourceFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *SourceFileUpsertBulk) Update(set func(*SourceFileUpsert)) *SourceFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SourceFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (u *SourceFileUpsertBulk) SetSubmissionID(v uuid.UUID) *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetSubmissionID(v)
+	})
+}
+
+// UpdateSubmissionID sets the "submission_id" field to the value that was provided on create.
+func (u *SourceFileUpsertBulk) UpdateSubmissionID() *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdateSubmissionID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *SourceFileUpsertBulk) SetPath(v string) *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *SourceFileUpsertBulk) UpdatePath() *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *SourceFileUpsertBulk) SetSize(v int64) *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *SourceFileUpsertBulk) AddSize(v int64) *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *SourceFileUpsertBulk) UpdateSize() *SourceFileUpsertBulk {
+	return u.Update(func(s *SourceFileUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// Exec executes the query.
+func (u *SourceFileUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SourceFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SourceFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SourceFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1022 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// SourceFileQuery is the builder for querying SourceFile entities.
+type SourceFileQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SourceFile
+	// eager-loading edges.
+	withBlob  *BlobQuery
+	withFKs   bool
+	modifiers []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SourceFileQuery builder.
+func (sfq *SourceFileQuery) Where(ps ...predicate.SourceFile) *SourceFileQuery {
+	sfq.predicates = append(sfq.predicates, ps...)
+	return sfq
+}
+
+// Limit adds a limit step to the query.
+func (sfq *SourceFileQuery) Limit(limit int) *SourceFileQuery {
+	sfq.limit = &limit
+	return sfq
+}
+
+// Offset adds an offset step to the query.
+func (sfq *SourceFileQuery) Offset(offset int) *SourceFileQuery {
+	sfq.offset = &offset
+	return sfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sfq *SourceFileQuery) Unique(unique bool) *SourceFileQuery {
+	sfq.unique = &unique
+	return sfq
+}
+
+// Order adds an order step to the query.
+func (sfq *SourceFileQuery) Order(o ...OrderFunc) *SourceFileQuery {
+	sfq.order = append(sfq.order, o...)
+	return sfq
+}
+
+// QueryBlob chains the current query on the "blob" edge.
+func (sfq *SourceFileQuery) QueryBlob() *BlobQuery {
+	query := &BlobQuery{config: sfq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcefile.Table, sourcefile.FieldID, selector),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sourcefile.BlobTable, sourcefile.BlobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SourceFile entity from the query.
+// Returns a *NotFoundError when no SourceFile was found.
+func (sfq *SourceFileQuery) First(ctx context.Context) (*SourceFile, error) {
+	nodes, err := sfq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sourcefile.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sfq *SourceFileQuery) FirstX(ctx context.Context) *SourceFile {
+	node, err := sfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SourceFile ID from the query.
+// Returns a *NotFoundError when no SourceFile ID was found.
+func (sfq *SourceFileQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sfq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sourcefile.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sfq *SourceFileQuery) FirstIDX(ctx context.Context) int {
+	id, err := sfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SourceFile entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SourceFile entity is found.
+// Returns a *NotFoundError when no SourceFile entities are found.
+func (sfq *SourceFileQuery) Only(ctx context.Context) (*SourceFile, error) {
+	nodes, err := sfq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sourcefile.Label}
+	default:
+		return nil, &NotSingularError{sourcefile.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sfq *SourceFileQuery) OnlyX(ctx context.Context) *SourceFile {
+	node, err := sfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SourceFile ID in the query.
+// Returns a *NotSingularError when more than one SourceFile ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sfq *SourceFileQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sfq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = &NotSingularError{sourcefile.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sfq *SourceFileQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SourceFiles.
+func (sfq *SourceFileQuery) All(ctx context.Context) ([]*SourceFile, error) {
+	if err := sfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return sfq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sfq *SourceFileQuery) AllX(ctx context.Context) []*SourceFile {
+	nodes, err := sfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SourceFile IDs.
+func (sfq *SourceFileQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := sfq.Select(sourcefile.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sfq *SourceFileQuery) IDsX(ctx context.Context) []int {
+	ids, err := sfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sfq *SourceFileQuery) Count(ctx context.Context) (int, error) {
+	if err := sfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return sfq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sfq *SourceFileQuery) CountX(ctx context.Context) int {
+	count, err := sfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sfq *SourceFileQuery) Exist(ctx context.Context) (bool, error) {
+	if err := sfq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return sfq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sfq *SourceFileQuery) ExistX(ctx context.Context) bool {
+	exist, err := sfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SourceFileQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sfq *SourceFileQuery) Clone() *SourceFileQuery {
+	if sfq == nil {
+		return nil
+	}
+	return &SourceFileQuery{
+		config:     sfq.config,
+		limit:      sfq.limit,
+		offset:     sfq.offset,
+		order:      append([]OrderFunc{}, sfq.order...),
+		predicates: append([]predicate.SourceFile{}, sfq.predicates...),
+		withBlob:   sfq.withBlob.Clone(),
+		// clone intermediate query.
+		sql:    sfq.sql.Clone(),
+		path:   sfq.path,
+		unique: sfq.unique,
+	}
+}
+
+// WithBlob tells the query-builder to eager-load the nodes that are connected to
+// the "blob" edge. The optional arguments are used to configure the query builder of the edge.
+func (sfq *SourceFileQuery) WithBlob(opts ...func(*BlobQuery)) *SourceFileQuery {
+	query := &BlobQuery{config: sfq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	sfq.withBlob = query
+	return sfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SubmissionID uuid.UUID `json:"submission_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SourceFile.Query().
+//		GroupBy(sourcefile.FieldSubmissionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (sfq *SourceFileQuery) GroupBy(field string, fields ...string) *SourceFileGroupBy {
+	group := &SourceFileGroupBy{config: sfq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sfq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SubmissionID uuid.UUID `json:"submission_id,omitempty"`
+//	}
+//
+//	client.SourceFile.Query().
+//		Select(sourcefile.FieldSubmissionID).
+//		Scan(ctx, &v)
+//
+func (sfq *SourceFileQuery) Select(fields ...string) *SourceFileSelect {
+	sfq.fields = append(sfq.fields, fields...)
+	return &SourceFileSelect{SourceFileQuery: sfq}
+}
+
+func (sfq *SourceFileQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range sfq.fields {
+		if !sourcefile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sfq.path != nil {
+		prev, err := sfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sfq.sql = prev
+	}
+	return nil
+}
+
+func (sfq *SourceFileQuery) sqlAll(ctx context.Context) ([]*SourceFile, error) {
+	var (
+		nodes       = []*SourceFile{}
+		withFKs     = sfq.withFKs
+		_spec       = sfq.querySpec()
+		loadedTypes = [1]bool{
+			sfq.withBlob != nil,
+		}
+	)
+	if sfq.withBlob != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcefile.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &SourceFile{config: sfq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sfq.modifiers) > 0 {
+		_spec.Modifiers = sfq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, sfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := sfq.withBlob; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*SourceFile)
+		for i := range nodes {
+			if nodes[i].blob_source_files == nil {
+				continue
+			}
+			fk := *nodes[i].blob_source_files
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(blob.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "blob_source_files" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Blob = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (sfq *SourceFileQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sfq.querySpec()
+	if len(sfq.modifiers) > 0 {
+		_spec.Modifiers = sfq.modifiers
+	}
+	_spec.Node.Columns = sfq.fields
+	if len(sfq.fields) > 0 {
+		_spec.Unique = sfq.unique != nil && *sfq.unique
+	}
+	return sqlgraph.CountNodes(ctx, sfq.driver, _spec)
+}
+
+func (sfq *SourceFileQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := sfq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (sfq *SourceFileQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sourcefile.Table,
+			Columns: sourcefile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sourcefile.FieldID,
+			},
+		},
+		From:   sfq.sql,
+		Unique: true,
+	}
+	if unique := sfq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := sfq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcefile.FieldID)
+		for i := range fields {
+			if fields[i] != sourcefile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sfq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sfq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sfq *SourceFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sfq.driver.Dialect())
+	t1 := builder.Table(sourcefile.Table)
+	columns := sfq.fields
+	if len(columns) == 0 {
+		columns = sourcefile.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sfq.sql != nil {
+		selector = sfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sfq.unique != nil && *sfq.unique {
+		selector.Distinct()
+	}
+	for _, m := range sfq.modifiers {
+		m(selector)
+	}
+	for _, p := range sfq.predicates {
+		p(selector)
+	}
+	for _, p := range sfq.order {
+		p(selector)
+	}
+	if offset := sfq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sfq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sfq *SourceFileQuery) Modify(modifiers ...func(s *sql.Selector)) *SourceFileSelect {
+	sfq.modifiers = append(sfq.modifiers, modifiers...)
+	return sfq.Select()
+}
+
+// SourceFileGroupBy is the group-by builder for SourceFile entities.
+type SourceFileGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sfgb *SourceFileGroupBy) Aggregate(fns ...AggregateFunc) *SourceFileGroupBy {
+	sfgb.fns = append(sfgb.fns, fns...)
+	return sfgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sfgb *SourceFileGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sfgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sfgb.sql = query
+	return sfgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := sfgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(sfgb.fields) > 1 {
+		return nil, errors.New("ent: SourceFileGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := sfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) StringsX(ctx context.Context) []string {
+	v, err := sfgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sfgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) StringX(ctx context.Context) string {
+	v, err := sfgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(sfgb.fields) > 1 {
+		return nil, errors.New("ent: SourceFileGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := sfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) IntsX(ctx context.Context) []int {
+	v, err := sfgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sfgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) IntX(ctx context.Context) int {
+	v, err := sfgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sfgb.fields) > 1 {
+		return nil, errors.New("ent: SourceFileGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := sfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := sfgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sfgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := sfgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(sfgb.fields) > 1 {
+		return nil, errors.New("ent: SourceFileGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := sfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := sfgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sfgb *SourceFileGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sfgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sfgb *SourceFileGroupBy) BoolX(ctx context.Context) bool {
+	v, err := sfgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sfgb *SourceFileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sfgb.fields {
+		if !sourcefile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sfgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sfgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sfgb *SourceFileGroupBy) sqlQuery() *sql.Selector {
+	selector := sfgb.sql.Select()
+	aggregation := make([]string, 0, len(sfgb.fns))
+	for _, fn := range sfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sfgb.fields)+len(sfgb.fns))
+		for _, f := range sfgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sfgb.fields...)...)
+}
+
+// SourceFileSelect is the builder for selecting fields of SourceFile entities.
+type SourceFileSelect struct {
+	*SourceFileQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sfs *SourceFileSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sfs.sql = sfs.SourceFileQuery.sqlQuery(ctx)
+	return sfs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sfs *SourceFileSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sfs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sfs.fields) > 1 {
+		return nil, errors.New("ent: SourceFileSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sfs *SourceFileSelect) StringsX(ctx context.Context) []string {
+	v, err := sfs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sfs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sfs *SourceFileSelect) StringX(ctx context.Context) string {
+	v, err := sfs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sfs.fields) > 1 {
+		return nil, errors.New("ent: SourceFileSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sfs *SourceFileSelect) IntsX(ctx context.Context) []int {
+	v, err := sfs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sfs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sfs *SourceFileSelect) IntX(ctx context.Context) int {
+	v, err := sfs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sfs.fields) > 1 {
+		return nil, errors.New("ent: SourceFileSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sfs *SourceFileSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sfs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sfs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sfs *SourceFileSelect) Float64X(ctx context.Context) float64 {
+	v, err := sfs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sfs.fields) > 1 {
+		return nil, errors.New("ent: SourceFileSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sfs *SourceFileSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sfs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (sfs *SourceFileSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sfs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{sourcefile.Label}
+	default:
+		err = fmt.Errorf("ent: SourceFileSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sfs *SourceFileSelect) BoolX(ctx context.Context) bool {
+	v, err := sfs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sfs *SourceFileSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sfs.sql.Query()
+	if err := sfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sfs *SourceFileSelect) Modify(modifiers ...func(s *sql.Selector)) *SourceFileSelect {
+	sfs.modifiers = append(sfs.modifiers, modifiers...)
+	return sfs
+}
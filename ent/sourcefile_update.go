@@ -0,0 +1,372 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// SourceFileUpdate is the builder for updating SourceFile entities.
+type SourceFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceFileMutation
+}
+
+// Where appends a list predicates to the SourceFileUpdate builder.
+func (sfu *SourceFileUpdate) Where(ps ...predicate.SourceFile) *SourceFileUpdate {
+	sfu.mutation.Where(ps...)
+	return sfu
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (sfu *SourceFileUpdate) SetBlobID(id string) *SourceFileUpdate {
+	sfu.mutation.SetBlobID(id)
+	return sfu
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (sfu *SourceFileUpdate) SetBlob(b *Blob) *SourceFileUpdate {
+	return sfu.SetBlobID(b.ID)
+}
+
+// Mutation returns the SourceFileMutation object of the builder.
+func (sfu *SourceFileUpdate) Mutation() *SourceFileMutation {
+	return sfu.mutation
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (sfu *SourceFileUpdate) ClearBlob() *SourceFileUpdate {
+	sfu.mutation.ClearBlob()
+	return sfu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sfu *SourceFileUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sfu.hooks) == 0 {
+		if err = sfu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = sfu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SourceFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sfu.check(); err != nil {
+				return 0, err
+			}
+			sfu.mutation = mutation
+			affected, err = sfu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sfu.hooks) - 1; i >= 0; i-- {
+			if sfu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sfu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sfu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sfu *SourceFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := sfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sfu *SourceFileUpdate) Exec(ctx context.Context) error {
+	_, err := sfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sfu *SourceFileUpdate) ExecX(ctx context.Context) {
+	if err := sfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sfu *SourceFileUpdate) check() error {
+	if _, ok := sfu.mutation.BlobID(); sfu.mutation.BlobCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SourceFile.blob"`)
+	}
+	return nil
+}
+
+func (sfu *SourceFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sourcefile.Table,
+			Columns: sourcefile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sourcefile.FieldID,
+			},
+		},
+	}
+	if ps := sfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sfu.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcefile.BlobTable,
+			Columns: []string{sourcefile.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sfu.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcefile.BlobTable,
+			Columns: []string{sourcefile.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SourceFileUpdateOne is the builder for updating a single SourceFile entity.
+type SourceFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceFileMutation
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (sfuo *SourceFileUpdateOne) SetBlobID(id string) *SourceFileUpdateOne {
+	sfuo.mutation.SetBlobID(id)
+	return sfuo
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (sfuo *SourceFileUpdateOne) SetBlob(b *Blob) *SourceFileUpdateOne {
+	return sfuo.SetBlobID(b.ID)
+}
+
+// Mutation returns the SourceFileMutation object of the builder.
+func (sfuo *SourceFileUpdateOne) Mutation() *SourceFileMutation {
+	return sfuo.mutation
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (sfuo *SourceFileUpdateOne) ClearBlob() *SourceFileUpdateOne {
+	sfuo.mutation.ClearBlob()
+	return sfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sfuo *SourceFileUpdateOne) Select(field string, fields ...string) *SourceFileUpdateOne {
+	sfuo.fields = append([]string{field}, fields...)
+	return sfuo
+}
+
+// Save executes the query and returns the updated SourceFile entity.
+func (sfuo *SourceFileUpdateOne) Save(ctx context.Context) (*SourceFile, error) {
+	var (
+		err  error
+		node *SourceFile
+	)
+	if len(sfuo.hooks) == 0 {
+		if err = sfuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = sfuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SourceFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sfuo.check(); err != nil {
+				return nil, err
+			}
+			sfuo.mutation = mutation
+			node, err = sfuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sfuo.hooks) - 1; i >= 0; i-- {
+			if sfuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sfuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sfuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sfuo *SourceFileUpdateOne) SaveX(ctx context.Context) *SourceFile {
+	node, err := sfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sfuo *SourceFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := sfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sfuo *SourceFileUpdateOne) ExecX(ctx context.Context) {
+	if err := sfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sfuo *SourceFileUpdateOne) check() error {
+	if _, ok := sfuo.mutation.BlobID(); sfuo.mutation.BlobCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SourceFile.blob"`)
+	}
+	return nil
+}
+
+func (sfuo *SourceFileUpdateOne) sqlSave(ctx context.Context) (_node *SourceFile, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sourcefile.Table,
+			Columns: sourcefile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: sourcefile.FieldID,
+			},
+		},
+	}
+	id, ok := sfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SourceFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcefile.FieldID)
+		for _, f := range fields {
+			if !sourcefile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sourcefile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sfuo.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcefile.BlobTable,
+			Columns: []string{sourcefile.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sfuo.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sourcefile.BlobTable,
+			Columns: []string{sourcefile.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SourceFile{config: sfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -44,19 +44,42 @@ type Submission struct {
 	ProcessingStartedAt time.Time `json:"processing_started_at,omitempty"`
 	// ProcessingFinishedAt holds the value of the "processing_finished_at" field.
 	ProcessingFinishedAt time.Time `json:"processing_finished_at,omitempty"`
+	// QueueLane holds the value of the "queue_lane" field.
+	QueueLane string `json:"queue_lane,omitempty"`
+	// HasMapping holds the value of the "has_mapping" field.
+	HasMapping bool `json:"has_mapping,omitempty"`
+	// InputHash holds the value of the "input_hash" field.
+	InputHash string `json:"input_hash,omitempty"`
+	// TranspilerVersion holds the value of the "transpiler_version" field.
+	TranspilerVersion string `json:"transpiler_version,omitempty"`
+	// CacheHit holds the value of the "cache_hit" field.
+	CacheHit bool `json:"cache_hit,omitempty"`
+	// ResultsEncrypted holds the value of the "results_encrypted" field.
+	ResultsEncrypted bool `json:"results_encrypted,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RetentionWarnedAt holds the value of the "retention_warned_at" field.
+	RetentionWarnedAt *time.Time `json:"retention_warned_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SubmissionQuery when eager-loading is set.
-	Edges            SubmissionEdges `json:"edges"`
-	user_submissions *uuid.UUID
+	Edges             SubmissionEdges `json:"edges"`
+	submission_reruns *uuid.UUID
+	user_submissions  *uuid.UUID
 }
 
 // SubmissionEdges holds the relations/edges for other nodes in the graph.
 type SubmissionEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Diagnostics holds the value of the diagnostics edge.
+	Diagnostics []*Diagnostic `json:"diagnostics,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Submission `json:"parent,omitempty"`
+	// Reruns holds the value of the reruns edge.
+	Reruns []*Submission `json:"reruns,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -73,22 +96,56 @@ func (e SubmissionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// DiagnosticsOrErr returns the Diagnostics value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubmissionEdges) DiagnosticsOrErr() ([]*Diagnostic, error) {
+	if e.loadedTypes[1] {
+		return e.Diagnostics, nil
+	}
+	return nil, &NotLoadedError{edge: "diagnostics"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubmissionEdges) ParentOrErr() (*Submission, error) {
+	if e.loadedTypes[2] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: submission.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RerunsOrErr returns the Reruns value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubmissionEdges) RerunsOrErr() ([]*Submission, error) {
+	if e.loadedTypes[3] {
+		return e.Reruns, nil
+	}
+	return nil, &NotLoadedError{edge: "reruns"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Submission) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case submission.FieldIsInline, submission.FieldIsPublic:
+		case submission.FieldIsInline, submission.FieldIsPublic, submission.FieldHasMapping, submission.FieldCacheHit, submission.FieldResultsEncrypted:
 			values[i] = new(sql.NullBool)
 		case submission.FieldSubmissionSourceSizeBytes, submission.FieldSubmissionTargetSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case submission.FieldSourceLanguage, submission.FieldTargetLanguage, submission.FieldStatus, submission.FieldReason, submission.FieldGitRepo, submission.FieldShareID:
+		case submission.FieldSourceLanguage, submission.FieldTargetLanguage, submission.FieldStatus, submission.FieldReason, submission.FieldGitRepo, submission.FieldShareID, submission.FieldQueueLane, submission.FieldInputHash, submission.FieldTranspilerVersion:
 			values[i] = new(sql.NullString)
-		case submission.FieldCreatedAt, submission.FieldProcessingStartedAt, submission.FieldProcessingFinishedAt:
+		case submission.FieldCreatedAt, submission.FieldProcessingStartedAt, submission.FieldProcessingFinishedAt, submission.FieldExpiresAt, submission.FieldRetentionWarnedAt:
 			values[i] = new(sql.NullTime)
 		case submission.FieldID:
 			values[i] = new(uuid.UUID)
-		case submission.ForeignKeys[0]: // user_submissions
+		case submission.ForeignKeys[0]: // submission_reruns
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case submission.ForeignKeys[1]: // user_submissions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Submission", columns[i])
@@ -189,7 +246,64 @@ func (s *Submission) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				s.ProcessingFinishedAt = value.Time
 			}
+		case submission.FieldQueueLane:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_lane", values[i])
+			} else if value.Valid {
+				s.QueueLane = value.String
+			}
+		case submission.FieldHasMapping:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_mapping", values[i])
+			} else if value.Valid {
+				s.HasMapping = value.Bool
+			}
+		case submission.FieldInputHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field input_hash", values[i])
+			} else if value.Valid {
+				s.InputHash = value.String
+			}
+		case submission.FieldTranspilerVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transpiler_version", values[i])
+			} else if value.Valid {
+				s.TranspilerVersion = value.String
+			}
+		case submission.FieldCacheHit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_hit", values[i])
+			} else if value.Valid {
+				s.CacheHit = value.Bool
+			}
+		case submission.FieldResultsEncrypted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field results_encrypted", values[i])
+			} else if value.Valid {
+				s.ResultsEncrypted = value.Bool
+			}
+		case submission.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
+		case submission.FieldRetentionWarnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_warned_at", values[i])
+			} else if value.Valid {
+				s.RetentionWarnedAt = new(time.Time)
+				*s.RetentionWarnedAt = value.Time
+			}
 		case submission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_reruns", values[i])
+			} else if value.Valid {
+				s.submission_reruns = new(uuid.UUID)
+				*s.submission_reruns = *value.S.(*uuid.UUID)
+			}
+		case submission.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_submissions", values[i])
 			} else if value.Valid {
@@ -206,6 +320,21 @@ func (s *Submission) QueryUser() *UserQuery {
 	return (&SubmissionClient{config: s.config}).QueryUser(s)
 }
 
+// QueryDiagnostics queries the "diagnostics" edge of the Submission entity.
+func (s *Submission) QueryDiagnostics() *DiagnosticQuery {
+	return (&SubmissionClient{config: s.config}).QueryDiagnostics(s)
+}
+
+// QueryParent queries the "parent" edge of the Submission entity.
+func (s *Submission) QueryParent() *SubmissionQuery {
+	return (&SubmissionClient{config: s.config}).QueryParent(s)
+}
+
+// QueryReruns queries the "reruns" edge of the Submission entity.
+func (s *Submission) QueryReruns() *SubmissionQuery {
+	return (&SubmissionClient{config: s.config}).QueryReruns(s)
+}
+
 // Update returns a builder for updating this Submission.
 // Note that you need to call Submission.Unwrap() before calling this method if this Submission
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -255,6 +384,26 @@ func (s *Submission) String() string {
 	builder.WriteString(s.ProcessingStartedAt.Format(time.ANSIC))
 	builder.WriteString(", processing_finished_at=")
 	builder.WriteString(s.ProcessingFinishedAt.Format(time.ANSIC))
+	builder.WriteString(", queue_lane=")
+	builder.WriteString(s.QueueLane)
+	builder.WriteString(", has_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", s.HasMapping))
+	builder.WriteString(", input_hash=")
+	builder.WriteString(s.InputHash)
+	builder.WriteString(", transpiler_version=")
+	builder.WriteString(s.TranspilerVersion)
+	builder.WriteString(", cache_hit=")
+	builder.WriteString(fmt.Sprintf("%v", s.CacheHit))
+	builder.WriteString(", results_encrypted=")
+	builder.WriteString(fmt.Sprintf("%v", s.ResultsEncrypted))
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := s.RetentionWarnedAt; v != nil {
+		builder.WriteString(", retention_warned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -40,8 +40,30 @@ const (
 	FieldProcessingStartedAt = "processing_started_at"
 	// FieldProcessingFinishedAt holds the string denoting the processing_finished_at field in the database.
 	FieldProcessingFinishedAt = "processing_finished_at"
+	// FieldQueueLane holds the string denoting the queue_lane field in the database.
+	FieldQueueLane = "queue_lane"
+	// FieldHasMapping holds the string denoting the has_mapping field in the database.
+	FieldHasMapping = "has_mapping"
+	// FieldInputHash holds the string denoting the input_hash field in the database.
+	FieldInputHash = "input_hash"
+	// FieldTranspilerVersion holds the string denoting the transpiler_version field in the database.
+	FieldTranspilerVersion = "transpiler_version"
+	// FieldCacheHit holds the string denoting the cache_hit field in the database.
+	FieldCacheHit = "cache_hit"
+	// FieldResultsEncrypted holds the string denoting the results_encrypted field in the database.
+	FieldResultsEncrypted = "results_encrypted"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRetentionWarnedAt holds the string denoting the retention_warned_at field in the database.
+	FieldRetentionWarnedAt = "retention_warned_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeDiagnostics holds the string denoting the diagnostics edge name in mutations.
+	EdgeDiagnostics = "diagnostics"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeReruns holds the string denoting the reruns edge name in mutations.
+	EdgeReruns = "reruns"
 	// Table holds the table name of the submission in the database.
 	Table = "submissions"
 	// UserTable is the table that holds the user relation/edge.
@@ -51,6 +73,21 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_submissions"
+	// DiagnosticsTable is the table that holds the diagnostics relation/edge.
+	DiagnosticsTable = "diagnostics"
+	// DiagnosticsInverseTable is the table name for the Diagnostic entity.
+	// It exists in this package in order to avoid circular dependency with the "diagnostic" package.
+	DiagnosticsInverseTable = "diagnostics"
+	// DiagnosticsColumn is the table column denoting the diagnostics relation/edge.
+	DiagnosticsColumn = "submission_diagnostics"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "submissions"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "submission_reruns"
+	// RerunsTable is the table that holds the reruns relation/edge.
+	RerunsTable = "submissions"
+	// RerunsColumn is the table column denoting the reruns relation/edge.
+	RerunsColumn = "submission_reruns"
 )
 
 // Columns holds all SQL columns for submission fields.
@@ -69,11 +106,20 @@ var Columns = []string{
 	FieldSubmissionTargetSizeBytes,
 	FieldProcessingStartedAt,
 	FieldProcessingFinishedAt,
+	FieldQueueLane,
+	FieldHasMapping,
+	FieldInputHash,
+	FieldTranspilerVersion,
+	FieldCacheHit,
+	FieldResultsEncrypted,
+	FieldExpiresAt,
+	FieldRetentionWarnedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "submissions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"submission_reruns",
 	"user_submissions",
 }
 
@@ -103,6 +149,14 @@ var (
 	DefaultSubmissionSourceSizeBytes int
 	// DefaultSubmissionTargetSizeBytes holds the default value on creation for the "submission_target_size_bytes" field.
 	DefaultSubmissionTargetSizeBytes int
+	// DefaultQueueLane holds the default value on creation for the "queue_lane" field.
+	DefaultQueueLane string
+	// DefaultHasMapping holds the default value on creation for the "has_mapping" field.
+	DefaultHasMapping bool
+	// DefaultCacheHit holds the default value on creation for the "cache_hit" field.
+	DefaultCacheHit bool
+	// DefaultResultsEncrypted holds the default value on creation for the "results_encrypted" field.
+	DefaultResultsEncrypted bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -120,6 +174,7 @@ const (
 	StatusDone       Status = "done"
 	StatusFailed     Status = "failed"
 	StatusCleaned    Status = "cleaned"
+	StatusCancelled  Status = "cancelled"
 )
 
 func (s Status) String() string {
@@ -129,7 +184,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusProcessing, StatusDone, StatusFailed, StatusCleaned:
+	case StatusPending, StatusProcessing, StatusDone, StatusFailed, StatusCleaned, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("submission: invalid enum value for status field: %q", s)
@@ -178,6 +178,62 @@ func ProcessingFinishedAt(v time.Time) predicate.Submission {
 	})
 }
 
+// QueueLane applies equality check predicate on the "queue_lane" field. It's identical to QueueLaneEQ.
+func QueueLane(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueueLane), v))
+	})
+}
+
+// HasMapping applies equality check predicate on the "has_mapping" field. It's identical to HasMappingEQ.
+func HasMapping(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHasMapping), v))
+	})
+}
+
+// InputHash applies equality check predicate on the "input_hash" field. It's identical to InputHashEQ.
+func InputHash(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInputHash), v))
+	})
+}
+
+// TranspilerVersion applies equality check predicate on the "transpiler_version" field. It's identical to TranspilerVersionEQ.
+func TranspilerVersion(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// CacheHit applies equality check predicate on the "cache_hit" field. It's identical to CacheHitEQ.
+func CacheHit(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCacheHit), v))
+	})
+}
+
+// ResultsEncrypted applies equality check predicate on the "results_encrypted" field. It's identical to ResultsEncryptedEQ.
+func ResultsEncrypted(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResultsEncrypted), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// RetentionWarnedAt applies equality check predicate on the "retention_warned_at" field. It's identical to RetentionWarnedAtEQ.
+func RetentionWarnedAt(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
 // SourceLanguageEQ applies the EQ predicate on the "source_language" field.
 func SourceLanguageEQ(v string) predicate.Submission {
 	return predicate.Submission(func(s *sql.Selector) {
@@ -1259,6 +1315,589 @@ func ProcessingFinishedAtNotNil() predicate.Submission {
 	})
 }
 
+// QueueLaneEQ applies the EQ predicate on the "queue_lane" field.
+func QueueLaneEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneNEQ applies the NEQ predicate on the "queue_lane" field.
+func QueueLaneNEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneIn applies the In predicate on the "queue_lane" field.
+func QueueLaneIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQueueLane), v...))
+	})
+}
+
+// QueueLaneNotIn applies the NotIn predicate on the "queue_lane" field.
+func QueueLaneNotIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQueueLane), v...))
+	})
+}
+
+// QueueLaneGT applies the GT predicate on the "queue_lane" field.
+func QueueLaneGT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneGTE applies the GTE predicate on the "queue_lane" field.
+func QueueLaneGTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneLT applies the LT predicate on the "queue_lane" field.
+func QueueLaneLT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneLTE applies the LTE predicate on the "queue_lane" field.
+func QueueLaneLTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneContains applies the Contains predicate on the "queue_lane" field.
+func QueueLaneContains(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneHasPrefix applies the HasPrefix predicate on the "queue_lane" field.
+func QueueLaneHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneHasSuffix applies the HasSuffix predicate on the "queue_lane" field.
+func QueueLaneHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneEqualFold applies the EqualFold predicate on the "queue_lane" field.
+func QueueLaneEqualFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldQueueLane), v))
+	})
+}
+
+// QueueLaneContainsFold applies the ContainsFold predicate on the "queue_lane" field.
+func QueueLaneContainsFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldQueueLane), v))
+	})
+}
+
+// HasMappingEQ applies the EQ predicate on the "has_mapping" field.
+func HasMappingEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHasMapping), v))
+	})
+}
+
+// HasMappingNEQ applies the NEQ predicate on the "has_mapping" field.
+func HasMappingNEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHasMapping), v))
+	})
+}
+
+// InputHashEQ applies the EQ predicate on the "input_hash" field.
+func InputHashEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashNEQ applies the NEQ predicate on the "input_hash" field.
+func InputHashNEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashIn applies the In predicate on the "input_hash" field.
+func InputHashIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInputHash), v...))
+	})
+}
+
+// InputHashNotIn applies the NotIn predicate on the "input_hash" field.
+func InputHashNotIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInputHash), v...))
+	})
+}
+
+// InputHashGT applies the GT predicate on the "input_hash" field.
+func InputHashGT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashGTE applies the GTE predicate on the "input_hash" field.
+func InputHashGTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashLT applies the LT predicate on the "input_hash" field.
+func InputHashLT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashLTE applies the LTE predicate on the "input_hash" field.
+func InputHashLTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashContains applies the Contains predicate on the "input_hash" field.
+func InputHashContains(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashHasPrefix applies the HasPrefix predicate on the "input_hash" field.
+func InputHashHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashHasSuffix applies the HasSuffix predicate on the "input_hash" field.
+func InputHashHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashIsNil applies the IsNil predicate on the "input_hash" field.
+func InputHashIsNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldInputHash)))
+	})
+}
+
+// InputHashNotNil applies the NotNil predicate on the "input_hash" field.
+func InputHashNotNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldInputHash)))
+	})
+}
+
+// InputHashEqualFold applies the EqualFold predicate on the "input_hash" field.
+func InputHashEqualFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldInputHash), v))
+	})
+}
+
+// InputHashContainsFold applies the ContainsFold predicate on the "input_hash" field.
+func InputHashContainsFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldInputHash), v))
+	})
+}
+
+// TranspilerVersionEQ applies the EQ predicate on the "transpiler_version" field.
+func TranspilerVersionEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionNEQ applies the NEQ predicate on the "transpiler_version" field.
+func TranspilerVersionNEQ(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionIn applies the In predicate on the "transpiler_version" field.
+func TranspilerVersionIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTranspilerVersion), v...))
+	})
+}
+
+// TranspilerVersionNotIn applies the NotIn predicate on the "transpiler_version" field.
+func TranspilerVersionNotIn(vs ...string) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTranspilerVersion), v...))
+	})
+}
+
+// TranspilerVersionGT applies the GT predicate on the "transpiler_version" field.
+func TranspilerVersionGT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionGTE applies the GTE predicate on the "transpiler_version" field.
+func TranspilerVersionGTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionLT applies the LT predicate on the "transpiler_version" field.
+func TranspilerVersionLT(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionLTE applies the LTE predicate on the "transpiler_version" field.
+func TranspilerVersionLTE(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionContains applies the Contains predicate on the "transpiler_version" field.
+func TranspilerVersionContains(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionHasPrefix applies the HasPrefix predicate on the "transpiler_version" field.
+func TranspilerVersionHasPrefix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionHasSuffix applies the HasSuffix predicate on the "transpiler_version" field.
+func TranspilerVersionHasSuffix(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionIsNil applies the IsNil predicate on the "transpiler_version" field.
+func TranspilerVersionIsNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTranspilerVersion)))
+	})
+}
+
+// TranspilerVersionNotNil applies the NotNil predicate on the "transpiler_version" field.
+func TranspilerVersionNotNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTranspilerVersion)))
+	})
+}
+
+// TranspilerVersionEqualFold applies the EqualFold predicate on the "transpiler_version" field.
+func TranspilerVersionEqualFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// TranspilerVersionContainsFold applies the ContainsFold predicate on the "transpiler_version" field.
+func TranspilerVersionContainsFold(v string) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTranspilerVersion), v))
+	})
+}
+
+// CacheHitEQ applies the EQ predicate on the "cache_hit" field.
+func CacheHitEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCacheHit), v))
+	})
+}
+
+// CacheHitNEQ applies the NEQ predicate on the "cache_hit" field.
+func CacheHitNEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCacheHit), v))
+	})
+}
+
+// ResultsEncryptedEQ applies the EQ predicate on the "results_encrypted" field.
+func ResultsEncryptedEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResultsEncrypted), v))
+	})
+}
+
+// ResultsEncryptedNEQ applies the NEQ predicate on the "results_encrypted" field.
+func ResultsEncryptedNEQ(v bool) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResultsEncrypted), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// RetentionWarnedAtEQ applies the EQ predicate on the "retention_warned_at" field.
+func RetentionWarnedAtEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtNEQ applies the NEQ predicate on the "retention_warned_at" field.
+func RetentionWarnedAtNEQ(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtIn applies the In predicate on the "retention_warned_at" field.
+func RetentionWarnedAtIn(vs ...time.Time) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRetentionWarnedAt), v...))
+	})
+}
+
+// RetentionWarnedAtNotIn applies the NotIn predicate on the "retention_warned_at" field.
+func RetentionWarnedAtNotIn(vs ...time.Time) predicate.Submission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Submission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRetentionWarnedAt), v...))
+	})
+}
+
+// RetentionWarnedAtGT applies the GT predicate on the "retention_warned_at" field.
+func RetentionWarnedAtGT(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtGTE applies the GTE predicate on the "retention_warned_at" field.
+func RetentionWarnedAtGTE(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtLT applies the LT predicate on the "retention_warned_at" field.
+func RetentionWarnedAtLT(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtLTE applies the LTE predicate on the "retention_warned_at" field.
+func RetentionWarnedAtLTE(v time.Time) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetentionWarnedAt), v))
+	})
+}
+
+// RetentionWarnedAtIsNil applies the IsNil predicate on the "retention_warned_at" field.
+func RetentionWarnedAtIsNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetentionWarnedAt)))
+	})
+}
+
+// RetentionWarnedAtNotNil applies the NotNil predicate on the "retention_warned_at" field.
+func RetentionWarnedAtNotNil() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetentionWarnedAt)))
+	})
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Submission {
 	return predicate.Submission(func(s *sql.Selector) {
@@ -1287,6 +1926,90 @@ func HasUserWith(preds ...predicate.User) predicate.Submission {
 	})
 }
 
+// HasDiagnostics applies the HasEdge predicate on the "diagnostics" edge.
+func HasDiagnostics() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DiagnosticsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiagnosticsTable, DiagnosticsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiagnosticsWith applies the HasEdge predicate on the "diagnostics" edge with a given conditions (other predicates).
+func HasDiagnosticsWith(preds ...predicate.Diagnostic) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DiagnosticsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiagnosticsTable, DiagnosticsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Submission) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReruns applies the HasEdge predicate on the "reruns" edge.
+func HasReruns() predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RerunsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RerunsTable, RerunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRerunsWith applies the HasEdge predicate on the "reruns" edge with a given conditions (other predicates).
+func HasRerunsWith(preds ...predicate.Submission) predicate.Submission {
+	return predicate.Submission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RerunsTable, RerunsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Submission) predicate.Submission {
 	return predicate.Submission(func(s *sql.Selector) {
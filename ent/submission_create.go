@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
@@ -191,6 +192,118 @@ func (sc *SubmissionCreate) SetNillableProcessingFinishedAt(t *time.Time) *Submi
 	return sc
 }
 
+// SetQueueLane sets the "queue_lane" field.
+func (sc *SubmissionCreate) SetQueueLane(s string) *SubmissionCreate {
+	sc.mutation.SetQueueLane(s)
+	return sc
+}
+
+// SetNillableQueueLane sets the "queue_lane" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableQueueLane(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetQueueLane(*s)
+	}
+	return sc
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (sc *SubmissionCreate) SetHasMapping(b bool) *SubmissionCreate {
+	sc.mutation.SetHasMapping(b)
+	return sc
+}
+
+// SetNillableHasMapping sets the "has_mapping" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableHasMapping(b *bool) *SubmissionCreate {
+	if b != nil {
+		sc.SetHasMapping(*b)
+	}
+	return sc
+}
+
+// SetInputHash sets the "input_hash" field.
+func (sc *SubmissionCreate) SetInputHash(s string) *SubmissionCreate {
+	sc.mutation.SetInputHash(s)
+	return sc
+}
+
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableInputHash(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetInputHash(*s)
+	}
+	return sc
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (sc *SubmissionCreate) SetTranspilerVersion(s string) *SubmissionCreate {
+	sc.mutation.SetTranspilerVersion(s)
+	return sc
+}
+
+// SetNillableTranspilerVersion sets the "transpiler_version" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableTranspilerVersion(s *string) *SubmissionCreate {
+	if s != nil {
+		sc.SetTranspilerVersion(*s)
+	}
+	return sc
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (sc *SubmissionCreate) SetCacheHit(b bool) *SubmissionCreate {
+	sc.mutation.SetCacheHit(b)
+	return sc
+}
+
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableCacheHit(b *bool) *SubmissionCreate {
+	if b != nil {
+		sc.SetCacheHit(*b)
+	}
+	return sc
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (sc *SubmissionCreate) SetResultsEncrypted(b bool) *SubmissionCreate {
+	sc.mutation.SetResultsEncrypted(b)
+	return sc
+}
+
+// SetNillableResultsEncrypted sets the "results_encrypted" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableResultsEncrypted(b *bool) *SubmissionCreate {
+	if b != nil {
+		sc.SetResultsEncrypted(*b)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *SubmissionCreate) SetExpiresAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableExpiresAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (sc *SubmissionCreate) SetRetentionWarnedAt(t time.Time) *SubmissionCreate {
+	sc.mutation.SetRetentionWarnedAt(t)
+	return sc
+}
+
+// SetNillableRetentionWarnedAt sets the "retention_warned_at" field if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableRetentionWarnedAt(t *time.Time) *SubmissionCreate {
+	if t != nil {
+		sc.SetRetentionWarnedAt(*t)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SubmissionCreate) SetID(u uuid.UUID) *SubmissionCreate {
 	sc.mutation.SetID(u)
@@ -216,6 +329,55 @@ func (sc *SubmissionCreate) SetUser(u *User) *SubmissionCreate {
 	return sc.SetUserID(u.ID)
 }
 
+// AddDiagnosticIDs adds the "diagnostics" edge to the Diagnostic entity by IDs.
+func (sc *SubmissionCreate) AddDiagnosticIDs(ids ...uuid.UUID) *SubmissionCreate {
+	sc.mutation.AddDiagnosticIDs(ids...)
+	return sc
+}
+
+// AddDiagnostics adds the "diagnostics" edges to the Diagnostic entity.
+func (sc *SubmissionCreate) AddDiagnostics(d ...*Diagnostic) *SubmissionCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return sc.AddDiagnosticIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Submission entity by ID.
+func (sc *SubmissionCreate) SetParentID(id uuid.UUID) *SubmissionCreate {
+	sc.mutation.SetParentID(id)
+	return sc
+}
+
+// SetNillableParentID sets the "parent" edge to the Submission entity by ID if the given value is not nil.
+func (sc *SubmissionCreate) SetNillableParentID(id *uuid.UUID) *SubmissionCreate {
+	if id != nil {
+		sc = sc.SetParentID(*id)
+	}
+	return sc
+}
+
+// SetParent sets the "parent" edge to the Submission entity.
+func (sc *SubmissionCreate) SetParent(s *Submission) *SubmissionCreate {
+	return sc.SetParentID(s.ID)
+}
+
+// AddRerunIDs adds the "reruns" edge to the Submission entity by IDs.
+func (sc *SubmissionCreate) AddRerunIDs(ids ...uuid.UUID) *SubmissionCreate {
+	sc.mutation.AddRerunIDs(ids...)
+	return sc
+}
+
+// AddReruns adds the "reruns" edges to the Submission entity.
+func (sc *SubmissionCreate) AddReruns(s ...*Submission) *SubmissionCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddRerunIDs(ids...)
+}
+
 // Mutation returns the SubmissionMutation object of the builder.
 func (sc *SubmissionCreate) Mutation() *SubmissionMutation {
 	return sc.mutation
@@ -311,6 +473,22 @@ func (sc *SubmissionCreate) defaults() {
 		v := submission.DefaultSubmissionTargetSizeBytes
 		sc.mutation.SetSubmissionTargetSizeBytes(v)
 	}
+	if _, ok := sc.mutation.QueueLane(); !ok {
+		v := submission.DefaultQueueLane
+		sc.mutation.SetQueueLane(v)
+	}
+	if _, ok := sc.mutation.HasMapping(); !ok {
+		v := submission.DefaultHasMapping
+		sc.mutation.SetHasMapping(v)
+	}
+	if _, ok := sc.mutation.CacheHit(); !ok {
+		v := submission.DefaultCacheHit
+		sc.mutation.SetCacheHit(v)
+	}
+	if _, ok := sc.mutation.ResultsEncrypted(); !ok {
+		v := submission.DefaultResultsEncrypted
+		sc.mutation.SetResultsEncrypted(v)
+	}
 	if _, ok := sc.mutation.ID(); !ok {
 		v := submission.DefaultID()
 		sc.mutation.SetID(v)
@@ -348,6 +526,18 @@ func (sc *SubmissionCreate) check() error {
 	if _, ok := sc.mutation.SubmissionTargetSizeBytes(); !ok {
 		return &ValidationError{Name: "submission_target_size_bytes", err: errors.New(`ent: missing required field "Submission.submission_target_size_bytes"`)}
 	}
+	if _, ok := sc.mutation.QueueLane(); !ok {
+		return &ValidationError{Name: "queue_lane", err: errors.New(`ent: missing required field "Submission.queue_lane"`)}
+	}
+	if _, ok := sc.mutation.HasMapping(); !ok {
+		return &ValidationError{Name: "has_mapping", err: errors.New(`ent: missing required field "Submission.has_mapping"`)}
+	}
+	if _, ok := sc.mutation.CacheHit(); !ok {
+		return &ValidationError{Name: "cache_hit", err: errors.New(`ent: missing required field "Submission.cache_hit"`)}
+	}
+	if _, ok := sc.mutation.ResultsEncrypted(); !ok {
+		return &ValidationError{Name: "results_encrypted", err: errors.New(`ent: missing required field "Submission.results_encrypted"`)}
+	}
 	if _, ok := sc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Submission.user"`)}
 	}
@@ -492,6 +682,70 @@ func (sc *SubmissionCreate) createSpec() (*Submission, *sqlgraph.CreateSpec) {
 		})
 		_node.ProcessingFinishedAt = value
 	}
+	if value, ok := sc.mutation.QueueLane(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldQueueLane,
+		})
+		_node.QueueLane = value
+	}
+	if value, ok := sc.mutation.HasMapping(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldHasMapping,
+		})
+		_node.HasMapping = value
+	}
+	if value, ok := sc.mutation.InputHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldInputHash,
+		})
+		_node.InputHash = value
+	}
+	if value, ok := sc.mutation.TranspilerVersion(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldTranspilerVersion,
+		})
+		_node.TranspilerVersion = value
+	}
+	if value, ok := sc.mutation.CacheHit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldCacheHit,
+		})
+		_node.CacheHit = value
+	}
+	if value, ok := sc.mutation.ResultsEncrypted(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldResultsEncrypted,
+		})
+		_node.ResultsEncrypted = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.RetentionWarnedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldRetentionWarnedAt,
+		})
+		_node.RetentionWarnedAt = &value
+	}
 	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -512,6 +766,64 @@ func (sc *SubmissionCreate) createSpec() (*Submission, *sqlgraph.CreateSpec) {
 		_node.user_submissions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.DiagnosticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   submission.ParentTable,
+			Columns: []string{submission.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.submission_reruns = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RerunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -764,6 +1076,126 @@ func (u *SubmissionUpsert) ClearProcessingFinishedAt() *SubmissionUpsert {
 	return u
 }
 
+// SetQueueLane sets the "queue_lane" field.
+func (u *SubmissionUpsert) SetQueueLane(v string) *SubmissionUpsert {
+	u.Set(submission.FieldQueueLane, v)
+	return u
+}
+
+// UpdateQueueLane sets the "queue_lane" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateQueueLane() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldQueueLane)
+	return u
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (u *SubmissionUpsert) SetHasMapping(v bool) *SubmissionUpsert {
+	u.Set(submission.FieldHasMapping, v)
+	return u
+}
+
+// UpdateHasMapping sets the "has_mapping" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateHasMapping() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldHasMapping)
+	return u
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *SubmissionUpsert) SetInputHash(v string) *SubmissionUpsert {
+	u.Set(submission.FieldInputHash, v)
+	return u
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateInputHash() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldInputHash)
+	return u
+}
+
+// ClearInputHash clears the value of the "input_hash" field.
+func (u *SubmissionUpsert) ClearInputHash() *SubmissionUpsert {
+	u.SetNull(submission.FieldInputHash)
+	return u
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (u *SubmissionUpsert) SetTranspilerVersion(v string) *SubmissionUpsert {
+	u.Set(submission.FieldTranspilerVersion, v)
+	return u
+}
+
+// UpdateTranspilerVersion sets the "transpiler_version" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateTranspilerVersion() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldTranspilerVersion)
+	return u
+}
+
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (u *SubmissionUpsert) ClearTranspilerVersion() *SubmissionUpsert {
+	u.SetNull(submission.FieldTranspilerVersion)
+	return u
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (u *SubmissionUpsert) SetCacheHit(v bool) *SubmissionUpsert {
+	u.Set(submission.FieldCacheHit, v)
+	return u
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateCacheHit() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldCacheHit)
+	return u
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (u *SubmissionUpsert) SetResultsEncrypted(v bool) *SubmissionUpsert {
+	u.Set(submission.FieldResultsEncrypted, v)
+	return u
+}
+
+// UpdateResultsEncrypted sets the "results_encrypted" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateResultsEncrypted() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldResultsEncrypted)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SubmissionUpsert) SetExpiresAt(v time.Time) *SubmissionUpsert {
+	u.Set(submission.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateExpiresAt() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SubmissionUpsert) ClearExpiresAt() *SubmissionUpsert {
+	u.SetNull(submission.FieldExpiresAt)
+	return u
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (u *SubmissionUpsert) SetRetentionWarnedAt(v time.Time) *SubmissionUpsert {
+	u.Set(submission.FieldRetentionWarnedAt, v)
+	return u
+}
+
+// UpdateRetentionWarnedAt sets the "retention_warned_at" field to the value that was provided on create.
+func (u *SubmissionUpsert) UpdateRetentionWarnedAt() *SubmissionUpsert {
+	u.SetExcluded(submission.FieldRetentionWarnedAt)
+	return u
+}
+
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (u *SubmissionUpsert) ClearRetentionWarnedAt() *SubmissionUpsert {
+	u.SetNull(submission.FieldRetentionWarnedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1045,6 +1477,146 @@ func (u *SubmissionUpsertOne) ClearProcessingFinishedAt() *SubmissionUpsertOne {
 	})
 }
 
+// SetQueueLane sets the "queue_lane" field.
+func (u *SubmissionUpsertOne) SetQueueLane(v string) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetQueueLane(v)
+	})
+}
+
+// UpdateQueueLane sets the "queue_lane" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateQueueLane() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateQueueLane()
+	})
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (u *SubmissionUpsertOne) SetHasMapping(v bool) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetHasMapping(v)
+	})
+}
+
+// UpdateHasMapping sets the "has_mapping" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateHasMapping() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateHasMapping()
+	})
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *SubmissionUpsertOne) SetInputHash(v string) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetInputHash(v)
+	})
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateInputHash() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateInputHash()
+	})
+}
+
+// ClearInputHash clears the value of the "input_hash" field.
+func (u *SubmissionUpsertOne) ClearInputHash() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearInputHash()
+	})
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (u *SubmissionUpsertOne) SetTranspilerVersion(v string) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetTranspilerVersion(v)
+	})
+}
+
+// UpdateTranspilerVersion sets the "transpiler_version" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateTranspilerVersion() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateTranspilerVersion()
+	})
+}
+
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (u *SubmissionUpsertOne) ClearTranspilerVersion() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearTranspilerVersion()
+	})
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (u *SubmissionUpsertOne) SetCacheHit(v bool) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetCacheHit(v)
+	})
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateCacheHit() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateCacheHit()
+	})
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (u *SubmissionUpsertOne) SetResultsEncrypted(v bool) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetResultsEncrypted(v)
+	})
+}
+
+// UpdateResultsEncrypted sets the "results_encrypted" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateResultsEncrypted() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateResultsEncrypted()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SubmissionUpsertOne) SetExpiresAt(v time.Time) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateExpiresAt() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SubmissionUpsertOne) ClearExpiresAt() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (u *SubmissionUpsertOne) SetRetentionWarnedAt(v time.Time) *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetRetentionWarnedAt(v)
+	})
+}
+
+// UpdateRetentionWarnedAt sets the "retention_warned_at" field to the value that was provided on create.
+func (u *SubmissionUpsertOne) UpdateRetentionWarnedAt() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateRetentionWarnedAt()
+	})
+}
+
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (u *SubmissionUpsertOne) ClearRetentionWarnedAt() *SubmissionUpsertOne {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearRetentionWarnedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *SubmissionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1492,6 +2064,146 @@ func (u *SubmissionUpsertBulk) ClearProcessingFinishedAt() *SubmissionUpsertBulk
 	})
 }
 
+// SetQueueLane sets the "queue_lane" field.
+func (u *SubmissionUpsertBulk) SetQueueLane(v string) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetQueueLane(v)
+	})
+}
+
+// UpdateQueueLane sets the "queue_lane" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateQueueLane() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateQueueLane()
+	})
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (u *SubmissionUpsertBulk) SetHasMapping(v bool) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetHasMapping(v)
+	})
+}
+
+// UpdateHasMapping sets the "has_mapping" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateHasMapping() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateHasMapping()
+	})
+}
+
+// SetInputHash sets the "input_hash" field.
+func (u *SubmissionUpsertBulk) SetInputHash(v string) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetInputHash(v)
+	})
+}
+
+// UpdateInputHash sets the "input_hash" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateInputHash() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateInputHash()
+	})
+}
+
+// ClearInputHash clears the value of the "input_hash" field.
+func (u *SubmissionUpsertBulk) ClearInputHash() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearInputHash()
+	})
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (u *SubmissionUpsertBulk) SetTranspilerVersion(v string) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetTranspilerVersion(v)
+	})
+}
+
+// UpdateTranspilerVersion sets the "transpiler_version" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateTranspilerVersion() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateTranspilerVersion()
+	})
+}
+
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (u *SubmissionUpsertBulk) ClearTranspilerVersion() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearTranspilerVersion()
+	})
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (u *SubmissionUpsertBulk) SetCacheHit(v bool) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetCacheHit(v)
+	})
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateCacheHit() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateCacheHit()
+	})
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (u *SubmissionUpsertBulk) SetResultsEncrypted(v bool) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetResultsEncrypted(v)
+	})
+}
+
+// UpdateResultsEncrypted sets the "results_encrypted" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateResultsEncrypted() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateResultsEncrypted()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SubmissionUpsertBulk) SetExpiresAt(v time.Time) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateExpiresAt() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SubmissionUpsertBulk) ClearExpiresAt() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (u *SubmissionUpsertBulk) SetRetentionWarnedAt(v time.Time) *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.SetRetentionWarnedAt(v)
+	})
+}
+
+// UpdateRetentionWarnedAt sets the "retention_warned_at" field to the value that was provided on create.
+func (u *SubmissionUpsertBulk) UpdateRetentionWarnedAt() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.UpdateRetentionWarnedAt()
+	})
+}
+
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (u *SubmissionUpsertBulk) ClearRetentionWarnedAt() *SubmissionUpsertBulk {
+	return u.Update(func(s *SubmissionUpsert) {
+		s.ClearRetentionWarnedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *SubmissionUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
 	"github.com/tereus-project/tereus-api/ent/predicate"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/user"
@@ -27,9 +29,12 @@ type SubmissionQuery struct {
 	fields     []string
 	predicates []predicate.Submission
 	// eager-loading edges.
-	withUser  *UserQuery
-	withFKs   bool
-	modifiers []func(s *sql.Selector)
+	withUser        *UserQuery
+	withDiagnostics *DiagnosticQuery
+	withParent      *SubmissionQuery
+	withReruns      *SubmissionQuery
+	withFKs         bool
+	modifiers       []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +93,72 @@ func (sq *SubmissionQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryDiagnostics chains the current query on the "diagnostics" edge.
+func (sq *SubmissionQuery) QueryDiagnostics() *DiagnosticQuery {
+	query := &DiagnosticQuery{config: sq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, selector),
+			sqlgraph.To(diagnostic.Table, diagnostic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, submission.DiagnosticsTable, submission.DiagnosticsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (sq *SubmissionQuery) QueryParent() *SubmissionQuery {
+	query := &SubmissionQuery{config: sq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, selector),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, submission.ParentTable, submission.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReruns chains the current query on the "reruns" edge.
+func (sq *SubmissionQuery) QueryReruns() *SubmissionQuery {
+	query := &SubmissionQuery{config: sq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(submission.Table, submission.FieldID, selector),
+			sqlgraph.To(submission.Table, submission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, submission.RerunsTable, submission.RerunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Submission entity from the query.
 // Returns a *NotFoundError when no Submission was found.
 func (sq *SubmissionQuery) First(ctx context.Context) (*Submission, error) {
@@ -264,12 +335,15 @@ func (sq *SubmissionQuery) Clone() *SubmissionQuery {
 		return nil
 	}
 	return &SubmissionQuery{
-		config:     sq.config,
-		limit:      sq.limit,
-		offset:     sq.offset,
-		order:      append([]OrderFunc{}, sq.order...),
-		predicates: append([]predicate.Submission{}, sq.predicates...),
-		withUser:   sq.withUser.Clone(),
+		config:          sq.config,
+		limit:           sq.limit,
+		offset:          sq.offset,
+		order:           append([]OrderFunc{}, sq.order...),
+		predicates:      append([]predicate.Submission{}, sq.predicates...),
+		withUser:        sq.withUser.Clone(),
+		withDiagnostics: sq.withDiagnostics.Clone(),
+		withParent:      sq.withParent.Clone(),
+		withReruns:      sq.withReruns.Clone(),
 		// clone intermediate query.
 		sql:    sq.sql.Clone(),
 		path:   sq.path,
@@ -288,6 +362,39 @@ func (sq *SubmissionQuery) WithUser(opts ...func(*UserQuery)) *SubmissionQuery {
 	return sq
 }
 
+// WithDiagnostics tells the query-builder to eager-load the nodes that are connected to
+// the "diagnostics" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SubmissionQuery) WithDiagnostics(opts ...func(*DiagnosticQuery)) *SubmissionQuery {
+	query := &DiagnosticQuery{config: sq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withDiagnostics = query
+	return sq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SubmissionQuery) WithParent(opts ...func(*SubmissionQuery)) *SubmissionQuery {
+	query := &SubmissionQuery{config: sq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withParent = query
+	return sq
+}
+
+// WithReruns tells the query-builder to eager-load the nodes that are connected to
+// the "reruns" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SubmissionQuery) WithReruns(opts ...func(*SubmissionQuery)) *SubmissionQuery {
+	query := &SubmissionQuery{config: sq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withReruns = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -354,11 +461,14 @@ func (sq *SubmissionQuery) sqlAll(ctx context.Context) ([]*Submission, error) {
 		nodes       = []*Submission{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			sq.withUser != nil,
+			sq.withDiagnostics != nil,
+			sq.withParent != nil,
+			sq.withReruns != nil,
 		}
 	)
-	if sq.withUser != nil {
+	if sq.withUser != nil || sq.withParent != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -416,6 +526,93 @@ func (sq *SubmissionQuery) sqlAll(ctx context.Context) ([]*Submission, error) {
 		}
 	}
 
+	if query := sq.withDiagnostics; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Submission)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Diagnostics = []*Diagnostic{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Diagnostic(func(s *sql.Selector) {
+			s.Where(sql.InValues(submission.DiagnosticsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.submission_diagnostics
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "submission_diagnostics" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "submission_diagnostics" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Diagnostics = append(node.Edges.Diagnostics, n)
+		}
+	}
+
+	if query := sq.withParent; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*Submission)
+		for i := range nodes {
+			if nodes[i].submission_reruns == nil {
+				continue
+			}
+			fk := *nodes[i].submission_reruns
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(submission.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "submission_reruns" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
+	if query := sq.withReruns; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Submission)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Reruns = []*Submission{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Submission(func(s *sql.Selector) {
+			s.Where(sql.InValues(submission.RerunsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.submission_reruns
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "submission_reruns" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "submission_reruns" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Reruns = append(node.Edges.Reruns, n)
+		}
+	}
+
 	return nodes, nil
 }
 
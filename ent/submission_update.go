@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
 	"github.com/tereus-project/tereus-api/ent/predicate"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/user"
@@ -240,6 +241,142 @@ func (su *SubmissionUpdate) ClearProcessingFinishedAt() *SubmissionUpdate {
 	return su
 }
 
+// SetQueueLane sets the "queue_lane" field.
+func (su *SubmissionUpdate) SetQueueLane(s string) *SubmissionUpdate {
+	su.mutation.SetQueueLane(s)
+	return su
+}
+
+// SetNillableQueueLane sets the "queue_lane" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableQueueLane(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetQueueLane(*s)
+	}
+	return su
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (su *SubmissionUpdate) SetHasMapping(b bool) *SubmissionUpdate {
+	su.mutation.SetHasMapping(b)
+	return su
+}
+
+// SetNillableHasMapping sets the "has_mapping" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableHasMapping(b *bool) *SubmissionUpdate {
+	if b != nil {
+		su.SetHasMapping(*b)
+	}
+	return su
+}
+
+// SetInputHash sets the "input_hash" field.
+func (su *SubmissionUpdate) SetInputHash(s string) *SubmissionUpdate {
+	su.mutation.SetInputHash(s)
+	return su
+}
+
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableInputHash(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetInputHash(*s)
+	}
+	return su
+}
+
+// ClearInputHash clears the value of the "input_hash" field.
+func (su *SubmissionUpdate) ClearInputHash() *SubmissionUpdate {
+	su.mutation.ClearInputHash()
+	return su
+}
+
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (su *SubmissionUpdate) SetTranspilerVersion(s string) *SubmissionUpdate {
+	su.mutation.SetTranspilerVersion(s)
+	return su
+}
+
+// SetNillableTranspilerVersion sets the "transpiler_version" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableTranspilerVersion(s *string) *SubmissionUpdate {
+	if s != nil {
+		su.SetTranspilerVersion(*s)
+	}
+	return su
+}
+
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (su *SubmissionUpdate) ClearTranspilerVersion() *SubmissionUpdate {
+	su.mutation.ClearTranspilerVersion()
+	return su
+}
+
+// SetCacheHit sets the "cache_hit" field.
+func (su *SubmissionUpdate) SetCacheHit(b bool) *SubmissionUpdate {
+	su.mutation.SetCacheHit(b)
+	return su
+}
+
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableCacheHit(b *bool) *SubmissionUpdate {
+	if b != nil {
+		su.SetCacheHit(*b)
+	}
+	return su
+}
+
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (su *SubmissionUpdate) SetResultsEncrypted(b bool) *SubmissionUpdate {
+	su.mutation.SetResultsEncrypted(b)
+	return su
+}
+
+// SetNillableResultsEncrypted sets the "results_encrypted" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableResultsEncrypted(b *bool) *SubmissionUpdate {
+	if b != nil {
+		su.SetResultsEncrypted(*b)
+	}
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *SubmissionUpdate) SetExpiresAt(t time.Time) *SubmissionUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableExpiresAt(t *time.Time) *SubmissionUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *SubmissionUpdate) ClearExpiresAt() *SubmissionUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (su *SubmissionUpdate) SetRetentionWarnedAt(t time.Time) *SubmissionUpdate {
+	su.mutation.SetRetentionWarnedAt(t)
+	return su
+}
+
+// SetNillableRetentionWarnedAt sets the "retention_warned_at" field if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableRetentionWarnedAt(t *time.Time) *SubmissionUpdate {
+	if t != nil {
+		su.SetRetentionWarnedAt(*t)
+	}
+	return su
+}
+
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (su *SubmissionUpdate) ClearRetentionWarnedAt() *SubmissionUpdate {
+	su.mutation.ClearRetentionWarnedAt()
+	return su
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (su *SubmissionUpdate) SetUserID(id uuid.UUID) *SubmissionUpdate {
 	su.mutation.SetUserID(id)
@@ -251,6 +388,55 @@ func (su *SubmissionUpdate) SetUser(u *User) *SubmissionUpdate {
 	return su.SetUserID(u.ID)
 }
 
+// AddDiagnosticIDs adds the "diagnostics" edge to the Diagnostic entity by IDs.
+func (su *SubmissionUpdate) AddDiagnosticIDs(ids ...uuid.UUID) *SubmissionUpdate {
+	su.mutation.AddDiagnosticIDs(ids...)
+	return su
+}
+
+// AddDiagnostics adds the "diagnostics" edges to the Diagnostic entity.
+func (su *SubmissionUpdate) AddDiagnostics(d ...*Diagnostic) *SubmissionUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.AddDiagnosticIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Submission entity by ID.
+func (su *SubmissionUpdate) SetParentID(id uuid.UUID) *SubmissionUpdate {
+	su.mutation.SetParentID(id)
+	return su
+}
+
+// SetNillableParentID sets the "parent" edge to the Submission entity by ID if the given value is not nil.
+func (su *SubmissionUpdate) SetNillableParentID(id *uuid.UUID) *SubmissionUpdate {
+	if id != nil {
+		su = su.SetParentID(*id)
+	}
+	return su
+}
+
+// SetParent sets the "parent" edge to the Submission entity.
+func (su *SubmissionUpdate) SetParent(s *Submission) *SubmissionUpdate {
+	return su.SetParentID(s.ID)
+}
+
+// AddRerunIDs adds the "reruns" edge to the Submission entity by IDs.
+func (su *SubmissionUpdate) AddRerunIDs(ids ...uuid.UUID) *SubmissionUpdate {
+	su.mutation.AddRerunIDs(ids...)
+	return su
+}
+
+// AddReruns adds the "reruns" edges to the Submission entity.
+func (su *SubmissionUpdate) AddReruns(s ...*Submission) *SubmissionUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddRerunIDs(ids...)
+}
+
 // Mutation returns the SubmissionMutation object of the builder.
 func (su *SubmissionUpdate) Mutation() *SubmissionMutation {
 	return su.mutation
@@ -262,6 +448,54 @@ func (su *SubmissionUpdate) ClearUser() *SubmissionUpdate {
 	return su
 }
 
+// ClearDiagnostics clears all "diagnostics" edges to the Diagnostic entity.
+func (su *SubmissionUpdate) ClearDiagnostics() *SubmissionUpdate {
+	su.mutation.ClearDiagnostics()
+	return su
+}
+
+// RemoveDiagnosticIDs removes the "diagnostics" edge to Diagnostic entities by IDs.
+func (su *SubmissionUpdate) RemoveDiagnosticIDs(ids ...uuid.UUID) *SubmissionUpdate {
+	su.mutation.RemoveDiagnosticIDs(ids...)
+	return su
+}
+
+// RemoveDiagnostics removes "diagnostics" edges to Diagnostic entities.
+func (su *SubmissionUpdate) RemoveDiagnostics(d ...*Diagnostic) *SubmissionUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.RemoveDiagnosticIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Submission entity.
+func (su *SubmissionUpdate) ClearParent() *SubmissionUpdate {
+	su.mutation.ClearParent()
+	return su
+}
+
+// ClearReruns clears all "reruns" edges to the Submission entity.
+func (su *SubmissionUpdate) ClearReruns() *SubmissionUpdate {
+	su.mutation.ClearReruns()
+	return su
+}
+
+// RemoveRerunIDs removes the "reruns" edge to Submission entities by IDs.
+func (su *SubmissionUpdate) RemoveRerunIDs(ids ...uuid.UUID) *SubmissionUpdate {
+	su.mutation.RemoveRerunIDs(ids...)
+	return su
+}
+
+// RemoveReruns removes "reruns" edges to Submission entities.
+func (su *SubmissionUpdate) RemoveReruns(s ...*Submission) *SubmissionUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveRerunIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SubmissionUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -488,6 +722,86 @@ func (su *SubmissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: submission.FieldProcessingFinishedAt,
 		})
 	}
+	if value, ok := su.mutation.QueueLane(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldQueueLane,
+		})
+	}
+	if value, ok := su.mutation.HasMapping(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldHasMapping,
+		})
+	}
+	if value, ok := su.mutation.InputHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldInputHash,
+		})
+	}
+	if su.mutation.InputHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: submission.FieldInputHash,
+		})
+	}
+	if value, ok := su.mutation.TranspilerVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldTranspilerVersion,
+		})
+	}
+	if su.mutation.TranspilerVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: submission.FieldTranspilerVersion,
+		})
+	}
+	if value, ok := su.mutation.CacheHit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldCacheHit,
+		})
+	}
+	if value, ok := su.mutation.ResultsEncrypted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldResultsEncrypted,
+		})
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldExpiresAt,
+		})
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: submission.FieldExpiresAt,
+		})
+	}
+	if value, ok := su.mutation.RetentionWarnedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldRetentionWarnedAt,
+		})
+	}
+	if su.mutation.RetentionWarnedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: submission.FieldRetentionWarnedAt,
+		})
+	}
 	if su.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -523,6 +837,149 @@ func (su *SubmissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.DiagnosticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedDiagnosticsIDs(); len(nodes) > 0 && !su.mutation.DiagnosticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.DiagnosticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   submission.ParentTable,
+			Columns: []string{submission.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   submission.ParentTable,
+			Columns: []string{submission.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.RerunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRerunsIDs(); len(nodes) > 0 && !su.mutation.RerunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RerunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{submission.Label}
@@ -650,105 +1107,241 @@ func (suo *SubmissionUpdateOne) SetNillableCreatedAt(t *time.Time) *SubmissionUp
 	return suo
 }
 
-// SetShareID sets the "share_id" field.
-func (suo *SubmissionUpdateOne) SetShareID(s string) *SubmissionUpdateOne {
-	suo.mutation.SetShareID(s)
+// SetShareID sets the "share_id" field.
+func (suo *SubmissionUpdateOne) SetShareID(s string) *SubmissionUpdateOne {
+	suo.mutation.SetShareID(s)
+	return suo
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableShareID(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetShareID(*s)
+	}
+	return suo
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (suo *SubmissionUpdateOne) ClearShareID() *SubmissionUpdateOne {
+	suo.mutation.ClearShareID()
+	return suo
+}
+
+// SetSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field.
+func (suo *SubmissionUpdateOne) SetSubmissionSourceSizeBytes(i int) *SubmissionUpdateOne {
+	suo.mutation.ResetSubmissionSourceSizeBytes()
+	suo.mutation.SetSubmissionSourceSizeBytes(i)
+	return suo
+}
+
+// SetNillableSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableSubmissionSourceSizeBytes(i *int) *SubmissionUpdateOne {
+	if i != nil {
+		suo.SetSubmissionSourceSizeBytes(*i)
+	}
+	return suo
+}
+
+// AddSubmissionSourceSizeBytes adds i to the "submission_source_size_bytes" field.
+func (suo *SubmissionUpdateOne) AddSubmissionSourceSizeBytes(i int) *SubmissionUpdateOne {
+	suo.mutation.AddSubmissionSourceSizeBytes(i)
+	return suo
+}
+
+// SetSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field.
+func (suo *SubmissionUpdateOne) SetSubmissionTargetSizeBytes(i int) *SubmissionUpdateOne {
+	suo.mutation.ResetSubmissionTargetSizeBytes()
+	suo.mutation.SetSubmissionTargetSizeBytes(i)
+	return suo
+}
+
+// SetNillableSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableSubmissionTargetSizeBytes(i *int) *SubmissionUpdateOne {
+	if i != nil {
+		suo.SetSubmissionTargetSizeBytes(*i)
+	}
+	return suo
+}
+
+// AddSubmissionTargetSizeBytes adds i to the "submission_target_size_bytes" field.
+func (suo *SubmissionUpdateOne) AddSubmissionTargetSizeBytes(i int) *SubmissionUpdateOne {
+	suo.mutation.AddSubmissionTargetSizeBytes(i)
+	return suo
+}
+
+// SetProcessingStartedAt sets the "processing_started_at" field.
+func (suo *SubmissionUpdateOne) SetProcessingStartedAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetProcessingStartedAt(t)
+	return suo
+}
+
+// SetNillableProcessingStartedAt sets the "processing_started_at" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableProcessingStartedAt(t *time.Time) *SubmissionUpdateOne {
+	if t != nil {
+		suo.SetProcessingStartedAt(*t)
+	}
+	return suo
+}
+
+// ClearProcessingStartedAt clears the value of the "processing_started_at" field.
+func (suo *SubmissionUpdateOne) ClearProcessingStartedAt() *SubmissionUpdateOne {
+	suo.mutation.ClearProcessingStartedAt()
+	return suo
+}
+
+// SetProcessingFinishedAt sets the "processing_finished_at" field.
+func (suo *SubmissionUpdateOne) SetProcessingFinishedAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetProcessingFinishedAt(t)
+	return suo
+}
+
+// SetNillableProcessingFinishedAt sets the "processing_finished_at" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableProcessingFinishedAt(t *time.Time) *SubmissionUpdateOne {
+	if t != nil {
+		suo.SetProcessingFinishedAt(*t)
+	}
+	return suo
+}
+
+// ClearProcessingFinishedAt clears the value of the "processing_finished_at" field.
+func (suo *SubmissionUpdateOne) ClearProcessingFinishedAt() *SubmissionUpdateOne {
+	suo.mutation.ClearProcessingFinishedAt()
+	return suo
+}
+
+// SetQueueLane sets the "queue_lane" field.
+func (suo *SubmissionUpdateOne) SetQueueLane(s string) *SubmissionUpdateOne {
+	suo.mutation.SetQueueLane(s)
+	return suo
+}
+
+// SetNillableQueueLane sets the "queue_lane" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableQueueLane(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetQueueLane(*s)
+	}
+	return suo
+}
+
+// SetHasMapping sets the "has_mapping" field.
+func (suo *SubmissionUpdateOne) SetHasMapping(b bool) *SubmissionUpdateOne {
+	suo.mutation.SetHasMapping(b)
+	return suo
+}
+
+// SetNillableHasMapping sets the "has_mapping" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableHasMapping(b *bool) *SubmissionUpdateOne {
+	if b != nil {
+		suo.SetHasMapping(*b)
+	}
+	return suo
+}
+
+// SetInputHash sets the "input_hash" field.
+func (suo *SubmissionUpdateOne) SetInputHash(s string) *SubmissionUpdateOne {
+	suo.mutation.SetInputHash(s)
 	return suo
 }
 
-// SetNillableShareID sets the "share_id" field if the given value is not nil.
-func (suo *SubmissionUpdateOne) SetNillableShareID(s *string) *SubmissionUpdateOne {
+// SetNillableInputHash sets the "input_hash" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableInputHash(s *string) *SubmissionUpdateOne {
 	if s != nil {
-		suo.SetShareID(*s)
+		suo.SetInputHash(*s)
 	}
 	return suo
 }
 
-// ClearShareID clears the value of the "share_id" field.
-func (suo *SubmissionUpdateOne) ClearShareID() *SubmissionUpdateOne {
-	suo.mutation.ClearShareID()
+// ClearInputHash clears the value of the "input_hash" field.
+func (suo *SubmissionUpdateOne) ClearInputHash() *SubmissionUpdateOne {
+	suo.mutation.ClearInputHash()
 	return suo
 }
 
-// SetSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field.
-func (suo *SubmissionUpdateOne) SetSubmissionSourceSizeBytes(i int) *SubmissionUpdateOne {
-	suo.mutation.ResetSubmissionSourceSizeBytes()
-	suo.mutation.SetSubmissionSourceSizeBytes(i)
+// SetTranspilerVersion sets the "transpiler_version" field.
+func (suo *SubmissionUpdateOne) SetTranspilerVersion(s string) *SubmissionUpdateOne {
+	suo.mutation.SetTranspilerVersion(s)
 	return suo
 }
 
-// SetNillableSubmissionSourceSizeBytes sets the "submission_source_size_bytes" field if the given value is not nil.
-func (suo *SubmissionUpdateOne) SetNillableSubmissionSourceSizeBytes(i *int) *SubmissionUpdateOne {
-	if i != nil {
-		suo.SetSubmissionSourceSizeBytes(*i)
+// SetNillableTranspilerVersion sets the "transpiler_version" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableTranspilerVersion(s *string) *SubmissionUpdateOne {
+	if s != nil {
+		suo.SetTranspilerVersion(*s)
 	}
 	return suo
 }
 
-// AddSubmissionSourceSizeBytes adds i to the "submission_source_size_bytes" field.
-func (suo *SubmissionUpdateOne) AddSubmissionSourceSizeBytes(i int) *SubmissionUpdateOne {
-	suo.mutation.AddSubmissionSourceSizeBytes(i)
+// ClearTranspilerVersion clears the value of the "transpiler_version" field.
+func (suo *SubmissionUpdateOne) ClearTranspilerVersion() *SubmissionUpdateOne {
+	suo.mutation.ClearTranspilerVersion()
 	return suo
 }
 
-// SetSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field.
-func (suo *SubmissionUpdateOne) SetSubmissionTargetSizeBytes(i int) *SubmissionUpdateOne {
-	suo.mutation.ResetSubmissionTargetSizeBytes()
-	suo.mutation.SetSubmissionTargetSizeBytes(i)
+// SetCacheHit sets the "cache_hit" field.
+func (suo *SubmissionUpdateOne) SetCacheHit(b bool) *SubmissionUpdateOne {
+	suo.mutation.SetCacheHit(b)
 	return suo
 }
 
-// SetNillableSubmissionTargetSizeBytes sets the "submission_target_size_bytes" field if the given value is not nil.
-func (suo *SubmissionUpdateOne) SetNillableSubmissionTargetSizeBytes(i *int) *SubmissionUpdateOne {
-	if i != nil {
-		suo.SetSubmissionTargetSizeBytes(*i)
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableCacheHit(b *bool) *SubmissionUpdateOne {
+	if b != nil {
+		suo.SetCacheHit(*b)
 	}
 	return suo
 }
 
-// AddSubmissionTargetSizeBytes adds i to the "submission_target_size_bytes" field.
-func (suo *SubmissionUpdateOne) AddSubmissionTargetSizeBytes(i int) *SubmissionUpdateOne {
-	suo.mutation.AddSubmissionTargetSizeBytes(i)
+// SetResultsEncrypted sets the "results_encrypted" field.
+func (suo *SubmissionUpdateOne) SetResultsEncrypted(b bool) *SubmissionUpdateOne {
+	suo.mutation.SetResultsEncrypted(b)
 	return suo
 }
 
-// SetProcessingStartedAt sets the "processing_started_at" field.
-func (suo *SubmissionUpdateOne) SetProcessingStartedAt(t time.Time) *SubmissionUpdateOne {
-	suo.mutation.SetProcessingStartedAt(t)
+// SetNillableResultsEncrypted sets the "results_encrypted" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableResultsEncrypted(b *bool) *SubmissionUpdateOne {
+	if b != nil {
+		suo.SetResultsEncrypted(*b)
+	}
 	return suo
 }
 
-// SetNillableProcessingStartedAt sets the "processing_started_at" field if the given value is not nil.
-func (suo *SubmissionUpdateOne) SetNillableProcessingStartedAt(t *time.Time) *SubmissionUpdateOne {
+// SetExpiresAt sets the "expires_at" field.
+func (suo *SubmissionUpdateOne) SetExpiresAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableExpiresAt(t *time.Time) *SubmissionUpdateOne {
 	if t != nil {
-		suo.SetProcessingStartedAt(*t)
+		suo.SetExpiresAt(*t)
 	}
 	return suo
 }
 
-// ClearProcessingStartedAt clears the value of the "processing_started_at" field.
-func (suo *SubmissionUpdateOne) ClearProcessingStartedAt() *SubmissionUpdateOne {
-	suo.mutation.ClearProcessingStartedAt()
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *SubmissionUpdateOne) ClearExpiresAt() *SubmissionUpdateOne {
+	suo.mutation.ClearExpiresAt()
 	return suo
 }
 
-// SetProcessingFinishedAt sets the "processing_finished_at" field.
-func (suo *SubmissionUpdateOne) SetProcessingFinishedAt(t time.Time) *SubmissionUpdateOne {
-	suo.mutation.SetProcessingFinishedAt(t)
+// SetRetentionWarnedAt sets the "retention_warned_at" field.
+func (suo *SubmissionUpdateOne) SetRetentionWarnedAt(t time.Time) *SubmissionUpdateOne {
+	suo.mutation.SetRetentionWarnedAt(t)
 	return suo
 }
 
-// SetNillableProcessingFinishedAt sets the "processing_finished_at" field if the given value is not nil.
-func (suo *SubmissionUpdateOne) SetNillableProcessingFinishedAt(t *time.Time) *SubmissionUpdateOne {
+// SetNillableRetentionWarnedAt sets the "retention_warned_at" field if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableRetentionWarnedAt(t *time.Time) *SubmissionUpdateOne {
 	if t != nil {
-		suo.SetProcessingFinishedAt(*t)
+		suo.SetRetentionWarnedAt(*t)
 	}
 	return suo
 }
 
-// ClearProcessingFinishedAt clears the value of the "processing_finished_at" field.
-func (suo *SubmissionUpdateOne) ClearProcessingFinishedAt() *SubmissionUpdateOne {
-	suo.mutation.ClearProcessingFinishedAt()
+// ClearRetentionWarnedAt clears the value of the "retention_warned_at" field.
+func (suo *SubmissionUpdateOne) ClearRetentionWarnedAt() *SubmissionUpdateOne {
+	suo.mutation.ClearRetentionWarnedAt()
 	return suo
 }
 
@@ -763,6 +1356,55 @@ func (suo *SubmissionUpdateOne) SetUser(u *User) *SubmissionUpdateOne {
 	return suo.SetUserID(u.ID)
 }
 
+// AddDiagnosticIDs adds the "diagnostics" edge to the Diagnostic entity by IDs.
+func (suo *SubmissionUpdateOne) AddDiagnosticIDs(ids ...uuid.UUID) *SubmissionUpdateOne {
+	suo.mutation.AddDiagnosticIDs(ids...)
+	return suo
+}
+
+// AddDiagnostics adds the "diagnostics" edges to the Diagnostic entity.
+func (suo *SubmissionUpdateOne) AddDiagnostics(d ...*Diagnostic) *SubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.AddDiagnosticIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Submission entity by ID.
+func (suo *SubmissionUpdateOne) SetParentID(id uuid.UUID) *SubmissionUpdateOne {
+	suo.mutation.SetParentID(id)
+	return suo
+}
+
+// SetNillableParentID sets the "parent" edge to the Submission entity by ID if the given value is not nil.
+func (suo *SubmissionUpdateOne) SetNillableParentID(id *uuid.UUID) *SubmissionUpdateOne {
+	if id != nil {
+		suo = suo.SetParentID(*id)
+	}
+	return suo
+}
+
+// SetParent sets the "parent" edge to the Submission entity.
+func (suo *SubmissionUpdateOne) SetParent(s *Submission) *SubmissionUpdateOne {
+	return suo.SetParentID(s.ID)
+}
+
+// AddRerunIDs adds the "reruns" edge to the Submission entity by IDs.
+func (suo *SubmissionUpdateOne) AddRerunIDs(ids ...uuid.UUID) *SubmissionUpdateOne {
+	suo.mutation.AddRerunIDs(ids...)
+	return suo
+}
+
+// AddReruns adds the "reruns" edges to the Submission entity.
+func (suo *SubmissionUpdateOne) AddReruns(s ...*Submission) *SubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddRerunIDs(ids...)
+}
+
 // Mutation returns the SubmissionMutation object of the builder.
 func (suo *SubmissionUpdateOne) Mutation() *SubmissionMutation {
 	return suo.mutation
@@ -774,6 +1416,54 @@ func (suo *SubmissionUpdateOne) ClearUser() *SubmissionUpdateOne {
 	return suo
 }
 
+// ClearDiagnostics clears all "diagnostics" edges to the Diagnostic entity.
+func (suo *SubmissionUpdateOne) ClearDiagnostics() *SubmissionUpdateOne {
+	suo.mutation.ClearDiagnostics()
+	return suo
+}
+
+// RemoveDiagnosticIDs removes the "diagnostics" edge to Diagnostic entities by IDs.
+func (suo *SubmissionUpdateOne) RemoveDiagnosticIDs(ids ...uuid.UUID) *SubmissionUpdateOne {
+	suo.mutation.RemoveDiagnosticIDs(ids...)
+	return suo
+}
+
+// RemoveDiagnostics removes "diagnostics" edges to Diagnostic entities.
+func (suo *SubmissionUpdateOne) RemoveDiagnostics(d ...*Diagnostic) *SubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.RemoveDiagnosticIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Submission entity.
+func (suo *SubmissionUpdateOne) ClearParent() *SubmissionUpdateOne {
+	suo.mutation.ClearParent()
+	return suo
+}
+
+// ClearReruns clears all "reruns" edges to the Submission entity.
+func (suo *SubmissionUpdateOne) ClearReruns() *SubmissionUpdateOne {
+	suo.mutation.ClearReruns()
+	return suo
+}
+
+// RemoveRerunIDs removes the "reruns" edge to Submission entities by IDs.
+func (suo *SubmissionUpdateOne) RemoveRerunIDs(ids ...uuid.UUID) *SubmissionUpdateOne {
+	suo.mutation.RemoveRerunIDs(ids...)
+	return suo
+}
+
+// RemoveReruns removes "reruns" edges to Submission entities.
+func (suo *SubmissionUpdateOne) RemoveReruns(s ...*Submission) *SubmissionUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveRerunIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (suo *SubmissionUpdateOne) Select(field string, fields ...string) *SubmissionUpdateOne {
@@ -1024,6 +1714,86 @@ func (suo *SubmissionUpdateOne) sqlSave(ctx context.Context) (_node *Submission,
 			Column: submission.FieldProcessingFinishedAt,
 		})
 	}
+	if value, ok := suo.mutation.QueueLane(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldQueueLane,
+		})
+	}
+	if value, ok := suo.mutation.HasMapping(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldHasMapping,
+		})
+	}
+	if value, ok := suo.mutation.InputHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldInputHash,
+		})
+	}
+	if suo.mutation.InputHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: submission.FieldInputHash,
+		})
+	}
+	if value, ok := suo.mutation.TranspilerVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: submission.FieldTranspilerVersion,
+		})
+	}
+	if suo.mutation.TranspilerVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: submission.FieldTranspilerVersion,
+		})
+	}
+	if value, ok := suo.mutation.CacheHit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldCacheHit,
+		})
+	}
+	if value, ok := suo.mutation.ResultsEncrypted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: submission.FieldResultsEncrypted,
+		})
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldExpiresAt,
+		})
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: submission.FieldExpiresAt,
+		})
+	}
+	if value, ok := suo.mutation.RetentionWarnedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: submission.FieldRetentionWarnedAt,
+		})
+	}
+	if suo.mutation.RetentionWarnedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: submission.FieldRetentionWarnedAt,
+		})
+	}
 	if suo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1059,6 +1829,149 @@ func (suo *SubmissionUpdateOne) sqlSave(ctx context.Context) (_node *Submission,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.DiagnosticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedDiagnosticsIDs(); len(nodes) > 0 && !suo.mutation.DiagnosticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.DiagnosticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.DiagnosticsTable,
+			Columns: []string{submission.DiagnosticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: diagnostic.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   submission.ParentTable,
+			Columns: []string{submission.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   submission.ParentTable,
+			Columns: []string{submission.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.RerunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRerunsIDs(); len(nodes) > 0 && !suo.mutation.RerunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RerunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   submission.RerunsTable,
+			Columns: []string{submission.RerunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: submission.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Submission{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -28,6 +28,8 @@ type Subscription struct {
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// Cancelled holds the value of the "cancelled" field.
 	Cancelled bool `json:"cancelled,omitempty"`
+	// Manual holds the value of the "manual" field.
+	Manual bool `json:"manual,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -64,7 +66,7 @@ func (*Subscription) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case subscription.FieldCancelled:
+		case subscription.FieldCancelled, subscription.FieldManual:
 			values[i] = new(sql.NullBool)
 		case subscription.FieldStripeCustomerID, subscription.FieldStripeSubscriptionID, subscription.FieldTier:
 			values[i] = new(sql.NullString)
@@ -125,6 +127,12 @@ func (s *Subscription) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				s.Cancelled = value.Bool
 			}
+		case subscription.FieldManual:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field manual", values[i])
+			} else if value.Valid {
+				s.Manual = value.Bool
+			}
 		case subscription.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -181,6 +189,8 @@ func (s *Subscription) String() string {
 	builder.WriteString(s.ExpiresAt.Format(time.ANSIC))
 	builder.WriteString(", cancelled=")
 	builder.WriteString(fmt.Sprintf("%v", s.Cancelled))
+	builder.WriteString(", manual=")
+	builder.WriteString(fmt.Sprintf("%v", s.Manual))
 	builder.WriteString(", created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -24,6 +24,8 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldCancelled holds the string denoting the cancelled field in the database.
 	FieldCancelled = "cancelled"
+	// FieldManual holds the string denoting the manual field in the database.
+	FieldManual = "manual"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -47,6 +49,7 @@ var Columns = []string{
 	FieldTier,
 	FieldExpiresAt,
 	FieldCancelled,
+	FieldManual,
 	FieldCreatedAt,
 }
 
@@ -74,6 +77,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCancelled holds the default value on creation for the "cancelled" field.
 	DefaultCancelled bool
+	// DefaultManual holds the default value on creation for the "manual" field.
+	DefaultManual bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
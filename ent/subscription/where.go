@@ -122,6 +122,13 @@ func Cancelled(v bool) predicate.Subscription {
 	})
 }
 
+// Manual applies equality check predicate on the "manual" field. It's identical to ManualEQ.
+func Manual(v bool) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManual), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
@@ -531,6 +538,20 @@ func CancelledNEQ(v bool) predicate.Subscription {
 	})
 }
 
+// ManualEQ applies the EQ predicate on the "manual" field.
+func ManualEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManual), v))
+	})
+}
+
+// ManualNEQ applies the NEQ predicate on the "manual" field.
+func ManualNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldManual), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Subscription {
 	return predicate.Subscription(func(s *sql.Selector) {
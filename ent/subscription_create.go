@@ -95,6 +95,20 @@ func (sc *SubscriptionCreate) SetNillableCancelled(b *bool) *SubscriptionCreate
 	return sc
 }
 
+// SetManual sets the "manual" field.
+func (sc *SubscriptionCreate) SetManual(b bool) *SubscriptionCreate {
+	sc.mutation.SetManual(b)
+	return sc
+}
+
+// SetNillableManual sets the "manual" field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableManual(b *bool) *SubscriptionCreate {
+	if b != nil {
+		sc.SetManual(*b)
+	}
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *SubscriptionCreate) SetCreatedAt(t time.Time) *SubscriptionCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -213,6 +227,10 @@ func (sc *SubscriptionCreate) defaults() {
 		v := subscription.DefaultCancelled
 		sc.mutation.SetCancelled(v)
 	}
+	if _, ok := sc.mutation.Manual(); !ok {
+		v := subscription.DefaultManual
+		sc.mutation.SetManual(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := subscription.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -236,6 +254,9 @@ func (sc *SubscriptionCreate) check() error {
 	if _, ok := sc.mutation.Cancelled(); !ok {
 		return &ValidationError{Name: "cancelled", err: errors.New(`ent: missing required field "Subscription.cancelled"`)}
 	}
+	if _, ok := sc.mutation.Manual(); !ok {
+		return &ValidationError{Name: "manual", err: errors.New(`ent: missing required field "Subscription.manual"`)}
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
 	}
@@ -319,6 +340,14 @@ func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		})
 		_node.Cancelled = value
 	}
+	if value, ok := sc.mutation.Manual(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldManual,
+		})
+		_node.Manual = value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -479,6 +508,18 @@ func (u *SubscriptionUpsert) UpdateCancelled() *SubscriptionUpsert {
 	return u
 }
 
+// SetManual sets the "manual" field.
+func (u *SubscriptionUpsert) SetManual(v bool) *SubscriptionUpsert {
+	u.Set(subscription.FieldManual, v)
+	return u
+}
+
+// UpdateManual sets the "manual" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateManual() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldManual)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SubscriptionUpsert) SetCreatedAt(v time.Time) *SubscriptionUpsert {
 	u.Set(subscription.FieldCreatedAt, v)
@@ -632,6 +673,20 @@ func (u *SubscriptionUpsertOne) UpdateCancelled() *SubscriptionUpsertOne {
 	})
 }
 
+// SetManual sets the "manual" field.
+func (u *SubscriptionUpsertOne) SetManual(v bool) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetManual(v)
+	})
+}
+
+// UpdateManual sets the "manual" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateManual() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateManual()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SubscriptionUpsertOne) SetCreatedAt(v time.Time) *SubscriptionUpsertOne {
 	return u.Update(func(s *SubscriptionUpsert) {
@@ -953,6 +1008,20 @@ func (u *SubscriptionUpsertBulk) UpdateCancelled() *SubscriptionUpsertBulk {
 	})
 }
 
+// SetManual sets the "manual" field.
+func (u *SubscriptionUpsertBulk) SetManual(v bool) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetManual(v)
+	})
+}
+
+// UpdateManual sets the "manual" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateManual() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateManual()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SubscriptionUpsertBulk) SetCreatedAt(v time.Time) *SubscriptionUpsertBulk {
 	return u.Update(func(s *SubscriptionUpsert) {
@@ -118,6 +118,20 @@ func (su *SubscriptionUpdate) SetNillableCancelled(b *bool) *SubscriptionUpdate
 	return su
 }
 
+// SetManual sets the "manual" field.
+func (su *SubscriptionUpdate) SetManual(b bool) *SubscriptionUpdate {
+	su.mutation.SetManual(b)
+	return su
+}
+
+// SetNillableManual sets the "manual" field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableManual(b *bool) *SubscriptionUpdate {
+	if b != nil {
+		su.SetManual(*b)
+	}
+	return su
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (su *SubscriptionUpdate) SetCreatedAt(t time.Time) *SubscriptionUpdate {
 	su.mutation.SetCreatedAt(t)
@@ -298,6 +312,13 @@ func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: subscription.FieldCancelled,
 		})
 	}
+	if value, ok := su.mutation.Manual(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldManual,
+		})
+	}
 	if value, ok := su.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -447,6 +468,20 @@ func (suo *SubscriptionUpdateOne) SetNillableCancelled(b *bool) *SubscriptionUpd
 	return suo
 }
 
+// SetManual sets the "manual" field.
+func (suo *SubscriptionUpdateOne) SetManual(b bool) *SubscriptionUpdateOne {
+	suo.mutation.SetManual(b)
+	return suo
+}
+
+// SetNillableManual sets the "manual" field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableManual(b *bool) *SubscriptionUpdateOne {
+	if b != nil {
+		suo.SetManual(*b)
+	}
+	return suo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (suo *SubscriptionUpdateOne) SetCreatedAt(t time.Time) *SubscriptionUpdateOne {
 	suo.mutation.SetCreatedAt(t)
@@ -651,6 +686,13 @@ func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscript
 			Column: subscription.FieldCancelled,
 		})
 	}
+	if value, ok := suo.mutation.Manual(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: subscription.FieldManual,
+		})
+	}
 	if value, ok := suo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -22,6 +22,12 @@ type Token struct {
 	IsActive bool `json:"is_active,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ImpersonatorID holds the value of the "impersonator_id" field.
+	ImpersonatorID *uuid.UUID `json:"impersonator_id,omitempty"`
+	// ReadOnly holds the value of the "read_only" field.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenQuery when eager-loading is set.
 	Edges       TokenEdges `json:"edges"`
@@ -56,9 +62,11 @@ func (*Token) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case token.FieldIsActive:
+		case token.FieldImpersonatorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case token.FieldIsActive, token.FieldReadOnly:
 			values[i] = new(sql.NullBool)
-		case token.FieldCreatedAt:
+		case token.FieldCreatedAt, token.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case token.FieldID:
 			values[i] = new(uuid.UUID)
@@ -97,6 +105,26 @@ func (t *Token) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.CreatedAt = value.Time
 			}
+		case token.FieldImpersonatorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_id", values[i])
+			} else if value.Valid {
+				t.ImpersonatorID = new(uuid.UUID)
+				*t.ImpersonatorID = *value.S.(*uuid.UUID)
+			}
+		case token.FieldReadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_only", values[i])
+			} else if value.Valid {
+				t.ReadOnly = value.Bool
+			}
+		case token.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				t.ExpiresAt = new(time.Time)
+				*t.ExpiresAt = value.Time
+			}
 		case token.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_tokens", values[i])
@@ -141,6 +169,16 @@ func (t *Token) String() string {
 	builder.WriteString(fmt.Sprintf("%v", t.IsActive))
 	builder.WriteString(", created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	if v := t.ImpersonatorID; v != nil {
+		builder.WriteString(", impersonator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", read_only=")
+	builder.WriteString(fmt.Sprintf("%v", t.ReadOnly))
+	if v := t.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,12 @@ const (
 	FieldIsActive = "is_active"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldImpersonatorID holds the string denoting the impersonator_id field in the database.
+	FieldImpersonatorID = "impersonator_id"
+	// FieldReadOnly holds the string denoting the read_only field in the database.
+	FieldReadOnly = "read_only"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the token in the database.
@@ -35,6 +41,9 @@ var Columns = []string{
 	FieldID,
 	FieldIsActive,
 	FieldCreatedAt,
+	FieldImpersonatorID,
+	FieldReadOnly,
+	FieldExpiresAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tokens"
@@ -63,6 +72,8 @@ var (
 	DefaultIsActive bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultReadOnly holds the default value on creation for the "read_only" field.
+	DefaultReadOnly bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -53,6 +53,48 @@ func (tc *TokenCreate) SetNillableCreatedAt(t *time.Time) *TokenCreate {
 	return tc
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (tc *TokenCreate) SetImpersonatorID(u uuid.UUID) *TokenCreate {
+	tc.mutation.SetImpersonatorID(u)
+	return tc
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableImpersonatorID(u *uuid.UUID) *TokenCreate {
+	if u != nil {
+		tc.SetImpersonatorID(*u)
+	}
+	return tc
+}
+
+// SetReadOnly sets the "read_only" field.
+func (tc *TokenCreate) SetReadOnly(b bool) *TokenCreate {
+	tc.mutation.SetReadOnly(b)
+	return tc
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableReadOnly(b *bool) *TokenCreate {
+	if b != nil {
+		tc.SetReadOnly(*b)
+	}
+	return tc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tc *TokenCreate) SetExpiresAt(t time.Time) *TokenCreate {
+	tc.mutation.SetExpiresAt(t)
+	return tc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableExpiresAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetExpiresAt(*t)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TokenCreate) SetID(u uuid.UUID) *TokenCreate {
 	tc.mutation.SetID(u)
@@ -157,6 +199,10 @@ func (tc *TokenCreate) defaults() {
 		v := token.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := tc.mutation.ReadOnly(); !ok {
+		v := token.DefaultReadOnly
+		tc.mutation.SetReadOnly(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := token.DefaultID()
 		tc.mutation.SetID(v)
@@ -171,6 +217,9 @@ func (tc *TokenCreate) check() error {
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Token.created_at"`)}
 	}
+	if _, ok := tc.mutation.ReadOnly(); !ok {
+		return &ValidationError{Name: "read_only", err: errors.New(`ent: missing required field "Token.read_only"`)}
+	}
 	if _, ok := tc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Token.user"`)}
 	}
@@ -227,6 +276,30 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		})
 		_node.CreatedAt = value
 	}
+	if value, ok := tc.mutation.ImpersonatorID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: token.FieldImpersonatorID,
+		})
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := tc.mutation.ReadOnly(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: token.FieldReadOnly,
+		})
+		_node.ReadOnly = value
+	}
+	if value, ok := tc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
 	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -325,6 +398,54 @@ func (u *TokenUpsert) UpdateCreatedAt() *TokenUpsert {
 	return u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *TokenUpsert) SetImpersonatorID(v uuid.UUID) *TokenUpsert {
+	u.Set(token.FieldImpersonatorID, v)
+	return u
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateImpersonatorID() *TokenUpsert {
+	u.SetExcluded(token.FieldImpersonatorID)
+	return u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *TokenUpsert) ClearImpersonatorID() *TokenUpsert {
+	u.SetNull(token.FieldImpersonatorID)
+	return u
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *TokenUpsert) SetReadOnly(v bool) *TokenUpsert {
+	u.Set(token.FieldReadOnly, v)
+	return u
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateReadOnly() *TokenUpsert {
+	u.SetExcluded(token.FieldReadOnly)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenUpsert) SetExpiresAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateExpiresAt() *TokenUpsert {
+	u.SetExcluded(token.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TokenUpsert) ClearExpiresAt() *TokenUpsert {
+	u.SetNull(token.FieldExpiresAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -403,6 +524,62 @@ func (u *TokenUpsertOne) UpdateCreatedAt() *TokenUpsertOne {
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *TokenUpsertOne) SetImpersonatorID(v uuid.UUID) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateImpersonatorID() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *TokenUpsertOne) ClearImpersonatorID() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *TokenUpsertOne) SetReadOnly(v bool) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateReadOnly() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenUpsertOne) SetExpiresAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateExpiresAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TokenUpsertOne) ClearExpiresAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -647,6 +824,62 @@ func (u *TokenUpsertBulk) UpdateCreatedAt() *TokenUpsertBulk {
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *TokenUpsertBulk) SetImpersonatorID(v uuid.UUID) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateImpersonatorID() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *TokenUpsertBulk) ClearImpersonatorID() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *TokenUpsertBulk) SetReadOnly(v bool) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateReadOnly() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenUpsertBulk) SetExpiresAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateExpiresAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TokenUpsertBulk) ClearExpiresAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
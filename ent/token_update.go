@@ -58,6 +58,60 @@ func (tu *TokenUpdate) SetNillableCreatedAt(t *time.Time) *TokenUpdate {
 	return tu
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (tu *TokenUpdate) SetImpersonatorID(u uuid.UUID) *TokenUpdate {
+	tu.mutation.SetImpersonatorID(u)
+	return tu
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableImpersonatorID(u *uuid.UUID) *TokenUpdate {
+	if u != nil {
+		tu.SetImpersonatorID(*u)
+	}
+	return tu
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (tu *TokenUpdate) ClearImpersonatorID() *TokenUpdate {
+	tu.mutation.ClearImpersonatorID()
+	return tu
+}
+
+// SetReadOnly sets the "read_only" field.
+func (tu *TokenUpdate) SetReadOnly(b bool) *TokenUpdate {
+	tu.mutation.SetReadOnly(b)
+	return tu
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableReadOnly(b *bool) *TokenUpdate {
+	if b != nil {
+		tu.SetReadOnly(*b)
+	}
+	return tu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tu *TokenUpdate) SetExpiresAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetExpiresAt(t)
+	return tu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableExpiresAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetExpiresAt(*t)
+	}
+	return tu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (tu *TokenUpdate) ClearExpiresAt() *TokenUpdate {
+	tu.mutation.ClearExpiresAt()
+	return tu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tu *TokenUpdate) SetUserID(id uuid.UUID) *TokenUpdate {
 	tu.mutation.SetUserID(id)
@@ -180,6 +234,39 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: token.FieldCreatedAt,
 		})
 	}
+	if value, ok := tu.mutation.ImpersonatorID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: token.FieldImpersonatorID,
+		})
+	}
+	if tu.mutation.ImpersonatorIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: token.FieldImpersonatorID,
+		})
+	}
+	if value, ok := tu.mutation.ReadOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: token.FieldReadOnly,
+		})
+	}
+	if value, ok := tu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldExpiresAt,
+		})
+	}
+	if tu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldExpiresAt,
+		})
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -262,6 +349,60 @@ func (tuo *TokenUpdateOne) SetNillableCreatedAt(t *time.Time) *TokenUpdateOne {
 	return tuo
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (tuo *TokenUpdateOne) SetImpersonatorID(u uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.SetImpersonatorID(u)
+	return tuo
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableImpersonatorID(u *uuid.UUID) *TokenUpdateOne {
+	if u != nil {
+		tuo.SetImpersonatorID(*u)
+	}
+	return tuo
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (tuo *TokenUpdateOne) ClearImpersonatorID() *TokenUpdateOne {
+	tuo.mutation.ClearImpersonatorID()
+	return tuo
+}
+
+// SetReadOnly sets the "read_only" field.
+func (tuo *TokenUpdateOne) SetReadOnly(b bool) *TokenUpdateOne {
+	tuo.mutation.SetReadOnly(b)
+	return tuo
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableReadOnly(b *bool) *TokenUpdateOne {
+	if b != nil {
+		tuo.SetReadOnly(*b)
+	}
+	return tuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tuo *TokenUpdateOne) SetExpiresAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetExpiresAt(t)
+	return tuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableExpiresAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetExpiresAt(*t)
+	}
+	return tuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (tuo *TokenUpdateOne) ClearExpiresAt() *TokenUpdateOne {
+	tuo.mutation.ClearExpiresAt()
+	return tuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tuo *TokenUpdateOne) SetUserID(id uuid.UUID) *TokenUpdateOne {
 	tuo.mutation.SetUserID(id)
@@ -408,6 +549,39 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 			Column: token.FieldCreatedAt,
 		})
 	}
+	if value, ok := tuo.mutation.ImpersonatorID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: token.FieldImpersonatorID,
+		})
+	}
+	if tuo.mutation.ImpersonatorIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: token.FieldImpersonatorID,
+		})
+	}
+	if value, ok := tuo.mutation.ReadOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: token.FieldReadOnly,
+		})
+	}
+	if value, ok := tuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: token.FieldExpiresAt,
+		})
+	}
+	if tuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: token.FieldExpiresAt,
+		})
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
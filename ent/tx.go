@@ -12,12 +12,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AdminAction is the client for interacting with the AdminAction builders.
+	AdminAction *AdminActionClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// DataKey is the client for interacting with the DataKey builders.
+	DataKey *DataKeyClient
+	// Diagnostic is the client for interacting with the Diagnostic builders.
+	Diagnostic *DiagnosticClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// PendingDeletion is the client for interacting with the PendingDeletion builders.
+	PendingDeletion *PendingDeletionClient
+	// ResumableUpload is the client for interacting with the ResumableUpload builders.
+	ResumableUpload *ResumableUploadClient
+	// SourceFile is the client for interacting with the SourceFile builders.
+	SourceFile *SourceFileClient
 	// Submission is the client for interacting with the Submission builders.
 	Submission *SubmissionClient
 	// Subscription is the client for interacting with the Subscription builders.
 	Subscription *SubscriptionClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -155,9 +179,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AdminAction = NewAdminActionClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
+	tx.DataKey = NewDataKeyClient(tx.config)
+	tx.Diagnostic = NewDiagnosticClient(tx.config)
+	tx.Lease = NewLeaseClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.PendingDeletion = NewPendingDeletionClient(tx.config)
+	tx.ResumableUpload = NewResumableUploadClient(tx.config)
+	tx.SourceFile = NewSourceFileClient(tx.config)
 	tx.Submission = NewSubmissionClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
+	tx.UploadSession = NewUploadSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -168,7 +204,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Submission.QueryXXX(), the query will be executed
+// applies a query, for example: AdminAction.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,191 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/schema"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// UploadSession is the model entity for the UploadSession schema.
+type UploadSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SourceLanguage holds the value of the "source_language" field.
+	SourceLanguage string `json:"source_language,omitempty"`
+	// TargetLanguage holds the value of the "target_language" field.
+	TargetLanguage string `json:"target_language,omitempty"`
+	// Files holds the value of the "files" field.
+	Files []schema.UploadSessionFile `json:"files,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UploadSessionQuery when eager-loading is set.
+	Edges                UploadSessionEdges `json:"edges"`
+	user_upload_sessions *uuid.UUID
+}
+
+// UploadSessionEdges holds the relations/edges for other nodes in the graph.
+type UploadSessionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UploadSessionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UploadSession) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case uploadsession.FieldFiles:
+			values[i] = new([]byte)
+		case uploadsession.FieldSourceLanguage, uploadsession.FieldTargetLanguage:
+			values[i] = new(sql.NullString)
+		case uploadsession.FieldExpiresAt, uploadsession.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case uploadsession.FieldID:
+			values[i] = new(uuid.UUID)
+		case uploadsession.ForeignKeys[0]: // user_upload_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type UploadSession", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UploadSession fields.
+func (us *UploadSession) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case uploadsession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				us.ID = *value
+			}
+		case uploadsession.FieldSourceLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_language", values[i])
+			} else if value.Valid {
+				us.SourceLanguage = value.String
+			}
+		case uploadsession.FieldTargetLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_language", values[i])
+			} else if value.Valid {
+				us.TargetLanguage = value.String
+			}
+		case uploadsession.FieldFiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field files", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &us.Files); err != nil {
+					return fmt.Errorf("unmarshal field files: %w", err)
+				}
+			}
+		case uploadsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				us.ExpiresAt = value.Time
+			}
+		case uploadsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				us.CreatedAt = value.Time
+			}
+		case uploadsession.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_upload_sessions", values[i])
+			} else if value.Valid {
+				us.user_upload_sessions = new(uuid.UUID)
+				*us.user_upload_sessions = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the UploadSession entity.
+func (us *UploadSession) QueryUser() *UserQuery {
+	return (&UploadSessionClient{config: us.config}).QueryUser(us)
+}
+
+// Update returns a builder for updating this UploadSession.
+// Note that you need to call UploadSession.Unwrap() before calling this method if this UploadSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (us *UploadSession) Update() *UploadSessionUpdateOne {
+	return (&UploadSessionClient{config: us.config}).UpdateOne(us)
+}
+
+// Unwrap unwraps the UploadSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (us *UploadSession) Unwrap() *UploadSession {
+	tx, ok := us.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UploadSession is not a transactional entity")
+	}
+	us.config.driver = tx.drv
+	return us
+}
+
+// String implements the fmt.Stringer.
+func (us *UploadSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("UploadSession(")
+	builder.WriteString(fmt.Sprintf("id=%v", us.ID))
+	builder.WriteString(", source_language=")
+	builder.WriteString(us.SourceLanguage)
+	builder.WriteString(", target_language=")
+	builder.WriteString(us.TargetLanguage)
+	builder.WriteString(", files=")
+	builder.WriteString(fmt.Sprintf("%v", us.Files))
+	builder.WriteString(", expires_at=")
+	builder.WriteString(us.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(us.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UploadSessions is a parsable slice of UploadSession.
+type UploadSessions []*UploadSession
+
+func (us UploadSessions) config(cfg config) {
+	for _i := range us {
+		us[_i].config = cfg
+	}
+}
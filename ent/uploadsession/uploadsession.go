@@ -0,0 +1,75 @@
+// Code generated by entc, DO NOT EDIT.
+
+package uploadsession
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the uploadsession type in the database.
+	Label = "upload_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourceLanguage holds the string denoting the source_language field in the database.
+	FieldSourceLanguage = "source_language"
+	// FieldTargetLanguage holds the string denoting the target_language field in the database.
+	FieldTargetLanguage = "target_language"
+	// FieldFiles holds the string denoting the files field in the database.
+	FieldFiles = "files"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the uploadsession in the database.
+	Table = "upload_sessions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "upload_sessions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_upload_sessions"
+)
+
+// Columns holds all SQL columns for uploadsession fields.
+var Columns = []string{
+	FieldID,
+	FieldSourceLanguage,
+	FieldTargetLanguage,
+	FieldFiles,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "upload_sessions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_upload_sessions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
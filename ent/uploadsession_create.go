@@ -0,0 +1,828 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/schema"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// UploadSessionCreate is the builder for creating a UploadSession entity.
+type UploadSessionCreate struct {
+	config
+	mutation *UploadSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (usc *UploadSessionCreate) SetSourceLanguage(s string) *UploadSessionCreate {
+	usc.mutation.SetSourceLanguage(s)
+	return usc
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (usc *UploadSessionCreate) SetTargetLanguage(s string) *UploadSessionCreate {
+	usc.mutation.SetTargetLanguage(s)
+	return usc
+}
+
+// SetFiles sets the "files" field.
+func (usc *UploadSessionCreate) SetFiles(ssf []schema.UploadSessionFile) *UploadSessionCreate {
+	usc.mutation.SetFiles(ssf)
+	return usc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usc *UploadSessionCreate) SetExpiresAt(t time.Time) *UploadSessionCreate {
+	usc.mutation.SetExpiresAt(t)
+	return usc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (usc *UploadSessionCreate) SetCreatedAt(t time.Time) *UploadSessionCreate {
+	usc.mutation.SetCreatedAt(t)
+	return usc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (usc *UploadSessionCreate) SetNillableCreatedAt(t *time.Time) *UploadSessionCreate {
+	if t != nil {
+		usc.SetCreatedAt(*t)
+	}
+	return usc
+}
+
+// SetID sets the "id" field.
+func (usc *UploadSessionCreate) SetID(u uuid.UUID) *UploadSessionCreate {
+	usc.mutation.SetID(u)
+	return usc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (usc *UploadSessionCreate) SetNillableID(u *uuid.UUID) *UploadSessionCreate {
+	if u != nil {
+		usc.SetID(*u)
+	}
+	return usc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (usc *UploadSessionCreate) SetUserID(id uuid.UUID) *UploadSessionCreate {
+	usc.mutation.SetUserID(id)
+	return usc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (usc *UploadSessionCreate) SetUser(u *User) *UploadSessionCreate {
+	return usc.SetUserID(u.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (usc *UploadSessionCreate) Mutation() *UploadSessionMutation {
+	return usc.mutation
+}
+
+// Save creates the UploadSession in the database.
+func (usc *UploadSessionCreate) Save(ctx context.Context) (*UploadSession, error) {
+	var (
+		err  error
+		node *UploadSession
+	)
+	usc.defaults()
+	if len(usc.hooks) == 0 {
+		if err = usc.check(); err != nil {
+			return nil, err
+		}
+		node, err = usc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UploadSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = usc.check(); err != nil {
+				return nil, err
+			}
+			usc.mutation = mutation
+			if node, err = usc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(usc.hooks) - 1; i >= 0; i-- {
+			if usc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = usc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, usc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (usc *UploadSessionCreate) SaveX(ctx context.Context) *UploadSession {
+	v, err := usc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (usc *UploadSessionCreate) Exec(ctx context.Context) error {
+	_, err := usc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usc *UploadSessionCreate) ExecX(ctx context.Context) {
+	if err := usc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usc *UploadSessionCreate) defaults() {
+	if _, ok := usc.mutation.CreatedAt(); !ok {
+		v := uploadsession.DefaultCreatedAt()
+		usc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := usc.mutation.ID(); !ok {
+		v := uploadsession.DefaultID()
+		usc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usc *UploadSessionCreate) check() error {
+	if _, ok := usc.mutation.SourceLanguage(); !ok {
+		return &ValidationError{Name: "source_language", err: errors.New(`ent: missing required field "UploadSession.source_language"`)}
+	}
+	if _, ok := usc.mutation.TargetLanguage(); !ok {
+		return &ValidationError{Name: "target_language", err: errors.New(`ent: missing required field "UploadSession.target_language"`)}
+	}
+	if _, ok := usc.mutation.Files(); !ok {
+		return &ValidationError{Name: "files", err: errors.New(`ent: missing required field "UploadSession.files"`)}
+	}
+	if _, ok := usc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UploadSession.expires_at"`)}
+	}
+	if _, ok := usc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UploadSession.created_at"`)}
+	}
+	if _, ok := usc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UploadSession.user"`)}
+	}
+	return nil
+}
+
+func (usc *UploadSessionCreate) sqlSave(ctx context.Context) (*UploadSession, error) {
+	_node, _spec := usc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, usc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (usc *UploadSessionCreate) createSpec() (*UploadSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UploadSession{config: usc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: uploadsession.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: uploadsession.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = usc.conflict
+	if id, ok := usc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := usc.mutation.SourceLanguage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldSourceLanguage,
+		})
+		_node.SourceLanguage = value
+	}
+	if value, ok := usc.mutation.TargetLanguage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldTargetLanguage,
+		})
+		_node.TargetLanguage = value
+	}
+	if value, ok := usc.mutation.Files(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: uploadsession.FieldFiles,
+		})
+		_node.Files = value
+	}
+	if value, ok := usc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := usc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := usc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_upload_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UploadSession.Create().
+//		SetSourceLanguage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UploadSessionUpsert) {
+//			SetSourceLanguage(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (usc *UploadSessionCreate) OnConflict(opts ...sql.ConflictOption) *UploadSessionUpsertOne {
+	usc.conflict = opts
+	return &UploadSessionUpsertOne{
+		create: usc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (usc *UploadSessionCreate) OnConflictColumns(columns ...string) *UploadSessionUpsertOne {
+	usc.conflict = append(usc.conflict, sql.ConflictColumns(columns...))
+	return &UploadSessionUpsertOne{
+		create: usc,
+	}
+}
+
+type (
+	// UploadSessionUpsertOne is the builder for "upsert"-ing
+	//  one UploadSession node.
+	UploadSessionUpsertOne struct {
+		create *UploadSessionCreate
+	}
+
+	// UploadSessionUpsert is the "OnConflict" setter.
+	UploadSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *UploadSessionUpsert) SetSourceLanguage(v string) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldSourceLanguage, v)
+	return u
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateSourceLanguage() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldSourceLanguage)
+	return u
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *UploadSessionUpsert) SetTargetLanguage(v string) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldTargetLanguage, v)
+	return u
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateTargetLanguage() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldTargetLanguage)
+	return u
+}
+
+// SetFiles sets the "files" field.
+func (u *UploadSessionUpsert) SetFiles(v []schema.UploadSessionFile) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldFiles, v)
+	return u
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateFiles() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldFiles)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsert) SetExpiresAt(v time.Time) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateExpiresAt() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UploadSessionUpsert) SetCreatedAt(v time.Time) *UploadSessionUpsert {
+	u.Set(uploadsession.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UploadSessionUpsert) UpdateCreatedAt() *UploadSessionUpsert {
+	u.SetExcluded(uploadsession.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uploadsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *UploadSessionUpsertOne) UpdateNewValues() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(uploadsession.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.UploadSession.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *UploadSessionUpsertOne) Ignore() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UploadSessionUpsertOne) DoNothing() *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UploadSessionCreate.OnConflict
+// documentation for more info.
+func (u *UploadSessionUpsertOne) Update(set func(*UploadSessionUpsert)) *UploadSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UploadSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *UploadSessionUpsertOne) SetSourceLanguage(v string) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetSourceLanguage(v)
+	})
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateSourceLanguage() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateSourceLanguage()
+	})
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *UploadSessionUpsertOne) SetTargetLanguage(v string) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetTargetLanguage(v)
+	})
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateTargetLanguage() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateTargetLanguage()
+	})
+}
+
+// SetFiles sets the "files" field.
+func (u *UploadSessionUpsertOne) SetFiles(v []schema.UploadSessionFile) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetFiles(v)
+	})
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateFiles() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateFiles()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsertOne) SetExpiresAt(v time.Time) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateExpiresAt() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UploadSessionUpsertOne) SetCreatedAt(v time.Time) *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertOne) UpdateCreatedAt() *UploadSessionUpsertOne {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UploadSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UploadSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UploadSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UploadSessionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UploadSessionUpsertOne.ID is not supported by MySQL driver. Use UploadSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UploadSessionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UploadSessionCreateBulk is the builder for creating many UploadSession entities in bulk.
+type UploadSessionCreateBulk struct {
+	config
+	builders []*UploadSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UploadSession entities in the database.
+func (uscb *UploadSessionCreateBulk) Save(ctx context.Context) ([]*UploadSession, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(uscb.builders))
+	nodes := make([]*UploadSession, len(uscb.builders))
+	mutators := make([]Mutator, len(uscb.builders))
+	for i := range uscb.builders {
+		func(i int, root context.Context) {
+			builder := uscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uscb *UploadSessionCreateBulk) SaveX(ctx context.Context) []*UploadSession {
+	v, err := uscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uscb *UploadSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := uscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uscb *UploadSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := uscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UploadSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UploadSessionUpsert) {
+//			SetSourceLanguage(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (uscb *UploadSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *UploadSessionUpsertBulk {
+	uscb.conflict = opts
+	return &UploadSessionUpsertBulk{
+		create: uscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (uscb *UploadSessionCreateBulk) OnConflictColumns(columns ...string) *UploadSessionUpsertBulk {
+	uscb.conflict = append(uscb.conflict, sql.ConflictColumns(columns...))
+	return &UploadSessionUpsertBulk{
+		create: uscb,
+	}
+}
+
+// UploadSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of UploadSession nodes.
+type UploadSessionUpsertBulk struct {
+	create *UploadSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(uploadsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *UploadSessionUpsertBulk) UpdateNewValues() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(uploadsession.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UploadSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *UploadSessionUpsertBulk) Ignore() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UploadSessionUpsertBulk) DoNothing() *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UploadSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *UploadSessionUpsertBulk) Update(set func(*UploadSessionUpsert)) *UploadSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UploadSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (u *UploadSessionUpsertBulk) SetSourceLanguage(v string) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetSourceLanguage(v)
+	})
+}
+
+// UpdateSourceLanguage sets the "source_language" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateSourceLanguage() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateSourceLanguage()
+	})
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (u *UploadSessionUpsertBulk) SetTargetLanguage(v string) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetTargetLanguage(v)
+	})
+}
+
+// UpdateTargetLanguage sets the "target_language" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateTargetLanguage() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateTargetLanguage()
+	})
+}
+
+// SetFiles sets the "files" field.
+func (u *UploadSessionUpsertBulk) SetFiles(v []schema.UploadSessionFile) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetFiles(v)
+	})
+}
+
+// UpdateFiles sets the "files" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateFiles() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateFiles()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UploadSessionUpsertBulk) SetExpiresAt(v time.Time) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateExpiresAt() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UploadSessionUpsertBulk) SetCreatedAt(v time.Time) *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UploadSessionUpsertBulk) UpdateCreatedAt() *UploadSessionUpsertBulk {
+	return u.Update(func(s *UploadSessionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UploadSessionUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UploadSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UploadSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UploadSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
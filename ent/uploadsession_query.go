@@ -0,0 +1,1023 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// UploadSessionQuery is the builder for querying UploadSession entities.
+type UploadSessionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UploadSession
+	// eager-loading edges.
+	withUser  *UserQuery
+	withFKs   bool
+	modifiers []func(s *sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UploadSessionQuery builder.
+func (usq *UploadSessionQuery) Where(ps ...predicate.UploadSession) *UploadSessionQuery {
+	usq.predicates = append(usq.predicates, ps...)
+	return usq
+}
+
+// Limit adds a limit step to the query.
+func (usq *UploadSessionQuery) Limit(limit int) *UploadSessionQuery {
+	usq.limit = &limit
+	return usq
+}
+
+// Offset adds an offset step to the query.
+func (usq *UploadSessionQuery) Offset(offset int) *UploadSessionQuery {
+	usq.offset = &offset
+	return usq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (usq *UploadSessionQuery) Unique(unique bool) *UploadSessionQuery {
+	usq.unique = &unique
+	return usq
+}
+
+// Order adds an order step to the query.
+func (usq *UploadSessionQuery) Order(o ...OrderFunc) *UploadSessionQuery {
+	usq.order = append(usq.order, o...)
+	return usq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (usq *UploadSessionQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: usq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := usq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := usq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(uploadsession.Table, uploadsession.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, uploadsession.UserTable, uploadsession.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(usq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UploadSession entity from the query.
+// Returns a *NotFoundError when no UploadSession was found.
+func (usq *UploadSessionQuery) First(ctx context.Context) (*UploadSession, error) {
+	nodes, err := usq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{uploadsession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (usq *UploadSessionQuery) FirstX(ctx context.Context) *UploadSession {
+	node, err := usq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UploadSession ID from the query.
+// Returns a *NotFoundError when no UploadSession ID was found.
+func (usq *UploadSessionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = usq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{uploadsession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (usq *UploadSessionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := usq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UploadSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UploadSession entity is found.
+// Returns a *NotFoundError when no UploadSession entities are found.
+func (usq *UploadSessionQuery) Only(ctx context.Context) (*UploadSession, error) {
+	nodes, err := usq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{uploadsession.Label}
+	default:
+		return nil, &NotSingularError{uploadsession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (usq *UploadSessionQuery) OnlyX(ctx context.Context) *UploadSession {
+	node, err := usq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UploadSession ID in the query.
+// Returns a *NotSingularError when more than one UploadSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (usq *UploadSessionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = usq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = &NotSingularError{uploadsession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (usq *UploadSessionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := usq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UploadSessions.
+func (usq *UploadSessionQuery) All(ctx context.Context) ([]*UploadSession, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return usq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (usq *UploadSessionQuery) AllX(ctx context.Context) []*UploadSession {
+	nodes, err := usq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UploadSession IDs.
+func (usq *UploadSessionQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := usq.Select(uploadsession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (usq *UploadSessionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := usq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (usq *UploadSessionQuery) Count(ctx context.Context) (int, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return usq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (usq *UploadSessionQuery) CountX(ctx context.Context) int {
+	count, err := usq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (usq *UploadSessionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := usq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return usq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (usq *UploadSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := usq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UploadSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (usq *UploadSessionQuery) Clone() *UploadSessionQuery {
+	if usq == nil {
+		return nil
+	}
+	return &UploadSessionQuery{
+		config:     usq.config,
+		limit:      usq.limit,
+		offset:     usq.offset,
+		order:      append([]OrderFunc{}, usq.order...),
+		predicates: append([]predicate.UploadSession{}, usq.predicates...),
+		withUser:   usq.withUser.Clone(),
+		// clone intermediate query.
+		sql:    usq.sql.Clone(),
+		path:   usq.path,
+		unique: usq.unique,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (usq *UploadSessionQuery) WithUser(opts ...func(*UserQuery)) *UploadSessionQuery {
+	query := &UserQuery{config: usq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	usq.withUser = query
+	return usq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SourceLanguage string `json:"source_language,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UploadSession.Query().
+//		GroupBy(uploadsession.FieldSourceLanguage).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (usq *UploadSessionQuery) GroupBy(field string, fields ...string) *UploadSessionGroupBy {
+	group := &UploadSessionGroupBy{config: usq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := usq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return usq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SourceLanguage string `json:"source_language,omitempty"`
+//	}
+//
+//	client.UploadSession.Query().
+//		Select(uploadsession.FieldSourceLanguage).
+//		Scan(ctx, &v)
+//
+func (usq *UploadSessionQuery) Select(fields ...string) *UploadSessionSelect {
+	usq.fields = append(usq.fields, fields...)
+	return &UploadSessionSelect{UploadSessionQuery: usq}
+}
+
+func (usq *UploadSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range usq.fields {
+		if !uploadsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if usq.path != nil {
+		prev, err := usq.path(ctx)
+		if err != nil {
+			return err
+		}
+		usq.sql = prev
+	}
+	return nil
+}
+
+func (usq *UploadSessionQuery) sqlAll(ctx context.Context) ([]*UploadSession, error) {
+	var (
+		nodes       = []*UploadSession{}
+		withFKs     = usq.withFKs
+		_spec       = usq.querySpec()
+		loadedTypes = [1]bool{
+			usq.withUser != nil,
+		}
+	)
+	if usq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadsession.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &UploadSession{config: usq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(usq.modifiers) > 0 {
+		_spec.Modifiers = usq.modifiers
+	}
+	if err := sqlgraph.QueryNodes(ctx, usq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := usq.withUser; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*UploadSession)
+		for i := range nodes {
+			if nodes[i].user_upload_sessions == nil {
+				continue
+			}
+			fk := *nodes[i].user_upload_sessions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_upload_sessions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (usq *UploadSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := usq.querySpec()
+	if len(usq.modifiers) > 0 {
+		_spec.Modifiers = usq.modifiers
+	}
+	_spec.Node.Columns = usq.fields
+	if len(usq.fields) > 0 {
+		_spec.Unique = usq.unique != nil && *usq.unique
+	}
+	return sqlgraph.CountNodes(ctx, usq.driver, _spec)
+}
+
+func (usq *UploadSessionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := usq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (usq *UploadSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   uploadsession.Table,
+			Columns: uploadsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: uploadsession.FieldID,
+			},
+		},
+		From:   usq.sql,
+		Unique: true,
+	}
+	if unique := usq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := usq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadsession.FieldID)
+		for i := range fields {
+			if fields[i] != uploadsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := usq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := usq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := usq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := usq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (usq *UploadSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(usq.driver.Dialect())
+	t1 := builder.Table(uploadsession.Table)
+	columns := usq.fields
+	if len(columns) == 0 {
+		columns = uploadsession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if usq.sql != nil {
+		selector = usq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if usq.unique != nil && *usq.unique {
+		selector.Distinct()
+	}
+	for _, m := range usq.modifiers {
+		m(selector)
+	}
+	for _, p := range usq.predicates {
+		p(selector)
+	}
+	for _, p := range usq.order {
+		p(selector)
+	}
+	if offset := usq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := usq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (usq *UploadSessionQuery) Modify(modifiers ...func(s *sql.Selector)) *UploadSessionSelect {
+	usq.modifiers = append(usq.modifiers, modifiers...)
+	return usq.Select()
+}
+
+// UploadSessionGroupBy is the group-by builder for UploadSession entities.
+type UploadSessionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (usgb *UploadSessionGroupBy) Aggregate(fns ...AggregateFunc) *UploadSessionGroupBy {
+	usgb.fns = append(usgb.fns, fns...)
+	return usgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (usgb *UploadSessionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := usgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	usgb.sql = query
+	return usgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := usgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := usgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = usgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) StringX(ctx context.Context) string {
+	v, err := usgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := usgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = usgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) IntX(ctx context.Context) int {
+	v, err := usgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := usgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = usgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := usgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(usgb.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := usgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := usgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (usgb *UploadSessionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = usgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (usgb *UploadSessionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := usgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (usgb *UploadSessionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range usgb.fields {
+		if !uploadsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := usgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := usgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (usgb *UploadSessionGroupBy) sqlQuery() *sql.Selector {
+	selector := usgb.sql.Select()
+	aggregation := make([]string, 0, len(usgb.fns))
+	for _, fn := range usgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(usgb.fields)+len(usgb.fns))
+		for _, f := range usgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(usgb.fields...)...)
+}
+
+// UploadSessionSelect is the builder for selecting fields of UploadSession entities.
+type UploadSessionSelect struct {
+	*UploadSessionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uss *UploadSessionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := uss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	uss.sql = uss.UploadSessionQuery.sqlQuery(ctx)
+	return uss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (uss *UploadSessionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := uss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (uss *UploadSessionSelect) StringsX(ctx context.Context) []string {
+	v, err := uss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = uss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (uss *UploadSessionSelect) StringX(ctx context.Context) string {
+	v, err := uss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (uss *UploadSessionSelect) IntsX(ctx context.Context) []int {
+	v, err := uss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = uss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (uss *UploadSessionSelect) IntX(ctx context.Context) int {
+	v, err := uss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (uss *UploadSessionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := uss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = uss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (uss *UploadSessionSelect) Float64X(ctx context.Context) float64 {
+	v, err := uss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(uss.fields) > 1 {
+		return nil, errors.New("ent: UploadSessionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := uss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (uss *UploadSessionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := uss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (uss *UploadSessionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = uss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{uploadsession.Label}
+	default:
+		err = fmt.Errorf("ent: UploadSessionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (uss *UploadSessionSelect) BoolX(ctx context.Context) bool {
+	v, err := uss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (uss *UploadSessionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := uss.sql.Query()
+	if err := uss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uss *UploadSessionSelect) Modify(modifiers ...func(s *sql.Selector)) *UploadSessionSelect {
+	uss.modifiers = append(uss.modifiers, modifiers...)
+	return uss
+}
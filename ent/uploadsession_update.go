@@ -0,0 +1,521 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/schema"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// UploadSessionUpdate is the builder for updating UploadSession entities.
+type UploadSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadSessionMutation
+}
+
+// Where appends a list predicates to the UploadSessionUpdate builder.
+func (usu *UploadSessionUpdate) Where(ps ...predicate.UploadSession) *UploadSessionUpdate {
+	usu.mutation.Where(ps...)
+	return usu
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (usu *UploadSessionUpdate) SetSourceLanguage(s string) *UploadSessionUpdate {
+	usu.mutation.SetSourceLanguage(s)
+	return usu
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (usu *UploadSessionUpdate) SetTargetLanguage(s string) *UploadSessionUpdate {
+	usu.mutation.SetTargetLanguage(s)
+	return usu
+}
+
+// SetFiles sets the "files" field.
+func (usu *UploadSessionUpdate) SetFiles(ssf []schema.UploadSessionFile) *UploadSessionUpdate {
+	usu.mutation.SetFiles(ssf)
+	return usu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usu *UploadSessionUpdate) SetExpiresAt(t time.Time) *UploadSessionUpdate {
+	usu.mutation.SetExpiresAt(t)
+	return usu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (usu *UploadSessionUpdate) SetCreatedAt(t time.Time) *UploadSessionUpdate {
+	usu.mutation.SetCreatedAt(t)
+	return usu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (usu *UploadSessionUpdate) SetNillableCreatedAt(t *time.Time) *UploadSessionUpdate {
+	if t != nil {
+		usu.SetCreatedAt(*t)
+	}
+	return usu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (usu *UploadSessionUpdate) SetUserID(id uuid.UUID) *UploadSessionUpdate {
+	usu.mutation.SetUserID(id)
+	return usu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (usu *UploadSessionUpdate) SetUser(u *User) *UploadSessionUpdate {
+	return usu.SetUserID(u.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (usu *UploadSessionUpdate) Mutation() *UploadSessionMutation {
+	return usu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (usu *UploadSessionUpdate) ClearUser() *UploadSessionUpdate {
+	usu.mutation.ClearUser()
+	return usu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (usu *UploadSessionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(usu.hooks) == 0 {
+		if err = usu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = usu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UploadSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = usu.check(); err != nil {
+				return 0, err
+			}
+			usu.mutation = mutation
+			affected, err = usu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(usu.hooks) - 1; i >= 0; i-- {
+			if usu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = usu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, usu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usu *UploadSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := usu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (usu *UploadSessionUpdate) Exec(ctx context.Context) error {
+	_, err := usu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usu *UploadSessionUpdate) ExecX(ctx context.Context) {
+	if err := usu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usu *UploadSessionUpdate) check() error {
+	if _, ok := usu.mutation.UserID(); usu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.user"`)
+	}
+	return nil
+}
+
+func (usu *UploadSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   uploadsession.Table,
+			Columns: uploadsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: uploadsession.FieldID,
+			},
+		},
+	}
+	if ps := usu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usu.mutation.SourceLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldSourceLanguage,
+		})
+	}
+	if value, ok := usu.mutation.TargetLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldTargetLanguage,
+		})
+	}
+	if value, ok := usu.mutation.Files(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: uploadsession.FieldFiles,
+		})
+	}
+	if value, ok := usu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldExpiresAt,
+		})
+	}
+	if value, ok := usu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldCreatedAt,
+		})
+	}
+	if usu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := usu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, usu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UploadSessionUpdateOne is the builder for updating a single UploadSession entity.
+type UploadSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadSessionMutation
+}
+
+// SetSourceLanguage sets the "source_language" field.
+func (usuo *UploadSessionUpdateOne) SetSourceLanguage(s string) *UploadSessionUpdateOne {
+	usuo.mutation.SetSourceLanguage(s)
+	return usuo
+}
+
+// SetTargetLanguage sets the "target_language" field.
+func (usuo *UploadSessionUpdateOne) SetTargetLanguage(s string) *UploadSessionUpdateOne {
+	usuo.mutation.SetTargetLanguage(s)
+	return usuo
+}
+
+// SetFiles sets the "files" field.
+func (usuo *UploadSessionUpdateOne) SetFiles(ssf []schema.UploadSessionFile) *UploadSessionUpdateOne {
+	usuo.mutation.SetFiles(ssf)
+	return usuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usuo *UploadSessionUpdateOne) SetExpiresAt(t time.Time) *UploadSessionUpdateOne {
+	usuo.mutation.SetExpiresAt(t)
+	return usuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (usuo *UploadSessionUpdateOne) SetCreatedAt(t time.Time) *UploadSessionUpdateOne {
+	usuo.mutation.SetCreatedAt(t)
+	return usuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (usuo *UploadSessionUpdateOne) SetNillableCreatedAt(t *time.Time) *UploadSessionUpdateOne {
+	if t != nil {
+		usuo.SetCreatedAt(*t)
+	}
+	return usuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (usuo *UploadSessionUpdateOne) SetUserID(id uuid.UUID) *UploadSessionUpdateOne {
+	usuo.mutation.SetUserID(id)
+	return usuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (usuo *UploadSessionUpdateOne) SetUser(u *User) *UploadSessionUpdateOne {
+	return usuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (usuo *UploadSessionUpdateOne) Mutation() *UploadSessionMutation {
+	return usuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (usuo *UploadSessionUpdateOne) ClearUser() *UploadSessionUpdateOne {
+	usuo.mutation.ClearUser()
+	return usuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (usuo *UploadSessionUpdateOne) Select(field string, fields ...string) *UploadSessionUpdateOne {
+	usuo.fields = append([]string{field}, fields...)
+	return usuo
+}
+
+// Save executes the query and returns the updated UploadSession entity.
+func (usuo *UploadSessionUpdateOne) Save(ctx context.Context) (*UploadSession, error) {
+	var (
+		err  error
+		node *UploadSession
+	)
+	if len(usuo.hooks) == 0 {
+		if err = usuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = usuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UploadSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = usuo.check(); err != nil {
+				return nil, err
+			}
+			usuo.mutation = mutation
+			node, err = usuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(usuo.hooks) - 1; i >= 0; i-- {
+			if usuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = usuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, usuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usuo *UploadSessionUpdateOne) SaveX(ctx context.Context) *UploadSession {
+	node, err := usuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (usuo *UploadSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := usuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usuo *UploadSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := usuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usuo *UploadSessionUpdateOne) check() error {
+	if _, ok := usuo.mutation.UserID(); usuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.user"`)
+	}
+	return nil
+}
+
+func (usuo *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSession, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   uploadsession.Table,
+			Columns: uploadsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: uploadsession.FieldID,
+			},
+		},
+	}
+	id, ok := usuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UploadSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := usuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadsession.FieldID)
+		for _, f := range fields {
+			if !uploadsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != uploadsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := usuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usuo.mutation.SourceLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldSourceLanguage,
+		})
+	}
+	if value, ok := usuo.mutation.TargetLanguage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: uploadsession.FieldTargetLanguage,
+		})
+	}
+	if value, ok := usuo.mutation.Files(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: uploadsession.FieldFiles,
+		})
+	}
+	if value, ok := usuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldExpiresAt,
+		})
+	}
+	if value, ok := usuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: uploadsession.FieldCreatedAt,
+		})
+	}
+	if usuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := usuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UploadSession{config: usuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, usuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
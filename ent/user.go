@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
@@ -34,6 +35,8 @@ type User struct {
 	GitlabRefreshToken string `json:"gitlab_refresh_token,omitempty"`
 	// GitlabAccessTokenExpiresAt holds the value of the "gitlab_access_token_expires_at" field.
 	GitlabAccessTokenExpiresAt time.Time `json:"gitlab_access_token_expires_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -47,11 +50,21 @@ type UserEdges struct {
 	Tokens []*Token `json:"tokens,omitempty"`
 	// Submissions holds the value of the submissions edge.
 	Submissions []*Submission `json:"submissions,omitempty"`
+	// UploadSessions holds the value of the upload_sessions edge.
+	UploadSessions []*UploadSession `json:"upload_sessions,omitempty"`
+	// ResumableUploads holds the value of the resumable_uploads edge.
+	ResumableUploads []*ResumableUpload `json:"resumable_uploads,omitempty"`
+	// DataKeys holds the value of the data_keys edge.
+	DataKeys []*DataKey `json:"data_keys,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// NotificationPreference holds the value of the notification_preference edge.
+	NotificationPreference *NotificationPreference `json:"notification_preference,omitempty"`
 	// Subscription holds the value of the subscription edge.
 	Subscription *Subscription `json:"subscription,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [8]bool
 }
 
 // TokensOrErr returns the Tokens value or an error if the edge
@@ -72,10 +85,60 @@ func (e UserEdges) SubmissionsOrErr() ([]*Submission, error) {
 	return nil, &NotLoadedError{edge: "submissions"}
 }
 
+// UploadSessionsOrErr returns the UploadSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UploadSessionsOrErr() ([]*UploadSession, error) {
+	if e.loadedTypes[2] {
+		return e.UploadSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "upload_sessions"}
+}
+
+// ResumableUploadsOrErr returns the ResumableUploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ResumableUploadsOrErr() ([]*ResumableUpload, error) {
+	if e.loadedTypes[3] {
+		return e.ResumableUploads, nil
+	}
+	return nil, &NotLoadedError{edge: "resumable_uploads"}
+}
+
+// DataKeysOrErr returns the DataKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataKeysOrErr() ([]*DataKey, error) {
+	if e.loadedTypes[4] {
+		return e.DataKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "data_keys"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[5] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// NotificationPreferenceOrErr returns the NotificationPreference value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) NotificationPreferenceOrErr() (*NotificationPreference, error) {
+	if e.loadedTypes[6] {
+		if e.NotificationPreference == nil {
+			// The edge notification_preference was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: notificationpreference.Label}
+		}
+		return e.NotificationPreference, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preference"}
+}
+
 // SubscriptionOrErr returns the Subscription value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) SubscriptionOrErr() (*Subscription, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[7] {
 		if e.Subscription == nil {
 			// The edge subscription was loaded in eager-loading,
 			// but was not found.
@@ -93,7 +156,7 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case user.FieldGithubUserID, user.FieldGitlabUserID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldPassword, user.FieldGithubAccessToken, user.FieldGitlabAccessToken, user.FieldGitlabRefreshToken:
+		case user.FieldEmail, user.FieldPassword, user.FieldGithubAccessToken, user.FieldGitlabAccessToken, user.FieldGitlabRefreshToken, user.FieldRole:
 			values[i] = new(sql.NullString)
 		case user.FieldGitlabAccessTokenExpiresAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -168,6 +231,12 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.GitlabAccessTokenExpiresAt = value.Time
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -189,6 +258,31 @@ func (u *User) QuerySubmissions() *SubmissionQuery {
 	return (&UserClient{config: u.config}).QuerySubmissions(u)
 }
 
+// QueryUploadSessions queries the "upload_sessions" edge of the User entity.
+func (u *User) QueryUploadSessions() *UploadSessionQuery {
+	return (&UserClient{config: u.config}).QueryUploadSessions(u)
+}
+
+// QueryResumableUploads queries the "resumable_uploads" edge of the User entity.
+func (u *User) QueryResumableUploads() *ResumableUploadQuery {
+	return (&UserClient{config: u.config}).QueryResumableUploads(u)
+}
+
+// QueryDataKeys queries the "data_keys" edge of the User entity.
+func (u *User) QueryDataKeys() *DataKeyQuery {
+	return (&UserClient{config: u.config}).QueryDataKeys(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return (&UserClient{config: u.config}).QueryNotifications(u)
+}
+
+// QueryNotificationPreference queries the "notification_preference" edge of the User entity.
+func (u *User) QueryNotificationPreference() *NotificationPreferenceQuery {
+	return (&UserClient{config: u.config}).QueryNotificationPreference(u)
+}
+
 // QuerySubscription queries the "subscription" edge of the User entity.
 func (u *User) QuerySubscription() *SubscriptionQuery {
 	return (&UserClient{config: u.config}).QuerySubscription(u)
@@ -233,6 +327,8 @@ func (u *User) String() string {
 	builder.WriteString(u.GitlabRefreshToken)
 	builder.WriteString(", gitlab_access_token_expires_at=")
 	builder.WriteString(u.GitlabAccessTokenExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteString(", created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
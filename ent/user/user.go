@@ -3,6 +3,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -29,12 +30,24 @@ const (
 	FieldGitlabRefreshToken = "gitlab_refresh_token"
 	// FieldGitlabAccessTokenExpiresAt holds the string denoting the gitlab_access_token_expires_at field in the database.
 	FieldGitlabAccessTokenExpiresAt = "gitlab_access_token_expires_at"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeTokens holds the string denoting the tokens edge name in mutations.
 	EdgeTokens = "tokens"
 	// EdgeSubmissions holds the string denoting the submissions edge name in mutations.
 	EdgeSubmissions = "submissions"
+	// EdgeUploadSessions holds the string denoting the upload_sessions edge name in mutations.
+	EdgeUploadSessions = "upload_sessions"
+	// EdgeResumableUploads holds the string denoting the resumable_uploads edge name in mutations.
+	EdgeResumableUploads = "resumable_uploads"
+	// EdgeDataKeys holds the string denoting the data_keys edge name in mutations.
+	EdgeDataKeys = "data_keys"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeNotificationPreference holds the string denoting the notification_preference edge name in mutations.
+	EdgeNotificationPreference = "notification_preference"
 	// EdgeSubscription holds the string denoting the subscription edge name in mutations.
 	EdgeSubscription = "subscription"
 	// Table holds the table name of the user in the database.
@@ -53,6 +66,41 @@ const (
 	SubmissionsInverseTable = "submissions"
 	// SubmissionsColumn is the table column denoting the submissions relation/edge.
 	SubmissionsColumn = "user_submissions"
+	// UploadSessionsTable is the table that holds the upload_sessions relation/edge.
+	UploadSessionsTable = "upload_sessions"
+	// UploadSessionsInverseTable is the table name for the UploadSession entity.
+	// It exists in this package in order to avoid circular dependency with the "uploadsession" package.
+	UploadSessionsInverseTable = "upload_sessions"
+	// UploadSessionsColumn is the table column denoting the upload_sessions relation/edge.
+	UploadSessionsColumn = "user_upload_sessions"
+	// ResumableUploadsTable is the table that holds the resumable_uploads relation/edge.
+	ResumableUploadsTable = "resumable_uploads"
+	// ResumableUploadsInverseTable is the table name for the ResumableUpload entity.
+	// It exists in this package in order to avoid circular dependency with the "resumableupload" package.
+	ResumableUploadsInverseTable = "resumable_uploads"
+	// ResumableUploadsColumn is the table column denoting the resumable_uploads relation/edge.
+	ResumableUploadsColumn = "user_resumable_uploads"
+	// DataKeysTable is the table that holds the data_keys relation/edge.
+	DataKeysTable = "data_keys"
+	// DataKeysInverseTable is the table name for the DataKey entity.
+	// It exists in this package in order to avoid circular dependency with the "datakey" package.
+	DataKeysInverseTable = "data_keys"
+	// DataKeysColumn is the table column denoting the data_keys relation/edge.
+	DataKeysColumn = "user_data_keys"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_notifications"
+	// NotificationPreferenceTable is the table that holds the notification_preference relation/edge.
+	NotificationPreferenceTable = "notification_preferences"
+	// NotificationPreferenceInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferenceInverseTable = "notification_preferences"
+	// NotificationPreferenceColumn is the table column denoting the notification_preference relation/edge.
+	NotificationPreferenceColumn = "user_notification_preference"
 	// SubscriptionTable is the table that holds the subscription relation/edge.
 	SubscriptionTable = "subscriptions"
 	// SubscriptionInverseTable is the table name for the Subscription entity.
@@ -73,6 +121,7 @@ var Columns = []string{
 	FieldGitlabAccessToken,
 	FieldGitlabRefreshToken,
 	FieldGitlabAccessTokenExpiresAt,
+	FieldRole,
 	FieldCreatedAt,
 }
 
@@ -92,3 +141,29 @@ var (
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
@@ -13,10 +13,15 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
 	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
 
@@ -30,10 +35,15 @@ type UserQuery struct {
 	fields     []string
 	predicates []predicate.User
 	// eager-loading edges.
-	withTokens       *TokenQuery
-	withSubmissions  *SubmissionQuery
-	withSubscription *SubscriptionQuery
-	modifiers        []func(s *sql.Selector)
+	withTokens                 *TokenQuery
+	withSubmissions            *SubmissionQuery
+	withUploadSessions         *UploadSessionQuery
+	withResumableUploads       *ResumableUploadQuery
+	withDataKeys               *DataKeyQuery
+	withNotifications          *NotificationQuery
+	withNotificationPreference *NotificationPreferenceQuery
+	withSubscription           *SubscriptionQuery
+	modifiers                  []func(s *sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -114,6 +124,116 @@ func (uq *UserQuery) QuerySubmissions() *SubmissionQuery {
 	return query
 }
 
+// QueryUploadSessions chains the current query on the "upload_sessions" edge.
+func (uq *UserQuery) QueryUploadSessions() *UploadSessionQuery {
+	query := &UploadSessionQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(uploadsession.Table, uploadsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UploadSessionsTable, user.UploadSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResumableUploads chains the current query on the "resumable_uploads" edge.
+func (uq *UserQuery) QueryResumableUploads() *ResumableUploadQuery {
+	query := &ResumableUploadQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(resumableupload.Table, resumableupload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ResumableUploadsTable, user.ResumableUploadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDataKeys chains the current query on the "data_keys" edge.
+func (uq *UserQuery) QueryDataKeys() *DataKeyQuery {
+	query := &DataKeyQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(datakey.Table, datakey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DataKeysTable, user.DataKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (uq *UserQuery) QueryNotifications() *NotificationQuery {
+	query := &NotificationQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference chains the current query on the "notification_preference" edge.
+func (uq *UserQuery) QueryNotificationPreference() *NotificationPreferenceQuery {
+	query := &NotificationPreferenceQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.NotificationPreferenceTable, user.NotificationPreferenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySubscription chains the current query on the "subscription" edge.
 func (uq *UserQuery) QuerySubscription() *SubscriptionQuery {
 	query := &SubscriptionQuery{config: uq.config}
@@ -312,14 +432,19 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:           uq.config,
-		limit:            uq.limit,
-		offset:           uq.offset,
-		order:            append([]OrderFunc{}, uq.order...),
-		predicates:       append([]predicate.User{}, uq.predicates...),
-		withTokens:       uq.withTokens.Clone(),
-		withSubmissions:  uq.withSubmissions.Clone(),
-		withSubscription: uq.withSubscription.Clone(),
+		config:                     uq.config,
+		limit:                      uq.limit,
+		offset:                     uq.offset,
+		order:                      append([]OrderFunc{}, uq.order...),
+		predicates:                 append([]predicate.User{}, uq.predicates...),
+		withTokens:                 uq.withTokens.Clone(),
+		withSubmissions:            uq.withSubmissions.Clone(),
+		withUploadSessions:         uq.withUploadSessions.Clone(),
+		withResumableUploads:       uq.withResumableUploads.Clone(),
+		withDataKeys:               uq.withDataKeys.Clone(),
+		withNotifications:          uq.withNotifications.Clone(),
+		withNotificationPreference: uq.withNotificationPreference.Clone(),
+		withSubscription:           uq.withSubscription.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
 		path:   uq.path,
@@ -349,6 +474,61 @@ func (uq *UserQuery) WithSubmissions(opts ...func(*SubmissionQuery)) *UserQuery
 	return uq
 }
 
+// WithUploadSessions tells the query-builder to eager-load the nodes that are connected to
+// the "upload_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithUploadSessions(opts ...func(*UploadSessionQuery)) *UserQuery {
+	query := &UploadSessionQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withUploadSessions = query
+	return uq
+}
+
+// WithResumableUploads tells the query-builder to eager-load the nodes that are connected to
+// the "resumable_uploads" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithResumableUploads(opts ...func(*ResumableUploadQuery)) *UserQuery {
+	query := &ResumableUploadQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withResumableUploads = query
+	return uq
+}
+
+// WithDataKeys tells the query-builder to eager-load the nodes that are connected to
+// the "data_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDataKeys(opts ...func(*DataKeyQuery)) *UserQuery {
+	query := &DataKeyQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDataKeys = query
+	return uq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := &NotificationQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotifications = query
+	return uq
+}
+
+// WithNotificationPreference tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preference" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotificationPreference(opts ...func(*NotificationPreferenceQuery)) *UserQuery {
+	query := &NotificationPreferenceQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotificationPreference = query
+	return uq
+}
+
 // WithSubscription tells the query-builder to eager-load the nodes that are connected to
 // the "subscription" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSubscription(opts ...func(*SubscriptionQuery)) *UserQuery {
@@ -425,9 +605,14 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [8]bool{
 			uq.withTokens != nil,
 			uq.withSubmissions != nil,
+			uq.withUploadSessions != nil,
+			uq.withResumableUploads != nil,
+			uq.withDataKeys != nil,
+			uq.withNotifications != nil,
+			uq.withNotificationPreference != nil,
 			uq.withSubscription != nil,
 		}
 	)
@@ -512,6 +697,150 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withUploadSessions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.UploadSessions = []*UploadSession{}
+		}
+		query.withFKs = true
+		query.Where(predicate.UploadSession(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.UploadSessionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_upload_sessions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_upload_sessions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_upload_sessions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.UploadSessions = append(node.Edges.UploadSessions, n)
+		}
+	}
+
+	if query := uq.withResumableUploads; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.ResumableUploads = []*ResumableUpload{}
+		}
+		query.withFKs = true
+		query.Where(predicate.ResumableUpload(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.ResumableUploadsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_resumable_uploads
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_resumable_uploads" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_resumable_uploads" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.ResumableUploads = append(node.Edges.ResumableUploads, n)
+		}
+	}
+
+	if query := uq.withDataKeys; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.DataKeys = []*DataKey{}
+		}
+		query.withFKs = true
+		query.Where(predicate.DataKey(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.DataKeysColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_data_keys
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_data_keys" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_data_keys" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.DataKeys = append(node.Edges.DataKeys, n)
+		}
+	}
+
+	if query := uq.withNotifications; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Notifications = []*Notification{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Notification(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.NotificationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_notifications
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_notifications" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_notifications" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Notifications = append(node.Edges.Notifications, n)
+		}
+	}
+
+	if query := uq.withNotificationPreference; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.NotificationPreference(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.NotificationPreferenceColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_notification_preference
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_notification_preference" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_notification_preference" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.NotificationPreference = n
+		}
+	}
+
 	if query := uq.withSubscription; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[uuid.UUID]*User)
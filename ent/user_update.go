@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
 	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
 	"github.com/tereus-project/tereus-api/ent/user"
 )
 
@@ -192,6 +197,20 @@ func (uu *UserUpdate) ClearGitlabAccessTokenExpiresAt() *UserUpdate {
 	return uu
 }
 
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -236,6 +255,85 @@ func (uu *UserUpdate) AddSubmissions(s ...*Submission) *UserUpdate {
 	return uu.AddSubmissionIDs(ids...)
 }
 
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by IDs.
+func (uu *UserUpdate) AddUploadSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUploadSessionIDs(ids...)
+	return uu
+}
+
+// AddUploadSessions adds the "upload_sessions" edges to the UploadSession entity.
+func (uu *UserUpdate) AddUploadSessions(u ...*UploadSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddUploadSessionIDs(ids...)
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (uu *UserUpdate) AddResumableUploadIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddResumableUploadIDs(ids...)
+	return uu
+}
+
+// AddResumableUploads adds the "resumable_uploads" edges to the ResumableUpload entity.
+func (uu *UserUpdate) AddResumableUploads(r ...*ResumableUpload) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddResumableUploadIDs(ids...)
+}
+
+// AddDataKeyIDs adds the "data_keys" edge to the DataKey entity by IDs.
+func (uu *UserUpdate) AddDataKeyIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddDataKeyIDs(ids...)
+	return uu
+}
+
+// AddDataKeys adds the "data_keys" edges to the DataKey entity.
+func (uu *UserUpdate) AddDataKeys(d ...*DataKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDataKeyIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uu *UserUpdate) AddNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddNotificationIDs(ids...)
+	return uu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uu *UserUpdate) AddNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNotificationIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (uu *UserUpdate) SetNotificationPreferenceID(id uuid.UUID) *UserUpdate {
+	uu.mutation.SetNotificationPreferenceID(id)
+	return uu
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableNotificationPreferenceID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		uu = uu.SetNotificationPreferenceID(*id)
+	}
+	return uu
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (uu *UserUpdate) SetNotificationPreference(n *NotificationPreference) *UserUpdate {
+	return uu.SetNotificationPreferenceID(n.ID)
+}
+
 // SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
 func (uu *UserUpdate) SetSubscriptionID(id uuid.UUID) *UserUpdate {
 	uu.mutation.SetSubscriptionID(id)
@@ -302,6 +400,96 @@ func (uu *UserUpdate) RemoveSubmissions(s ...*Submission) *UserUpdate {
 	return uu.RemoveSubmissionIDs(ids...)
 }
 
+// ClearUploadSessions clears all "upload_sessions" edges to the UploadSession entity.
+func (uu *UserUpdate) ClearUploadSessions() *UserUpdate {
+	uu.mutation.ClearUploadSessions()
+	return uu
+}
+
+// RemoveUploadSessionIDs removes the "upload_sessions" edge to UploadSession entities by IDs.
+func (uu *UserUpdate) RemoveUploadSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUploadSessionIDs(ids...)
+	return uu
+}
+
+// RemoveUploadSessions removes "upload_sessions" edges to UploadSession entities.
+func (uu *UserUpdate) RemoveUploadSessions(u ...*UploadSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveUploadSessionIDs(ids...)
+}
+
+// ClearResumableUploads clears all "resumable_uploads" edges to the ResumableUpload entity.
+func (uu *UserUpdate) ClearResumableUploads() *UserUpdate {
+	uu.mutation.ClearResumableUploads()
+	return uu
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to ResumableUpload entities by IDs.
+func (uu *UserUpdate) RemoveResumableUploadIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveResumableUploadIDs(ids...)
+	return uu
+}
+
+// RemoveResumableUploads removes "resumable_uploads" edges to ResumableUpload entities.
+func (uu *UserUpdate) RemoveResumableUploads(r ...*ResumableUpload) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveResumableUploadIDs(ids...)
+}
+
+// ClearDataKeys clears all "data_keys" edges to the DataKey entity.
+func (uu *UserUpdate) ClearDataKeys() *UserUpdate {
+	uu.mutation.ClearDataKeys()
+	return uu
+}
+
+// RemoveDataKeyIDs removes the "data_keys" edge to DataKey entities by IDs.
+func (uu *UserUpdate) RemoveDataKeyIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveDataKeyIDs(ids...)
+	return uu
+}
+
+// RemoveDataKeys removes "data_keys" edges to DataKey entities.
+func (uu *UserUpdate) RemoveDataKeys(d ...*DataKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDataKeyIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uu *UserUpdate) ClearNotifications() *UserUpdate {
+	uu.mutation.ClearNotifications()
+	return uu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveNotificationIDs(ids...)
+	return uu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uu *UserUpdate) RemoveNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (uu *UserUpdate) ClearNotificationPreference() *UserUpdate {
+	uu.mutation.ClearNotificationPreference()
+	return uu
+}
+
 // ClearSubscription clears the "subscription" edge to the Subscription entity.
 func (uu *UserUpdate) ClearSubscription() *UserUpdate {
 	uu.mutation.ClearSubscription()
@@ -315,6 +503,9 @@ func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(uu.hooks) == 0 {
+		if err = uu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = uu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -322,6 +513,9 @@ func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = uu.check(); err != nil {
+				return 0, err
+			}
 			uu.mutation = mutation
 			affected, err = uu.sqlSave(ctx)
 			mutation.done = true
@@ -362,6 +556,16 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -492,6 +696,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldGitlabAccessTokenExpiresAt,
 		})
 	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -607,33 +818,52 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.SubscriptionCleared() {
+	if uu.mutation.UploadSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SubscriptionTable,
-			Columns: []string{user.SubscriptionColumn},
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: subscription.FieldID,
+					Column: uploadsession.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.SubscriptionIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.RemovedUploadSessionsIDs(); len(nodes) > 0 && !uu.mutation.UploadSessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SubscriptionTable,
-			Columns: []string{user.SubscriptionColumn},
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: subscription.FieldID,
+					Column: uploadsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UploadSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: uploadsession.FieldID,
 				},
 			},
 		}
@@ -642,100 +872,332 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{err.Error(), err}
+	if uu.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
+	if nodes := uu.mutation.RemovedResumableUploadsIDs(); len(nodes) > 0 && !uu.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearPassword clears the value of the "password" field.
-func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
-	uuo.mutation.ClearPassword()
-	return uuo
-}
-
-// SetGithubUserID sets the "github_user_id" field.
-func (uuo *UserUpdateOne) SetGithubUserID(i int64) *UserUpdateOne {
-	uuo.mutation.ResetGithubUserID()
-	uuo.mutation.SetGithubUserID(i)
-	return uuo
-}
-
-// SetNillableGithubUserID sets the "github_user_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableGithubUserID(i *int64) *UserUpdateOne {
-	if i != nil {
-		uuo.SetGithubUserID(*i)
+	if nodes := uu.mutation.ResumableUploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// AddGithubUserID adds i to the "github_user_id" field.
-func (uuo *UserUpdateOne) AddGithubUserID(i int64) *UserUpdateOne {
-	uuo.mutation.AddGithubUserID(i)
-	return uuo
-}
-
-// ClearGithubUserID clears the value of the "github_user_id" field.
-func (uuo *UserUpdateOne) ClearGithubUserID() *UserUpdateOne {
-	uuo.mutation.ClearGithubUserID()
-	return uuo
-}
-
-// SetGithubAccessToken sets the "github_access_token" field.
-func (uuo *UserUpdateOne) SetGithubAccessToken(s string) *UserUpdateOne {
-	uuo.mutation.SetGithubAccessToken(s)
-	return uuo
-}
-
-// SetNillableGithubAccessToken sets the "github_access_token" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableGithubAccessToken(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetGithubAccessToken(*s)
+	if uu.mutation.DataKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearGithubAccessToken clears the value of the "github_access_token" field.
-func (uuo *UserUpdateOne) ClearGithubAccessToken() *UserUpdateOne {
-	uuo.mutation.ClearGithubAccessToken()
-	return uuo
-}
-
-// SetGitlabUserID sets the "gitlab_user_id" field.
-func (uuo *UserUpdateOne) SetGitlabUserID(i int) *UserUpdateOne {
+	if nodes := uu.mutation.RemovedDataKeysIDs(); len(nodes) > 0 && !uu.mutation.DataKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DataKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notificationpreference.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notificationpreference.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: subscription.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SubscriptionTable,
+			Columns: []string{user.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: subscription.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	uuo.mutation.ClearPassword()
+	return uuo
+}
+
+// SetGithubUserID sets the "github_user_id" field.
+func (uuo *UserUpdateOne) SetGithubUserID(i int64) *UserUpdateOne {
+	uuo.mutation.ResetGithubUserID()
+	uuo.mutation.SetGithubUserID(i)
+	return uuo
+}
+
+// SetNillableGithubUserID sets the "github_user_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGithubUserID(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetGithubUserID(*i)
+	}
+	return uuo
+}
+
+// AddGithubUserID adds i to the "github_user_id" field.
+func (uuo *UserUpdateOne) AddGithubUserID(i int64) *UserUpdateOne {
+	uuo.mutation.AddGithubUserID(i)
+	return uuo
+}
+
+// ClearGithubUserID clears the value of the "github_user_id" field.
+func (uuo *UserUpdateOne) ClearGithubUserID() *UserUpdateOne {
+	uuo.mutation.ClearGithubUserID()
+	return uuo
+}
+
+// SetGithubAccessToken sets the "github_access_token" field.
+func (uuo *UserUpdateOne) SetGithubAccessToken(s string) *UserUpdateOne {
+	uuo.mutation.SetGithubAccessToken(s)
+	return uuo
+}
+
+// SetNillableGithubAccessToken sets the "github_access_token" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGithubAccessToken(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetGithubAccessToken(*s)
+	}
+	return uuo
+}
+
+// ClearGithubAccessToken clears the value of the "github_access_token" field.
+func (uuo *UserUpdateOne) ClearGithubAccessToken() *UserUpdateOne {
+	uuo.mutation.ClearGithubAccessToken()
+	return uuo
+}
+
+// SetGitlabUserID sets the "gitlab_user_id" field.
+func (uuo *UserUpdateOne) SetGitlabUserID(i int) *UserUpdateOne {
 	uuo.mutation.ResetGitlabUserID()
 	uuo.mutation.SetGitlabUserID(i)
 	return uuo
@@ -821,6 +1283,20 @@ func (uuo *UserUpdateOne) ClearGitlabAccessTokenExpiresAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetCreatedAt(t)
@@ -856,13 +1332,92 @@ func (uuo *UserUpdateOne) AddSubmissionIDs(ids ...uuid.UUID) *UserUpdateOne {
 	return uuo
 }
 
-// AddSubmissions adds the "submissions" edges to the Submission entity.
-func (uuo *UserUpdateOne) AddSubmissions(s ...*Submission) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// AddSubmissions adds the "submissions" edges to the Submission entity.
+func (uuo *UserUpdateOne) AddSubmissions(s ...*Submission) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSubmissionIDs(ids...)
+}
+
+// AddUploadSessionIDs adds the "upload_sessions" edge to the UploadSession entity by IDs.
+func (uuo *UserUpdateOne) AddUploadSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUploadSessionIDs(ids...)
+	return uuo
+}
+
+// AddUploadSessions adds the "upload_sessions" edges to the UploadSession entity.
+func (uuo *UserUpdateOne) AddUploadSessions(u ...*UploadSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddUploadSessionIDs(ids...)
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (uuo *UserUpdateOne) AddResumableUploadIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddResumableUploadIDs(ids...)
+	return uuo
+}
+
+// AddResumableUploads adds the "resumable_uploads" edges to the ResumableUpload entity.
+func (uuo *UserUpdateOne) AddResumableUploads(r ...*ResumableUpload) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddResumableUploadIDs(ids...)
+}
+
+// AddDataKeyIDs adds the "data_keys" edge to the DataKey entity by IDs.
+func (uuo *UserUpdateOne) AddDataKeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddDataKeyIDs(ids...)
+	return uuo
+}
+
+// AddDataKeys adds the "data_keys" edges to the DataKey entity.
+func (uuo *UserUpdateOne) AddDataKeys(d ...*DataKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDataKeyIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddNotificationIDs(ids...)
+	return uuo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotificationIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (uuo *UserUpdateOne) SetNotificationPreferenceID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetNotificationPreferenceID(id)
+	return uuo
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNotificationPreferenceID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetNotificationPreferenceID(*id)
 	}
-	return uuo.AddSubmissionIDs(ids...)
+	return uuo
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (uuo *UserUpdateOne) SetNotificationPreference(n *NotificationPreference) *UserUpdateOne {
+	return uuo.SetNotificationPreferenceID(n.ID)
 }
 
 // SetSubscriptionID sets the "subscription" edge to the Subscription entity by ID.
@@ -931,6 +1486,96 @@ func (uuo *UserUpdateOne) RemoveSubmissions(s ...*Submission) *UserUpdateOne {
 	return uuo.RemoveSubmissionIDs(ids...)
 }
 
+// ClearUploadSessions clears all "upload_sessions" edges to the UploadSession entity.
+func (uuo *UserUpdateOne) ClearUploadSessions() *UserUpdateOne {
+	uuo.mutation.ClearUploadSessions()
+	return uuo
+}
+
+// RemoveUploadSessionIDs removes the "upload_sessions" edge to UploadSession entities by IDs.
+func (uuo *UserUpdateOne) RemoveUploadSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUploadSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveUploadSessions removes "upload_sessions" edges to UploadSession entities.
+func (uuo *UserUpdateOne) RemoveUploadSessions(u ...*UploadSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveUploadSessionIDs(ids...)
+}
+
+// ClearResumableUploads clears all "resumable_uploads" edges to the ResumableUpload entity.
+func (uuo *UserUpdateOne) ClearResumableUploads() *UserUpdateOne {
+	uuo.mutation.ClearResumableUploads()
+	return uuo
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to ResumableUpload entities by IDs.
+func (uuo *UserUpdateOne) RemoveResumableUploadIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveResumableUploadIDs(ids...)
+	return uuo
+}
+
+// RemoveResumableUploads removes "resumable_uploads" edges to ResumableUpload entities.
+func (uuo *UserUpdateOne) RemoveResumableUploads(r ...*ResumableUpload) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveResumableUploadIDs(ids...)
+}
+
+// ClearDataKeys clears all "data_keys" edges to the DataKey entity.
+func (uuo *UserUpdateOne) ClearDataKeys() *UserUpdateOne {
+	uuo.mutation.ClearDataKeys()
+	return uuo
+}
+
+// RemoveDataKeyIDs removes the "data_keys" edge to DataKey entities by IDs.
+func (uuo *UserUpdateOne) RemoveDataKeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveDataKeyIDs(ids...)
+	return uuo
+}
+
+// RemoveDataKeys removes "data_keys" edges to DataKey entities.
+func (uuo *UserUpdateOne) RemoveDataKeys(d ...*DataKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDataKeyIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	uuo.mutation.ClearNotifications()
+	return uuo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveNotificationIDs(ids...)
+	return uuo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (uuo *UserUpdateOne) ClearNotificationPreference() *UserUpdateOne {
+	uuo.mutation.ClearNotificationPreference()
+	return uuo
+}
+
 // ClearSubscription clears the "subscription" edge to the Subscription entity.
 func (uuo *UserUpdateOne) ClearSubscription() *UserUpdateOne {
 	uuo.mutation.ClearSubscription()
@@ -951,6 +1596,9 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 		node *User
 	)
 	if len(uuo.hooks) == 0 {
+		if err = uuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = uuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -958,6 +1606,9 @@ func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = uuo.check(); err != nil {
+				return nil, err
+			}
 			uuo.mutation = mutation
 			node, err = uuo.sqlSave(ctx)
 			mutation.done = true
@@ -998,6 +1649,16 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -1145,6 +1806,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldGitlabAccessTokenExpiresAt,
 		})
 	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldRole,
+		})
+	}
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -1260,6 +1928,257 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.UploadSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: uploadsession.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUploadSessionsIDs(); len(nodes) > 0 && !uuo.mutation.UploadSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: uploadsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UploadSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadSessionsTable,
+			Columns: []string{user.UploadSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: uploadsession.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedResumableUploadsIDs(); len(nodes) > 0 && !uuo.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ResumableUploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: resumableupload.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DataKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDataKeysIDs(); len(nodes) > 0 && !uuo.mutation.DataKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DataKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataKeysTable,
+			Columns: []string{user.DataKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: datakey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uuo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notification.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notificationpreference.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: notificationpreference.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.SubscriptionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -1,6 +1,8 @@
 package env
 
 import (
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
@@ -9,17 +11,79 @@ import (
 type Env struct {
 	DatabaseDriver   string `env:"DATABASE_DRIVER" env-required:"true"`
 	DatabaseEndpoint string `env:"DATABASE_ENDPOINT" env-required:"true"`
+	// Apply the pending migrations on startup instead of refusing to start,
+	// meant for the development databases
+	DatabaseMigrateOnStart bool `env:"DATABASE_MIGRATE_ON_START" env-default:"false"`
+
+	// "s3" or "local"
+	StorageBackend   string `env:"STORAGE_BACKEND" env-default:"s3"`
+	StorageLocalPath string `env:"STORAGE_LOCAL_PATH" env-default:"./storage"`
 
-	S3Bucket          string `env:"S3_BUCKET" env-required:"true"`
-	S3AccessKey       string `env:"S3_ACCESS_KEY" env-required:"true"`
-	S3SecretKey       string `env:"S3_SECRET_KEY" env-required:"true"`
-	S3Endpoint        string `env:"S3_ENDPOINT" env-required:"true"`
+	// Required by the s3 storage backend
+	S3Bucket          string `env:"S3_BUCKET"`
+	S3AccessKey       string `env:"S3_ACCESS_KEY"`
+	S3SecretKey       string `env:"S3_SECRET_KEY"`
+	S3Endpoint        string `env:"S3_ENDPOINT"`
 	S3HTTPSEnabled    bool   `env:"S3_HTTPS_ENABLED" env-default:"false"`
 	SubmissionsFolder string `env:"SUBMISSIONS_FOLDER" env-required:"true"`
 
+	// Base64 encoded 32 bytes keys, the stored objects are not encrypted if
+	// the master key is empty. The data keys still encrypted by the previous
+	// master key are rewrapped by the rewrap-keys command
+	EncryptionMasterKey         string `env:"ENCRYPTION_MASTER_KEY"`
+	EncryptionPreviousMasterKey string `env:"ENCRYPTION_PREVIOUS_MASTER_KEY"`
+
+	// How long after scheduling the objects for deletion their removal is
+	// checked, the S3 lifecycle expires them after a day and runs daily
+	StorageDeletionVerifyDelay time.Duration `env:"STORAGE_DELETION_VERIFY_DELAY" env-default:"48h"`
+
+	// Interval between the checks of the consistency of the object storage
+	// with the database, the inconsistencies found are only reported unless
+	// the repair is enabled
+	StorageReconciliationInterval time.Duration `env:"STORAGE_RECONCILIATION_INTERVAL" env-default:"24h"`
+	StorageReconciliationRepair   bool          `env:"STORAGE_RECONCILIATION_REPAIR" env-default:"false"`
+
+	// The periodic workers only run in the API process holding their lease,
+	// another process takes over at most this long after the holder stopped
+	LeaderLeaseDuration time.Duration `env:"LEADER_LEASE_DURATION" env-default:"30s"`
+
+	UploadSessionMaxSizeBytes int64 `env:"UPLOAD_SESSION_MAX_SIZE_BYTES" env-default:"1073741824"`
+
+	// When stopping, the process first reports itself as not ready for the
+	// drain delay, then gives the shutdown timeout to the requests and worker
+	// iterations in progress to complete
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
+	ShutdownTimeout    time.Duration `env:"SHUTDOWN_TIMEOUT" env-default:"25s"`
+	// Address of the health endpoint of the processes only running workers
+	WorkerHealthAddress string `env:"WORKER_HEALTH_ADDRESS" env-default:":1324"`
+
 	NSQEndpoint        string `env:"NSQ_ENDPOINT" env-required:"true"`
 	NSQLookupdEndpoint string `env:"NSQ_LOOKUPD" env-required:"true"`
 
+	// Every tier uses the standard lane by default, the other lanes must only
+	// be enabled once the transpilers consume their topics
+	SubmissionLanesFree       string `env:"SUBMISSION_LANES_FREE" env-default:"standard"`
+	SubmissionLanesPro        string `env:"SUBMISSION_LANES_PRO" env-default:"standard"`
+	SubmissionLanesEnterprise string `env:"SUBMISSION_LANES_ENTERPRISE" env-default:"standard"`
+
+	TranspilerVersions string `env:"TRANSPILER_VERSIONS"`
+
+	RetentionPolicyFree       string        `env:"RETENTION_POLICY_FREE" env-default:"done=24h,failed=24h,cancelled=24h"`
+	RetentionPolicyPro        string        `env:"RETENTION_POLICY_PRO"`
+	RetentionPolicyEnterprise string        `env:"RETENTION_POLICY_ENTERPRISE"`
+	RetentionWarningLeadTime  time.Duration `env:"RETENTION_WARNING_LEAD_TIME" env-default:"6h"`
+
+	// Emails are not sent if the SMTP host is empty
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT" env-default:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	SMTPFrom     string `env:"SMTP_FROM" env-default:"Tereus <no-reply@tereus.dev>"`
+
+	// Used to build the links sent in the notifications
+	APIURL     string `env:"API_URL" env-default:"https://api.tereus.dev"`
+	UpgradeURL string `env:"UPGRADE_URL" env-default:"https://tereus.dev/pricing"`
+
 	GithubOAuthClientId     string `env:"GITHUB_OAUTH_CLIENT_ID" env-required:"true"`
 	GithubOAuthClientSecret string `env:"GITHUB_OAUTH_CLIENT_SECRET" env-required:"true"`
 
@@ -0,0 +1,775 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/subscription"
+	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/user"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+type AdminHandler struct {
+	databaseService       *services.DatabaseService
+	tokenService          *services.TokenService
+	adminService          *services.AdminService
+	submissionService     *services.SubmissionService
+	subscriptionService   *services.SubscriptionService
+	reconciliationService *services.ReconciliationService
+}
+
+func NewAdminHandler(databaseService *services.DatabaseService, tokenService *services.TokenService, adminService *services.AdminService, submissionService *services.SubmissionService, subscriptionService *services.SubscriptionService, reconciliationService *services.ReconciliationService) (*AdminHandler, error) {
+	return &AdminHandler{
+		databaseService:       databaseService,
+		tokenService:          tokenService,
+		adminService:          adminService,
+		submissionService:     submissionService,
+		subscriptionService:   subscriptionService,
+		reconciliationService: reconciliationService,
+	}, nil
+}
+
+// Record an action before performing it, return a *echo.HTTPError if failing
+func (h *AdminHandler) recordAction(admin *ent.User, action string, target services.AdminActionTarget, metadata map[string]interface{}) error {
+	err := h.adminService.RecordAction(admin, action, target, metadata)
+	if err != nil {
+		logrus.WithError(err).WithField("action", action).Error("Failed to record admin action")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record admin action")
+	}
+
+	return nil
+}
+
+// Most items returned by a page of the paginated routes
+const maxPaginationLimit = 100
+
+// Get the page and the number of items per page from the query
+func getAdminPagination(c echo.Context) (int, int) {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > maxPaginationLimit {
+		limit = maxPaginationLimit
+	}
+
+	return page, limit
+}
+
+func newPaginatedMeta(itemCount int, totalItems int, page int, limit int) PaginatedMeta {
+	return PaginatedMeta{
+		ItemCount:    itemCount,
+		TotalItems:   totalItems,
+		ItemsPerPage: limit,
+		TotalPages:   int(math.Ceil(float64(totalItems) / float64(limit))),
+		CurrentPage:  page,
+	}
+}
+
+// Get the user of the :id parameter, return a *echo.HTTPError if failing
+func (h *AdminHandler) getTargetUser(c echo.Context) (*ent.User, error) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	target, err := h.databaseService.User.Get(context.Background(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "User not found")
+		}
+		logrus.WithError(err).Error("Failed to get user")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user")
+	}
+
+	return target, nil
+}
+
+// Get the submission of the :id parameter and the ID of its owner, return a
+// *echo.HTTPError if failing
+func (h *AdminHandler) getTargetSubmission(c echo.Context) (*ent.Submission, uuid.UUID, error) {
+	submissionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid submission ID")
+	}
+
+	sub, err := h.databaseService.Submission.Get(context.Background(), submissionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, uuid.Nil, echo.NewHTTPError(http.StatusNotFound, "Submission not found")
+		}
+		logrus.WithError(err).Error("Failed to get submission")
+		return nil, uuid.Nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission")
+	}
+
+	owner, err := sub.QueryUser().OnlyID(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission owner")
+		return nil, uuid.Nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission owner")
+	}
+
+	return sub, owner, nil
+}
+
+type adminUserItem struct {
+	ID           string `json:"id"`
+	Email        string `json:"email"`
+	Role         string `json:"role"`
+	GithubLinked bool   `json:"github_linked"`
+	GitlabLinked bool   `json:"gitlab_linked"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func toAdminUserItem(u *ent.User) *adminUserItem {
+	return &adminUserItem{
+		ID:           u.ID.String(),
+		Email:        u.Email,
+		Role:         u.Role.String(),
+		GithubLinked: u.GithubAccessToken != "",
+		GitlabLinked: u.GitlabAccessToken != "",
+		CreatedAt:    u.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+// GET /admin/users?search=
+// Search by user ID or by part of the email address
+func (h *AdminHandler) SearchUsers(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	search := c.QueryParam("search")
+	page, limit := getAdminPagination(c)
+
+	err = h.recordAction(admin, "search_users", services.AdminActionTarget{}, map[string]interface{}{
+		"search": search,
+	})
+	if err != nil {
+		return err
+	}
+
+	users, totalItems, err := h.adminService.SearchUsers(search, limit*(page-1), limit)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to search users")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to search users")
+	}
+
+	items := make([]*adminUserItem, len(users))
+	for i, u := range users {
+		items[i] = toAdminUserItem(u)
+	}
+
+	return c.JSON(http.StatusOK, PaginatedResponse[*adminUserItem]{
+		Items: items,
+		Meta:  newPaginatedMeta(len(items), totalItems, page, limit),
+	})
+}
+
+type adminSubscriptionItem struct {
+	Tier             string  `json:"tier"`
+	ExpiresAt        *string `json:"expires_at"`
+	Cancelled        bool    `json:"cancelled"`
+	Manual           bool    `json:"manual"`
+	StripeCustomerID string  `json:"stripe_customer_id"`
+}
+
+func toAdminSubscriptionItem(s *ent.Subscription) *adminSubscriptionItem {
+	var expiresAt *time.Time
+	if !s.ExpiresAt.IsZero() {
+		expiresAt = &s.ExpiresAt
+	}
+
+	return &adminSubscriptionItem{
+		Tier:             s.Tier.String(),
+		ExpiresAt:        formatOptionalTime(expiresAt),
+		Cancelled:        s.Cancelled,
+		Manual:           s.Manual,
+		StripeCustomerID: s.StripeCustomerID,
+	}
+}
+
+type adminUserDetails struct {
+	*adminUserItem
+	// Tier the submissions of the user are processed with
+	Tier string `json:"tier"`
+	// Last subscription of the user, even expired, null if they never had one
+	Subscription      *adminSubscriptionItem `json:"subscription"`
+	CurrentUsageBytes int64                  `json:"current_usage_bytes"`
+	ActiveTokens      int                    `json:"active_tokens"`
+}
+
+// GET /admin/users/:id
+func (h *AdminHandler) GetUser(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.getTargetUser(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "view_user", services.AdminActionTarget{UserID: &target.ID}, nil)
+	if err != nil {
+		return err
+	}
+
+	tier, err := h.subscriptionService.GetUserTier(target.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user tier")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user tier")
+	}
+
+	var subscriptionItem *adminSubscriptionItem
+	lastSubscription, err := h.subscriptionService.GetLastUserSubscription(target.ID)
+	if err == nil {
+		subscriptionItem = toAdminSubscriptionItem(lastSubscription)
+	} else if !ent.IsNotFound(err) {
+		logrus.WithError(err).Error("Failed to get user subscription")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user subscription")
+	}
+
+	currentUsageBytes, err := h.databaseService.Submission.Query().
+		Where(submission.HasUserWith(user.ID(target.ID))).
+		Modify(func(s *sql.Selector) {
+			s.Select("COALESCE(SUM(submission_source_size_bytes + submission_target_size_bytes),0) as usage")
+		}).
+		Int(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user usage")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user usage")
+	}
+
+	activeTokens, err := h.databaseService.Token.Query().
+		Where(
+			token.HasUserWith(user.ID(target.ID)),
+			token.IsActive(true),
+		).
+		Count(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to count user tokens")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to count user tokens")
+	}
+
+	return c.JSON(http.StatusOK, adminUserDetails{
+		adminUserItem:     toAdminUserItem(target),
+		Tier:              tier.String(),
+		Subscription:      subscriptionItem,
+		CurrentUsageBytes: int64(currentUsageBytes),
+		ActiveTokens:      activeTokens,
+	})
+}
+
+type adminSubmissionItem struct {
+	ID                   string  `json:"id"`
+	SourceLanguage       string  `json:"source_language"`
+	TargetLanguage       string  `json:"target_language"`
+	IsInline             bool    `json:"is_inline"`
+	IsPublic             bool    `json:"is_public"`
+	Status               string  `json:"status"`
+	Reason               string  `json:"reason"`
+	QueueLane            string  `json:"queue_lane"`
+	TranspilerVersion    string  `json:"transpiler_version"`
+	CacheHit             bool    `json:"cache_hit"`
+	SourceSizeBytes      int     `json:"source_size_bytes"`
+	TargetSizeBytes      int     `json:"target_size_bytes"`
+	CreatedAt            string  `json:"created_at"`
+	ProcessingStartedAt  *string `json:"processing_started_at"`
+	ProcessingFinishedAt *string `json:"processing_finished_at"`
+	ExpiresAt            *string `json:"expires_at"`
+}
+
+func toAdminSubmissionItem(s *ent.Submission) *adminSubmissionItem {
+	var processingStartedAt, processingFinishedAt *time.Time
+	if !s.ProcessingStartedAt.IsZero() {
+		processingStartedAt = &s.ProcessingStartedAt
+	}
+	if !s.ProcessingFinishedAt.IsZero() {
+		processingFinishedAt = &s.ProcessingFinishedAt
+	}
+
+	return &adminSubmissionItem{
+		ID:                   s.ID.String(),
+		SourceLanguage:       s.SourceLanguage,
+		TargetLanguage:       s.TargetLanguage,
+		IsInline:             s.IsInline,
+		IsPublic:             s.IsPublic,
+		Status:               s.Status.String(),
+		Reason:               s.Reason,
+		QueueLane:            s.QueueLane,
+		TranspilerVersion:    s.TranspilerVersion,
+		CacheHit:             s.CacheHit,
+		SourceSizeBytes:      s.SubmissionSourceSizeBytes,
+		TargetSizeBytes:      s.SubmissionTargetSizeBytes,
+		CreatedAt:            s.CreatedAt.Format(time.RFC3339Nano),
+		ProcessingStartedAt:  formatOptionalTime(processingStartedAt),
+		ProcessingFinishedAt: formatOptionalTime(processingFinishedAt),
+		ExpiresAt:            formatOptionalTime(s.ExpiresAt),
+	}
+}
+
+// GET /admin/users/:id/submissions
+func (h *AdminHandler) GetUserSubmissions(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.getTargetUser(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit := getAdminPagination(c)
+
+	err = h.recordAction(admin, "view_user_submissions", services.AdminActionTarget{UserID: &target.ID}, nil)
+	if err != nil {
+		return err
+	}
+
+	submissions, totalItems, err := h.adminService.GetUserSubmissions(target.ID, limit*(page-1), limit)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user submissions")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user submissions")
+	}
+
+	items := make([]*adminSubmissionItem, len(submissions))
+	for i, s := range submissions {
+		items[i] = toAdminSubmissionItem(s)
+	}
+
+	return c.JSON(http.StatusOK, PaginatedResponse[*adminSubmissionItem]{
+		Items: items,
+		Meta:  newPaginatedMeta(len(items), totalItems, page, limit),
+	})
+}
+
+// POST /admin/submissions/:id/retry
+// Publish a pending, processing or failed submission again under the same ID
+func (h *AdminHandler) RetrySubmission(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	sub, owner, err := h.getTargetSubmission(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "retry_submission", services.AdminActionTarget{UserID: &owner, SubmissionID: &sub.ID}, map[string]interface{}{
+		"status": sub.Status.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	tier, err := h.subscriptionService.GetUserTier(owner)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user tier")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user tier")
+	}
+
+	retried, err := h.submissionService.RetrySubmission(sub, tier)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to retry submission")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to retry submission")
+	}
+
+	if !retried {
+		return echo.NewHTTPError(http.StatusConflict, "Only pending, processing or failed submissions can be retried")
+	}
+
+	sub, err = h.databaseService.Submission.Get(context.Background(), sub.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission")
+	}
+
+	return c.JSON(http.StatusOK, toAdminSubmissionItem(sub))
+}
+
+type failSubmissionBody struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+// POST /admin/submissions/:id/fail
+// Fail a pending or processing submission
+func (h *AdminHandler) FailSubmission(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(failSubmissionBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	sub, owner, err := h.getTargetSubmission(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "fail_submission", services.AdminActionTarget{UserID: &owner, SubmissionID: &sub.ID}, map[string]interface{}{
+		"status": sub.Status.String(),
+		"reason": body.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	failed, err := h.submissionService.FailSubmission(sub.ID, body.Reason)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to fail submission")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to fail submission")
+	}
+
+	if !failed {
+		return echo.NewHTTPError(http.StatusConflict, "Only pending or processing submissions can be failed")
+	}
+
+	return c.JSON(http.StatusOK, &cancelSubmissionResponse{
+		Id:     sub.ID.String(),
+		Status: submission.StatusFailed.String(),
+	})
+}
+
+// POST /admin/submissions/:id/clean
+// Delete the stored objects of a done, failed or cancelled submission
+func (h *AdminHandler) CleanSubmission(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	sub, owner, err := h.getTargetSubmission(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "clean_submission", services.AdminActionTarget{UserID: &owner, SubmissionID: &sub.ID}, map[string]interface{}{
+		"status": sub.Status.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	cleaned, err := h.submissionService.CleanSubmission(sub)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to clean submission")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to clean submission")
+	}
+
+	if !cleaned {
+		return echo.NewHTTPError(http.StatusConflict, "Only done, failed or cancelled submissions can be cleaned")
+	}
+
+	return c.JSON(http.StatusOK, &cancelSubmissionResponse{
+		Id:     sub.ID.String(),
+		Status: submission.StatusCleaned.String(),
+	})
+}
+
+type revokeTokensResponse struct {
+	Revoked int `json:"revoked"`
+}
+
+// POST /admin/users/:id/tokens/revoke
+// Log the user out everywhere
+func (h *AdminHandler) RevokeUserTokens(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.getTargetUser(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "revoke_tokens", services.AdminActionTarget{UserID: &target.ID}, nil)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := h.tokenService.RevokeUserTokens(target.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to revoke user tokens")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to revoke user tokens")
+	}
+
+	return c.JSON(http.StatusOK, revokeTokensResponse{
+		Revoked: revoked,
+	})
+}
+
+type impersonationResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// POST /admin/users/:id/impersonate
+// Create a read-only token of the user, it can't reach the admin routes
+func (h *AdminHandler) ImpersonateUser(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.getTargetUser(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "impersonate_user", services.AdminActionTarget{UserID: &target.ID}, nil)
+	if err != nil {
+		return err
+	}
+
+	impersonationToken, err := h.tokenService.GenerateImpersonationToken(target.ID, admin.ID, services.NewAdminAuditActor(c, admin))
+	if err != nil {
+		logrus.WithError(err).Error("Failed to generate impersonation token")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate impersonation token")
+	}
+
+	return c.JSON(http.StatusOK, impersonationResponse{
+		Token:     impersonationToken.ID.String(),
+		ExpiresAt: impersonationToken.ExpiresAt.Format(time.RFC3339Nano),
+	})
+}
+
+type setUserTierBody struct {
+	Tier string `json:"tier" validate:"required"`
+	// Required unless the tier is free
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PUT /admin/users/:id/tier
+// Give a tier to a user without Stripe subscription until a date
+func (h *AdminHandler) SetUserTier(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(setUserTierBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	tier := subscription.Tier(body.Tier)
+	if err := subscription.TierValidator(tier); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid tier")
+	}
+
+	if tier != subscription.TierFree && !body.ExpiresAt.After(time.Now()) {
+		return echo.NewHTTPError(http.StatusBadRequest, "The expiration date must be in the future")
+	}
+
+	target, err := h.getTargetUser(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.recordAction(admin, "set_tier", services.AdminActionTarget{UserID: &target.ID}, map[string]interface{}{
+		"tier":       tier.String(),
+		"expires_at": body.ExpiresAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return err
+	}
+
+	updated, err := h.subscriptionService.SetManualTier(target.ID, tier, body.ExpiresAt, services.NewAdminAuditActor(c, admin))
+	if err == services.ErrStripeSubscriptionActive {
+		return echo.NewHTTPError(http.StatusConflict, "The user pays through Stripe, their tier must be changed from Stripe")
+	}
+	if err != nil {
+		logrus.WithError(err).Error("Failed to set user tier")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to set user tier")
+	}
+
+	var subscriptionItem *adminSubscriptionItem
+	if updated != nil {
+		subscriptionItem = toAdminSubscriptionItem(updated)
+	}
+
+	return c.JSON(http.StatusOK, subscriptionItem)
+}
+
+type adminActionItem struct {
+	ID                 string                 `json:"id"`
+	AdminID            string                 `json:"admin_id"`
+	AdminEmail         string                 `json:"admin_email"`
+	Action             string                 `json:"action"`
+	TargetUserID       *string                `json:"target_user_id"`
+	TargetSubmissionID *string                `json:"target_submission_id"`
+	Metadata           map[string]interface{} `json:"metadata"`
+	CreatedAt          string                 `json:"created_at"`
+}
+
+func formatOptionalUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	formatted := id.String()
+	return &formatted
+}
+
+// GET /admin/audit-log?user_id=
+// List the recorded admin actions, only the ones targeting a user if set
+func (h *AdminHandler) GetAuditLog(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var userID *uuid.UUID
+	if c.QueryParam("user_id") != "" {
+		id, err := uuid.Parse(c.QueryParam("user_id"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+		}
+		userID = &id
+	}
+
+	page, limit := getAdminPagination(c)
+
+	err = h.recordAction(admin, "view_audit_log", services.AdminActionTarget{UserID: userID}, nil)
+	if err != nil {
+		return err
+	}
+
+	actions, totalItems, err := h.adminService.GetActions(userID, limit*(page-1), limit)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get admin actions")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get admin actions")
+	}
+
+	items := make([]*adminActionItem, len(actions))
+	for i, action := range actions {
+		items[i] = &adminActionItem{
+			ID:                 action.ID.String(),
+			AdminID:            action.AdminID.String(),
+			AdminEmail:         action.AdminEmail,
+			Action:             action.Action,
+			TargetUserID:       formatOptionalUUID(action.TargetUserID),
+			TargetSubmissionID: formatOptionalUUID(action.TargetSubmissionID),
+			Metadata:           action.Metadata,
+			CreatedAt:          action.CreatedAt.Format(time.RFC3339Nano),
+		}
+	}
+
+	return c.JSON(http.StatusOK, PaginatedResponse[*adminActionItem]{
+		Items: items,
+		Meta:  newPaginatedMeta(len(items), totalItems, page, limit),
+	})
+}
+
+type orphanPrefixItem struct {
+	Prefix       string `json:"prefix"`
+	ObjectCount  int    `json:"object_count"`
+	SizeBytes    int64  `json:"size_bytes"`
+	LastModified string `json:"last_modified"`
+}
+
+type sizeMismatchItem struct {
+	SubmissionID          string `json:"submission_id"`
+	SourceSizeBytes       int    `json:"source_size_bytes"`
+	ActualSourceSizeBytes int    `json:"actual_source_size_bytes"`
+	TargetSizeBytes       int    `json:"target_size_bytes"`
+	ActualTargetSizeBytes int    `json:"actual_target_size_bytes"`
+}
+
+type reconciliationReportResponse struct {
+	DryRun         bool                `json:"dry_run"`
+	OrphanPrefixes []*orphanPrefixItem `json:"orphan_prefixes"`
+	MissingResults []string            `json:"missing_results"`
+	SizeMismatches []*sizeMismatchItem `json:"size_mismatches"`
+	Repaired       int                 `json:"repaired"`
+}
+
+// POST /admin/reconciliation
+// Dry run unless ?repair=true
+func (h *AdminHandler) ReconcileStorage(c echo.Context) error {
+	admin, err := h.tokenService.GetAdminFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	dryRun := c.QueryParam("repair") != "true"
+
+	err = h.recordAction(admin, "reconcile_storage", services.AdminActionTarget{}, map[string]interface{}{
+		"dry_run": dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := h.reconciliationService.ReconcileStorage(context.Background(), dryRun)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to reconcile storage")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to reconcile storage")
+	}
+
+	response := reconciliationReportResponse{
+		DryRun:         dryRun,
+		OrphanPrefixes: make([]*orphanPrefixItem, len(report.OrphanPrefixes)),
+		MissingResults: make([]string, len(report.MissingResults)),
+		SizeMismatches: make([]*sizeMismatchItem, len(report.SizeMismatches)),
+		Repaired:       report.Repaired,
+	}
+
+	for i, prefix := range report.OrphanPrefixes {
+		response.OrphanPrefixes[i] = &orphanPrefixItem{
+			Prefix:       prefix.Prefix,
+			ObjectCount:  prefix.ObjectCount,
+			SizeBytes:    prefix.Size,
+			LastModified: prefix.LastModified.Format(time.RFC3339Nano),
+		}
+	}
+
+	for i, id := range report.MissingResults {
+		response.MissingResults[i] = id.String()
+	}
+
+	for i, mismatch := range report.SizeMismatches {
+		response.SizeMismatches[i] = &sizeMismatchItem{
+			SubmissionID:          mismatch.SubmissionID.String(),
+			SourceSizeBytes:       mismatch.SourceSizeBytes,
+			ActualSourceSizeBytes: mismatch.ActualSourceSizeBytes,
+			TargetSizeBytes:       mismatch.TargetSizeBytes,
+			ActualTargetSizeBytes: mismatch.ActualTargetSizeBytes,
+		}
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
 	"github.com/tereus-project/tereus-api/ent/user"
 	"github.com/tereus-project/tereus-api/services"
 )
@@ -17,6 +18,7 @@ type AuthHandler struct {
 	githubService   *services.GithubService
 	gitlabService   *services.GitlabService
 	tokenService    *services.TokenService
+	auditService    *services.AuditService
 }
 
 func NewAuthHandler(
@@ -24,12 +26,14 @@ func NewAuthHandler(
 	githubService *services.GithubService,
 	gitlabService *services.GitlabService,
 	tokenService *services.TokenService,
+	auditService *services.AuditService,
 ) (*AuthHandler, error) {
 	return &AuthHandler{
 		databaseService: databaseService,
 		githubService:   githubService,
 		gitlabService:   gitlabService,
 		tokenService:    tokenService,
+		auditService:    auditService,
 	}, nil
 }
 
@@ -96,6 +100,10 @@ func (h *AuthHandler) LoginGithub(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 		}
 
+		h.auditService.Record(tereusUser.ID, auditevent.TypeAccountLinked, services.NewUserAuditActor(c, tereusUser), map[string]interface{}{
+			"provider": "github",
+		})
+
 		token, _ := h.tokenService.GetTokenFromContext(c)
 		return c.JSON(http.StatusOK, signupResult{
 			Token: token.String(),
@@ -115,11 +123,15 @@ func (h *AuthHandler) LoginGithub(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 		}
 
-		token, err := h.tokenService.GenerateToken(existingUser.ID)
+		token, err := h.tokenService.GenerateToken(existingUser.ID, services.NewUserAuditActor(c, existingUser))
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create token")
 		}
 
+		h.auditService.Record(existingUser.ID, auditevent.TypeLogin, services.NewUserAuditActor(c, existingUser), map[string]interface{}{
+			"provider": "github",
+		})
+
 		return c.JSON(http.StatusOK, signupResult{
 			Token: token.String(),
 		})
@@ -164,11 +176,15 @@ func (h *AuthHandler) LoginGithub(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user")
 	}
 
-	token, err := h.tokenService.GenerateToken(newUser.ID)
+	token, err := h.tokenService.GenerateToken(newUser.ID, services.NewUserAuditActor(c, newUser))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create token")
 	}
 
+	h.auditService.Record(newUser.ID, auditevent.TypeSignup, services.NewUserAuditActor(c, newUser), map[string]interface{}{
+		"provider": "github",
+	})
+
 	return c.JSON(http.StatusOK, signupResult{
 		Token: token.String(),
 	})
@@ -202,6 +218,10 @@ func (h *AuthHandler) RevokeGithub(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 	}
 
+	h.auditService.Record(tereusUser.ID, auditevent.TypeAccountUnlinked, services.NewUserAuditActor(c, tereusUser), map[string]interface{}{
+		"provider": "github",
+	})
+
 	return c.JSON(http.StatusOK, revokeResult{
 		Success: true,
 	})
@@ -273,6 +293,10 @@ func (h *AuthHandler) LoginGitlab(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 		}
 
+		h.auditService.Record(tereusUser.ID, auditevent.TypeAccountLinked, services.NewUserAuditActor(c, tereusUser), map[string]interface{}{
+			"provider": "gitlab",
+		})
+
 		token, _ := h.tokenService.GetTokenFromContext(c)
 		return c.JSON(http.StatusOK, signupResult{
 			Token: token.String(),
@@ -294,11 +318,15 @@ func (h *AuthHandler) LoginGitlab(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 		}
 
-		token, err := h.tokenService.GenerateToken(existingUser.ID)
+		token, err := h.tokenService.GenerateToken(existingUser.ID, services.NewUserAuditActor(c, existingUser))
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create token")
 		}
 
+		h.auditService.Record(existingUser.ID, auditevent.TypeLogin, services.NewUserAuditActor(c, existingUser), map[string]interface{}{
+			"provider": "gitlab",
+		})
+
 		return c.JSON(http.StatusOK, signupResult{
 			Token: token.String(),
 		})
@@ -338,11 +366,15 @@ func (h *AuthHandler) LoginGitlab(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user")
 	}
 
-	token, err := h.tokenService.GenerateToken(newUser.ID)
+	token, err := h.tokenService.GenerateToken(newUser.ID, services.NewUserAuditActor(c, newUser))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create token")
 	}
 
+	h.auditService.Record(newUser.ID, auditevent.TypeSignup, services.NewUserAuditActor(c, newUser), map[string]interface{}{
+		"provider": "gitlab",
+	})
+
 	return c.JSON(http.StatusOK, signupResult{
 		Token: token.String(),
 	})
@@ -374,6 +406,10 @@ func (h *AuthHandler) RevokeGitlab(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user")
 	}
 
+	h.auditService.Record(tereusUser.ID, auditevent.TypeAccountUnlinked, services.NewUserAuditActor(c, tereusUser), map[string]interface{}{
+		"provider": "gitlab",
+	})
+
 	return c.JSON(http.StatusOK, revokeResult{
 		Success: true,
 	})
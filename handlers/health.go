@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+type HealthHandler struct {
+	healthService *services.HealthService
+}
+
+func NewHealthHandler(healthService *services.HealthService) (*HealthHandler, error) {
+	return &HealthHandler{
+		healthService: healthService,
+	}, nil
+}
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+type readinessResponse struct {
+	Status string `json:"status"`
+	// Result of each check, "ok" or the error
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// GET /healthz
+// Liveness of the process, the dependencies are not checked
+func (h *HealthHandler) GetHealth(c echo.Context) error {
+	return c.JSON(http.StatusOK, healthResponse{
+		Status: "ok",
+	})
+}
+
+// GET /readyz
+// Whether the process can handle requests, it is not while stopping or when
+// the database, the storage or the queue can't be reached
+func (h *HealthHandler) GetReadiness(c echo.Context) error {
+	if h.healthService.IsDraining() {
+		return c.JSON(http.StatusServiceUnavailable, readinessResponse{
+			Status: "stopping",
+		})
+	}
+
+	response := readinessResponse{
+		Status: "ok",
+		Checks: map[string]string{},
+	}
+
+	for name, err := range h.healthService.CheckReadiness(c.Request().Context()) {
+		if err != nil {
+			logrus.WithError(err).WithField("check", name).Warn("Readiness check failed")
+			response.Status = "unavailable"
+			response.Checks[name] = err.Error()
+			continue
+		}
+
+		response.Checks[name] = "ok"
+	}
+
+	if response.Status != "ok" {
+		return c.JSON(http.StatusServiceUnavailable, response)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
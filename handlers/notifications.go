@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+type NotificationHandler struct {
+	tokenService        *services.TokenService
+	notificationService *services.NotificationService
+}
+
+func NewNotificationHandler(tokenService *services.TokenService, notificationService *services.NotificationService) (*NotificationHandler, error) {
+	return &NotificationHandler{
+		tokenService:        tokenService,
+		notificationService: notificationService,
+	}, nil
+}
+
+type notificationItem struct {
+	ID            string   `json:"id"`
+	Type          string   `json:"type"`
+	Title         string   `json:"title"`
+	Message       string   `json:"message"`
+	SubmissionIDs []string `json:"submission_ids"`
+	ReadAt        *string  `json:"read_at"`
+	CreatedAt     string   `json:"created_at"`
+}
+
+func toNotificationItem(n *ent.Notification) *notificationItem {
+	submissionIDs := n.SubmissionIds
+	if submissionIDs == nil {
+		submissionIDs = []string{}
+	}
+
+	return &notificationItem{
+		ID:            n.ID.String(),
+		Type:          n.Type.String(),
+		Title:         n.Title,
+		Message:       n.Message,
+		SubmissionIDs: submissionIDs,
+		ReadAt:        formatOptionalTime(n.ReadAt),
+		CreatedAt:     n.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+// GET /users/me/notifications
+func (h *NotificationHandler) GetNotifications(c echo.Context) error {
+	loggedUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	notifications, totalItems, err := h.notificationService.GetUserNotifications(loggedUser.ID, c.QueryParam("unread") == "true", limit*(page-1), limit)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get notifications")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notifications")
+	}
+
+	items := make([]*notificationItem, len(notifications))
+	for i, n := range notifications {
+		items[i] = toNotificationItem(n)
+	}
+
+	return c.JSON(http.StatusOK, PaginatedResponse[*notificationItem]{
+		Items: items,
+		Meta: PaginatedMeta{
+			ItemCount:    len(items),
+			TotalItems:   totalItems,
+			ItemsPerPage: limit,
+			TotalPages:   int(math.Ceil(float64(totalItems) / float64(limit))),
+			CurrentPage:  page,
+		},
+	})
+}
+
+// POST /users/me/notifications/:id/read
+func (h *NotificationHandler) MarkNotificationRead(c echo.Context) error {
+	loggedUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid notification ID")
+	}
+
+	found, err := h.notificationService.MarkNotificationRead(loggedUser.ID, notificationID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to mark notification as read")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to mark notification as read")
+	}
+
+	if !found {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+type notificationPreferences struct {
+	RetentionWarningEmail bool `json:"retention_warning_email"`
+	RetentionWarningInApp bool `json:"retention_warning_in_app"`
+}
+
+type updateNotificationPreferencesBody struct {
+	RetentionWarningEmail *bool `json:"retention_warning_email"`
+	RetentionWarningInApp *bool `json:"retention_warning_in_app"`
+}
+
+// GET /users/me/notification-preferences
+func (h *NotificationHandler) GetPreferences(c echo.Context) error {
+	loggedUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	preferences, err := h.notificationService.GetUserPreferences(loggedUser.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get notification preferences")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification preferences")
+	}
+
+	return c.JSON(http.StatusOK, notificationPreferences{
+		RetentionWarningEmail: preferences.RetentionWarningEmail,
+		RetentionWarningInApp: preferences.RetentionWarningInApp,
+	})
+}
+
+// PATCH /users/me/notification-preferences
+func (h *NotificationHandler) UpdatePreferences(c echo.Context) error {
+	loggedUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(updateNotificationPreferencesBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	preferences, err := h.notificationService.UpdateUserPreferences(loggedUser.ID, services.NotificationPreferencesUpdate{
+		RetentionWarningEmail: body.RetentionWarningEmail,
+		RetentionWarningInApp: body.RetentionWarningInApp,
+	})
+	if err != nil {
+		logrus.WithError(err).Error("Failed to update notification preferences")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update notification preferences")
+	}
+
+	return c.JSON(http.StatusOK, notificationPreferences{
+		RetentionWarningEmail: preferences.RetentionWarningEmail,
+		RetentionWarningInApp: preferences.RetentionWarningInApp,
+	})
+}
@@ -47,7 +47,7 @@ func (h *StripeWebhooksHandler) HandleWebhooks(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 		}
 
-		err = h.subscriptionService.UpdateSubscription(stripeSubscription)
+		err = h.subscriptionService.UpdateSubscription(stripeSubscription, services.StripeAuditActor)
 		if err != nil {
 			logrus.WithError(err).Error("Failed to create subscription from invoice")
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -60,13 +60,13 @@ func (h *StripeWebhooksHandler) HandleWebhooks(c echo.Context) error {
 		}
 
 		if subscription.CancelAtPeriodEnd {
-			err = h.subscriptionService.CancelSubscriptionFromStripeCustomerId(subscription.Customer.ID, time.Unix(subscription.CancelAt, 0))
+			err = h.subscriptionService.CancelSubscriptionFromStripeCustomerId(subscription.Customer.ID, time.Unix(subscription.CancelAt, 0), services.StripeAuditActor)
 			if err != nil {
 				logrus.WithError(err).Error("Failed to cancel subscription")
 				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 			}
 		} else if subscription.Status == "active" {
-			err = h.subscriptionService.UpdateSubscription(&subscription)
+			err = h.subscriptionService.UpdateSubscription(&subscription, services.StripeAuditActor)
 			if err != nil {
 				logrus.WithError(err).Error("Failed to update subscription")
 				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -79,7 +79,7 @@ func (h *StripeWebhooksHandler) HandleWebhooks(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 
-		err = h.subscriptionService.CancelSubscriptionFromStripeCustomerId(invoice.Customer.ID, time.Unix(invoice.PeriodEnd, 0))
+		err = h.subscriptionService.CancelSubscriptionFromStripeCustomerId(invoice.Customer.ID, time.Unix(invoice.PeriodEnd, 0), services.StripeAuditActor)
 		if err != nil {
 			logrus.WithError(err).Error("Failed to expire subscription from invoice")
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -91,7 +91,7 @@ func (h *StripeWebhooksHandler) HandleWebhooks(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 
-		err = h.subscriptionService.RemoveSubscriptionsFromStripeCustomerId(customer.ID)
+		err = h.subscriptionService.RemoveSubscriptionsFromStripeCustomerId(customer.ID, services.StripeAuditActor)
 		if err != nil {
 			logrus.WithError(err).Error("Failed to remove customer's subscriptions")
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -2,31 +2,582 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	gonanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/services"
 )
 
 type SubmissionsHandler struct {
-	databaseService *services.DatabaseService
-	tokenService    *services.TokenService
-	storageService  *services.StorageService
+	databaseService   *services.DatabaseService
+	tokenService      *services.TokenService
+	storageService    *services.StorageService
+	submissionService *services.SubmissionService
+	retentionService  *services.RetentionService
+	auditService      *services.AuditService
 }
 
-func NewSubmissionsHandler(databaseService *services.DatabaseService, tokenService *services.TokenService, storageService *services.StorageService) (*SubmissionsHandler, error) {
+func NewSubmissionsHandler(databaseService *services.DatabaseService, tokenService *services.TokenService, storageService *services.StorageService, submissionService *services.SubmissionService, retentionService *services.RetentionService, auditService *services.AuditService) (*SubmissionsHandler, error) {
 	return &SubmissionsHandler{
-		databaseService: databaseService,
-		tokenService:    tokenService,
-		storageService:  storageService,
+		databaseService:   databaseService,
+		tokenService:      tokenService,
+		storageService:    storageService,
+		submissionService: submissionService,
+		retentionService:  retentionService,
+		auditService:      auditService,
 	}, nil
 }
 
+// Get the submission identified by the ":id" parameter, either a submission ID
+// or a share ID, and check that the current user is allowed to read it.
+// Return a *echo.HTTPError if failing
+func getReadableSubmission(c echo.Context, databaseService *services.DatabaseService, tokenService *services.TokenService) (*ent.Submission, error) {
+	query := databaseService.Submission.Query()
+
+	subID, err := uuid.Parse(c.Param("id"))
+	if err == nil {
+		query = query.Where(submission.ID(subID))
+	} else {
+		if len(c.Param("id")) != 8 {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid submission ID")
+		}
+		query = query.Where(submission.ShareID(c.Param("id")))
+	}
+
+	sub, err := query.Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "This submission does not exist")
+		}
+
+		logrus.WithError(err).Error("Failed to get submission")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission")
+	}
+
+	if !sub.IsPublic {
+		user, err := tokenService.GetUserFromContext(c)
+		if err != nil {
+			return nil, err
+		}
+
+		owner, err := sub.QueryUser().OnlyID(context.Background())
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get owner of submission")
+		}
+
+		if user.ID != owner {
+			return nil, echo.NewHTTPError(http.StatusForbidden, "This submission is not public and you are not the owner")
+		}
+	}
+
+	return sub, nil
+}
+
+type diagnosticItem struct {
+	Severity string `json:"severity"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+}
+
+func toDiagnosticItems(diagnostics []*ent.Diagnostic) []*diagnosticItem {
+	items := make([]*diagnosticItem, len(diagnostics))
+	for i, d := range diagnostics {
+		items[i] = &diagnosticItem{
+			Severity: d.Severity.String(),
+			File:     d.File,
+			Line:     d.Line,
+			Column:   d.Column,
+			Code:     d.Code,
+			Message:  d.Message,
+		}
+	}
+
+	return items
+}
+
+// Build a diagnostics query modifier from the comma separated "severity"
+// query parameter.
+// Return a *echo.HTTPError if failing
+func getDiagnosticsFilter(c echo.Context) (func(*ent.DiagnosticQuery), error) {
+	severities := []diagnostic.Severity{}
+
+	for _, value := range strings.Split(c.QueryParam("severity"), ",") {
+		if value == "" {
+			continue
+		}
+
+		severity := diagnostic.Severity(strings.ToLower(value))
+		if err := diagnostic.SeverityValidator(severity); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid severity '%s', must be one of error, warning, info", value))
+		}
+
+		severities = append(severities, severity)
+	}
+
+	return func(q *ent.DiagnosticQuery) {
+		if len(severities) > 0 {
+			q.Where(diagnostic.SeverityIn(severities...))
+		}
+
+		q.Order(ent.Asc(diagnostic.FieldFile), ent.Asc(diagnostic.FieldLine), ent.Asc(diagnostic.FieldColumn))
+	}, nil
+}
+
+type submissionFileItem struct {
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	Checksum  string `json:"checksum"`
+}
+
+type submissionFiles struct {
+	Source []*submissionFileItem `json:"source"`
+	Output []*submissionFileItem `json:"output"`
+}
+
+type submissionDetails struct {
+	ID                   string            `json:"id"`
+	ParentID             string            `json:"parent_id"`
+	SourceLanguage       string            `json:"source_language"`
+	TargetLanguage       string            `json:"target_language"`
+	IsInline             bool              `json:"is_inline"`
+	IsPublic             bool              `json:"is_public"`
+	Status               string            `json:"status"`
+	Reason               string            `json:"reason"`
+	GitRepo              string            `json:"git_repo"`
+	CreatedAt            string            `json:"created_at"`
+	ShareID              string            `json:"share_id"`
+	QueueLane            string            `json:"queue_lane"`
+	HasMapping           bool              `json:"has_mapping"`
+	CacheHit             bool              `json:"cache_hit"`
+	TranspilerVersion    string            `json:"transpiler_version,omitempty"`
+	SourceSizeBytes      int               `json:"source_size_bytes"`
+	TargetSizeBytes      int               `json:"target_size_bytes"`
+	ProcessingStartedAt  string            `json:"processing_started_at"`
+	ProcessingFinishedAt string            `json:"processing_finished_at"`
+	Duration             *time.Duration    `json:"duration"`
+	Diagnostics          []*diagnosticItem `json:"diagnostics"`
+	Files                submissionFiles   `json:"files"`
+}
+
+// GET /submissions/:id
+func (h *SubmissionsHandler) GetSubmission(c echo.Context) error {
+	sub, err := getReadableSubmission(c, h.databaseService, h.tokenService)
+	if err != nil {
+		return err
+	}
+
+	diagnosticsFilter, err := getDiagnosticsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	parentIDs, err := sub.QueryParent().IDs(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission parent")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission")
+	}
+
+	diagnosticsQuery := sub.QueryDiagnostics()
+	diagnosticsFilter(diagnosticsQuery)
+	diagnostics, err := diagnosticsQuery.All(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission diagnostics")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission")
+	}
+
+	details := submissionDetails{
+		ID:                   sub.ID.String(),
+		SourceLanguage:       sub.SourceLanguage,
+		TargetLanguage:       sub.TargetLanguage,
+		IsInline:             sub.IsInline,
+		IsPublic:             sub.IsPublic,
+		Status:               sub.Status.String(),
+		Reason:               sub.Reason,
+		GitRepo:              sub.GitRepo,
+		CreatedAt:            sub.CreatedAt.Format(time.RFC3339Nano),
+		ShareID:              sub.ShareID,
+		QueueLane:            sub.QueueLane,
+		HasMapping:           sub.HasMapping,
+		CacheHit:             sub.CacheHit,
+		TranspilerVersion:    sub.TranspilerVersion,
+		SourceSizeBytes:      sub.SubmissionSourceSizeBytes,
+		TargetSizeBytes:      sub.SubmissionTargetSizeBytes,
+		ProcessingStartedAt:  sub.ProcessingStartedAt.Format(time.RFC3339Nano),
+		ProcessingFinishedAt: sub.ProcessingFinishedAt.Format(time.RFC3339Nano),
+		Diagnostics:          toDiagnosticItems(diagnostics),
+		Files: submissionFiles{
+			Source: []*submissionFileItem{},
+			Output: []*submissionFileItem{},
+		},
+	}
+
+	if len(parentIDs) > 0 {
+		details.ParentID = parentIDs[0].String()
+	}
+
+	if !sub.ProcessingStartedAt.IsZero() && !sub.ProcessingFinishedAt.IsZero() {
+		duration := sub.ProcessingFinishedAt.Sub(sub.ProcessingStartedAt)
+		details.Duration = &duration
+	}
+
+	files, err := h.storageService.ListSubmissionFiles(sub.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to list submission files")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list submission files")
+	}
+
+	for _, file := range files {
+		item := &submissionFileItem{
+			Path:      file.RelativePath,
+			SizeBytes: file.Size,
+			Checksum:  file.Checksum,
+		}
+
+		switch file.Type {
+		case services.SubmissionFileTypeSource:
+			details.Files.Source = append(details.Files.Source, item)
+		case services.SubmissionFileTypeOutput:
+			details.Files.Output = append(details.Files.Output, item)
+		}
+	}
+
+	return c.JSON(http.StatusOK, details)
+}
+
+// GET /submissions/:id/files/:type/*
+func (h *SubmissionsHandler) GetSubmissionFile(c echo.Context) error {
+	sub, err := getReadableSubmission(c, h.databaseService, h.tokenService)
+	if err != nil {
+		return err
+	}
+
+	fileType := services.SubmissionFileType(c.Param("type"))
+	if fileType != services.SubmissionFileTypeSource && fileType != services.SubmissionFileTypeOutput {
+		return echo.NewHTTPError(http.StatusBadRequest, "File type must be source or output")
+	}
+
+	if sub.Status == submission.StatusCleaned {
+		return echo.NewHTTPError(http.StatusNotFound, "This submission has been cleaned")
+	}
+
+	filePath, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid file path")
+	}
+
+	filePath = path.Clean("/" + filePath)[1:]
+	if filePath == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "Missing file path")
+	}
+
+	if c.QueryParam("presigned") == "true" {
+		url, err := h.storageService.PresignSubmissionFileDownload(sub.ID.String(), fileType, filePath, presignedDownloadDuration)
+		if err != nil {
+			if err == services.ErrSubmissionFileNotFound {
+				return echo.NewHTTPError(http.StatusNotFound, "This file does not exist")
+			}
+
+			if err == services.ErrPresignNotSupported {
+				return echo.NewHTTPError(http.StatusNotImplemented, "Presigned downloads are not supported by the storage configuration")
+			}
+
+			logrus.WithError(err).Error("Failed to presign file download")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to presign file download")
+		}
+
+		return c.JSON(http.StatusOK, presignedDownloadResult{
+			URL:       url,
+			ExpiresAt: time.Now().Add(presignedDownloadDuration).Format(time.RFC3339Nano),
+		})
+	}
+
+	object, info, err := h.storageService.GetSubmissionFile(sub.ID.String(), fileType, filePath)
+	if err != nil {
+		if err == services.ErrSubmissionFileNotFound {
+			return echo.NewHTTPError(http.StatusNotFound, "This file does not exist")
+		}
+
+		logrus.WithError(err).Error("Failed to get file from S3")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get file from S3")
+	}
+	defer object.Close()
+
+	c.Response().Header().Set("ETag", fmt.Sprintf(`"%s"`, info.ETag))
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, strings.ReplaceAll(path.Base(filePath), `"`, "")))
+
+	// ServeContent handles Range and conditional requests, and guesses the
+	// content type from the extension or the content itself
+	http.ServeContent(c.Response(), c.Request(), path.Base(filePath), info.LastModified, object)
+
+	return nil
+}
+
+// Files bigger than this are listed in the diff without their content
+const maxDiffFileSizeBytes = 1024 * 1024
+
+// The diff stops listing files once it holds this many files or this much
+// content, the files left out can be compared one by one with ?path=
+const (
+	maxDiffFiles          = 500
+	maxDiffTotalSizeBytes = 16 * 1024 * 1024
+)
+
+type submissionDiffSide struct {
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	Lines     int    `json:"lines"`
+	Content   string `json:"content"`
+	TooLarge  bool   `json:"too_large"`
+}
+
+type submissionDiffFile struct {
+	// One of "paired", "source_only" or "output_only"
+	Status       string                 `json:"status"`
+	Source       *submissionDiffSide    `json:"source"`
+	Output       *submissionDiffSide    `json:"output"`
+	LineMappings []services.LineMapping `json:"line_mappings,omitempty"`
+}
+
+type submissionDiff struct {
+	ID             string                `json:"id"`
+	Status         string                `json:"status"`
+	SourceLanguage string                `json:"source_language"`
+	TargetLanguage string                `json:"target_language"`
+	Files          []*submissionDiffFile `json:"files"`
+	// Set if some files were left out because of the size of the diff
+	Truncated bool `json:"truncated"`
+}
+
+func (h *SubmissionsHandler) readDiffSide(file *services.SubmissionFile) (*submissionDiffSide, error) {
+	side := &submissionDiffSide{
+		Path:      file.RelativePath,
+		SizeBytes: file.Size,
+	}
+
+	if file.Size > maxDiffFileSizeBytes {
+		side.TooLarge = true
+		return side, nil
+	}
+
+	object, err := h.storageService.GetObject(file.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, err
+	}
+
+	side.Content = string(data)
+	if len(data) > 0 {
+		side.Lines = strings.Count(side.Content, "\n")
+		if !strings.HasSuffix(side.Content, "\n") {
+			side.Lines++
+		}
+	}
+
+	return side, nil
+}
+
+// GET /submissions/:id/diff
+func (h *SubmissionsHandler) GetSubmissionDiff(c echo.Context) error {
+	sub, err := getReadableSubmission(c, h.databaseService, h.tokenService)
+	if err != nil {
+		return err
+	}
+
+	if sub.Status == submission.StatusCleaned {
+		return echo.NewHTTPError(http.StatusNotFound, "This submission has been cleaned")
+	}
+
+	languagePairDetails, err := h.submissionService.GetLanguagePairDetails(sub.SourceLanguage, sub.TargetLanguage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get language pair details")
+	}
+
+	// Only compare a single file if asked to
+	onlyPath := c.QueryParam("path")
+
+	// Line mappings by source and output paths
+	lineMappings := map[[2]string][]services.LineMapping{}
+	if sub.HasMapping {
+		mapping, err := h.submissionService.GetSubmissionMapping(sub.ID.String())
+		if err != nil {
+			logrus.WithError(err).Error("Failed to get submission mapping")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission mapping")
+		}
+
+		for _, file := range mapping.Files {
+			lineMappings[[2]string{file.Source, file.Output}] = file.Mappings
+		}
+	}
+
+	sources := []*services.SubmissionFile{}
+	outputs := map[string]*services.SubmissionFile{}
+
+	files, err := h.storageService.ListSubmissionFiles(sub.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to list submission files")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list submission files")
+	}
+
+	for _, file := range files {
+		switch file.Type {
+		case services.SubmissionFileTypeSource:
+			sources = append(sources, file)
+		case services.SubmissionFileTypeOutput:
+			outputs[file.RelativePath] = file
+		}
+	}
+
+	diff := submissionDiff{
+		ID:             sub.ID.String(),
+		Status:         sub.Status.String(),
+		SourceLanguage: sub.SourceLanguage,
+		TargetLanguage: sub.TargetLanguage,
+		Files:          []*submissionDiffFile{},
+	}
+
+	pairs := []diffFilePair{}
+	for _, source := range sources {
+		var output *services.SubmissionFile
+
+		// A source file is transpiled to a file at the same path with the
+		// target language extension
+		if strings.HasSuffix(source.RelativePath, languagePairDetails.SourceLanguageFileExtension) {
+			outputPath := strings.TrimSuffix(source.RelativePath, languagePairDetails.SourceLanguageFileExtension) + languagePairDetails.TargetLanguageFileExtension
+			output = outputs[outputPath]
+			delete(outputs, outputPath)
+		}
+
+		if onlyPath != "" && source.RelativePath != onlyPath && (output == nil || output.RelativePath != onlyPath) {
+			continue
+		}
+
+		pairs = append(pairs, diffFilePair{source, output})
+	}
+
+	for _, output := range outputs {
+		if onlyPath != "" && output.RelativePath != onlyPath {
+			continue
+		}
+
+		pairs = append(pairs, diffFilePair{nil, output})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].path() < pairs[j].path()
+	})
+
+	totalSize := int64(0)
+	for _, pair := range pairs {
+		size := pair.contentSize()
+		if len(diff.Files) == maxDiffFiles || totalSize+size > maxDiffTotalSizeBytes {
+			diff.Truncated = true
+			break
+		}
+		totalSize += size
+
+		file := &submissionDiffFile{}
+
+		if pair.source != nil {
+			file.Source, err = h.readDiffSide(pair.source)
+			if err != nil {
+				logrus.WithError(err).Error("Failed to read file from S3")
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to read file from S3")
+			}
+		}
+
+		if pair.output != nil {
+			file.Output, err = h.readDiffSide(pair.output)
+			if err != nil {
+				logrus.WithError(err).Error("Failed to read file from S3")
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to read file from S3")
+			}
+		}
+
+		switch {
+		case file.Source != nil && file.Output != nil:
+			file.Status = "paired"
+			file.LineMappings = lineMappings[[2]string{file.Source.Path, file.Output.Path}]
+		case file.Source != nil:
+			file.Status = "source_only"
+		default:
+			file.Status = "output_only"
+		}
+
+		diff.Files = append(diff.Files, file)
+	}
+
+	return c.JSON(http.StatusOK, diff)
+}
+
+// GET /submissions/:id/mapping
+func (h *SubmissionsHandler) GetSubmissionMapping(c echo.Context) error {
+	sub, err := getReadableSubmission(c, h.databaseService, h.tokenService)
+	if err != nil {
+		return err
+	}
+
+	if !sub.HasMapping || sub.Status == submission.StatusCleaned {
+		return echo.NewHTTPError(http.StatusNotFound, "This submission has no mapping")
+	}
+
+	mapping, err := h.submissionService.GetSubmissionMapping(sub.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission mapping")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission mapping")
+	}
+
+	return c.JSON(http.StatusOK, mapping)
+}
+
+type diffFilePair struct {
+	source *services.SubmissionFile
+	output *services.SubmissionFile
+}
+
+func (p diffFilePair) path() string {
+	if p.source != nil {
+		return p.source.RelativePath
+	}
+
+	return p.output.RelativePath
+}
+
+// Size of the content the pair adds to the diff, the files too large to be
+// compared are listed without content
+func (p diffFilePair) contentSize() int64 {
+	size := int64(0)
+
+	for _, file := range []*services.SubmissionFile{p.source, p.output} {
+		if file != nil && file.Size <= maxDiffFileSizeBytes {
+			size += file.Size
+		}
+	}
+
+	return size
+}
+
 // DELETE /submissions/:id
 func (h *SubmissionsHandler) DeleteSubmission(c echo.Context) error {
 	tereusUser, err := h.tokenService.GetUserFromContext(c)
@@ -86,6 +637,58 @@ func (h *SubmissionsHandler) DeleteSubmission(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+type cancelSubmissionResponse struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// POST /submissions/:id/cancel
+func (h *SubmissionsHandler) CancelSubmission(c echo.Context) error {
+	tereusUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	submissionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid submission ID")
+	}
+
+	sub, err := h.databaseService.Submission.Get(context.Background(), submissionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Submission not found")
+		}
+		logrus.WithError(err).Error("Failed to get submission")
+		return err
+	}
+
+	owner, err := sub.QueryUser().FirstID(c.Request().Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission owner")
+		return err
+	}
+
+	if owner != tereusUser.ID {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not allowed to cancel this submission")
+	}
+
+	cancelled, err := h.submissionService.CancelSubmission(sub.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to cancel submission")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to cancel submission")
+	}
+
+	if !cancelled {
+		return echo.NewHTTPError(http.StatusConflict, "Only pending or processing submissions can be cancelled")
+	}
+
+	return c.JSON(http.StatusOK, &cancelSubmissionResponse{
+		Id:     sub.ID.String(),
+		Status: submission.StatusCancelled.String(),
+	})
+}
+
 type updateSubmissionVisibilityBody struct {
 	IsPublic bool `json:"is_public"`
 }
@@ -165,9 +768,109 @@ func (h *SubmissionsHandler) UpdateSubmissionVisibility(c echo.Context) error {
 		}
 	}
 
+	h.auditService.Record(tereusUser.ID, auditevent.TypeSubmissionVisibilityChanged, services.NewUserAuditActor(c, tereusUser), map[string]interface{}{
+		"submission_id": sub.ID.String(),
+		"is_public":     body.IsPublic,
+	})
+
 	return c.JSON(http.StatusOK, &updateSubmissionVisibilityResponse{
 		Id:       sub.ID.String(),
 		IsPublic: body.IsPublic,
 		ShareID:  share_id,
 	})
 }
+
+// Format an optional time, nil if not set
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.Format(time.RFC3339Nano)
+	return &formatted
+}
+
+type updateSubmissionExpirationBody struct {
+	// null to fall back to the retention policy of the tier
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type updateSubmissionExpirationResponse struct {
+	Id        string  `json:"id"`
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// PATCH /submissions/:id/expiration
+func (h *SubmissionsHandler) UpdateSubmissionExpiration(c echo.Context) error {
+	tereusUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(updateSubmissionExpirationBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	submissionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid submission ID")
+	}
+
+	sub, err := h.databaseService.Submission.Get(context.Background(), submissionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Submission not found")
+		}
+		logrus.WithError(err).Error("Failed to get submission")
+		return err
+	}
+
+	owner, err := sub.QueryUser().FirstID(c.Request().Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission owner")
+		return err
+	}
+
+	if owner != tereusUser.ID {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not allowed to update this submission")
+	}
+
+	if sub.Status == submission.StatusCleaned {
+		return echo.NewHTTPError(http.StatusConflict, "This submission was already cleaned")
+	}
+
+	policy, err := h.retentionService.GetUserRetentionPolicy(tereusUser.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user retention policy")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get retention policy")
+	}
+
+	submissionUpdate := h.databaseService.Submission.UpdateOneID(sub.ID)
+
+	if body.ExpiresAt != nil {
+		err = policy.CheckSubmissionExpiration(sub, *body.ExpiresAt)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		submissionUpdate.SetExpiresAt(*body.ExpiresAt)
+	} else {
+		submissionUpdate.ClearExpiresAt()
+	}
+
+	// The owner is warned again before the new expiration
+	submissionUpdate.ClearRetentionWarnedAt()
+
+	sub, err = submissionUpdate.Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to update submission expiration")
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &updateSubmissionExpirationResponse{
+		Id:        sub.ID.String(),
+		ExpiresAt: formatOptionalTime(policy.GetSubmissionExpiration(sub)),
+	})
+}
@@ -65,6 +65,11 @@ func (h *SubscriptionHandler) CreateCheckoutSession(c echo.Context) error {
 		return err
 	}
 
+	// Saving the Stripe customer would reset the tier given by the support
+	if lastUserSubscription != nil && lastUserSubscription.Manual && h.subscriptionService.IsActive(lastUserSubscription) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Your subscription is not managed through Stripe")
+	}
+
 	stripeCustomer, userSubscription, err := h.subscriptionService.GetOrCreateStripeCustomer(user, lastUserSubscription)
 	if err != nil {
 		logrus.WithError(err).Error("Failed to get or create customer")
@@ -84,7 +89,7 @@ func (h *SubscriptionHandler) CreateCheckoutSession(c echo.Context) error {
 					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to downgrade subscription")
 				}
 
-				err = h.subscriptionService.CancelUserSubscription(user.ID, userSubscription.ExpiresAt)
+				err = h.subscriptionService.CancelUserSubscription(user.ID, userSubscription.ExpiresAt, services.NewUserAuditActor(c, user))
 				if err != nil {
 					logrus.WithError(err).Error("Failed to downgrade subscription")
 					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to downgrade subscription")
@@ -97,7 +102,7 @@ func (h *SubscriptionHandler) CreateCheckoutSession(c echo.Context) error {
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upgrade subscription")
 			}
 
-			err = h.subscriptionService.UpdateSubscription(stripeSubscription)
+			err = h.subscriptionService.UpdateSubscription(stripeSubscription, services.NewUserAuditActor(c, user))
 			if err != nil {
 				logrus.WithError(err).Error("Failed to upgrade subscription")
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upgrade subscription")
@@ -166,6 +171,10 @@ func (h *SubscriptionHandler) CreatePortalSession(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get current user subscription")
 	}
 
+	if subscription.Manual {
+		return echo.NewHTTPError(http.StatusBadRequest, "Your subscription is not managed through Stripe")
+	}
+
 	portalSession, err := h.subscriptionService.CreatePortalSession(subscription, &services.PortalSessionConfig{
 		ReturnURL: body.ReturnURL,
 	})
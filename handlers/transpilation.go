@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"strings"
 	"time"
 
@@ -20,24 +21,27 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/schema"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/env"
 	"github.com/tereus-project/tereus-api/services"
 )
 
 type TranspilationHandler struct {
-	storageService    *services.StorageService
-	databaseService   *services.DatabaseService
-	tokenService      *services.TokenService
-	submissionService *services.SubmissionService
+	storageService      *services.StorageService
+	databaseService     *services.DatabaseService
+	tokenService        *services.TokenService
+	submissionService   *services.SubmissionService
+	subscriptionService *services.SubscriptionService
 }
 
-func NewTranspilationHandler(storageService *services.StorageService, databaseService *services.DatabaseService, tokenService *services.TokenService, submissionService *services.SubmissionService) (*TranspilationHandler, error) {
+func NewTranspilationHandler(storageService *services.StorageService, databaseService *services.DatabaseService, tokenService *services.TokenService, submissionService *services.SubmissionService, subscriptionService *services.SubscriptionService) (*TranspilationHandler, error) {
 	return &TranspilationHandler{
-		storageService:    storageService,
-		databaseService:   databaseService,
-		tokenService:      tokenService,
-		submissionService: submissionService,
+		storageService:      storageService,
+		databaseService:     databaseService,
+		tokenService:        tokenService,
+		submissionService:   submissionService,
+		subscriptionService: subscriptionService,
 	}, nil
 }
 
@@ -48,6 +52,8 @@ type TranspilationResult struct {
 	Status         string `json:"status"`
 	Reason         string `json:"reason"`
 	CreatedAt      string `json:"created_at"`
+	ParentID       string `json:"parent_id,omitempty"`
+	CacheHit       bool   `json:"cache_hit"`
 }
 
 type transpilationBody struct {
@@ -111,7 +117,8 @@ func (h *TranspilationHandler) Transpile(c echo.Context, transpilationType Trans
 
 		reader := strings.NewReader(body.SourceCode)
 		submissionSourceSize = int(reader.Size())
-		_, err := h.storageService.PutSubmissionObject(
+		err := h.storageService.PutSubmissionObject(
+			user.ID,
 			submissionId.String(),
 			fmt.Sprintf("main%s", languagePairDetails.SourceLanguageFileExtension),
 			reader,
@@ -135,32 +142,9 @@ func (h *TranspilationHandler) Transpile(c echo.Context, transpilationType Trans
 		}
 		defer source.Close()
 
-		zipReader, err := zip.NewReader(source, int64(file.Size))
+		submissionSourceSize, err = h.putZipSubmissionObjects(user.ID, submissionId, source, file.Size)
 		if err != nil {
-			logrus.WithError(err).Error("Failed to unzip file")
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unzip file")
-		}
-
-		// Upload files to minio
-		for _, file := range zipReader.File {
-			if file.FileInfo().IsDir() {
-				continue
-			}
-
-			f, err := file.Open()
-			if err != nil {
-				logrus.WithError(err).Error("Failed to open file")
-				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to open file "%s"`, file.Name))
-			}
-			defer f.Close()
-
-			submissionSourceSize += int(file.UncompressedSize64)
-
-			_, err = h.storageService.PutSubmissionObject(submissionId.String(), file.Name, f, file.FileInfo().Size())
-			if err != nil {
-				logrus.WithError(err).Error("Failed to upload file to S3")
-				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to upload file "%s" to object storage`, file.Name))
-			}
+			return err
 		}
 
 	case GitTranspilationType:
@@ -225,7 +209,7 @@ func (h *TranspilationHandler) Transpile(c echo.Context, transpilationType Trans
 
 			submissionSourceSize += int(info.Size())
 
-			_, err = h.storageService.PutSubmissionObject(submissionId.String(), file.Name(), f, info.Size())
+			err = h.storageService.PutSubmissionObject(user.ID, submissionId.String(), file.Name(), f, info.Size())
 			if err != nil {
 				logrus.WithError(err).Error("Failed to upload file to S3")
 				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to upload file "%s" to object storage`, file.Name()))
@@ -240,34 +224,455 @@ func (h *TranspilationHandler) Transpile(c echo.Context, transpilationType Trans
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid transpilation type")
 	}
 
-	newSubmission := services.SubmissionMessage{
-		ID:             submissionId.String(),
-		SourceLanguage: srcLanguage,
-		TargetLanguage: targetLanguage,
+	newSub := &newSubmission{
+		ID:              submissionId,
+		SourceLanguage:  srcLanguage,
+		TargetLanguage:  targetLanguage,
+		SourceSizeBytes: submissionSourceSize,
+		IsInline:        transpilationType == InlineTranspilationType,
+		BypassCache:     getBypassCache(c),
 	}
-	err = h.submissionService.PublishSubmissionToTranspile(newSubmission)
+
+	if transpilationType == GitTranspilationType {
+		newSub.GitRepo = body.GitRepo
+	}
+
+	s, err := h.publishSubmission(user, newSub)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to publish submission")
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to process submission")
+		return err
 	}
 
+	return c.JSON(http.StatusOK, TranspilationResult{
+		ID:             s.ID.String(),
+		SourceLanguage: s.SourceLanguage,
+		TargetLanguage: s.TargetLanguage,
+		Status:         s.Status.String(),
+		Reason:         s.Reason,
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339Nano),
+		CacheHit:       s.CacheHit,
+	})
+}
+
+// Upload the files of a zip archive as the sources of a submission of a user
+// and return their uncompressed size.
+// Return a *echo.HTTPError if failing
+func (h *TranspilationHandler) putZipSubmissionObjects(userID uuid.UUID, submissionId uuid.UUID, reader io.ReaderAt, size int64) (int, error) {
+	zipReader, err := zip.NewReader(reader, size)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to unzip file")
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, "Failed to unzip file")
+	}
+
+	submissionSourceSize := 0
+
+	// Upload files to minio
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		f, err := file.Open()
+		if err != nil {
+			logrus.WithError(err).Error("Failed to open file")
+			return 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to open file "%s"`, file.Name))
+		}
+		defer f.Close()
+
+		submissionSourceSize += int(file.UncompressedSize64)
+
+		err = h.storageService.PutSubmissionObject(userID, submissionId.String(), file.Name, f, file.FileInfo().Size())
+		if err != nil {
+			logrus.WithError(err).Error("Failed to upload file to S3")
+			return 0, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to upload file "%s" to object storage`, file.Name))
+		}
+	}
+
+	return submissionSourceSize, nil
+}
+
+type newSubmission struct {
+	ID              uuid.UUID
+	SourceLanguage  string
+	TargetLanguage  string
+	SourceSizeBytes int
+	IsInline        bool
+	GitRepo         string
+	ParentID        uuid.UUID
+	// Run the transpiler even if the results of identical sources are cached
+	BypassCache bool
+}
+
+// Whether the client asked not to reuse cached results
+func getBypassCache(c echo.Context) bool {
+	return c.QueryParam("no_cache") == "true"
+}
+
+func (h *TranspilationHandler) newSubmissionCreation(user *ent.User, sub *newSubmission, inputHash string, transpilerVersion string) *ent.SubmissionCreate {
 	submissionCreation := h.databaseService.Submission.Create().
-		SetID(submissionId).
+		SetID(sub.ID).
+		SetSourceLanguage(sub.SourceLanguage).
+		SetTargetLanguage(sub.TargetLanguage).
+		SetSubmissionSourceSizeBytes(sub.SourceSizeBytes).
+		SetIsInline(sub.IsInline).
+		SetUserID(user.ID)
+
+	if sub.GitRepo != "" {
+		submissionCreation.SetGitRepo(sub.GitRepo)
+	}
+
+	if sub.ParentID != uuid.Nil {
+		submissionCreation.SetParentID(sub.ParentID)
+	}
+
+	if inputHash != "" {
+		submissionCreation.SetInputHash(inputHash)
+	}
+
+	if transpilerVersion != "" {
+		submissionCreation.SetTranspilerVersion(transpilerVersion)
+	}
+
+	return submissionCreation
+}
+
+// Save a submission as done with the results of a previous submission of the
+// user with the same input hash. Return a nil submission if there is none
+func (h *TranspilationHandler) createCachedSubmission(user *ent.User, sub *newSubmission, inputHash string, transpilerVersion string) (*ent.Submission, error) {
+	cached, err := h.submissionService.FindCachedSubmission(user.ID, inputHash)
+	if err != nil || cached == nil {
+		return nil, err
+	}
+
+	err = h.storageService.CopySubmissionResults(cached.ID.String(), sub.ID.String())
+	if err != nil {
+		_ = h.storageService.DeleteSubmissionResults(sub.ID.String())
+		return nil, err
+	}
+
+	now := time.Now()
+
+	s, err := h.newSubmissionCreation(user, sub, inputHash, transpilerVersion).
+		SetStatus(submission.StatusDone).
+		SetCacheHit(true).
+		SetSubmissionTargetSizeBytes(cached.SubmissionTargetSizeBytes).
+		SetHasMapping(cached.HasMapping).
+		SetResultsEncrypted(cached.ResultsEncrypted).
+		SetProcessingStartedAt(now).
+		SetProcessingFinishedAt(now).
+		Save(context.Background())
+	if err != nil {
+		_ = h.storageService.DeleteSubmissionResults(sub.ID.String())
+		return nil, err
+	}
+
+	err = h.submissionService.CopySubmissionDiagnostics(cached.ID, s.ID)
+	if err != nil {
+		logrus.WithError(err).WithField("id", s.ID).Error("Failed to copy cached submission diagnostics")
+	}
+
+	err = h.storageService.ReleaseSubmissionWorkingCopy(s.ID.String())
+	if err != nil {
+		logrus.WithError(err).WithField("id", s.ID).Error("Failed to delete submission working copy from S3")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":        s.ID,
+		"cached_id": cached.ID,
+	}).Info("Reused cached submission results")
+
+	return s, nil
+}
+
+// Publish a submission whose sources are already in the object storage and
+// save it to the database.
+// Return a *echo.HTTPError if failing
+func (h *TranspilationHandler) publishSubmission(user *ent.User, sub *newSubmission) (*ent.Submission, error) {
+	inputHash, transpilerVersion, err := h.submissionService.GetSubmissionInputHash(sub.ID.String(), sub.SourceLanguage, sub.TargetLanguage)
+	if err != nil {
+		logrus.WithError(err).WithField("id", sub.ID).Warn("Failed to compute submission input hash")
+		inputHash = ""
+	}
+
+	if inputHash != "" && !sub.BypassCache {
+		s, err := h.createCachedSubmission(user, sub, inputHash, transpilerVersion)
+		if err != nil {
+			logrus.WithError(err).WithField("id", sub.ID).Warn("Failed to reuse cached submission results")
+		} else if s != nil {
+			return s, nil
+		}
+	}
+
+	tier, err := h.subscriptionService.GetUserTier(user.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get user tier")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to process submission")
+	}
+
+	lane, err := h.submissionService.PublishSubmissionToTranspile(services.SubmissionMessage{
+		ID:             sub.ID.String(),
+		SourceLanguage: sub.SourceLanguage,
+		TargetLanguage: sub.TargetLanguage,
+	}, tier)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to publish submission")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to process submission")
+	}
+
+	s, err := h.newSubmissionCreation(user, sub, inputHash, transpilerVersion).
+		SetProcessingStartedAt(time.Now()).
+		SetQueueLane(lane).
+		Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to save submission to database")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to save submission to database")
+	}
+
+	return s, nil
+}
+
+const (
+	uploadSessionDuration = time.Hour
+	// Files bigger than this are uploaded in parts of this size
+	uploadSessionPartSizeBytes = 64 * 1024 * 1024
+	uploadSessionMaxFiles      = 10000
+)
+
+type uploadSessionBodyFile struct {
+	Path      string `json:"path" validate:"required"`
+	SizeBytes int64  `json:"size_bytes" validate:"min=0"`
+}
+
+type uploadSessionBody struct {
+	Files []*uploadSessionBodyFile `json:"files" validate:"required,min=1,dive"`
+}
+
+type uploadSessionResultFile struct {
+	Path string `json:"path"`
+	// Set for files uploaded in a single request
+	UploadURL string `json:"upload_url,omitempty"`
+	// Set for files uploaded in several parts, in part number order
+	PartSizeBytes int64    `json:"part_size_bytes,omitempty"`
+	PartURLs      []string `json:"part_urls,omitempty"`
+}
+
+type uploadSessionResult struct {
+	ID        string                     `json:"id"`
+	ExpiresAt string                     `json:"expires_at"`
+	Files     []*uploadSessionResultFile `json:"files"`
+}
+
+// POST /submissions/upload/:src/to/:target
+func (h *TranspilationHandler) CreateUploadSession(c echo.Context) error {
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(uploadSessionBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	srcLanguage := strings.ToLower(c.Param("src"))
+	targetLanguage := strings.ToLower(c.Param("target"))
+
+	_, err = h.submissionService.GetLanguagePairDetails(srcLanguage, targetLanguage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if len(body.Files) > uploadSessionMaxFiles {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Too many files, at most %d files can be uploaded", uploadSessionMaxFiles))
+	}
+
+	config := env.Get()
+	totalSize := int64(0)
+	paths := map[string]bool{}
+
+	for _, file := range body.Files {
+		cleanPath := path.Clean(file.Path)
+		if path.IsAbs(cleanPath) || cleanPath == "." || cleanPath == ".." || strings.HasPrefix(cleanPath, "../") || cleanPath != file.Path {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`Invalid file path "%s"`, file.Path))
+		}
+
+		if paths[file.Path] {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`Duplicate file path "%s"`, file.Path))
+		}
+		paths[file.Path] = true
+
+		totalSize += file.SizeBytes
+	}
+
+	if totalSize > config.UploadSessionMaxSizeBytes {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("Submissions can't be bigger than %d bytes", config.UploadSessionMaxSizeBytes))
+	}
+
+	sessionId := uuid.New()
+	expiresAt := time.Now().Add(uploadSessionDuration)
+
+	result := uploadSessionResult{
+		ID:        sessionId.String(),
+		ExpiresAt: expiresAt.Format(time.RFC3339Nano),
+		Files:     make([]*uploadSessionResultFile, len(body.Files)),
+	}
+	sessionFiles := make([]schema.UploadSessionFile, len(body.Files))
+
+	for i, file := range body.Files {
+		sessionFiles[i] = schema.UploadSessionFile{
+			Path:      file.Path,
+			SizeBytes: file.SizeBytes,
+		}
+		result.Files[i] = &uploadSessionResultFile{
+			Path: file.Path,
+		}
+
+		if file.SizeBytes <= uploadSessionPartSizeBytes {
+			result.Files[i].UploadURL, err = h.storageService.PresignSubmissionObjectUpload(sessionId.String(), file.Path, uploadSessionDuration)
+			if err != nil {
+				if err == services.ErrPresignNotSupported {
+					return echo.NewHTTPError(http.StatusNotImplemented, "Upload sessions are not supported by this storage backend")
+				}
+				logrus.WithError(err).Error("Failed to presign upload")
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create upload session")
+			}
+
+			continue
+		}
+
+		uploadId, err := h.storageService.NewSubmissionObjectMultipartUpload(sessionId.String(), file.Path)
+		if err != nil {
+			if err == services.ErrPresignNotSupported {
+				return echo.NewHTTPError(http.StatusNotImplemented, "Upload sessions are not supported by this storage backend")
+			}
+			logrus.WithError(err).Error("Failed to start multipart upload")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create upload session")
+		}
+
+		partCount := int((file.SizeBytes + uploadSessionPartSizeBytes - 1) / uploadSessionPartSizeBytes)
+		sessionFiles[i].UploadID = uploadId
+		sessionFiles[i].PartCount = partCount
+		result.Files[i].PartSizeBytes = uploadSessionPartSizeBytes
+		result.Files[i].PartURLs = make([]string, partCount)
+
+		for part := 1; part <= partCount; part++ {
+			result.Files[i].PartURLs[part-1], err = h.storageService.PresignSubmissionObjectPartUpload(sessionId.String(), file.Path, uploadId, part, uploadSessionDuration)
+			if err != nil {
+				logrus.WithError(err).Error("Failed to presign part upload")
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create upload session")
+			}
+		}
+	}
+
+	_, err = h.databaseService.UploadSession.Create().
+		SetID(sessionId).
 		SetSourceLanguage(srcLanguage).
 		SetTargetLanguage(targetLanguage).
-		SetSubmissionSourceSizeBytes(submissionSourceSize).
-		SetIsInline(transpilationType == InlineTranspilationType).
+		SetFiles(sessionFiles).
+		SetExpiresAt(expiresAt).
 		SetUserID(user.ID).
-		SetProcessingStartedAt(time.Now())
+		Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to save upload session to database")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create upload session")
+	}
 
-	if transpilationType == GitTranspilationType {
-		submissionCreation.SetGitRepo(body.GitRepo)
+	return c.JSON(http.StatusOK, result)
+}
+
+// POST /submissions/upload/:id/finalize
+func (h *TranspilationHandler) FinalizeUploadSession(c echo.Context) error {
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
 	}
 
-	s, err := submissionCreation.Save(context.Background())
+	sessionId, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		logrus.WithError(err).Error("Failed to save submission to database")
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to save submission to database")
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid upload session ID")
+	}
+
+	session, err := h.databaseService.UploadSession.Get(context.Background(), sessionId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Upload session not found")
+		}
+		logrus.WithError(err).Error("Failed to get upload session")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get upload session")
+	}
+
+	owner, err := session.QueryUser().FirstID(c.Request().Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get upload session owner")
+		return err
+	}
+
+	if owner != user.ID {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not allowed to finalize this upload session")
+	}
+
+	if session.ExpiresAt.Before(time.Now()) {
+		return echo.NewHTTPError(http.StatusGone, "This upload session has expired")
+	}
+
+	submissionSourceSize := int64(0)
+
+	for _, file := range session.Files {
+		if file.UploadID != "" {
+			err := h.storageService.CompleteSubmissionObjectMultipartUpload(session.ID.String(), file.Path, file.UploadID)
+			if err != nil {
+				if err == services.ErrSubmissionFileNotFound {
+					return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`File "%s" was not uploaded`, file.Path))
+				}
+
+				logrus.WithError(err).Error("Failed to complete multipart upload")
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`Failed to complete the upload of file "%s": %s`, file.Path, err.Error()))
+			}
+		}
+
+		info, err := h.storageService.StatSubmissionFile(session.ID.String(), services.SubmissionFileTypeSource, file.Path)
+		if err != nil {
+			if err == services.ErrSubmissionFileNotFound {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`File "%s" was not uploaded`, file.Path))
+			}
+
+			logrus.WithError(err).Error("Failed to stat uploaded file")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check uploaded files")
+		}
+
+		if info.Size != file.SizeBytes {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf(`File "%s" is %d bytes but %d bytes were announced`, file.Path, info.Size, file.SizeBytes))
+		}
+
+		submissionSourceSize += info.Size
+	}
+
+	for _, file := range session.Files {
+		err := h.storageService.IngestSubmissionObject(user.ID, session.ID.String(), file.Path)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to ingest uploaded file")
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf(`Failed to store file "%s"`, file.Path))
+		}
+	}
+
+	s, err := h.publishSubmission(user, &newSubmission{
+		ID:              session.ID,
+		SourceLanguage:  session.SourceLanguage,
+		TargetLanguage:  session.TargetLanguage,
+		SourceSizeBytes: int(submissionSourceSize),
+		BypassCache:     getBypassCache(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.databaseService.UploadSession.DeleteOneID(session.ID).Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to delete upload session")
 	}
 
 	return c.JSON(http.StatusOK, TranspilationResult{
@@ -277,69 +682,167 @@ func (h *TranspilationHandler) Transpile(c echo.Context, transpilationType Trans
 		Status:         s.Status.String(),
 		Reason:         s.Reason,
 		CreatedAt:      s.CreatedAt.Format(time.RFC3339Nano),
+		CacheHit:       s.CacheHit,
 	})
 }
 
-// GET /submissions/:id/download
-func (h *TranspilationHandler) DownloadTranspiledFiles(c echo.Context) error {
-	id, err := uuid.Parse(c.Param("id"))
+type rerunBody struct {
+	TargetLanguage string `json:"target_language"`
+}
+
+// POST /submissions/:id/rerun
+func (h *TranspilationHandler) RerunSubmission(c echo.Context) error {
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(rerunBody)
+
+	if err := c.Bind(body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	parentID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid submission ID")
 	}
 
-	// Get sub from database
-	sub, err := h.databaseService.Submission.Query().Where(submission.ID(id)).Only(context.Background())
+	parent, err := h.databaseService.Submission.Get(context.Background(), parentID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusNotFound, "This submission does not exist")
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "Submission not found")
+		}
+		logrus.WithError(err).Error("Failed to get submission")
+		return err
 	}
 
-	if sub.Status != "done" {
-		return echo.NewHTTPError(http.StatusNotFound, "This submission is not done yet")
+	owner, err := parent.QueryUser().FirstID(c.Request().Context())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission owner")
+		return err
 	}
 
-	config := env.Get()
-	objectStoragePath := fmt.Sprintf("%s/%s", config.SubmissionsFolder, sub.ID)
+	if owner != user.ID {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not allowed to rerun this submission")
+	}
 
-	c.Response().Header().Set("Content-Type", "application/zip")
-	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", sub.ID))
+	if parent.Status != submission.StatusDone && parent.Status != submission.StatusFailed {
+		return echo.NewHTTPError(http.StatusConflict, "Only done or failed submissions can be rerun")
+	}
 
-	// Create zip file
-	zipFile := zip.NewWriter(c.Response().Writer)
+	targetLanguage := parent.TargetLanguage
+	if body.TargetLanguage != "" {
+		targetLanguage = strings.ToLower(body.TargetLanguage)
+	}
 
-	objects := h.storageService.GetObjects(objectStoragePath)
-	for object := range objects {
-		if object.Err != nil {
-			logrus.WithError(object.Err).Error("Failed to get file from S3")
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get files from S3")
-		}
+	_, err = h.submissionService.GetLanguagePairDetails(parent.SourceLanguage, targetLanguage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
 
-		reader, err := h.storageService.GetObject(object.Path)
-		if err != nil {
-			logrus.WithError(err).Error("Failed to get file from S3")
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get file from S3")
-		}
+	submissionId := uuid.New()
 
-		objectRelativePath := strings.TrimPrefix(object.Path, objectStoragePath)
-		zippedFilePath := fmt.Sprintf("%s/%s", sub.ID, objectRelativePath)
+	submissionSourceSize, err := h.storageService.CopySubmissionSources(user.ID, parent.ID.String(), submissionId.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to copy submission sources")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to copy submission sources")
+	}
 
-		writer, err := zipFile.Create(zippedFilePath)
-		if err != nil {
-			logrus.WithError(err).Error("Failed to create file in zip")
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create file in zip")
-		}
+	s, err := h.publishSubmission(user, &newSubmission{
+		ID:              submissionId,
+		SourceLanguage:  parent.SourceLanguage,
+		TargetLanguage:  targetLanguage,
+		SourceSizeBytes: int(submissionSourceSize),
+		IsInline:        parent.IsInline,
+		GitRepo:         parent.GitRepo,
+		ParentID:        parent.ID,
+		BypassCache:     getBypassCache(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, TranspilationResult{
+		ID:             s.ID.String(),
+		SourceLanguage: s.SourceLanguage,
+		TargetLanguage: s.TargetLanguage,
+		Status:         s.Status.String(),
+		Reason:         s.Reason,
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339Nano),
+		CacheHit:       s.CacheHit,
+		ParentID:       parent.ID.String(),
+	})
+}
+
+// Lifetime of the presigned download URLs
+const presignedDownloadDuration = 15 * time.Minute
+
+type presignedDownloadResult struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// GET /submissions/:id/download
+func (h *TranspilationHandler) DownloadTranspiledFiles(c echo.Context) error {
+	sub, err := getReadableSubmission(c, h.databaseService, h.tokenService)
+	if err != nil {
+		return err
+	}
+
+	if sub.Status != "done" {
+		return echo.NewHTTPError(http.StatusNotFound, "This submission is not done yet")
+	}
+
+	owner, err := sub.QueryUser().OnlyID(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get submission owner")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get submission owner")
+	}
+
+	archivePath, err := h.storageService.GetSubmissionArchive(owner, sub.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to build submission archive")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get files from S3")
+	}
+
+	filename := fmt.Sprintf("%s.zip", sub.ID)
 
-		_, err = io.Copy(writer, reader)
+	if c.QueryParam("presigned") == "true" {
+		url, err := h.storageService.PresignObjectDownload(archivePath, presignedDownloadDuration, filename)
 		if err != nil {
-			logrus.WithError(err).Error("Failed to copy file to zip")
-			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to copy file to zip")
+			if err == services.ErrPresignNotSupported {
+				return echo.NewHTTPError(http.StatusNotImplemented, "Presigned downloads are not supported by the storage configuration")
+			}
+
+			logrus.WithError(err).Error("Failed to presign archive download")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to presign archive download")
 		}
-		reader.Close()
+
+		return c.JSON(http.StatusOK, presignedDownloadResult{
+			URL:       url,
+			ExpiresAt: time.Now().Add(presignedDownloadDuration).Format(time.RFC3339Nano),
+		})
 	}
 
-	err = zipFile.Close()
+	reader, err := h.storageService.GetObject(archivePath)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to close zip file")
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to close zip file")
+		logrus.WithError(err).Error("Failed to get file from S3")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get file from S3")
+	}
+	defer reader.Close()
+
+	c.Response().Header().Set("Content-Type", "application/zip")
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+
+	_, err = io.Copy(c.Response().Writer, reader)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to copy archive to response")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to copy archive to response")
 	}
 
 	return nil
@@ -426,20 +929,18 @@ func (h *TranspilationHandler) DownloadInlineTranspilationSource(c echo.Context)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get language pair details")
 	}
-	objectStoragePath := fmt.Sprintf("transpilations/%s/main%s", sub.ID, languagePairDetails.SourceLanguageFileExtension)
 
 	// Get files from S3
-	object, err := h.storageService.GetObject(objectStoragePath)
+	object, _, err := h.storageService.GetSubmissionFile(sub.ID.String(), services.SubmissionFileTypeSource, fmt.Sprintf("main%s", languagePairDetails.SourceLanguageFileExtension))
 	if err != nil {
+		if err == services.ErrSubmissionFileNotFound {
+			return echo.NewHTTPError(http.StatusNoContent)
+		}
 		logrus.WithError(err).Error("Failed to get files from S3")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get files from S3")
 	}
 	defer object.Close()
 
-	if _, err := object.Stat(); err != nil {
-		return echo.NewHTTPError(http.StatusNoContent)
-	}
-
 	data, err := ioutil.ReadAll(object)
 	if err != nil {
 		logrus.WithError(err).Error("Failed to read file from S3")
@@ -543,15 +1044,14 @@ func (h *TranspilationHandler) DownloadInlineTranspiledOutput(c echo.Context) er
 	// Get files from S3
 	object, err := h.storageService.GetObject(objectStoragePath)
 	if err != nil {
+		if err == services.ErrObjectNotFound {
+			return echo.NewHTTPError(http.StatusNoContent)
+		}
 		logrus.WithError(err).Error("Failed to get files from S3")
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get files from S3")
 	}
 	defer object.Close()
 
-	if _, err := object.Stat(); err != nil {
-		return echo.NewHTTPError(http.StatusNoContent)
-	}
-
 	data, err := ioutil.ReadAll(object)
 	if err != nil {
 		logrus.WithError(err).Error("Failed to read file from S3")
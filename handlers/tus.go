@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/env"
+)
+
+// Implementation of the tus resumable upload protocol (https://tus.io/protocols/resumable-upload.html)
+// with the creation, expiration and termination extensions.
+// The uploaded file is a zip archive handled like the ones sent to POST /submissions/zip/:src/to/:target
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,expiration,termination"
+
+	tusUploadDuration = 24 * time.Hour
+)
+
+// Headers that browsers must be allowed to read from tus responses
+var TusExposedHeaders = []string{
+	"Location",
+	"Tus-Resumable",
+	"Tus-Version",
+	"Tus-Extension",
+	"Tus-Max-Size",
+	"Upload-Offset",
+	"Upload-Length",
+	"Upload-Expires",
+	"Upload-Metadata",
+}
+
+func setTusHeaders(c echo.Context) {
+	c.Response().Header().Set("Tus-Resumable", tusVersion)
+}
+
+// Check the protocol version requested by the client
+func checkTusResumable(c echo.Context) error {
+	setTusHeaders(c)
+
+	if c.Request().Header.Get("Tus-Resumable") != tusVersion {
+		c.Response().Header().Set("Tus-Version", tusVersion)
+		return echo.NewHTTPError(http.StatusPreconditionFailed, fmt.Sprintf("Unsupported tus version, only %s is supported", tusVersion))
+	}
+
+	return nil
+}
+
+// Get a resumable upload owned by the current user.
+// Return a *echo.HTTPError if failing
+func (h *TranspilationHandler) getResumableUpload(c echo.Context, user *ent.User) (*ent.ResumableUpload, error) {
+	uploadId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Upload not found")
+	}
+
+	upload, err := h.databaseService.ResumableUpload.Query().
+		Where(resumableupload.ID(uploadId)).
+		WithUser().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Upload not found")
+		}
+		logrus.WithError(err).Error("Failed to get resumable upload")
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get upload")
+	}
+
+	if upload.Edges.User.ID != user.ID {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "You are not allowed to access this upload")
+	}
+
+	if upload.ExpiresAt.Before(time.Now()) {
+		return nil, echo.NewHTTPError(http.StatusGone, "This upload has expired")
+	}
+
+	return upload, nil
+}
+
+// OPTIONS /submissions/tus
+func (h *TranspilationHandler) GetTusOptions(c echo.Context) error {
+	config := env.Get()
+
+	setTusHeaders(c)
+	c.Response().Header().Set("Tus-Version", tusVersion)
+	c.Response().Header().Set("Tus-Extension", tusExtensions)
+	c.Response().Header().Set("Tus-Max-Size", strconv.FormatInt(config.UploadSessionMaxSizeBytes, 10))
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// POST /submissions/tus/:src/to/:target
+func (h *TranspilationHandler) CreateTusUpload(c echo.Context) error {
+	if err := checkTusResumable(c); err != nil {
+		return err
+	}
+
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	srcLanguage := strings.ToLower(c.Param("src"))
+	targetLanguage := strings.ToLower(c.Param("target"))
+
+	_, err = h.submissionService.GetLanguagePairDetails(srcLanguage, targetLanguage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	length, err := strconv.ParseInt(c.Request().Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Missing or invalid Upload-Length header")
+	}
+
+	config := env.Get()
+	if length > config.UploadSessionMaxSizeBytes {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("Submissions can't be bigger than %d bytes", config.UploadSessionMaxSizeBytes))
+	}
+
+	uploadCreation := h.databaseService.ResumableUpload.Create().
+		SetSourceLanguage(srcLanguage).
+		SetTargetLanguage(targetLanguage).
+		SetLength(length).
+		SetExpiresAt(time.Now().Add(tusUploadDuration)).
+		SetBypassCache(getBypassCache(c)).
+		SetUser(user)
+
+	if metadata := c.Request().Header.Get("Upload-Metadata"); metadata != "" {
+		uploadCreation.SetMetadata(metadata)
+	}
+
+	upload, err := uploadCreation.Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to save resumable upload to database")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create upload")
+	}
+
+	c.Response().Header().Set("Location", fmt.Sprintf("%s://%s/submissions/tus/%s", c.Scheme(), c.Request().Host, upload.ID))
+	c.Response().Header().Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	return c.NoContent(http.StatusCreated)
+}
+
+// HEAD /submissions/tus/:id
+func (h *TranspilationHandler) GetTusUploadOffset(c echo.Context) error {
+	if err := checkTusResumable(c); err != nil {
+		return err
+	}
+
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	upload, err := h.getResumableUpload(c, user)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	c.Response().Header().Set("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	c.Response().Header().Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	if upload.Metadata != "" {
+		c.Response().Header().Set("Upload-Metadata", upload.Metadata)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PATCH /submissions/tus/:id
+func (h *TranspilationHandler) PatchTusUpload(c echo.Context) error {
+	if err := checkTusResumable(c); err != nil {
+		return err
+	}
+
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Request().Header.Get("Content-Type") != "application/offset+octet-stream" {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "Content-Type must be application/offset+octet-stream")
+	}
+
+	offset, err := strconv.ParseInt(c.Request().Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Missing or invalid Upload-Offset header")
+	}
+
+	size := c.Request().ContentLength
+	if size < 0 {
+		return echo.NewHTTPError(http.StatusLengthRequired, "Missing Content-Length header")
+	}
+
+	upload, err := h.getResumableUpload(c, user)
+	if err != nil {
+		return err
+	}
+
+	if offset != upload.Offset {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("Upload-Offset does not match the current offset %d", upload.Offset))
+	}
+
+	if offset+size > upload.Length {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "The chunk exceeds the announced Upload-Length")
+	}
+
+	if size > 0 {
+		chunk, err := h.storageService.PutResumableUploadChunk(upload.ID.String(), offset, c.Request().Body, size)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to upload chunk to S3")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upload chunk to object storage")
+		}
+
+		// Only accept the chunk if no other request moved the offset in the meantime,
+		// the chunks of the other requests are stored under their own names
+		chunks := append(append([]string{}, upload.Chunks...), chunk)
+		count, err := h.databaseService.ResumableUpload.Update().
+			Where(resumableupload.ID(upload.ID), resumableupload.OffsetEQ(offset)).
+			SetOffset(offset + size).
+			SetChunks(chunks).
+			Save(context.Background())
+		if err != nil {
+			logrus.WithError(err).Error("Failed to update resumable upload offset")
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update upload")
+		}
+
+		if count == 0 {
+			err = h.storageService.DeleteResumableUploadChunk(upload.ID.String(), chunk)
+			if err != nil {
+				logrus.WithError(err).Error("Failed to delete rejected resumable upload chunk")
+			}
+
+			return echo.NewHTTPError(http.StatusConflict, "The upload was modified by another request")
+		}
+
+		upload.Offset = offset + size
+		upload.Chunks = chunks
+	}
+
+	c.Response().Header().Set("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	c.Response().Header().Set("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	if upload.Offset == upload.Length {
+		err = h.completeTusUpload(user, upload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Hand a complete upload over to the submission pipeline, the upload ID is
+// reused as the submission ID.
+// Return a *echo.HTTPError if failing
+func (h *TranspilationHandler) completeTusUpload(user *ent.User, upload *ent.ResumableUpload) error {
+	file, err := ioutil.TempFile("", "tus")
+	if err != nil {
+		logrus.WithError(err).Error("Failed to create temporary file")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to process upload")
+	}
+	defer file.Close()
+	defer os.Remove(file.Name())
+
+	err = h.storageService.WriteResumableUpload(file, upload.ID.String(), upload.Chunks)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get upload chunks from S3")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to process upload")
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get upload size")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to process upload")
+	}
+
+	if size != upload.Length {
+		logrus.WithFields(logrus.Fields{
+			"id":       upload.ID,
+			"size":     size,
+			"expected": upload.Length,
+		}).Error("Resumable upload size mismatch")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to process upload")
+	}
+
+	submissionSourceSize, err := h.putZipSubmissionObjects(user.ID, upload.ID, file, size)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.publishSubmission(user, &newSubmission{
+		ID:              upload.ID,
+		SourceLanguage:  upload.SourceLanguage,
+		TargetLanguage:  upload.TargetLanguage,
+		SourceSizeBytes: submissionSourceSize,
+		BypassCache:     upload.BypassCache,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.databaseService.ResumableUpload.DeleteOneID(upload.ID).Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to delete resumable upload")
+	}
+
+	err = h.storageService.DeleteResumableUpload(upload.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to delete resumable upload chunks")
+	}
+
+	return nil
+}
+
+// DELETE /submissions/tus/:id
+func (h *TranspilationHandler) DeleteTusUpload(c echo.Context) error {
+	if err := checkTusResumable(c); err != nil {
+		return err
+	}
+
+	user, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	upload, err := h.getResumableUpload(c, user)
+	if err != nil {
+		return err
+	}
+
+	err = h.databaseService.ResumableUpload.DeleteOneID(upload.ID).Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to delete resumable upload")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete upload")
+	}
+
+	err = h.storageService.DeleteResumableUpload(upload.ID.String())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to delete resumable upload chunks")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
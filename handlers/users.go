@@ -17,6 +17,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
 	"github.com/tereus-project/tereus-api/ent/submission"
 	"github.com/tereus-project/tereus-api/ent/user"
 	"github.com/tereus-project/tereus-api/services"
@@ -27,14 +28,20 @@ type UserHandler struct {
 	tokenService        *services.TokenService
 	subscriptionService *services.SubscriptionService
 	storageService      *services.StorageService
+	encryptionService   *services.EncryptionService
+	retentionService    *services.RetentionService
+	auditService        *services.AuditService
 }
 
-func NewUserHandler(databaseService *services.DatabaseService, tokenService *services.TokenService, subscriptionService *services.SubscriptionService, storageService *services.StorageService) (*UserHandler, error) {
+func NewUserHandler(databaseService *services.DatabaseService, tokenService *services.TokenService, subscriptionService *services.SubscriptionService, storageService *services.StorageService, encryptionService *services.EncryptionService, retentionService *services.RetentionService, auditService *services.AuditService) (*UserHandler, error) {
 	return &UserHandler{
 		databaseService:     databaseService,
 		tokenService:        tokenService,
 		subscriptionService: subscriptionService,
 		storageService:      storageService,
+		encryptionService:   encryptionService,
+		retentionService:    retentionService,
+		auditService:        auditService,
 	}, nil
 }
 
@@ -125,18 +132,21 @@ func (h *UserHandler) GetCurrentUserLinkedAccounts(c echo.Context) error {
 }
 
 type submissionsHistoryItem struct {
-	ID              string        `json:"id"`
-	SourceLanguage  string        `json:"source_language"`
-	TargetLanguage  string        `json:"target_language"`
-	IsInline        bool          `json:"is_inline"`
-	IsPublic        bool          `json:"is_public"`
-	Status          string        `json:"status"`
-	Reason          string        `json:"reason"`
-	CreatedAt       string        `json:"created_at"`
-	ShareID         string        `json:"share_id"`
-	SourceSizeBytes int           `json:"source_size_bytes"`
-	TargetSizeBytes int           `json:"target_size_bytes"`
-	Duration        time.Duration `json:"duration"`
+	ID              string `json:"id"`
+	SourceLanguage  string `json:"source_language"`
+	TargetLanguage  string `json:"target_language"`
+	IsInline        bool   `json:"is_inline"`
+	IsPublic        bool   `json:"is_public"`
+	Status          string `json:"status"`
+	Reason          string `json:"reason"`
+	CreatedAt       string `json:"created_at"`
+	ShareID         string `json:"share_id"`
+	SourceSizeBytes int    `json:"source_size_bytes"`
+	TargetSizeBytes int    `json:"target_size_bytes"`
+	// When the submission will be cleaned, null if it is kept
+	ExpiresAt   *string           `json:"expires_at"`
+	Duration    time.Duration     `json:"duration"`
+	Diagnostics []*diagnosticItem `json:"diagnostics"`
 }
 
 type submissionsHistory struct {
@@ -160,8 +170,14 @@ func (h *UserHandler) GetSubmissionsHistory(c echo.Context) error {
 		limit = 10
 	}
 
+	diagnosticsFilter, err := getDiagnosticsFilter(c)
+	if err != nil {
+		return err
+	}
+
 	submissions, err := h.databaseService.Submission.Query().
 		Where(submission.HasUserWith(user.ID(tereusUser.ID))).
+		WithDiagnostics(diagnosticsFilter).
 		Order(ent.Desc(submission.FieldCreatedAt)).
 		Offset(limit * (page - 1)).
 		Limit(limit).
@@ -170,6 +186,11 @@ func (h *UserHandler) GetSubmissionsHistory(c echo.Context) error {
 		return err
 	}
 
+	policy, err := h.retentionService.GetUserRetentionPolicy(tereusUser.ID)
+	if err != nil {
+		return err
+	}
+
 	items := submissionsHistory{
 		Submissions: make([]*submissionsHistoryItem, len(submissions)),
 	}
@@ -187,7 +208,9 @@ func (h *UserHandler) GetSubmissionsHistory(c echo.Context) error {
 			ShareID:         s.ShareID,
 			SourceSizeBytes: s.SubmissionSourceSizeBytes,
 			TargetSizeBytes: s.SubmissionTargetSizeBytes,
+			ExpiresAt:       formatOptionalTime(policy.GetSubmissionExpiration(s)),
 			Duration:        s.ProcessingFinishedAt.Sub(s.ProcessingStartedAt),
+			Diagnostics:     toDiagnosticItems(s.Edges.Diagnostics),
 		}
 	}
 
@@ -227,6 +250,16 @@ func (h *UserHandler) DeleteCurrentUser(c echo.Context) error {
 		return err
 	}
 
+	// Shred the data keys first so that the objects are unreadable even if
+	// their deletion fails or they are kept in backups
+	shredded, err := h.encryptionService.ShredUserDataKeys(loggedUser.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to shred user data keys")
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	logrus.WithField("keys", shredded).Info("Shredded data keys for user")
+
 	logrus.WithField("submissions", len(submissions)).Info("Deleting submissions for user")
 
 	// Delete all submissions
@@ -253,6 +286,11 @@ func (h *UserHandler) DeleteCurrentUser(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	// Kept after the account for the security and billing history
+	h.auditService.Record(loggedUser.ID, auditevent.TypeAccountDeleted, services.NewUserAuditActor(c, loggedUser), map[string]interface{}{
+		"submissions": len(submissions),
+	})
+
 	return c.NoContent(http.StatusOK)
 }
 
@@ -320,20 +358,20 @@ func (h *UserHandler) GetExport(c echo.Context) error {
 
 	// Extract submissions source and results from S3
 	for _, s := range submissions {
-		objects := h.storageService.ListSubmissionFiles(s.ID.String())
+		objects, err := h.storageService.ListSubmissionFiles(s.ID.String())
 		if err != nil {
 			logrus.WithError(err).Error("Failed to list submission files")
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export data")
 		}
 
-		for object := range objects {
+		for _, object := range objects {
 			o, err := h.storageService.GetObject(object.Path)
 			if err != nil {
 				logrus.WithError(err).Error("Failed to get object")
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export data")
 			}
 
-			codeFile, err := zipWriter.Create(object.Path)
+			codeFile, err := zipWriter.Create(object.FolderPath)
 			if err != nil {
 				logrus.WithError(err).Error("Failed to create code file in zip")
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to export data")
@@ -370,3 +408,85 @@ func (h *UserHandler) GetExport(c echo.Context) error {
 
 	return nil
 }
+
+type auditEventItem struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	ActorType string                 `json:"actor_type"`
+	IP        string                 `json:"ip,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	CreatedAt string                 `json:"created_at"`
+}
+
+func toAuditEventItem(e *ent.AuditEvent) *auditEventItem {
+	item := &auditEventItem{
+		ID:        e.ID.String(),
+		Type:      e.Type.String(),
+		ActorType: e.ActorType.String(),
+		Metadata:  e.Metadata,
+		CreatedAt: e.CreatedAt.Format(time.RFC3339Nano),
+	}
+
+	// The users only see where their own requests came from, not the admins'
+	if e.ActorType == auditevent.ActorTypeUser {
+		item.IP = e.IP
+		item.UserAgent = e.UserAgent
+	}
+
+	return item
+}
+
+// GET /users/me/audit-log
+func (h *UserHandler) GetAuditLog(c echo.Context) error {
+	tereusUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit := getAdminPagination(c)
+
+	events, totalItems, err := h.auditService.GetUserEvents(tereusUser.ID, limit*(page-1), limit)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get audit events")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get audit events")
+	}
+
+	items := make([]*auditEventItem, len(events))
+	for i, e := range events {
+		items[i] = toAuditEventItem(e)
+	}
+
+	return c.JSON(http.StatusOK, PaginatedResponse[*auditEventItem]{
+		Items: items,
+		Meta:  newPaginatedMeta(len(items), totalItems, page, limit),
+	})
+}
+
+type rotateEncryptionKeyResult struct {
+	KeyID     string `json:"key_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// POST /users/me/encryption-key/rotate
+func (h *UserHandler) RotateEncryptionKey(c echo.Context) error {
+	loggedUser, err := h.tokenService.GetUserFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if !h.encryptionService.Enabled() {
+		return echo.NewHTTPError(http.StatusNotImplemented, "Encryption is not enabled")
+	}
+
+	key, err := h.encryptionService.RotateUserDataKey(loggedUser.ID)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to rotate user data key")
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to rotate encryption key")
+	}
+
+	return c.JSON(http.StatusOK, rotateEncryptionKeyResult{
+		KeyID:     key.ID.String(),
+		CreatedAt: time.Now().Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/env"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// Run a migrate subcommand: up [n], down [n], status or baseline
+func runMigrate(ctx context.Context, config *env.Env, args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flags.Usage = usage
+	_ = flags.Parse(args)
+
+	if flags.NArg() == 0 || flags.NArg() > 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	// Number of migrations to apply or revert, all the pending ones are
+	// applied and only the last one is reverted by default
+	limit := 0
+	if flags.Arg(0) == "down" {
+		limit = 1
+	}
+	if flags.NArg() == 2 {
+		if flags.Arg(0) != "up" && flags.Arg(0) != "down" {
+			usage()
+			os.Exit(2)
+		}
+
+		n, err := strconv.Atoi(flags.Arg(1))
+		if err != nil || n < 1 {
+			usage()
+			os.Exit(2)
+		}
+		limit = n
+	}
+
+	databaseService, err := services.NewDatabaseService(config.DatabaseDriver, config.DatabaseEndpoint)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize database service")
+	}
+	defer databaseService.Close()
+
+	migrationService, err := services.NewMigrationService(databaseService, config.DatabaseDriver)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize migration service")
+	}
+
+	switch flags.Arg(0) {
+	case "up":
+		applied, err := migrationService.Up(ctx, limit)
+		for _, migration := range applied {
+			logrus.WithField("version", migration.Version).WithField("name", migration.Name).Info("Applied migration")
+		}
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to apply migrations")
+		}
+		if len(applied) == 0 {
+			logrus.Info("No pending migration")
+		}
+	case "down":
+		reverted, err := migrationService.Down(ctx, limit)
+		for _, migration := range reverted {
+			logrus.WithField("version", migration.Version).WithField("name", migration.Name).Info("Reverted migration")
+		}
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to revert migrations")
+		}
+		if len(reverted) == 0 {
+			logrus.Info("No applied migration")
+		}
+	case "status":
+		status, err := migrationService.Status(ctx)
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to get migration status")
+		}
+
+		for _, migration := range status {
+			state := "pending"
+			if migration.Missing {
+				state = "applied by another build on " + migration.AppliedAt.Format("2006-01-02 15:04:05")
+			} else if migration.AppliedAt != nil {
+				state = "applied on " + migration.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+
+			fmt.Printf("%s_%s\t%s\n", migration.Version, migration.Name, state)
+		}
+	case "baseline":
+		baseline, err := migrationService.Baseline(ctx)
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to record the baseline migration")
+		}
+
+		logrus.WithField("version", baseline.Version).WithField("name", baseline.Name).Info("Recorded the baseline migration as applied")
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
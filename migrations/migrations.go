@@ -0,0 +1,11 @@
+// Package migrations embeds the versioned SQL migrations of the database,
+// generated from the ent schema by ent/migrate/main.go
+package migrations
+
+import "embed"
+
+// One directory per database driver, holding <version>_<name>.up.sql and
+// <version>_<name>.down.sql files
+//
+//go:embed postgres sqlite3
+var FS embed.FS
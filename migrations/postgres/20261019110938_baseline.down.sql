@@ -0,0 +1,4 @@
+DROP TABLE "tokens";
+DROP TABLE "subscriptions";
+DROP TABLE "submissions";
+DROP TABLE "users";
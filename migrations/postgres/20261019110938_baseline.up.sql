@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "submissions"("id" uuid NOT NULL, "source_language" varchar NOT NULL, "target_language" varchar NOT NULL, "is_inline" boolean NOT NULL DEFAULT false, "is_public" boolean NOT NULL DEFAULT false, "status" varchar NOT NULL DEFAULT 'pending', "reason" varchar NULL, "git_repo" varchar NULL, "created_at" timestamp with time zone NOT NULL, "share_id" varchar UNIQUE NULL, "submission_source_size_bytes" bigint NOT NULL DEFAULT 0, "submission_target_size_bytes" bigint NOT NULL DEFAULT 0, "processing_started_at" timestamp with time zone NULL, "processing_finished_at" timestamp with time zone NULL, "user_submissions" uuid NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "subscriptions"("id" uuid NOT NULL, "stripe_customer_id" varchar NULL, "stripe_subscription_id" varchar NULL, "tier" varchar NOT NULL DEFAULT 'free', "expires_at" timestamp with time zone NULL, "cancelled" boolean NOT NULL DEFAULT false, "created_at" timestamp with time zone NOT NULL, "user_subscription" uuid UNIQUE NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "tokens"("id" uuid NOT NULL, "is_active" boolean NOT NULL DEFAULT true, "created_at" timestamp with time zone NOT NULL, "user_tokens" uuid NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "users"("id" uuid NOT NULL, "email" varchar UNIQUE NOT NULL, "password" varchar NULL, "github_user_id" bigint NULL, "github_access_token" varchar NULL, "gitlab_user_id" bigint NULL, "gitlab_access_token" varchar NULL, "gitlab_refresh_token" varchar NULL, "gitlab_access_token_expires_at" timestamp with time zone NULL, "created_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+ALTER TABLE "submissions" ADD CONSTRAINT "submissions_users_submissions" FOREIGN KEY("user_submissions") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "subscriptions" ADD CONSTRAINT "subscriptions_users_subscription" FOREIGN KEY("user_subscription") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "tokens" ADD CONSTRAINT "tokens_users_tokens" FOREIGN KEY("user_tokens") REFERENCES "users"("id") ON DELETE CASCADE;
@@ -0,0 +1,16 @@
+DROP TABLE "resumable_uploads";
+DROP INDEX "notification_preferences_user_notification_preference_key";
+DROP TABLE "notification_preferences";
+DROP TABLE "notifications";
+DROP INDEX "sourcefile_submission_id_path";
+DROP TABLE "source_files";
+DROP TABLE "blobs";
+DROP TABLE "diagnostics";
+DROP INDEX "data_keys_active_user_id_key";
+DROP TABLE "data_keys";
+DROP TABLE "upload_sessions";
+DROP TABLE "leases";
+DROP INDEX "pendingdeletion_status_verify_after";
+DROP TABLE "pending_deletions";
+DROP INDEX "submission_input_hash";
+ALTER TABLE "submissions" DROP CONSTRAINT "submissions_submissions_reruns", DROP COLUMN "submission_reruns", DROP COLUMN "retention_warned_at", DROP COLUMN "expires_at", DROP COLUMN "results_encrypted", DROP COLUMN "cache_hit", DROP COLUMN "transpiler_version", DROP COLUMN "input_hash", DROP COLUMN "has_mapping", DROP COLUMN "queue_lane";
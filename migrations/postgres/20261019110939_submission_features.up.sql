@@ -0,0 +1,16 @@
+ALTER TABLE "submissions" ADD COLUMN "queue_lane" character varying NOT NULL DEFAULT 'standard', ADD COLUMN "has_mapping" boolean NOT NULL DEFAULT false, ADD COLUMN "input_hash" character varying NULL, ADD COLUMN "transpiler_version" character varying NULL, ADD COLUMN "cache_hit" boolean NOT NULL DEFAULT false, ADD COLUMN "results_encrypted" boolean NOT NULL DEFAULT false, ADD COLUMN "expires_at" timestamp(0)with time zone NULL, ADD COLUMN "retention_warned_at" timestamp(0)with time zone NULL, ADD COLUMN "submission_reruns" uuid NULL, ADD CONSTRAINT "submissions_submissions_reruns" FOREIGN KEY ("submission_reruns") REFERENCES "submissions" ("id") ON DELETE SET NULL;
+CREATE INDEX "submission_input_hash" ON "submissions" ("input_hash");
+CREATE TABLE "pending_deletions" ("id" uuid NOT NULL, "prefix" character varying NOT NULL, "submission_id" uuid NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "last_error" text NULL, "created_at" timestamp(0)with time zone NOT NULL, "scheduled_at" timestamp(0)with time zone NULL, "verify_after" timestamp(0)with time zone NULL, PRIMARY KEY ("id"));
+CREATE INDEX "pendingdeletion_status_verify_after" ON "pending_deletions" ("status", "verify_after");
+CREATE TABLE "leases" ("id" character varying NOT NULL, "holder" character varying NOT NULL, "expires_at" timestamp(0)with time zone NOT NULL, "updated_at" timestamp(0)with time zone NOT NULL, PRIMARY KEY ("id"));
+CREATE TABLE "upload_sessions" ("id" uuid NOT NULL, "source_language" character varying NOT NULL, "target_language" character varying NOT NULL, "files" jsonb NOT NULL, "expires_at" timestamp(0)with time zone NOT NULL, "created_at" timestamp(0)with time zone NOT NULL, "user_upload_sessions" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "upload_sessions_users_upload_sessions" FOREIGN KEY ("user_upload_sessions") REFERENCES "users" ("id") ON DELETE CASCADE);
+CREATE TABLE "data_keys" ("id" uuid NOT NULL, "encrypted_key" bytea NOT NULL, "master_key_id" character varying NOT NULL, "active_user_id" uuid NULL, "created_at" timestamp(0)with time zone NOT NULL, "rotated_at" timestamp(0)with time zone NULL, "user_data_keys" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "data_keys_users_data_keys" FOREIGN KEY ("user_data_keys") REFERENCES "users" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "data_keys_active_user_id_key" ON "data_keys" ("active_user_id");
+CREATE TABLE "diagnostics" ("id" uuid NOT NULL, "severity" character varying NOT NULL, "file" character varying NULL, "line" bigint NULL, "column" bigint NULL, "code" character varying NULL, "message" text NOT NULL, "created_at" timestamp(0)with time zone NOT NULL, "submission_diagnostics" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "diagnostics_submissions_diagnostics" FOREIGN KEY ("submission_diagnostics") REFERENCES "submissions" ("id") ON DELETE CASCADE);
+CREATE TABLE "blobs" ("id" character varying NOT NULL, "size" bigint NOT NULL, "encrypted" boolean NOT NULL DEFAULT false, "reference_count" bigint NOT NULL DEFAULT 0, "created_at" timestamp(0)with time zone NOT NULL, "updated_at" timestamp(0)with time zone NOT NULL, PRIMARY KEY ("id"));
+CREATE TABLE "source_files" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "submission_id" uuid NOT NULL, "path" character varying NOT NULL, "size" bigint NOT NULL, "blob_source_files" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "source_files_blobs_source_files" FOREIGN KEY ("blob_source_files") REFERENCES "blobs" ("id") ON DELETE NO ACTION);
+CREATE UNIQUE INDEX "sourcefile_submission_id_path" ON "source_files" ("submission_id", "path");
+CREATE TABLE "notifications" ("id" uuid NOT NULL, "type" character varying NOT NULL, "title" character varying NOT NULL, "message" text NOT NULL, "submission_ids" jsonb NULL, "read_at" timestamp(0)with time zone NULL, "created_at" timestamp(0)with time zone NOT NULL, "user_notifications" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "notifications_users_notifications" FOREIGN KEY ("user_notifications") REFERENCES "users" ("id") ON DELETE CASCADE);
+CREATE TABLE "notification_preferences" ("id" uuid NOT NULL, "retention_warning_email" boolean NOT NULL DEFAULT true, "retention_warning_in_app" boolean NOT NULL DEFAULT true, "updated_at" timestamp(0)with time zone NOT NULL, "user_notification_preference" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "notification_preferences_users_notification_preference" FOREIGN KEY ("user_notification_preference") REFERENCES "users" ("id") ON DELETE CASCADE);
+CREATE UNIQUE INDEX "notification_preferences_user_notification_preference_key" ON "notification_preferences" ("user_notification_preference");
+CREATE TABLE "resumable_uploads" ("id" uuid NOT NULL, "source_language" character varying NOT NULL, "target_language" character varying NOT NULL, "length" bigint NOT NULL, "offset" bigint NOT NULL DEFAULT 0, "chunks" jsonb NULL, "metadata" character varying NULL, "bypass_cache" boolean NOT NULL DEFAULT false, "expires_at" timestamp(0)with time zone NOT NULL, "created_at" timestamp(0)with time zone NOT NULL, "user_resumable_uploads" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "resumable_uploads_users_resumable_uploads" FOREIGN KEY ("user_resumable_uploads") REFERENCES "users" ("id") ON DELETE CASCADE);
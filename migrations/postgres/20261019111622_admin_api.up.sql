@@ -0,0 +1,6 @@
+ALTER TABLE "subscriptions" ADD COLUMN "manual" boolean NOT NULL DEFAULT false;
+ALTER TABLE "tokens" ADD COLUMN "impersonator_id" uuid NULL, ADD COLUMN "read_only" boolean NOT NULL DEFAULT false, ADD COLUMN "expires_at" timestamp(0)with time zone NULL;
+ALTER TABLE "users" ADD COLUMN "role" character varying NOT NULL DEFAULT 'user';
+CREATE TABLE "admin_actions" ("id" uuid NOT NULL, "admin_id" uuid NOT NULL, "admin_email" character varying NOT NULL, "action" character varying NOT NULL, "target_user_id" uuid NULL, "target_submission_id" uuid NULL, "metadata" jsonb NULL, "created_at" timestamp(0)with time zone NOT NULL, PRIMARY KEY ("id"));
+CREATE INDEX "adminaction_created_at" ON "admin_actions" ("created_at");
+CREATE INDEX "adminaction_target_user_id_created_at" ON "admin_actions" ("target_user_id", "created_at");
@@ -0,0 +1,2 @@
+CREATE TABLE "audit_events" ("id" uuid NOT NULL, "user_id" uuid NOT NULL, "type" character varying NOT NULL, "actor_type" character varying NOT NULL, "actor_id" uuid NULL, "ip" character varying NULL, "user_agent" character varying NULL, "metadata" jsonb NULL, "created_at" timestamp(0)with time zone NOT NULL, PRIMARY KEY ("id"));
+CREATE INDEX "auditevent_user_id_created_at" ON "audit_events" ("user_id", "created_at");
@@ -0,0 +1,4 @@
+CREATE TABLE `submissions`(`id` uuid NOT NULL, `source_language` varchar(255) NOT NULL, `target_language` varchar(255) NOT NULL, `is_inline` bool NOT NULL DEFAULT false, `is_public` bool NOT NULL DEFAULT false, `status` varchar(255) NOT NULL DEFAULT 'pending', `reason` varchar(255) NULL, `git_repo` varchar(255) NULL, `created_at` datetime NOT NULL, `share_id` varchar(255) UNIQUE NULL, `submission_source_size_bytes` integer NOT NULL DEFAULT 0, `submission_target_size_bytes` integer NOT NULL DEFAULT 0, `processing_started_at` datetime NULL, `processing_finished_at` datetime NULL, `user_submissions` uuid NOT NULL, PRIMARY KEY(`id`), FOREIGN KEY(`user_submissions`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `subscriptions`(`id` uuid NOT NULL, `stripe_customer_id` varchar(255) NULL, `stripe_subscription_id` varchar(255) NULL, `tier` varchar(255) NOT NULL DEFAULT 'free', `expires_at` datetime NULL, `cancelled` bool NOT NULL DEFAULT false, `created_at` datetime NOT NULL, `user_subscription` uuid UNIQUE NOT NULL, PRIMARY KEY(`id`), FOREIGN KEY(`user_subscription`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `tokens`(`id` uuid NOT NULL, `is_active` bool NOT NULL DEFAULT true, `created_at` datetime NOT NULL, `user_tokens` uuid NOT NULL, PRIMARY KEY(`id`), FOREIGN KEY(`user_tokens`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `users`(`id` uuid NOT NULL, `email` varchar(255) UNIQUE NOT NULL, `password` varchar(255) NULL, `github_user_id` integer NULL, `github_access_token` varchar(255) NULL, `gitlab_user_id` integer NULL, `gitlab_access_token` varchar(255) NULL, `gitlab_refresh_token` varchar(255) NULL, `gitlab_access_token_expires_at` datetime NULL, `created_at` datetime NOT NULL, PRIMARY KEY(`id`));
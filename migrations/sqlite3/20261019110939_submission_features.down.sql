@@ -0,0 +1,27 @@
+PRAGMA foreign_keys = off;
+DROP TABLE `upload_sessions`;
+DROP INDEX `sourcefile_submission_id_path`;
+DROP TABLE `source_files`;
+DROP TABLE `resumable_uploads`;
+DROP INDEX `pendingdeletion_status_verify_after`;
+DROP TABLE `pending_deletions`;
+DROP INDEX `user_notification_preference`;
+DROP TABLE `notification_preferences`;
+DROP TABLE `notifications`;
+DROP TABLE `leases`;
+DROP TABLE `diagnostics`;
+DROP INDEX `active_user_id`;
+DROP TABLE `data_keys`;
+DROP TABLE `blobs`;
+DROP INDEX `email`;
+CREATE TABLE `new_users`(`id` uuid NOT NULL, `email` varchar(255) UNIQUE NOT NULL, `password` varchar(255) NULL, `github_user_id` integer NULL, `github_access_token` varchar(255) NULL, `gitlab_user_id` integer NULL, `gitlab_access_token` varchar(255) NULL, `gitlab_refresh_token` varchar(255) NULL, `gitlab_access_token_expires_at` datetime NULL, `created_at` datetime NOT NULL, PRIMARY KEY(`id`));
+INSERT INTO `new_users` (`id`, `email`, `password`, `github_user_id`, `github_access_token`, `gitlab_user_id`, `gitlab_access_token`, `gitlab_refresh_token`, `gitlab_access_token_expires_at`, `created_at`) SELECT `id`, `email`, `password`, `github_user_id`, `github_access_token`, `gitlab_user_id`, `gitlab_access_token`, `gitlab_refresh_token`, `gitlab_access_token_expires_at`, `created_at` FROM `users`;
+DROP TABLE `users`;
+ALTER TABLE `new_users` RENAME TO `users`;
+DROP INDEX `submission_input_hash`;
+DROP INDEX `share_id`;
+CREATE TABLE `new_submissions`(`id` uuid NOT NULL, `source_language` varchar(255) NOT NULL, `target_language` varchar(255) NOT NULL, `is_inline` bool NOT NULL DEFAULT false, `is_public` bool NOT NULL DEFAULT false, `status` varchar(255) NOT NULL DEFAULT 'pending', `reason` varchar(255) NULL, `git_repo` varchar(255) NULL, `created_at` datetime NOT NULL, `share_id` varchar(255) UNIQUE NULL, `submission_source_size_bytes` integer NOT NULL DEFAULT 0, `submission_target_size_bytes` integer NOT NULL DEFAULT 0, `processing_started_at` datetime NULL, `processing_finished_at` datetime NULL, `user_submissions` uuid NOT NULL, PRIMARY KEY(`id`), FOREIGN KEY(`user_submissions`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+INSERT INTO `new_submissions` (`id`, `source_language`, `target_language`, `is_inline`, `is_public`, `status`, `reason`, `git_repo`, `created_at`, `share_id`, `submission_source_size_bytes`, `submission_target_size_bytes`, `processing_started_at`, `processing_finished_at`, `user_submissions`) SELECT `id`, `source_language`, `target_language`, `is_inline`, `is_public`, `status`, `reason`, `git_repo`, `created_at`, `share_id`, `submission_source_size_bytes`, `submission_target_size_bytes`, `processing_started_at`, `processing_finished_at`, `user_submissions` FROM `submissions`;
+DROP TABLE `submissions`;
+ALTER TABLE `new_submissions` RENAME TO `submissions`;
+PRAGMA foreign_keys = on;
@@ -0,0 +1,27 @@
+PRAGMA foreign_keys = off;
+CREATE TABLE `new_submissions` (`id` uuid NOT NULL, `source_language` text NOT NULL, `target_language` text NOT NULL, `is_inline` bool NOT NULL DEFAULT false, `is_public` bool NOT NULL DEFAULT false, `status` text NOT NULL DEFAULT 'pending', `reason` text NULL, `git_repo` text NULL, `created_at` datetime NOT NULL, `share_id` text NULL, `submission_source_size_bytes` integer NOT NULL DEFAULT 0, `submission_target_size_bytes` integer NOT NULL DEFAULT 0, `processing_started_at` datetime NULL, `processing_finished_at` datetime NULL, `queue_lane` text NOT NULL DEFAULT 'standard', `has_mapping` bool NOT NULL DEFAULT false, `input_hash` text NULL, `transpiler_version` text NULL, `cache_hit` bool NOT NULL DEFAULT false, `results_encrypted` bool NOT NULL DEFAULT false, `expires_at` datetime NULL, `retention_warned_at` datetime NULL, `submission_reruns` uuid NULL, `user_submissions` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `submissions_submissions_reruns` FOREIGN KEY (`submission_reruns`) REFERENCES `submissions` (`id`) ON DELETE SET NULL, CONSTRAINT `submissions_users_submissions` FOREIGN KEY (`user_submissions`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+INSERT INTO `new_submissions` (`id`, `source_language`, `target_language`, `is_inline`, `is_public`, `status`, `reason`, `git_repo`, `created_at`, `share_id`, `submission_source_size_bytes`, `submission_target_size_bytes`, `processing_started_at`, `processing_finished_at`, `user_submissions`) SELECT `id`, `source_language`, `target_language`, `is_inline`, `is_public`, `status`, `reason`, `git_repo`, `created_at`, `share_id`, `submission_source_size_bytes`, `submission_target_size_bytes`, `processing_started_at`, `processing_finished_at`, `user_submissions` FROM `submissions`;
+DROP TABLE `submissions`;
+ALTER TABLE `new_submissions` RENAME TO `submissions`;
+CREATE UNIQUE INDEX `share_id` ON `submissions` (`share_id`);
+CREATE INDEX `submission_input_hash` ON `submissions` (`input_hash`);
+CREATE TABLE `new_users` (`id` uuid NOT NULL, `email` text NOT NULL, `password` text NULL, `github_user_id` integer NULL, `github_access_token` text NULL, `gitlab_user_id` integer NULL, `gitlab_access_token` text NULL, `gitlab_refresh_token` text NULL, `gitlab_access_token_expires_at` datetime NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+INSERT INTO `new_users` (`id`, `email`, `password`, `github_user_id`, `github_access_token`, `gitlab_user_id`, `gitlab_access_token`, `gitlab_refresh_token`, `gitlab_access_token_expires_at`, `created_at`) SELECT `id`, `email`, `password`, `github_user_id`, `github_access_token`, `gitlab_user_id`, `gitlab_access_token`, `gitlab_refresh_token`, `gitlab_access_token_expires_at`, `created_at` FROM `users`;
+DROP TABLE `users`;
+ALTER TABLE `new_users` RENAME TO `users`;
+CREATE UNIQUE INDEX `email` ON `users` (`email`);
+CREATE TABLE `blobs` (`id` text NOT NULL, `size` integer NOT NULL, `encrypted` bool NOT NULL DEFAULT false, `reference_count` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+CREATE TABLE `data_keys` (`id` uuid NOT NULL, `encrypted_key` blob NOT NULL, `master_key_id` text NOT NULL, `active_user_id` uuid NULL, `created_at` datetime NOT NULL, `rotated_at` datetime NULL, `user_data_keys` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `data_keys_users_data_keys` FOREIGN KEY (`user_data_keys`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+CREATE UNIQUE INDEX `active_user_id` ON `data_keys` (`active_user_id`);
+CREATE TABLE `diagnostics` (`id` uuid NOT NULL, `severity` text NOT NULL, `file` text NULL, `line` integer NULL, `column` integer NULL, `code` text NULL, `message` text NOT NULL, `created_at` datetime NOT NULL, `submission_diagnostics` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `diagnostics_submissions_diagnostics` FOREIGN KEY (`submission_diagnostics`) REFERENCES `submissions` (`id`) ON DELETE CASCADE);
+CREATE TABLE `leases` (`id` text NOT NULL, `holder` text NOT NULL, `expires_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+CREATE TABLE `notifications` (`id` uuid NOT NULL, `type` text NOT NULL, `title` text NOT NULL, `message` text NOT NULL, `submission_ids` json NULL, `read_at` datetime NULL, `created_at` datetime NOT NULL, `user_notifications` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notifications_users_notifications` FOREIGN KEY (`user_notifications`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+CREATE TABLE `notification_preferences` (`id` uuid NOT NULL, `retention_warning_email` bool NOT NULL DEFAULT true, `retention_warning_in_app` bool NOT NULL DEFAULT true, `updated_at` datetime NOT NULL, `user_notification_preference` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notification_preferences_users_notification_preference` FOREIGN KEY (`user_notification_preference`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+CREATE UNIQUE INDEX `user_notification_preference` ON `notification_preferences` (`user_notification_preference`);
+CREATE TABLE `pending_deletions` (`id` uuid NOT NULL, `prefix` text NOT NULL, `submission_id` uuid NULL, `status` text NOT NULL DEFAULT 'pending', `attempts` integer NOT NULL DEFAULT 0, `last_error` text NULL, `created_at` datetime NOT NULL, `scheduled_at` datetime NULL, `verify_after` datetime NULL, PRIMARY KEY (`id`));
+CREATE INDEX `pendingdeletion_status_verify_after` ON `pending_deletions` (`status`, `verify_after`);
+CREATE TABLE `resumable_uploads` (`id` uuid NOT NULL, `source_language` text NOT NULL, `target_language` text NOT NULL, `length` integer NOT NULL, `offset` integer NOT NULL DEFAULT 0, `chunks` json NULL, `metadata` text NULL, `bypass_cache` bool NOT NULL DEFAULT false, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `user_resumable_uploads` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `resumable_uploads_users_resumable_uploads` FOREIGN KEY (`user_resumable_uploads`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+CREATE TABLE `source_files` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `submission_id` uuid NOT NULL, `path` text NOT NULL, `size` integer NOT NULL, `blob_source_files` text NOT NULL, CONSTRAINT `source_files_blobs_source_files` FOREIGN KEY (`blob_source_files`) REFERENCES `blobs` (`id`) ON DELETE NO ACTION);
+CREATE UNIQUE INDEX `sourcefile_submission_id_path` ON `source_files` (`submission_id`, `path`);
+CREATE TABLE `upload_sessions` (`id` uuid NOT NULL, `source_language` text NOT NULL, `target_language` text NOT NULL, `files` json NOT NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `user_upload_sessions` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `upload_sessions_users_upload_sessions` FOREIGN KEY (`user_upload_sessions`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+PRAGMA foreign_keys = on;
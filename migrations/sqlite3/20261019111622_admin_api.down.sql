@@ -0,0 +1,8 @@
+DROP INDEX `adminaction_target_user_id_created_at`;
+DROP INDEX `adminaction_created_at`;
+DROP TABLE `admin_actions`;
+ALTER TABLE `tokens` DROP COLUMN `expires_at`;
+ALTER TABLE `tokens` DROP COLUMN `read_only`;
+ALTER TABLE `tokens` DROP COLUMN `impersonator_id`;
+ALTER TABLE `subscriptions` DROP COLUMN `manual`;
+ALTER TABLE `users` DROP COLUMN `role`;
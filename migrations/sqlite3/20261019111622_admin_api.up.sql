@@ -0,0 +1,15 @@
+PRAGMA foreign_keys = off;
+CREATE TABLE `new_subscriptions` (`id` uuid NOT NULL, `stripe_customer_id` text NULL, `stripe_subscription_id` text NULL, `tier` text NOT NULL DEFAULT 'free', `expires_at` datetime NULL, `cancelled` bool NOT NULL DEFAULT false, `manual` bool NOT NULL DEFAULT false, `created_at` datetime NOT NULL, `user_subscription` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `subscriptions_users_subscription` FOREIGN KEY (`user_subscription`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+INSERT INTO `new_subscriptions` (`id`, `stripe_customer_id`, `stripe_subscription_id`, `tier`, `expires_at`, `cancelled`, `created_at`, `user_subscription`) SELECT `id`, `stripe_customer_id`, `stripe_subscription_id`, `tier`, `expires_at`, `cancelled`, `created_at`, `user_subscription` FROM `subscriptions`;
+DROP TABLE `subscriptions`;
+ALTER TABLE `new_subscriptions` RENAME TO `subscriptions`;
+CREATE UNIQUE INDEX `user_subscription` ON `subscriptions` (`user_subscription`);
+CREATE TABLE `new_tokens` (`id` uuid NOT NULL, `is_active` bool NOT NULL DEFAULT true, `created_at` datetime NOT NULL, `impersonator_id` uuid NULL, `read_only` bool NOT NULL DEFAULT false, `expires_at` datetime NULL, `user_tokens` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `tokens_users_tokens` FOREIGN KEY (`user_tokens`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+INSERT INTO `new_tokens` (`id`, `is_active`, `created_at`, `user_tokens`) SELECT `id`, `is_active`, `created_at`, `user_tokens` FROM `tokens`;
+DROP TABLE `tokens`;
+ALTER TABLE `new_tokens` RENAME TO `tokens`;
+ALTER TABLE `users` ADD COLUMN `role` text NOT NULL DEFAULT 'user';
+CREATE TABLE `admin_actions` (`id` uuid NOT NULL, `admin_id` uuid NOT NULL, `admin_email` text NOT NULL, `action` text NOT NULL, `target_user_id` uuid NULL, `target_submission_id` uuid NULL, `metadata` json NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+CREATE INDEX `adminaction_created_at` ON `admin_actions` (`created_at`);
+CREATE INDEX `adminaction_target_user_id_created_at` ON `admin_actions` (`target_user_id`, `created_at`);
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+DROP INDEX `auditevent_user_id_created_at`;
+DROP TABLE `audit_events`;
@@ -0,0 +1,2 @@
+CREATE TABLE `audit_events` (`id` uuid NOT NULL, `user_id` uuid NOT NULL, `type` text NOT NULL, `actor_type` text NOT NULL, `actor_id` uuid NULL, `ip` text NULL, `user_agent` text NULL, `metadata` json NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+CREATE INDEX `auditevent_user_id_created_at` ON `audit_events` (`user_id`, `created_at`);
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/env"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// Encrypt the data keys still encrypted by the previous master key with the
+// current one, once after replacing the master key. Run on its own rather than
+// on every start so that the replicas don't rewrap the same keys concurrently
+func runRewrapKeys(ctx context.Context, config *env.Env) {
+	if config.EncryptionMasterKey == "" || config.EncryptionPreviousMasterKey == "" {
+		logrus.Fatalln("ENCRYPTION_MASTER_KEY and ENCRYPTION_PREVIOUS_MASTER_KEY are required to rewrap the data keys")
+	}
+
+	databaseService, err := services.NewDatabaseService(config.DatabaseDriver, config.DatabaseEndpoint)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize database service")
+	}
+	defer databaseService.Close()
+
+	migrationService, err := services.NewMigrationService(databaseService, config.DatabaseDriver)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize migration service")
+	}
+
+	if err := migrationService.CheckSchema(ctx); err != nil {
+		logrus.WithError(err).Fatalln("The database schema is not up to date, run the migrate up command")
+	}
+
+	encryptionService, err := services.NewEncryptionService(databaseService, config.EncryptionMasterKey, config.EncryptionPreviousMasterKey)
+	if err != nil {
+		logrus.WithError(err).Fatalln("Failed to initialize encryption service")
+	}
+
+	rewrapped, err := encryptionService.RewrapDataKeys()
+	if err != nil {
+		logrus.WithError(err).WithField("count", rewrapped).Fatalln("Failed to rewrap data keys with the new master key")
+	}
+
+	logrus.WithField("count", rewrapped).Info("Rewrapped data keys with the new master key")
+}
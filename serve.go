@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo-contrib/prometheus"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/sirupsen/logrus"
+	echoSwagger "github.com/swaggo/echo-swagger"
+	"github.com/tereus-project/tereus-api/handlers"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// Build the HTTP API
+func newServer(app *application) *echo.Echo {
+	// Echo instance
+	e := echo.New()
+	e.HideBanner = true
+
+	// Middleware
+	e.Use(middleware.Logger())
+	e.Use(middleware.Recover())
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		ExposeHeaders: handlers.TusExposedHeaders,
+	}))
+
+	e.Validator = &services.CustomValidator{Validator: validator.New()}
+
+	transpilationHandler, err := handlers.NewTranspilationHandler(app.storageService, app.databaseService, app.tokenService, app.submissionService, app.subscriptionService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	authHandler, err := handlers.NewAuthHandler(app.databaseService, app.githubService, app.gitlabService, app.tokenService, app.auditService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	userHandler, err := handlers.NewUserHandler(app.databaseService, app.tokenService, app.subscriptionService, app.storageService, app.encryptionService, app.retentionService, app.auditService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	submissionHandler, err := handlers.NewSubmissionsHandler(app.databaseService, app.tokenService, app.storageService, app.submissionService, app.retentionService, app.auditService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	subscriptionHandler, err := handlers.NewSubscriptionHandler(app.databaseService, app.tokenService, app.subscriptionService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	notificationHandler, err := handlers.NewNotificationHandler(app.tokenService, app.notificationService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	adminHandler, err := handlers.NewAdminHandler(app.databaseService, app.tokenService, app.adminService, app.submissionService, app.subscriptionService, app.reconciliationService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	healthHandler, err := handlers.NewHealthHandler(app.healthService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	stripeWebhooksHandler, err := handlers.NewStripeWebhooksHandler(app.databaseService, app.subscriptionService, app.config.StripeWebhookSecret)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	e.GET("/healthz", healthHandler.GetHealth)
+	e.GET("/readyz", healthHandler.GetReadiness)
+	e.GET("/swagger/*", echoSwagger.WrapHandler)
+
+	e.POST("/submissions/inline/:src/to/:target", transpilationHandler.TranspileInline)
+	e.POST("/submissions/zip/:src/to/:target", transpilationHandler.TranspileZip)
+	e.POST("/submissions/git/:src/to/:target", transpilationHandler.TranspileGit)
+	e.POST("/submissions/upload/:src/to/:target", transpilationHandler.CreateUploadSession)
+	e.POST("/submissions/upload/:id/finalize", transpilationHandler.FinalizeUploadSession)
+	e.OPTIONS("/submissions/tus", transpilationHandler.GetTusOptions)
+	e.POST("/submissions/tus/:src/to/:target", transpilationHandler.CreateTusUpload)
+	e.HEAD("/submissions/tus/:id", transpilationHandler.GetTusUploadOffset)
+	e.PATCH("/submissions/tus/:id", transpilationHandler.PatchTusUpload)
+	e.DELETE("/submissions/tus/:id", transpilationHandler.DeleteTusUpload)
+
+	e.GET("/submissions/:id", submissionHandler.GetSubmission)
+	e.DELETE("/submissions/:id", submissionHandler.DeleteSubmission)
+	e.PATCH("/submissions/:id/visibility", submissionHandler.UpdateSubmissionVisibility)
+	e.PATCH("/submissions/:id/expiration", submissionHandler.UpdateSubmissionExpiration)
+	e.POST("/submissions/:id/cancel", submissionHandler.CancelSubmission)
+	e.POST("/submissions/:id/rerun", transpilationHandler.RerunSubmission)
+
+	e.GET("/submissions/:id/download", transpilationHandler.DownloadTranspiledFiles)
+	e.GET("/submissions/:id/files/:type/*", submissionHandler.GetSubmissionFile)
+	e.GET("/submissions/:id/diff", submissionHandler.GetSubmissionDiff)
+	e.GET("/submissions/:id/mapping", submissionHandler.GetSubmissionMapping)
+	e.GET("/submissions/:id/inline/source", transpilationHandler.DownloadInlineTranspilationSource)
+	e.GET("/submissions/:id/inline/output", transpilationHandler.DownloadInlineTranspiledOutput)
+
+	e.POST("/auth/login/github", authHandler.LoginGithub)
+	e.POST("/auth/revoke/github", authHandler.RevokeGithub)
+	e.POST("/auth/login/gitlab", authHandler.LoginGitlab)
+	e.POST("/auth/revoke/gitlab", authHandler.RevokeGitlab)
+	e.POST("/auth/check", authHandler.Check)
+
+	e.GET("/users/me", userHandler.GetCurrentUser)
+	e.DELETE("/users/me", userHandler.DeleteCurrentUser)
+	e.GET("/users/me/linked-accounts", userHandler.GetCurrentUserLinkedAccounts)
+	e.GET("/users/me/submissions", userHandler.GetSubmissionsHistory)
+	e.GET("/users/me/export", userHandler.GetExport)
+	e.GET("/users/me/audit-log", userHandler.GetAuditLog)
+	e.POST("/users/me/encryption-key/rotate", userHandler.RotateEncryptionKey)
+	e.GET("/users/me/notifications", notificationHandler.GetNotifications)
+	e.POST("/users/me/notifications/:id/read", notificationHandler.MarkNotificationRead)
+	e.GET("/users/me/notification-preferences", notificationHandler.GetPreferences)
+	e.PATCH("/users/me/notification-preferences", notificationHandler.UpdatePreferences)
+
+	e.POST("/subscription/checkout", subscriptionHandler.CreateCheckoutSession)
+	e.POST("/subscription/portal", subscriptionHandler.CreatePortalSession)
+
+	admin := e.Group("/admin")
+	admin.GET("/users", adminHandler.SearchUsers)
+	admin.GET("/users/:id", adminHandler.GetUser)
+	admin.GET("/users/:id/submissions", adminHandler.GetUserSubmissions)
+	admin.POST("/users/:id/tokens/revoke", adminHandler.RevokeUserTokens)
+	admin.POST("/users/:id/impersonate", adminHandler.ImpersonateUser)
+	admin.PUT("/users/:id/tier", adminHandler.SetUserTier)
+	admin.POST("/submissions/:id/retry", adminHandler.RetrySubmission)
+	admin.POST("/submissions/:id/fail", adminHandler.FailSubmission)
+	admin.POST("/submissions/:id/clean", adminHandler.CleanSubmission)
+	admin.GET("/audit-log", adminHandler.GetAuditLog)
+	admin.POST("/reconciliation", adminHandler.ReconcileStorage)
+
+	e.POST("/stripe-webhooks", stripeWebhooksHandler.HandleWebhooks)
+
+	p := prometheus.NewPrometheus("echo", nil)
+	p.Use(e)
+
+	return e
+}
+
+// Build the HTTP server of the processes only running workers
+func newWorkerServer(app *application) *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+
+	healthHandler, err := handlers.NewHealthHandler(app.healthService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	e.GET("/healthz", healthHandler.GetHealth)
+	e.GET("/readyz", healthHandler.GetReadiness)
+
+	return e
+}
+
+// Serve HTTP on address until ctx is done. The process then reports itself
+// as not ready for drainDelay so that the load balancer stops sending it
+// requests, and waits for the requests in progress for up to shutdownTimeout.
+// Return the shutdown deadline
+func runServer(ctx context.Context, e *echo.Echo, address string, healthService *services.HealthService, drainDelay time.Duration, shutdownTimeout time.Duration) time.Time {
+	go func() {
+		err := e.Start(address)
+		if err != nil && err != http.ErrServerClosed {
+			logrus.WithError(err).Fatalln("Failed to start HTTP server")
+		}
+	}()
+
+	<-ctx.Done()
+
+	// The workers stopping at the same time are given the same deadline
+	deadline := time.Now().Add(drainDelay + shutdownTimeout)
+
+	logrus.WithField("drain_delay", drainDelay.String()).Info("Draining HTTP server")
+	healthService.StartDraining()
+	time.Sleep(drainDelay)
+
+	logrus.Info("Shutting down HTTP server")
+
+	shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	err := e.Shutdown(shutdownCtx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to shut down HTTP server gracefully")
+	}
+
+	return deadline
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/adminaction"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// What an admin action applies to, either field may be nil
+type AdminActionTarget struct {
+	UserID       *uuid.UUID
+	SubmissionID *uuid.UUID
+}
+
+type AdminService struct {
+	databaseService *DatabaseService
+}
+
+func NewAdminService(databaseService *DatabaseService) *AdminService {
+	return &AdminService{
+		databaseService: databaseService,
+	}
+}
+
+// RecordAction adds an action of an admin to the audit log. The actions are
+// recorded before being performed so that none is performed unrecorded
+func (s *AdminService) RecordAction(admin *ent.User, action string, target AdminActionTarget, metadata map[string]interface{}) error {
+	return s.databaseService.AdminAction.Create().
+		SetAdminID(admin.ID).
+		SetAdminEmail(admin.Email).
+		SetAction(action).
+		SetNillableTargetUserID(target.UserID).
+		SetNillableTargetSubmissionID(target.SubmissionID).
+		SetMetadata(metadata).
+		Exec(context.Background())
+}
+
+// GetActions lists the recorded admin actions from the most recent one, only
+// the ones targeting a user if userID is set, and returns their total number
+func (s *AdminService) GetActions(userID *uuid.UUID, offset int, limit int) ([]*ent.AdminAction, int, error) {
+	query := s.databaseService.AdminAction.Query()
+
+	if userID != nil {
+		query = query.Where(adminaction.TargetUserID(*userID))
+	}
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	actions, err := query.
+		Order(ent.Desc(adminaction.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return actions, total, nil
+}
+
+// SearchUsers finds the users by ID or by part of their email address and
+// returns their total number
+func (s *AdminService) SearchUsers(search string, offset int, limit int) ([]*ent.User, int, error) {
+	query := s.databaseService.User.Query()
+
+	if search != "" {
+		if id, err := uuid.Parse(search); err == nil {
+			query = query.Where(user.ID(id))
+		} else {
+			query = query.Where(user.EmailContainsFold(search))
+		}
+	}
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := query.
+		Order(ent.Asc(user.FieldEmail)).
+		Offset(offset).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// GetUserSubmissions lists the submissions of a user from the most recent one
+// and returns their total number
+func (s *AdminService) GetUserSubmissions(userID uuid.UUID, offset int, limit int) ([]*ent.Submission, int, error) {
+	query := s.databaseService.Submission.Query().
+		Where(submission.HasUserWith(user.ID(userID)))
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	submissions, err := query.
+		Order(ent.Desc(submission.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return submissions, total, nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+)
+
+// Who caused an audit event, and from where for the HTTP requests
+type AuditActor struct {
+	Type      auditevent.ActorType
+	ID        *uuid.UUID
+	IP        string
+	UserAgent string
+}
+
+// NewUserAuditActor is the user authenticated by the request
+func NewUserAuditActor(c echo.Context, actor *ent.User) AuditActor {
+	return AuditActor{
+		Type:      auditevent.ActorTypeUser,
+		ID:        &actor.ID,
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
+// NewAdminAuditActor is an admin acting on the account of another user
+func NewAdminAuditActor(c echo.Context, admin *ent.User) AuditActor {
+	return AuditActor{
+		Type:      auditevent.ActorTypeAdmin,
+		ID:        &admin.ID,
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
+// StripeAuditActor is a Stripe webhook, the changes made by the users on the
+// Stripe pages reach the API this way
+var StripeAuditActor = AuditActor{Type: auditevent.ActorTypeStripe}
+
+type AuditService struct {
+	databaseService *DatabaseService
+}
+
+func NewAuditService(databaseService *DatabaseService) *AuditService {
+	return &AuditService{
+		databaseService: databaseService,
+	}
+}
+
+// Record adds an event to the audit log of a user. It is called once the
+// change is made, a failure is logged rather than returned since the change
+// can't be undone anymore
+func (s *AuditService) Record(userID uuid.UUID, eventType auditevent.Type, actor AuditActor, metadata map[string]interface{}) {
+	err := s.databaseService.AuditEvent.Create().
+		SetUserID(userID).
+		SetType(eventType).
+		SetActorType(actor.Type).
+		SetNillableActorID(actor.ID).
+		SetIP(actor.IP).
+		SetUserAgent(actor.UserAgent).
+		SetMetadata(metadata).
+		Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).WithField("type", eventType).Error("Failed to record audit event")
+	}
+}
+
+// GetUserEvents lists the audit events of a user from the most recent one and
+// returns their total number
+func (s *AuditService) GetUserEvents(userID uuid.UUID, offset int, limit int) ([]*ent.AuditEvent, int, error) {
+	query := s.databaseService.AuditEvent.Query().
+		Where(auditevent.UserID(userID))
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events, err := query.
+		Order(ent.Desc(auditevent.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
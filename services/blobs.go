@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/blob"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+)
+
+// The sources of a submission are stored once per content as blobs keyed by
+// their SHA-256 and listed in the manifest of the submission (its SourceFile
+// rows). The transpilers still read them from transpilations/<id>/, this
+// working copy is removed once the submission is processed.
+// When the encryption is enabled the blobs are encrypted by the data key of
+// their owner and keyed by an HMAC of their SHA-256 with that key, so that
+// the users only share blobs with themselves and the blob IDs don't reveal
+// the content.
+
+// Minimum time a blob stays unreferenced before being collected so that
+// uploads in progress can still reference it
+const blobCollectionGracePeriod = time.Hour
+
+func getBlobID(key *DataKey, hash []byte) string {
+	if key == nil {
+		return hex.EncodeToString(hash)
+	}
+
+	mac := hmac.New(sha256.New, key.Key)
+	mac.Write(hash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func getBlobPath(hash string) string {
+	return fmt.Sprintf("blobs/%s/%s", hash[:2], hash)
+}
+
+// Add a source file of a submission whose working copy is already stored
+// to its manifest and make sure its content is stored as a blob, encrypted by
+// key unless it is nil
+func (s *StorageService) addSubmissionSource(submissionId string, path string, hash string, size int64, key *DataKey) error {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return err
+	}
+
+	// Files ingested by a previous attempt are kept as they are
+	ingestedHash, err := s.databaseService.SourceFile.Query().
+		Where(sourcefile.SubmissionID(id), sourcefile.Path(path)).
+		QueryBlob().
+		OnlyID(context.Background())
+	if err == nil {
+		if ingestedHash != hash {
+			return fmt.Errorf("source file %s of submission %s was already stored with another content", path, submissionId)
+		}
+		return nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		return err
+	}
+
+	err = tx.Blob.Create().
+		SetID(hash).
+		SetSize(size).
+		SetEncrypted(key != nil).
+		SetReferenceCount(1).
+		OnConflict().
+		Update(func(u *ent.BlobUpsert) {
+			u.AddReferenceCount(1)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.SourceFile.Create().
+		SetSubmissionID(id).
+		SetPath(path).
+		SetSize(size).
+		SetBlobID(hash).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	// The reference is committed first so that the blob can't be collected
+	// between the check and the copy
+	blobPath := getBlobPath(hash)
+
+	_, err = s.backend.StatObject(blobPath)
+	if err == nil {
+		return nil
+	}
+
+	if err != ErrObjectNotFound {
+		return err
+	}
+
+	workingCopyPath := getSubmissionObjectPath(submissionId, path)
+
+	if key == nil {
+		return s.backend.CopyObject(workingCopyPath, blobPath)
+	}
+
+	workingCopy, info, err := s.backend.GetObject(workingCopyPath)
+	if err != nil {
+		return err
+	}
+	defer workingCopy.Close()
+
+	return s.putObject(blobPath, workingCopy, info.Size, key)
+}
+
+// IngestSubmissionObject adds a source file uploaded directly to the object
+// storage to the manifest of its submission owned by a user
+func (s *StorageService) IngestSubmissionObject(userID uuid.UUID, submissionId string, path string) error {
+	key, err := s.encryptionService.GetUserDataKey(userID)
+	if err != nil {
+		return err
+	}
+
+	object, _, err := s.backend.GetObject(getSubmissionObjectPath(submissionId, path))
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, object)
+	if err != nil {
+		return err
+	}
+
+	return s.addSubmissionSource(submissionId, path, getBlobID(key, hasher.Sum(nil)), size, key)
+}
+
+// Get the manifest of a submission ordered by path.
+// Submissions created before the blob storage have an empty manifest and
+// their sources are only stored in transpilations/<id>/
+func (s *StorageService) getSubmissionManifest(submissionId string) ([]*ent.SourceFile, error) {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.databaseService.SourceFile.Query().
+		Where(sourcefile.SubmissionID(id)).
+		Order(ent.Asc(sourcefile.FieldPath)).
+		WithBlob().
+		All(context.Background())
+}
+
+// Get the object path of a source file, either its blob or its working copy
+func (s *StorageService) getSubmissionSourcePath(submissionId string, path string) (string, error) {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := s.databaseService.SourceFile.Query().
+		Where(sourcefile.SubmissionID(id), sourcefile.Path(path)).
+		QueryBlob().
+		OnlyID(context.Background())
+	if err == nil {
+		return getBlobPath(hash), nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+
+	return getSubmissionObjectPath(submissionId, path), nil
+}
+
+// ReleaseSubmissionWorkingCopy removes the copy of the sources read by the
+// transpilers once a submission is processed. Sources without a manifest are
+// kept as they are their only copy
+func (s *StorageService) ReleaseSubmissionWorkingCopy(submissionId string) error {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return err
+	}
+
+	hasManifest, err := s.databaseService.SourceFile.Query().
+		Where(sourcefile.SubmissionID(id)).
+		Exist(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if !hasManifest {
+		return nil
+	}
+
+	return s.backend.RemoveObjects(fmt.Sprintf("transpilations/%s/", submissionId))
+}
+
+// RestoreSubmissionWorkingCopy copies the sources of a submission back from
+// its blobs to the working copy read by the transpilers. The sources without a
+// manifest were never released
+func (s *StorageService) RestoreSubmissionWorkingCopy(submissionId string) error {
+	manifest, err := s.getSubmissionManifest(submissionId)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range manifest {
+		blobPath := getBlobPath(file.Edges.Blob.ID)
+		destinationPath := getSubmissionObjectPath(submissionId, file.Path)
+
+		// The transpilers read the working copy in plaintext
+		if file.Edges.Blob.Encrypted {
+			err = s.copyDecryptedObject(blobPath, destinationPath)
+		} else {
+			err = s.backend.CopyObject(blobPath, destinationPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remove the manifest of a submission and release its references to the blobs
+func (s *StorageService) deleteSubmissionManifest(submissionId string) error {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		return err
+	}
+
+	files, err := tx.SourceFile.Query().
+		Where(sourcefile.SubmissionID(id)).
+		WithBlob().
+		All(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for _, file := range files {
+		err = tx.Blob.UpdateOneID(file.Edges.Blob.ID).
+			AddReferenceCount(-1).
+			Exec(context.Background())
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	_, err = tx.SourceFile.Delete().
+		Where(sourcefile.SubmissionID(id)).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CollectOrphanBlobs deletes the blobs no submission references anymore and
+// returns how many were deleted
+func (s *StorageService) CollectOrphanBlobs() (int, error) {
+	hashes, err := s.databaseService.Blob.Query().
+		Where(
+			blob.ReferenceCountLTE(0),
+			blob.UpdatedAtLT(time.Now().Add(-blobCollectionGracePeriod)),
+		).
+		IDs(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	collected := 0
+	for _, hash := range hashes {
+		deleted, err := s.collectBlob(hash)
+		if err != nil {
+			logrus.WithError(err).WithField("hash", hash).Error("Failed to collect blob")
+			continue
+		}
+
+		if deleted {
+			collected++
+		}
+	}
+
+	return collected, nil
+}
+
+// Delete a blob if it is still unreferenced. The row stays locked by the
+// deletion until the object is removed so that a concurrent upload of the
+// same content waits and then stores the blob again
+func (s *StorageService) collectBlob(hash string) (bool, error) {
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := tx.Blob.Delete().
+		Where(blob.ID(hash), blob.ReferenceCountLTE(0)).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if deleted == 0 {
+		return false, tx.Rollback()
+	}
+
+	err = s.backend.RemoveObject(getBlobPath(hash))
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
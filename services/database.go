@@ -2,36 +2,56 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/tereus-project/tereus-api/ent"
-	"github.com/tereus-project/tereus-api/ent/migrate"
 )
 
 type DatabaseService struct {
 	*ent.Client
+
+	driver string
+	db     *sql.DB
 }
 
 func NewDatabaseService(driver string, dataSourceName string) (*DatabaseService, error) {
-	client, err := ent.Open(driver, dataSourceName)
+	drv, err := entsql.Open(driver, dataSourceName)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DatabaseService{
-		Client: client,
+		Client: ent.NewClient(ent.Driver(drv)),
+		driver: driver,
+		db:     drv.DB(),
 	}, nil
 }
 
-func (s *DatabaseService) Close() error {
-	return s.Client.Close()
+// Ping checks that the database can be reached
+func (s *DatabaseService) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// Now returns the current time of the database, the same for every process
+// whatever the clock of its host. SQLite databases are local files so the
+// clock of the host is the one of the database
+func (s *DatabaseService) Now(ctx context.Context) (time.Time, error) {
+	if s.driver != dialect.Postgres {
+		return time.Now(), nil
+	}
+
+	var now time.Time
+	err := s.db.QueryRowContext(ctx, "SELECT now()").Scan(&now)
+	return now, err
 }
 
-func (s *DatabaseService) AutoMigrate() error {
-	return s.Client.Debug().Schema.Create(context.Background(),
-		migrate.WithDropIndex(true),
-		migrate.WithDropColumn(true),
-	)
+func (s *DatabaseService) Close() error {
+	return s.Client.Close()
 }
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+// The objects are not removed right away but queued as pending deletions.
+// The deletion worker then asks the backend to delete them later on (the S3
+// backend tags them for the bucket lifecycle) and once the lifecycle had
+// time to run, checks that they are gone, removing the leftovers itself.
+
+// Delay before retrying a pending deletion that failed
+const deletionRetryDelay = time.Hour
+
+// Prefixes of the objects of a submission, without trailing slash so that
+// the archive is included
+var submissionObjectPrefixes = []string{"transpilations/", "transpilations-results/", "transpilations-mappings/", "transpilations-archives/"}
+
+// Queue the deletion of the objects of a submission
+func (s *StorageService) queueSubmissionDeletion(submissionId string) error {
+	id, err := uuid.Parse(submissionId)
+	if err != nil {
+		return err
+	}
+
+	deletionCreations := make([]*ent.PendingDeletionCreate, len(submissionObjectPrefixes))
+	for i, prefix := range submissionObjectPrefixes {
+		deletionCreations[i] = s.databaseService.PendingDeletion.Create().
+			SetPrefix(prefix + submissionId).
+			SetSubmissionID(id)
+	}
+
+	return s.databaseService.PendingDeletion.CreateBulk(deletionCreations...).Exec(context.Background())
+}
+
+// Record the failure of a step of a pending deletion, it is retried later on
+func (s *StorageService) failPendingDeletion(deletion *ent.PendingDeletion, err error) {
+	logrus.WithError(err).WithField("prefix", deletion.Prefix).Error("Failed to process pending deletion")
+
+	err = s.databaseService.PendingDeletion.UpdateOne(deletion).
+		AddAttempts(1).
+		SetLastError(err.Error()).
+		SetVerifyAfter(time.Now().Add(deletionRetryDelay)).
+		Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("prefix", deletion.Prefix).Error("Failed to update pending deletion")
+	}
+}
+
+// SchedulePendingDeletions asks the backend to delete the objects of up to
+// limit queued deletions and returns how many were scheduled. Their removal
+// is verified after verifyDelay
+func (s *StorageService) SchedulePendingDeletions(limit int, verifyDelay time.Duration) (int, error) {
+	deletions, err := s.databaseService.PendingDeletion.Query().
+		Where(
+			pendingdeletion.StatusEQ(pendingdeletion.StatusPending),
+			pendingdeletion.Or(
+				pendingdeletion.VerifyAfterIsNil(),
+				pendingdeletion.VerifyAfterLT(time.Now()),
+			),
+		).
+		Order(ent.Asc(pendingdeletion.FieldCreatedAt)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	scheduled := 0
+	for _, deletion := range deletions {
+		err = s.backend.ScheduleForDeletion(deletion.Prefix)
+		if err != nil {
+			s.failPendingDeletion(deletion, err)
+			continue
+		}
+
+		now := time.Now()
+
+		err = s.databaseService.PendingDeletion.UpdateOne(deletion).
+			SetStatus(pendingdeletion.StatusScheduled).
+			SetScheduledAt(now).
+			SetVerifyAfter(now.Add(verifyDelay)).
+			Exec(context.Background())
+		if err != nil {
+			return scheduled, err
+		}
+
+		scheduled++
+	}
+
+	return scheduled, nil
+}
+
+// VerifyScheduledDeletions checks that the objects of up to limit scheduled
+// deletions were removed, removes the ones the backend left and returns how
+// many deletions were completed
+func (s *StorageService) VerifyScheduledDeletions(limit int) (int, error) {
+	deletions, err := s.databaseService.PendingDeletion.Query().
+		Where(
+			pendingdeletion.StatusEQ(pendingdeletion.StatusScheduled),
+			pendingdeletion.VerifyAfterLT(time.Now()),
+		).
+		Order(ent.Asc(pendingdeletion.FieldVerifyAfter)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, deletion := range deletions {
+		err = s.verifyDeletion(deletion)
+		if err != nil {
+			s.failPendingDeletion(deletion, err)
+			continue
+		}
+
+		completed++
+	}
+
+	return completed, nil
+}
+
+func (s *StorageService) verifyDeletion(deletion *ent.PendingDeletion) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leftovers := 0
+	for object := range s.backend.ListObjects(ctx, deletion.Prefix) {
+		if object.Err != nil {
+			return object.Err
+		}
+
+		leftovers++
+	}
+
+	if leftovers > 0 {
+		logrus.WithFields(logrus.Fields{
+			"prefix": deletion.Prefix,
+			"count":  leftovers,
+		}).Warn("Objects scheduled for deletion are still stored, removing them")
+
+		err := s.backend.RemoveObjects(deletion.Prefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	if deletion.SubmissionID != nil {
+		err := s.reconcileDeletedSubmission(*deletion.SubmissionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.databaseService.PendingDeletion.DeleteOne(deletion).Exec(context.Background())
+}
+
+// Make sure a submission whose objects are deleted is not listed with
+// results anymore, in case its status was not updated after the deletion was
+// queued. Cancelled submissions keep their status
+func (s *StorageService) reconcileDeletedSubmission(id uuid.UUID) error {
+	reconciled, err := s.databaseService.Submission.Update().
+		Where(
+			submission.ID(id),
+			submission.StatusNotIn(submission.StatusCleaned, submission.StatusCancelled),
+		).
+		SetStatus(submission.StatusCleaned).
+		Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if reconciled > 0 {
+		logrus.WithField("id", id).Warn("Marked submission with deleted objects as cleaned")
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// The stored submission code is encrypted with per-user data keys, which are
+// themselves stored in the database encrypted by the master key of the API.
+// Deleting the data keys of a user makes all their objects unreadable, even
+// the copies left behind in backups or not yet removed by the lifecycle rules
+
+const dataKeySize = 32
+
+var ErrDataKeyNotFound = errors.New("data key not found")
+
+type DataKey struct {
+	ID  uuid.UUID
+	Key []byte
+}
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+type EncryptionService struct {
+	databaseService *DatabaseService
+
+	// nil if the encryption is disabled
+	masterKey *masterKey
+	// Key being replaced by the master key, the data keys it encrypts are
+	// rewrapped by the rewrap-keys command
+	previousMasterKey *masterKey
+}
+
+// NewEncryptionService creates the encryption service from base64 encoded
+// 32 bytes master keys. The encryption is disabled if masterKey is empty
+func NewEncryptionService(databaseService *DatabaseService, masterKey string, previousMasterKey string) (*EncryptionService, error) {
+	s := &EncryptionService{
+		databaseService: databaseService,
+	}
+
+	if masterKey == "" {
+		return s, nil
+	}
+
+	var err error
+	s.masterKey, err = parseMasterKey(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+
+	if previousMasterKey != "" {
+		s.previousMasterKey, err = parseMasterKey(previousMasterKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous master key: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+func parseMasterKey(value string) (*masterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("the key must be %d bytes long", dataKeySize)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Identifies the key without revealing it
+	hash := sha256.Sum256(key)
+
+	return &masterKey{
+		id:   hex.EncodeToString(hash[:8]),
+		aead: aead,
+	}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Enabled reports whether the objects are encrypted
+func (s *EncryptionService) Enabled() bool {
+	return s.masterKey != nil
+}
+
+func (s *EncryptionService) wrapKey(id uuid.UUID, key []byte) ([]byte, error) {
+	nonce := make([]byte, s.masterKey.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key ID is authenticated so that a wrapped key can't be swapped
+	// with the one of another user
+	return s.masterKey.aead.Seal(nonce, nonce, key, id[:]), nil
+}
+
+func unwrapKey(masterKey *masterKey, id uuid.UUID, wrappedKey []byte) ([]byte, error) {
+	nonceSize := masterKey.aead.NonceSize()
+	if len(wrappedKey) < nonceSize {
+		return nil, fmt.Errorf("data key %s is malformed", id)
+	}
+
+	return masterKey.aead.Open(nil, wrappedKey[:nonceSize], wrappedKey[nonceSize:], id[:])
+}
+
+func (s *EncryptionService) toDataKey(key *ent.DataKey) (*DataKey, error) {
+	masterKey := s.masterKey
+	if s.previousMasterKey != nil && key.MasterKeyID == s.previousMasterKey.id {
+		masterKey = s.previousMasterKey
+	}
+
+	if masterKey == nil || key.MasterKeyID != masterKey.id {
+		return nil, fmt.Errorf("data key %s is encrypted by an unknown master key %s", key.ID, key.MasterKeyID)
+	}
+
+	plainKey, err := unwrapKey(masterKey, key.ID, key.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataKey{
+		ID:  key.ID,
+		Key: plainKey,
+	}, nil
+}
+
+func (s *EncryptionService) createDataKey(ctx context.Context, client *ent.Client, userID uuid.UUID) (*DataKey, error) {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+
+	wrappedKey, err := s.wrapKey(id, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.DataKey.Create().
+		SetID(id).
+		SetEncryptedKey(wrappedKey).
+		SetMasterKeyID(s.masterKey.id).
+		SetUserID(userID).
+		SetActiveUserID(userID).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataKey{
+		ID:  id,
+		Key: key,
+	}, nil
+}
+
+// GetUserDataKey returns the key encrypting the new objects of a user,
+// creating it on first use. It returns nil if the encryption is disabled
+func (s *EncryptionService) GetUserDataKey(userID uuid.UUID) (*DataKey, error) {
+	if !s.Enabled() {
+		return nil, nil
+	}
+
+	key, err := s.databaseService.DataKey.Query().
+		Where(datakey.ActiveUserID(userID)).
+		Only(context.Background())
+	if err == nil {
+		return s.toDataKey(key)
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	created, err := s.createDataKey(context.Background(), s.databaseService.Client, userID)
+	if ent.IsConstraintError(err) {
+		// Created by a concurrent request in the meantime
+		key, err = s.databaseService.DataKey.Query().
+			Where(datakey.ActiveUserID(userID)).
+			Only(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		return s.toDataKey(key)
+	}
+
+	return created, err
+}
+
+// GetDataKey returns a key by its ID, active or not.
+// It returns ErrDataKeyNotFound if the key was shredded
+func (s *EncryptionService) GetDataKey(id uuid.UUID) (*DataKey, error) {
+	key, err := s.databaseService.DataKey.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDataKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return s.toDataKey(key)
+}
+
+// RotateUserDataKey replaces the active key of a user by a new one. The
+// existing objects stay encrypted by the previous keys, which are kept to
+// decrypt them
+func (s *EncryptionService) RotateUserDataKey(userID uuid.UUID) (*DataKey, error) {
+	if !s.Enabled() {
+		return nil, errors.New("encryption is disabled")
+	}
+
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.DataKey.Update().
+		Where(datakey.ActiveUserID(userID)).
+		ClearActiveUserID().
+		SetRotatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	key, err := s.createDataKey(context.Background(), tx.Client(), userID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return key, tx.Commit()
+}
+
+// ShredUserDataKeys deletes all the keys of a user, making the objects they
+// encrypt permanently unreadable
+func (s *EncryptionService) ShredUserDataKeys(userID uuid.UUID) (int, error) {
+	return s.databaseService.DataKey.Delete().
+		Where(datakey.HasUserWith(user.ID(userID))).
+		Exec(context.Background())
+}
+
+// RewrapDataKeys encrypts the data keys still encrypted by the previous master
+// key with the current one and returns how many were rewrapped
+func (s *EncryptionService) RewrapDataKeys() (int, error) {
+	if !s.Enabled() || s.previousMasterKey == nil {
+		return 0, nil
+	}
+
+	keys, err := s.databaseService.DataKey.Query().
+		Where(datakey.MasterKeyID(s.previousMasterKey.id)).
+		All(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	for i, key := range keys {
+		plainKey, err := unwrapKey(s.previousMasterKey, key.ID, key.EncryptedKey)
+		if err != nil {
+			return i, err
+		}
+
+		wrappedKey, err := s.wrapKey(key.ID, plainKey)
+		if err != nil {
+			return i, err
+		}
+
+		err = s.databaseService.DataKey.UpdateOne(key).
+			SetEncryptedKey(wrappedKey).
+			SetMasterKeyID(s.masterKey.id).
+			Exec(context.Background())
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(keys), nil
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// Encrypted objects start with a header made of a magic string, the ID of the
+// data key and a random nonce prefix, followed by the content split in chunks
+// sealed separately with AES-GCM so that they can be read from any offset.
+// The nonce of a chunk is the nonce prefix, the chunk index and a flag set on
+// the last chunk so that a truncated object can't be read as a complete one
+
+const (
+	encryptedObjectMagic = "TEREUSE1"
+
+	encryptedChunkSize       = 64 * 1024
+	encryptedNoncePrefixSize = 7
+	encryptedTagSize         = 16
+	encryptedHeaderSize      = len(encryptedObjectMagic) + 16 + encryptedNoncePrefixSize
+)
+
+var ErrObjectTampered = errors.New("encrypted object is corrupted or was tampered with")
+
+func getEncryptedChunkCount(size int64) int64 {
+	// An empty content is still sealed as one empty chunk
+	if size == 0 {
+		return 1
+	}
+
+	return (size + encryptedChunkSize - 1) / encryptedChunkSize
+}
+
+// EncryptedSize returns the size of an encrypted object from its plaintext size
+func EncryptedSize(size int64) int64 {
+	return int64(encryptedHeaderSize) + size + getEncryptedChunkCount(size)*encryptedTagSize
+}
+
+// DecryptedSize returns the plaintext size of an encrypted object from its size
+func DecryptedSize(size int64) int64 {
+	body := size - int64(encryptedHeaderSize)
+	if body < encryptedTagSize {
+		return 0
+	}
+
+	chunks := (body + encryptedChunkSize + encryptedTagSize - 1) / (encryptedChunkSize + encryptedTagSize)
+	return body - chunks*encryptedTagSize
+}
+
+func getChunkNonce(prefix []byte, index int64, last bool) []byte {
+	nonce := make([]byte, encryptedNoncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[encryptedNoncePrefixSize:], uint32(index))
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+type encryptingReader struct {
+	aead        cipher.AEAD
+	source      io.Reader
+	header      []byte
+	noncePrefix []byte
+
+	// Plaintext bytes left to read from the source
+	remaining int64
+	index     int64
+	done      bool
+
+	chunk  []byte
+	sealed []byte
+	// Bytes left to return from the header or the last sealed chunk
+	output []byte
+}
+
+// newEncryptingReader encrypts the size bytes read from source with a data key
+// and returns the reader of the encrypted object with its size
+func newEncryptingReader(key *DataKey, source io.Reader, size int64) (io.Reader, int64, error) {
+	aead, err := newAEAD(key.Key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	noncePrefix := make([]byte, encryptedNoncePrefixSize)
+	_, err = rand.Read(noncePrefix)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	header := make([]byte, 0, encryptedHeaderSize)
+	header = append(header, encryptedObjectMagic...)
+	header = append(header, key.ID[:]...)
+	header = append(header, noncePrefix...)
+
+	return &encryptingReader{
+		aead:        aead,
+		source:      source,
+		header:      header,
+		noncePrefix: noncePrefix,
+		remaining:   size,
+		chunk:       make([]byte, encryptedChunkSize),
+		sealed:      make([]byte, 0, encryptedChunkSize+encryptedTagSize),
+		output:      header,
+	}, EncryptedSize(size), nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.output) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		size := int64(encryptedChunkSize)
+		if r.remaining < size {
+			size = r.remaining
+		}
+
+		_, err := io.ReadFull(r.source, r.chunk[:size])
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		r.remaining -= size
+		r.done = r.remaining == 0
+
+		// The header is authenticated with every chunk
+		r.sealed = r.aead.Seal(r.sealed[:0], getChunkNonce(r.noncePrefix, r.index, r.done), r.chunk[:size], r.header)
+		r.output = r.sealed
+		r.index++
+	}
+
+	n := copy(p, r.output)
+	r.output = r.output[n:]
+	return n, nil
+}
+
+type decryptingReader struct {
+	aead        cipher.AEAD
+	source      io.ReadSeekCloser
+	header      []byte
+	noncePrefix []byte
+
+	encryptedSize int64
+	size          int64
+	offset        int64
+
+	// Index of the chunk held decrypted in chunk, -1 if none
+	chunkIndex int64
+	chunk      []byte
+	buffer     []byte
+}
+
+func (r *decryptingReader) loadChunk(index int64) error {
+	start := int64(encryptedHeaderSize) + index*(encryptedChunkSize+encryptedTagSize)
+	length := int64(encryptedChunkSize + encryptedTagSize)
+	if start+length > r.encryptedSize {
+		length = r.encryptedSize - start
+	}
+
+	_, err := r.source.Seek(start, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(r.source, r.buffer[:length])
+	if err != nil {
+		return err
+	}
+
+	last := index == getEncryptedChunkCount(r.size)-1
+
+	r.chunk, err = r.aead.Open(r.chunk[:0], getChunkNonce(r.noncePrefix, index, last), r.buffer[:length], r.header)
+	if err != nil {
+		r.chunkIndex = -1
+		return ErrObjectTampered
+	}
+
+	r.chunkIndex = index
+	return nil
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	index := r.offset / encryptedChunkSize
+	if index != r.chunkIndex {
+		err := r.loadChunk(index)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.chunk[r.offset-index*encryptedChunkSize:])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *decryptingReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.source.Close()
+}
+
+// DecryptObject wraps an object read from the storage backend so that it
+// reads as plaintext and returns it with its plaintext size. Objects stored
+// before the encryption was enabled are returned as they are
+func (s *EncryptionService) DecryptObject(object io.ReadSeekCloser, size int64) (io.ReadSeekCloser, int64, error) {
+	header := make([]byte, encryptedHeaderSize)
+
+	_, err := io.ReadFull(object, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, 0, err
+	}
+
+	if err != nil || !bytes.HasPrefix(header, []byte(encryptedObjectMagic)) {
+		_, err = object.Seek(0, io.SeekStart)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return object, size, nil
+	}
+
+	keyID, err := uuid.FromBytes(header[len(encryptedObjectMagic) : len(encryptedObjectMagic)+16])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	key, err := s.GetDataKey(keyID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get data key %s: %w", keyID, err)
+	}
+
+	aead, err := newAEAD(key.Key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	plainSize := DecryptedSize(size)
+
+	return &decryptingReader{
+		aead:          aead,
+		source:        object,
+		header:        header,
+		noncePrefix:   header[len(encryptedObjectMagic)+16:],
+		encryptedSize: size,
+		size:          plainSize,
+		chunkIndex:    -1,
+		chunk:         make([]byte, 0, encryptedChunkSize),
+		buffer:        make([]byte, encryptedChunkSize+encryptedTagSize),
+	}, plainSize, nil
+}
+
+// Report whether an object read from the storage backend
+// starts with the header of an encrypted object
+func isEncryptedObject(object io.Reader) (bool, error) {
+	magic := make([]byte, len(encryptedObjectMagic))
+
+	_, err := io.ReadFull(object, magic)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return string(magic) == encryptedObjectMagic, nil
+}
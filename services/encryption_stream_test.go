@@ -0,0 +1,222 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// An object read from the storage backend
+type testObject struct {
+	*bytes.Reader
+}
+
+func (testObject) Close() error {
+	return nil
+}
+
+func newTestEncryptionService(t *testing.T) (*EncryptionService, uuid.UUID) {
+	t.Helper()
+
+	databaseService := newTestDatabaseService(t)
+	if err := databaseService.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to create the schema: %v", err)
+	}
+
+	masterKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(masterKey); err != nil {
+		t.Fatalf("failed to generate the master key: %v", err)
+	}
+
+	s, err := NewEncryptionService(databaseService, base64.StdEncoding.EncodeToString(masterKey), "")
+	if err != nil {
+		t.Fatalf("NewEncryptionService() failed: %v", err)
+	}
+
+	user, err := databaseService.User.Create().
+		SetEmail("user@example.com").
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create the user: %v", err)
+	}
+
+	return s, user.ID
+}
+
+func encryptTestObject(t *testing.T, key *DataKey, plaintext []byte) []byte {
+	t.Helper()
+
+	reader, size, err := newEncryptingReader(key, bytes.NewReader(plaintext), int64(len(plaintext)))
+	if err != nil {
+		t.Fatalf("newEncryptingReader() failed: %v", err)
+	}
+
+	encrypted, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if int64(len(encrypted)) != size {
+		t.Fatalf("encrypted %d bytes, want %d", len(encrypted), size)
+	}
+
+	return encrypted
+}
+
+func decryptTestObject(s *EncryptionService, object []byte) ([]byte, error) {
+	reader, _, err := s.DecryptObject(testObject{bytes.NewReader(object)}, int64(len(object)))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	s, userID := newTestEncryptionService(t)
+
+	key, err := s.GetUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("GetUserDataKey() failed: %v", err)
+	}
+
+	magic := append([]byte(encryptedObjectMagic), make([]byte, 2*encryptedHeaderSize)...)
+
+	tests := []struct {
+		name      string
+		plaintext []byte
+	}{
+		{name: "empty", plaintext: []byte{}},
+		{name: "one byte", plaintext: []byte("a")},
+		{name: "one chunk", plaintext: bytes.Repeat([]byte("a"), encryptedChunkSize)},
+		{name: "exact chunk multiple", plaintext: bytes.Repeat([]byte("a"), 3*encryptedChunkSize)},
+		{name: "multi chunk", plaintext: bytes.Repeat([]byte("abc"), encryptedChunkSize)},
+		{name: "starting with the magic", plaintext: magic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encrypted := encryptTestObject(t, key, tt.plaintext)
+
+			if got := DecryptedSize(int64(len(encrypted))); got != int64(len(tt.plaintext)) {
+				t.Errorf("DecryptedSize() = %d, want %d", got, len(tt.plaintext))
+			}
+
+			reader, size, err := s.DecryptObject(testObject{bytes.NewReader(encrypted)}, int64(len(encrypted)))
+			if err != nil {
+				t.Fatalf("DecryptObject() failed: %v", err)
+			}
+			if size != int64(len(tt.plaintext)) {
+				t.Errorf("DecryptObject() size = %d, want %d", size, len(tt.plaintext))
+			}
+
+			got, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("failed to decrypt: %v", err)
+			}
+			if !bytes.Equal(got, tt.plaintext) {
+				t.Errorf("decrypted %d bytes different from the plaintext", len(got))
+			}
+
+			// Read from the middle, across the chunks
+			offset := int64(len(tt.plaintext) / 2)
+			if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+				t.Fatalf("Seek() failed: %v", err)
+			}
+			got, err = io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("failed to decrypt after seeking: %v", err)
+			}
+			if !bytes.Equal(got, tt.plaintext[offset:]) {
+				t.Errorf("decrypted %d bytes after seeking different from the plaintext", len(got))
+			}
+		})
+	}
+}
+
+func TestEncryptionTampering(t *testing.T) {
+	s, userID := newTestEncryptionService(t)
+
+	key, err := s.GetUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("GetUserDataKey() failed: %v", err)
+	}
+
+	plaintext := bytes.Repeat([]byte("abcd"), encryptedChunkSize)
+	encrypted := encryptTestObject(t, key, plaintext)
+	chunk := encryptedChunkSize + encryptedTagSize
+
+	tests := []struct {
+		name   string
+		tamper func(object []byte) []byte
+	}{
+		{
+			name:   "last chunk removed",
+			tamper: func(object []byte) []byte { return object[:encryptedHeaderSize+3*chunk] },
+		},
+		{
+			name:   "truncated",
+			tamper: func(object []byte) []byte { return object[:len(object)-1] },
+		},
+		{
+			name: "chunks swapped",
+			tamper: func(object []byte) []byte {
+				first := append([]byte{}, object[encryptedHeaderSize:encryptedHeaderSize+chunk]...)
+				copy(object[encryptedHeaderSize:], object[encryptedHeaderSize+chunk:encryptedHeaderSize+2*chunk])
+				copy(object[encryptedHeaderSize+chunk:], first)
+				return object
+			},
+		},
+		{
+			name: "byte flipped",
+			tamper: func(object []byte) []byte {
+				object[len(object)/2] ^= 1
+				return object
+			},
+		},
+		{
+			name: "header changed",
+			tamper: func(object []byte) []byte {
+				object[encryptedHeaderSize-1] ^= 1
+				return object
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object := tt.tamper(append([]byte{}, encrypted...))
+
+			if _, err := decryptTestObject(s, object); !errors.Is(err, ErrObjectTampered) {
+				t.Errorf("decrypting = %v, want ErrObjectTampered", err)
+			}
+		})
+	}
+}
+
+func TestDecryptObjectUnencrypted(t *testing.T) {
+	s, _ := newTestEncryptionService(t)
+
+	// Stored before the encryption was enabled
+	tests := [][]byte{
+		{},
+		[]byte("short"),
+		bytes.Repeat([]byte("a"), 2*encryptedHeaderSize),
+	}
+
+	for _, plaintext := range tests {
+		got, err := decryptTestObject(s, plaintext)
+		if err != nil {
+			t.Fatalf("decrypting %d unencrypted bytes failed: %v", len(plaintext), err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("decrypting %d unencrypted bytes = %q, want them unchanged", len(plaintext), got)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/datakey"
+)
+
+func TestUserDataKeys(t *testing.T) {
+	s, userID := newTestEncryptionService(t)
+
+	key, err := s.GetUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("GetUserDataKey() failed: %v", err)
+	}
+
+	again, err := s.GetUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("GetUserDataKey() failed: %v", err)
+	}
+	if again.ID != key.ID {
+		t.Errorf("GetUserDataKey() = %s, want the existing key %s", again.ID, key.ID)
+	}
+
+	// Like a concurrent request creating the key at the same time
+	_, err = s.createDataKey(context.Background(), s.databaseService.Client, userID)
+	if !ent.IsConstraintError(err) {
+		t.Errorf("createDataKey() with an active key = %v, want a constraint error", err)
+	}
+
+	rotated, err := s.RotateUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("RotateUserDataKey() failed: %v", err)
+	}
+
+	active, err := s.GetUserDataKey(userID)
+	if err != nil {
+		t.Fatalf("GetUserDataKey() failed: %v", err)
+	}
+	if active.ID != rotated.ID || rotated.ID == key.ID {
+		t.Errorf("GetUserDataKey() after rotation = %s, want the new key %s", active.ID, rotated.ID)
+	}
+
+	// The previous key still decrypts the objects it encrypted
+	if _, err := s.GetDataKey(key.ID); err != nil {
+		t.Errorf("GetDataKey() of the rotated key failed: %v", err)
+	}
+
+	count, err := s.databaseService.DataKey.Query().
+		Where(datakey.ActiveUserID(userID)).
+		Count(context.Background())
+	if err != nil {
+		t.Fatalf("failed to count the active keys: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("found %d active keys, want 1", count)
+	}
+
+	shredded, err := s.ShredUserDataKeys(userID)
+	if err != nil {
+		t.Fatalf("ShredUserDataKeys() failed: %v", err)
+	}
+	if shredded != 2 {
+		t.Errorf("ShredUserDataKeys() = %d, want 2", shredded)
+	}
+	if _, err := s.GetDataKey(key.ID); err != ErrDataKeyNotFound {
+		t.Errorf("GetDataKey() of a shredded key = %v, want ErrDataKeyNotFound", err)
+	}
+}
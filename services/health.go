@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Maximum duration of a readiness check
+const healthCheckTimeout = 2 * time.Second
+
+type HealthService struct {
+	databaseService *DatabaseService
+	storageService  *StorageService
+
+	nsqEndpoint        string
+	nsqLookupdEndpoint string
+
+	mu sync.RWMutex
+	// Set once the process is stopping so that it is taken out of the load
+	// balancer before the server stops accepting requests
+	draining bool
+}
+
+func NewHealthService(databaseService *DatabaseService, storageService *StorageService, nsqEndpoint string, nsqLookupdEndpoint string) *HealthService {
+	return &HealthService{
+		databaseService:    databaseService,
+		storageService:     storageService,
+		nsqEndpoint:        nsqEndpoint,
+		nsqLookupdEndpoint: nsqLookupdEndpoint,
+	}
+}
+
+// StartDraining makes the process report itself as not ready
+func (s *HealthService) StartDraining() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.draining = true
+}
+
+func (s *HealthService) IsDraining() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.draining
+}
+
+// CheckReadiness checks the dependencies of the process concurrently and
+// returns the error of each check by name, nil if the dependency is reachable
+func (s *HealthService) CheckReadiness(ctx context.Context) map[string]error {
+	checks := map[string]func(ctx context.Context) error{
+		"database": s.databaseService.Ping,
+		"storage":  s.storageService.Ping,
+		"queue":    s.pingQueue,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	results := map[string]error{}
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(ctx context.Context) error) {
+			defer wg.Done()
+
+			err := check(ctx)
+
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}(name, check)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// Check that nsqd accepts connections and that nsqlookupd answers, the
+// consumers discover nsqd through it
+func (s *HealthService) pingQueue(ctx context.Context) error {
+	dialer := net.Dialer{}
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.nsqEndpoint)
+	if err != nil {
+		return err
+	}
+	conn.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/ping", s.nsqLookupdEndpoint), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("nsqlookupd answered with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/lease"
+)
+
+// The periodic workers run in every API process but only work in the process
+// holding their lease. The holder renews the lease well before it expires and
+// the other processes take it over once it expired, when the holder stopped.
+
+type LeaderElectionService struct {
+	databaseService *DatabaseService
+
+	// Identifies this process as the holder of the leases
+	holderID      string
+	leaseDuration time.Duration
+}
+
+func NewLeaderElectionService(databaseService *DatabaseService, leaseDuration time.Duration) *LeaderElectionService {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &LeaderElectionService{
+		databaseService: databaseService,
+		holderID:        fmt.Sprintf("%s-%s", hostname, uuid.New()),
+		leaseDuration:   leaseDuration,
+	}
+}
+
+// Leadership is the state of this process in the election for a lease
+type Leadership struct {
+	name string
+
+	stop    chan struct{}
+	stopped chan struct{}
+
+	mu sync.Mutex
+	// Until when this process holds the lease, it only relies on its own
+	// clock so that it stops acting as leader even if the database is unreachable
+	expiresAt time.Time
+}
+
+// IsLeader reports whether this process currently holds the lease
+func (l *Leadership) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return time.Now().Before(l.expiresAt)
+}
+
+// Context returns a copy of parent which is cancelled once this process stops
+// holding the lease, for the work done as leader to stop on the loss of the lease
+func (l *Leadership) Context(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	go func() {
+		for {
+			l.mu.Lock()
+			remaining := time.Until(l.expiresAt)
+			l.mu.Unlock()
+
+			if remaining <= 0 {
+				cancel()
+				return
+			}
+
+			// Checked again once the lease expires, it may have been renewed
+			// in the meantime
+			timer := time.NewTimer(remaining)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// Campaign keeps trying to acquire the lease of name in the background and
+// renews it once held, until the leadership is released
+func (s *LeaderElectionService) Campaign(name string) *Leadership {
+	leadership := &Leadership{
+		name:    name,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(leadership.stopped)
+
+		ticker := time.NewTicker(s.leaseDuration / 3)
+		defer ticker.Stop()
+
+		for {
+			s.renewLeadership(leadership)
+
+			select {
+			case <-leadership.stop:
+				s.releaseLease(leadership)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return leadership
+}
+
+// Release stops the campaign and gives up the lease so that another process
+// takes it over without waiting for its expiration
+func (l *Leadership) Release() {
+	close(l.stop)
+	<-l.stopped
+}
+
+func (s *LeaderElectionService) renewLeadership(leadership *Leadership) {
+	wasLeader := leadership.IsLeader()
+
+	// Computed before the queries so that the lease expires later in the
+	// database than in this process
+	expiresAt := time.Now().Add(s.leaseDuration)
+
+	acquired, err := s.tryAcquireLease(leadership.name)
+	if err != nil {
+		// The lease held so far lapses unless renewed by a later attempt
+		logrus.WithError(err).WithField("lease", leadership.name).Error("Failed to renew lease")
+		return
+	}
+
+	leadership.mu.Lock()
+	if acquired {
+		leadership.expiresAt = expiresAt
+	} else {
+		leadership.expiresAt = time.Time{}
+	}
+	leadership.mu.Unlock()
+
+	if acquired && !wasLeader {
+		logrus.WithFields(logrus.Fields{
+			"lease":  leadership.name,
+			"holder": s.holderID,
+		}).Info("Acquired lease")
+	} else if !acquired && wasLeader {
+		logrus.WithFields(logrus.Fields{
+			"lease":  leadership.name,
+			"holder": s.holderID,
+		}).Warn("Lost lease")
+	}
+}
+
+// Renew the lease if this process holds it, or take it over if it expired.
+// The expiration of the leases is compared with the clock of the database so
+// that the processes agree on it whatever the clocks of their hosts
+func (s *LeaderElectionService) tryAcquireLease(name string) (bool, error) {
+	now, err := s.databaseService.Now(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	expiresAt := now.Add(s.leaseDuration)
+
+	updated, err := s.databaseService.Lease.Update().
+		Where(
+			lease.ID(name),
+			lease.Or(
+				lease.Holder(s.holderID),
+				lease.ExpiresAtLT(now),
+			),
+		).
+		SetHolder(s.holderID).
+		SetExpiresAt(expiresAt).
+		Save(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	if updated > 0 {
+		return true, nil
+	}
+
+	err = s.databaseService.Lease.Create().
+		SetID(name).
+		SetHolder(s.holderID).
+		SetExpiresAt(expiresAt).
+		Exec(context.Background())
+	if ent.IsConstraintError(err) {
+		// Held by another process
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *LeaderElectionService) releaseLease(leadership *Leadership) {
+	leadership.mu.Lock()
+	leadership.expiresAt = time.Time{}
+	leadership.mu.Unlock()
+
+	_, err := s.databaseService.Lease.Delete().
+		Where(
+			lease.ID(leadership.name),
+			lease.Holder(s.holderID),
+		).
+		Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("lease", leadership.name).Error("Failed to release lease")
+	}
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+const testLeaseDuration = 300 * time.Millisecond
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * testLeaseDuration)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestLeaderElection(t *testing.T) {
+	databaseService := newTestDatabaseService(t)
+	if err := databaseService.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to create the schema: %v", err)
+	}
+
+	first := NewLeaderElectionService(databaseService, testLeaseDuration).Campaign("test")
+	waitFor(t, "the first process to acquire the lease", first.IsLeader)
+
+	second := NewLeaderElectionService(databaseService, testLeaseDuration).Campaign("test")
+	defer second.Release()
+
+	// Renewed by the first process for longer than the lease duration
+	time.Sleep(2 * testLeaseDuration)
+	if !first.IsLeader() || second.IsLeader() {
+		t.Fatalf("IsLeader() = %v, %v, want only the first process to hold the lease", first.IsLeader(), second.IsLeader())
+	}
+
+	secondCtx, cancel := second.Context(context.Background())
+	defer cancel()
+	waitFor(t, "the context of the second process to be cancelled", func() bool { return secondCtx.Err() != nil })
+
+	firstCtx, cancel := first.Context(context.Background())
+	defer cancel()
+	if firstCtx.Err() != nil {
+		t.Fatal("the context of the leader is cancelled")
+	}
+
+	first.Release()
+	waitFor(t, "the context of the first process to be cancelled", func() bool { return firstCtx.Err() != nil })
+	waitFor(t, "the second process to take over the lease", second.IsLeader)
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// MailService sends plain text emails through an SMTP server
+type MailService struct {
+	address  string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// NewMailService creates the mail service, the emails are not sent if host is empty
+func NewMailService(host string, port int, username string, password string, from string) *MailService {
+	return &MailService{
+		address:  fmt.Sprintf("%s:%d", host, port),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Enabled reports whether an SMTP server is configured
+func (s *MailService) Enabled() bool {
+	return s.host != ""
+}
+
+func (s *MailService) SendMail(to string, subject string, body string) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	message := strings.Join([]string{
+		fmt.Sprintf("From: %s", s.from),
+		fmt.Sprintf("To: %s", to),
+		fmt.Sprintf("Subject: %s", subject),
+		fmt.Sprintf("Date: %s", time.Now().Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		strings.ReplaceAll(body, "\n", "\r\n"),
+	}, "\r\n")
+
+	return smtp.SendMail(s.address, auth, s.from, []string{to}, []byte(message))
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tereus-project/tereus-api/migrations"
+)
+
+// The database schema is only changed by the versioned migrations embedded in
+// the binary. The versions applied to a database are recorded in its
+// schema_migrations table.
+
+const migrationsTableQuery = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version VARCHAR(255) NOT NULL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`
+
+type Migration struct {
+	Version string
+	Name    string
+	// Nil if the migration is pending
+	AppliedAt *time.Time
+	// Set if the migration was applied by another build and has no file in
+	// this one, the database is ahead of this build
+	Missing bool
+
+	upFile   string
+	downFile string
+}
+
+type MigrationService struct {
+	databaseService *DatabaseService
+	driver          string
+
+	files fs.FS
+	// Sorted by version
+	migrations []*Migration
+}
+
+func NewMigrationService(databaseService *DatabaseService, driver string) (*MigrationService, error) {
+	files, err := fs.Sub(migrations.FS, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := fs.Stat(files, "."); err != nil {
+		return nil, fmt.Errorf("no migrations for the %q database driver", driver)
+	}
+
+	return newMigrationService(databaseService, driver, files)
+}
+
+func newMigrationService(databaseService *DatabaseService, driver string, files fs.FS) (*MigrationService, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[string]*Migration{}
+	for _, entry := range entries {
+		var direction string
+		switch {
+		case strings.HasSuffix(entry.Name(), ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(entry.Name(), ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimSuffix(entry.Name(), "."+direction+".sql"), "_", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<name>.%s.sql", entry.Name(), direction)
+		}
+
+		migration := byVersion[parts[0]]
+		if migration == nil {
+			migration = &Migration{Version: parts[0], Name: parts[1]}
+			byVersion[parts[0]] = migration
+		}
+
+		if direction == "up" {
+			migration.upFile = entry.Name()
+		} else {
+			migration.downFile = entry.Name()
+		}
+	}
+
+	s := &MigrationService{
+		databaseService: databaseService,
+		driver:          driver,
+		files:           files,
+	}
+
+	for _, migration := range byVersion {
+		if migration.upFile == "" {
+			return nil, fmt.Errorf("migration %s_%s has no up file", migration.Version, migration.Name)
+		}
+		s.migrations = append(s.migrations, migration)
+	}
+
+	sort.Slice(s.migrations, func(i, j int) bool {
+		return s.migrations[i].Version < s.migrations[j].Version
+	})
+
+	return s, nil
+}
+
+// Status lists the migrations of this build and the ones applied by other
+// builds, sorted by version
+func (s *MigrationService) Status(ctx context.Context) ([]*Migration, error) {
+	_, err := s.databaseService.db.ExecContext(ctx, migrationsTableQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.databaseService.db.QueryContext(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[string]*Migration{}
+	for rows.Next() {
+		migration := &Migration{AppliedAt: &time.Time{}}
+		if err := rows.Scan(&migration.Version, &migration.Name, migration.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied[migration.Version] = migration
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	status := []*Migration{}
+	for _, migration := range s.migrations {
+		m := *migration
+		if a, ok := applied[m.Version]; ok {
+			m.AppliedAt = a.AppliedAt
+			delete(applied, m.Version)
+		}
+		status = append(status, &m)
+	}
+
+	for _, migration := range applied {
+		migration.Missing = true
+		status = append(status, migration)
+	}
+
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].Version < status[j].Version
+	})
+
+	return status, nil
+}
+
+// Pending lists the migrations of this build not applied yet
+func (s *MigrationService) Pending(ctx context.Context) ([]*Migration, error) {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []*Migration{}
+	for _, migration := range status {
+		if migration.AppliedAt == nil {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// CheckSchema fails if some migrations of this build are not applied, the
+// queries of this build would not match the schema
+func (s *MigrationService) CheckSchema(ctx context.Context) error {
+	pending, err := s.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%d pending migrations, starting with %s_%s", len(pending), pending[0].Version, pending[0].Name)
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order, at most limit of them unless
+// limit is 0. Each migration is applied in its own transaction
+func (s *MigrationService) Up(ctx context.Context, limit int) ([]*Migration, error) {
+	pending, err := s.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit > 0 && len(pending) > limit {
+		pending = pending[:limit]
+	}
+
+	applied := []*Migration{}
+	for _, migration := range pending {
+		err := s.run(ctx, migration.upFile, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)", migration.Version, migration.Name, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last limit applied migrations, from the most recent one
+func (s *MigrationService) Down(ctx context.Context, limit int) ([]*Migration, error) {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reverted := []*Migration{}
+	for i := len(status) - 1; i >= 0 && len(reverted) < limit; i-- {
+		migration := status[i]
+		if migration.AppliedAt == nil {
+			continue
+		}
+
+		if migration.Missing {
+			return reverted, fmt.Errorf("migration %s_%s was applied by another build and can't be reverted by this one", migration.Version, migration.Name)
+		}
+		if migration.downFile == "" {
+			return reverted, fmt.Errorf("migration %s_%s has no down file", migration.Version, migration.Name)
+		}
+
+		err := s.run(ctx, migration.downFile, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+			return err
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		reverted = append(reverted, migration)
+	}
+
+	return reverted, nil
+}
+
+// Baseline records the first migration as applied without running it, for
+// the databases whose schema was created by the auto-migration of the releases
+// before the versioned migrations, which is the schema of that migration
+func (s *MigrationService) Baseline(ctx context.Context) (*Migration, error) {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.migrations) == 0 {
+		return nil, errors.New("there is no migration to record")
+	}
+
+	for _, migration := range status {
+		if migration.AppliedAt != nil {
+			return nil, errors.New("the database already has applied migrations")
+		}
+	}
+
+	baseline := s.migrations[0]
+
+	_, err = s.databaseService.db.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)", baseline.Version, baseline.Name, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return baseline, nil
+}
+
+// Run the statements of a migration file and record the change of version in
+// the same transaction
+func (s *MigrationService) run(ctx context.Context, file string, record func(tx *sql.Tx) error) error {
+	statements, err := fs.ReadFile(s.files, file)
+	if err != nil {
+		return err
+	}
+
+	conn, err := s.databaseService.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// SQLite alters tables by copying them, dropping the original tables would
+	// cascade to the rows referencing them. The foreign keys can only be
+	// disabled outside of a transaction
+	if s.driver == "sqlite3" {
+		_, err = conn.ExecContext(ctx, "PRAGMA foreign_keys = off")
+		if err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.Background(), "PRAGMA foreign_keys = on")
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(string(statements)) != "" {
+		_, err = tx.ExecContext(ctx, string(statements))
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = record(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func newTestDatabaseService(t *testing.T) *DatabaseService {
+	t.Helper()
+
+	databaseService, err := NewDatabaseService("sqlite3", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_fk=1")
+	if err != nil {
+		t.Fatalf("failed to open the database: %v", err)
+	}
+	t.Cleanup(func() { databaseService.Close() })
+
+	return databaseService
+}
+
+func migrationNames(migrations []*Migration) []string {
+	names := []string{}
+	for _, migration := range migrations {
+		names = append(names, migration.Version+"_"+migration.Name)
+	}
+	return names
+}
+
+func TestNewMigrationServiceFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", files: fstest.MapFS{}, want: []string{}},
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"2_add_users_locale.up.sql":   {Data: []byte("")},
+				"2_add_users_locale.down.sql": {Data: []byte("")},
+				"1_baseline.up.sql":           {Data: []byte("")},
+				"1_baseline.down.sql":         {Data: []byte("")},
+			},
+			want: []string{"1_baseline", "2_add_users_locale"},
+		},
+		{
+			name: "other files and missing down file",
+			files: fstest.MapFS{
+				"1_baseline.up.sql": {Data: []byte("")},
+				"README.md":         {Data: []byte("")},
+			},
+			want: []string{"1_baseline"},
+		},
+		{
+			name:    "missing up file",
+			files:   fstest.MapFS{"1_baseline.down.sql": {Data: []byte("")}},
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			files:   fstest.MapFS{"1.up.sql": {Data: []byte("")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newMigrationService(nil, "sqlite3", tt.files)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("newMigrationService() = %v, want an error", migrationNames(s.migrations))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("newMigrationService() failed: %v", err)
+			}
+			if got := migrationNames(s.migrations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newMigrationService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMigrationServiceDrivers(t *testing.T) {
+	sqlite, err := NewMigrationService(nil, "sqlite3")
+	if err != nil {
+		t.Fatalf("NewMigrationService(sqlite3) failed: %v", err)
+	}
+
+	postgres, err := NewMigrationService(nil, "postgres")
+	if err != nil {
+		t.Fatalf("NewMigrationService(postgres) failed: %v", err)
+	}
+
+	// Both drivers share the same migration history
+	if got, want := migrationNames(postgres.migrations), migrationNames(sqlite.migrations); !reflect.DeepEqual(got, want) {
+		t.Errorf("postgres migrations = %v, want the sqlite3 ones %v", got, want)
+	}
+
+	if _, err := NewMigrationService(nil, "mysql"); err == nil {
+		t.Error("NewMigrationService(mysql) succeeded, want an error")
+	}
+}
+
+func TestMigrationServiceUpDown(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := newMigrationService(newTestDatabaseService(t), "sqlite3", fstest.MapFS{
+		"1_users.up.sql":     {Data: []byte("CREATE TABLE users (id integer NOT NULL PRIMARY KEY);")},
+		"1_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+		"2_locale.up.sql":    {Data: []byte("ALTER TABLE users ADD COLUMN locale text NULL;")},
+		"2_locale.down.sql":  {Data: []byte("ALTER TABLE users DROP COLUMN locale;")},
+		"3_invalid.up.sql":   {Data: []byte("ALTER TABLE missing ADD COLUMN locale text NULL;")},
+		"3_invalid.down.sql": {Data: []byte("")},
+	})
+	if err != nil {
+		t.Fatalf("newMigrationService() failed: %v", err)
+	}
+
+	applied, err := s.Up(ctx, 1)
+	if err != nil {
+		t.Fatalf("Up(1) failed: %v", err)
+	}
+	if got, want := migrationNames(applied), []string{"1_users"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Up(1) = %v, want %v", got, want)
+	}
+
+	applied, err = s.Up(ctx, 0)
+	if err == nil {
+		t.Fatal("Up(0) succeeded, want the error of 3_invalid")
+	}
+	if got, want := migrationNames(applied), []string{"2_locale"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Up(0) = %v, want %v", got, want)
+	}
+
+	// The failed migration is not recorded
+	pending, err := s.Pending(ctx)
+	if err != nil {
+		t.Fatalf("Pending() failed: %v", err)
+	}
+	if got, want := migrationNames(pending), []string{"3_invalid"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pending() = %v, want %v", got, want)
+	}
+	if err := s.CheckSchema(ctx); err == nil {
+		t.Error("CheckSchema() succeeded with a pending migration, want an error")
+	}
+
+	reverted, err := s.Down(ctx, 2)
+	if err != nil {
+		t.Fatalf("Down(2) failed: %v", err)
+	}
+	if got, want := migrationNames(reverted), []string{"2_locale", "1_users"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Down(2) = %v, want %v", got, want)
+	}
+
+	if _, err := s.Baseline(ctx); err != nil {
+		t.Fatalf("Baseline() failed: %v", err)
+	}
+	if _, err := s.Baseline(ctx); err == nil {
+		t.Error("Baseline() succeeded with an applied migration, want an error")
+	}
+}
+
+func TestMigrationServiceMissing(t *testing.T) {
+	ctx := context.Background()
+	databaseService := newTestDatabaseService(t)
+
+	newer, err := newMigrationService(databaseService, "sqlite3", fstest.MapFS{
+		"1_users.up.sql":  {Data: []byte("CREATE TABLE users (id integer NOT NULL PRIMARY KEY);")},
+		"2_locale.up.sql": {Data: []byte("ALTER TABLE users ADD COLUMN locale text NULL;")},
+	})
+	if err != nil {
+		t.Fatalf("newMigrationService() failed: %v", err)
+	}
+	if _, err := newer.Up(ctx, 0); err != nil {
+		t.Fatalf("Up(0) failed: %v", err)
+	}
+
+	// An older build does not know the last migration
+	older, err := newMigrationService(databaseService, "sqlite3", fstest.MapFS{
+		"1_users.up.sql": {Data: []byte("CREATE TABLE users (id integer NOT NULL PRIMARY KEY);")},
+	})
+	if err != nil {
+		t.Fatalf("newMigrationService() failed: %v", err)
+	}
+
+	status, err := older.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() failed: %v", err)
+	}
+	if len(status) != 2 || status[0].Missing || !status[1].Missing {
+		t.Errorf("Status() = %v, want 2_locale to be the only missing migration", migrationNames(status))
+	}
+	if err := older.CheckSchema(ctx); err != nil {
+		t.Errorf("CheckSchema() failed: %v", err)
+	}
+	if _, err := older.Down(ctx, 1); err == nil {
+		t.Error("Down(1) reverted a migration of another build, want an error")
+	}
+}
+
+func TestEmbeddedSQLiteMigrations(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := NewMigrationService(newTestDatabaseService(t), "sqlite3")
+	if err != nil {
+		t.Fatalf("NewMigrationService() failed: %v", err)
+	}
+
+	if _, err := s.Up(ctx, 0); err != nil {
+		t.Fatalf("Up(0) failed: %v", err)
+	}
+	if err := s.CheckSchema(ctx); err != nil {
+		t.Fatalf("CheckSchema() failed: %v", err)
+	}
+
+	reverted, err := s.Down(ctx, len(s.migrations))
+	if err != nil {
+		t.Fatalf("Down() failed: %v", err)
+	}
+	if len(reverted) != len(s.migrations) {
+		t.Errorf("Down() reverted %d migrations, want %d", len(reverted), len(s.migrations))
+	}
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/notification"
+	"github.com/tereus-project/tereus-api/ent/notificationpreference"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+type NotificationService struct {
+	databaseService *DatabaseService
+	mailService     *MailService
+
+	// Base URL of the API the download links point to
+	apiURL string
+	// Page where the users can upgrade their subscription
+	upgradeURL string
+}
+
+func NewNotificationService(databaseService *DatabaseService, mailService *MailService, apiURL string, upgradeURL string) *NotificationService {
+	return &NotificationService{
+		databaseService: databaseService,
+		mailService:     mailService,
+		apiURL:          strings.TrimSuffix(apiURL, "/"),
+		upgradeURL:      upgradeURL,
+	}
+}
+
+// GetUserPreferences returns the notification preferences of a user, with
+// the default values if they never changed them
+func (s *NotificationService) GetUserPreferences(userID uuid.UUID) (*ent.NotificationPreference, error) {
+	preferences, err := s.databaseService.NotificationPreference.Query().
+		Where(notificationpreference.HasUserWith(user.ID(userID))).
+		Only(context.Background())
+	if err == nil {
+		return preferences, nil
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	preferences, err = s.databaseService.NotificationPreference.Create().
+		SetUserID(userID).
+		Save(context.Background())
+	if ent.IsConstraintError(err) {
+		// Created by a concurrent call
+		return s.databaseService.NotificationPreference.Query().
+			Where(notificationpreference.HasUserWith(user.ID(userID))).
+			Only(context.Background())
+	}
+
+	return preferences, err
+}
+
+type NotificationPreferencesUpdate struct {
+	RetentionWarningEmail *bool
+	RetentionWarningInApp *bool
+}
+
+// UpdateUserPreferences changes the notification preferences of a user, the
+// fields left nil are not changed
+func (s *NotificationService) UpdateUserPreferences(userID uuid.UUID, update NotificationPreferencesUpdate) (*ent.NotificationPreference, error) {
+	preferences, err := s.GetUserPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferencesUpdate := preferences.Update()
+
+	if update.RetentionWarningEmail != nil {
+		preferencesUpdate.SetRetentionWarningEmail(*update.RetentionWarningEmail)
+	}
+
+	if update.RetentionWarningInApp != nil {
+		preferencesUpdate.SetRetentionWarningInApp(*update.RetentionWarningInApp)
+	}
+
+	return preferencesUpdate.Save(context.Background())
+}
+
+type ExpiringSubmission struct {
+	Submission *ent.Submission
+	ExpiresAt  time.Time
+}
+
+// SendRetentionWarning notifies a user that submissions will be cleaned,
+// by email and in the app depending on their preferences, and marks the
+// submissions as warned
+func (s *NotificationService) SendRetentionWarning(recipient *ent.User, submissions []ExpiringSubmission, canUpgrade bool) error {
+	if len(submissions) == 0 {
+		return nil
+	}
+
+	preferences, err := s.GetUserPreferences(recipient.ID)
+	if err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("%d submission(s) will be deleted soon", len(submissions))
+	message := s.getRetentionWarningMessage(submissions, canUpgrade)
+
+	submissionIDs := make([]string, len(submissions))
+	ids := make([]uuid.UUID, len(submissions))
+	for i, expiring := range submissions {
+		submissionIDs[i] = expiring.Submission.ID.String()
+		ids[i] = expiring.Submission.ID
+	}
+
+	// An address that can't receive emails must not keep the submissions from
+	// being cleaned, the user is still notified in the app
+	if preferences.RetentionWarningEmail && s.mailService.Enabled() {
+		err = s.mailService.SendMail(recipient.Email, fmt.Sprintf("[Tereus] %s", title), message)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", recipient.ID).Error("Failed to send retention warning email")
+		}
+	}
+
+	if preferences.RetentionWarningInApp {
+		err = s.databaseService.Notification.Create().
+			SetType(notification.TypeRetentionWarning).
+			SetTitle(title).
+			SetMessage(message).
+			SetSubmissionIds(submissionIDs).
+			SetUserID(recipient.ID).
+			Exec(context.Background())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Marked even if the user opted out so that they are not considered again
+	return s.databaseService.Submission.Update().
+		Where(submission.IDIn(ids...)).
+		SetRetentionWarnedAt(time.Now()).
+		Exec(context.Background())
+}
+
+func (s *NotificationService) getRetentionWarningMessage(submissions []ExpiringSubmission, canUpgrade bool) string {
+	var message strings.Builder
+
+	message.WriteString("The following submissions will be deleted, download their results before then if you want to keep them:\n\n")
+
+	for _, expiring := range submissions {
+		sub := expiring.Submission
+
+		fmt.Fprintf(&message, "- %s (%s to %s, %s), deleted on %s\n", sub.ID, sub.SourceLanguage, sub.TargetLanguage, sub.Status, expiring.ExpiresAt.UTC().Format(time.RFC1123))
+		if sub.Status == submission.StatusDone {
+			fmt.Fprintf(&message, "  Download: %s/submissions/%s/download\n", s.apiURL, sub.ID)
+		}
+	}
+
+	if canUpgrade && s.upgradeURL != "" {
+		fmt.Fprintf(&message, "\nUpgrade your subscription to keep your submissions longer: %s\n", s.upgradeURL)
+	}
+
+	return message.String()
+}
+
+// GetUserNotifications returns the notifications of a user, latest first
+func (s *NotificationService) GetUserNotifications(userID uuid.UUID, unreadOnly bool, offset int, limit int) ([]*ent.Notification, int, error) {
+	query := s.databaseService.Notification.Query().
+		Where(notification.HasUserWith(user.ID(userID)))
+
+	if unreadOnly {
+		query = query.Where(notification.ReadAtIsNil())
+	}
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	notifications, err := query.
+		Order(ent.Desc(notification.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// MarkNotificationRead marks a notification of a user as read and returns
+// false if the user has no such notification
+func (s *NotificationService) MarkNotificationRead(userID uuid.UUID, notificationID uuid.UUID) (bool, error) {
+	updated, err := s.databaseService.Notification.Update().
+		Where(
+			notification.ID(notificationID),
+			notification.HasUserWith(user.ID(userID)),
+			notification.ReadAtIsNil(),
+		).
+		SetReadAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	if updated > 0 {
+		return true, nil
+	}
+
+	return s.databaseService.Notification.Query().
+		Where(
+			notification.ID(notificationID),
+			notification.HasUserWith(user.ID(userID)),
+		).
+		Exist(context.Background())
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"runtime"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/tereus-project/tereus-go-std/queue"
+)
+
+// QueueService publishes messages with the queue service of tereus-go-std and
+// consumes them with its own consumers, which can be stopped one by one
+// rather than only when closing the queue service
+type QueueService struct {
+	*queue.QueueService
+
+	nsqLookupdEndpoint string
+}
+
+func NewQueueService(nsqdEndpoint string, nsqLookupdEndpoint string) (*QueueService, error) {
+	queueService, err := queue.NewQueueService(nsqdEndpoint, nsqLookupdEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueueService{
+		QueueService:       queueService,
+		nsqLookupdEndpoint: nsqLookupdEndpoint,
+	}, nil
+}
+
+// QueueConsumer hands the messages of a channel to a handler until stopped
+type QueueConsumer struct {
+	consumer *nsq.Consumer
+}
+
+// Consume starts handling the messages of the channel of topic, a message is
+// requeued when handler returns an error
+func (s *QueueService) Consume(topic string, channel string, handler func(m *nsq.Message) error) (*QueueConsumer, error) {
+	consumer, err := nsq.NewConsumer(topic, channel, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	consumer.AddConcurrentHandlers(nsq.HandlerFunc(func(m *nsq.Message) error {
+		// Empty messages are discarded
+		if len(m.Body) == 0 {
+			return nil
+		}
+		return handler(m)
+	}), runtime.NumCPU())
+
+	err = consumer.ConnectToNSQLookupd(s.nsqLookupdEndpoint)
+	if err != nil {
+		consumer.Stop()
+		return nil, err
+	}
+
+	return &QueueConsumer{consumer: consumer}, nil
+}
+
+// Stop stops receiving messages and waits for the ones being handled
+func (c *QueueConsumer) Stop() {
+	c.consumer.Stop()
+	<-c.consumer.StopChan
+}
@@ -0,0 +1,419 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/pendingdeletion"
+	"github.com/tereus-project/tereus-api/ent/sourcefile"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+)
+
+// The object storage and the database drift apart when a request fails
+// between uploading the sources and creating the submission, or when objects
+// are lost. The reconciliation lists the objects of the submissions and
+// compares them to the database.
+
+// Minimum age of the objects of a prefix without submission before it is
+// reported, the sources are uploaded before the submission is created
+const reconciliationGracePeriod = 24 * time.Hour
+
+// Maximum number of submissions loaded per query
+const reconciliationBatchSize = 1000
+
+const missingResultsReason = "The results of the submission are missing from the storage"
+
+type OrphanPrefix struct {
+	Prefix       string
+	ObjectCount  int
+	Size         int64
+	LastModified time.Time
+}
+
+type SubmissionSizeMismatch struct {
+	SubmissionID          uuid.UUID
+	SourceSizeBytes       int
+	ActualSourceSizeBytes int
+	TargetSizeBytes       int
+	ActualTargetSizeBytes int
+}
+
+type ReconciliationReport struct {
+	// Prefixes of objects whose submission does not exist or is cleaned
+	OrphanPrefixes []OrphanPrefix
+	// Done submissions without output files
+	MissingResults []uuid.UUID
+	// Submissions whose sizes don't match their stored objects
+	SizeMismatches []SubmissionSizeMismatch
+	// Number of inconsistencies repaired, always 0 for a dry run
+	Repaired int
+}
+
+type ReconciliationService struct {
+	databaseService *DatabaseService
+	storageService  *StorageService
+}
+
+func NewReconciliationService(databaseService *DatabaseService, storageService *StorageService) *ReconciliationService {
+	return &ReconciliationService{
+		databaseService: databaseService,
+		storageService:  storageService,
+	}
+}
+
+// Objects stored under the prefix of a submission in one of the folders
+type storedPrefix struct {
+	OrphanPrefix
+	SubmissionID uuid.UUID
+}
+
+// Sizes of the objects of a submission by folder, the plaintext size of the
+// results is only meaningful if they are encrypted
+type storedSubmissionSizes struct {
+	WorkingCopySize  int64
+	HasWorkingCopy   bool
+	ResultsSize      int64
+	ResultsPlaintext int64
+	HasResults       bool
+}
+
+// ReconcileStorage looks for the objects without submission, the done
+// submissions without results and the submissions with wrong sizes. The
+// inconsistencies are repaired unless dryRun is set. It stops between the
+// batches once ctx is done
+func (s *ReconciliationService) ReconcileStorage(ctx context.Context, dryRun bool) (*ReconciliationReport, error) {
+	startedAt := time.Now()
+
+	report := &ReconciliationReport{
+		OrphanPrefixes: []OrphanPrefix{},
+		MissingResults: []uuid.UUID{},
+		SizeMismatches: []SubmissionSizeMismatch{},
+	}
+
+	prefixes, sizes, err := s.listSubmissionObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reconcileOrphanPrefixes(ctx, report, prefixes, startedAt, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reconcileSubmissions(ctx, report, sizes, startedAt, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// List the objects of the submissions grouped by prefix, and their sizes per
+// submission
+func (s *ReconciliationService) listSubmissionObjects(ctx context.Context) ([]*storedPrefix, map[uuid.UUID]*storedSubmissionSizes, error) {
+	prefixes := []*storedPrefix{}
+	sizes := map[uuid.UUID]*storedSubmissionSizes{}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for _, folder := range submissionObjectPrefixes {
+		var current *storedPrefix
+
+		for object := range s.storageService.backend.ListObjects(ctx, folder) {
+			if object.Err != nil {
+				return nil, nil, object.Err
+			}
+
+			name := strings.TrimPrefix(object.Path, folder)
+			if i := strings.Index(name, "/"); i >= 0 {
+				name = name[:i]
+			}
+
+			// The archives are stored as transpilations-archives/<id>.zip
+			id, err := uuid.Parse(strings.TrimSuffix(name, ".zip"))
+			if err != nil {
+				continue
+			}
+
+			// The objects are listed by path so the objects of a prefix follow each other
+			if current == nil || current.SubmissionID != id {
+				current = &storedPrefix{
+					OrphanPrefix: OrphanPrefix{Prefix: folder + id.String()},
+					SubmissionID: id,
+				}
+				prefixes = append(prefixes, current)
+			}
+
+			current.ObjectCount++
+			current.Size += object.Size
+			if object.LastModified.After(current.LastModified) {
+				current.LastModified = object.LastModified
+			}
+
+			if sizes[id] == nil {
+				sizes[id] = &storedSubmissionSizes{}
+			}
+
+			switch folder {
+			case "transpilations/":
+				sizes[id].HasWorkingCopy = true
+				sizes[id].WorkingCopySize += object.Size
+			case "transpilations-results/":
+				sizes[id].HasResults = true
+				sizes[id].ResultsSize += object.Size
+				sizes[id].ResultsPlaintext += DecryptedSize(object.Size)
+			}
+		}
+
+		// The listing ends early once ctx is done, an incomplete listing
+		// would report stored objects as missing
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return prefixes, sizes, nil
+}
+
+// Report the prefixes whose submission does not exist or is cleaned and
+// queue their deletion
+func (s *ReconciliationService) reconcileOrphanPrefixes(ctx context.Context, report *ReconciliationReport, prefixes []*storedPrefix, startedAt time.Time, dryRun bool) error {
+	for start := 0; start < len(prefixes); start += reconciliationBatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := start + reconciliationBatchSize
+		if end > len(prefixes) {
+			end = len(prefixes)
+		}
+		batch := prefixes[start:end]
+
+		ids := make([]uuid.UUID, len(batch))
+		paths := make([]string, len(batch))
+		for i, prefix := range batch {
+			ids[i] = prefix.SubmissionID
+			paths[i] = prefix.Prefix
+		}
+
+		// Submissions still stored, the cleaned ones should have no objects
+		stored, err := s.databaseService.Submission.Query().
+			Where(
+				submission.IDIn(ids...),
+				submission.StatusNEQ(submission.StatusCleaned),
+			).
+			IDs(context.Background())
+		if err != nil {
+			return err
+		}
+
+		// The sources of upload sessions are stored before the submission is created
+		uploading, err := s.databaseService.UploadSession.Query().
+			Where(uploadsession.IDIn(ids...)).
+			IDs(context.Background())
+		if err != nil {
+			return err
+		}
+
+		queued, err := s.databaseService.PendingDeletion.Query().
+			Where(pendingdeletion.PrefixIn(paths...)).
+			Select(pendingdeletion.FieldPrefix).
+			Strings(context.Background())
+		if err != nil {
+			return err
+		}
+
+		skipped := map[string]bool{}
+		for _, id := range append(stored, uploading...) {
+			skipped[id.String()] = true
+		}
+		for _, path := range queued {
+			skipped[path] = true
+		}
+
+		for _, prefix := range batch {
+			if skipped[prefix.SubmissionID.String()] || skipped[prefix.Prefix] || prefix.LastModified.After(startedAt.Add(-reconciliationGracePeriod)) {
+				continue
+			}
+
+			report.OrphanPrefixes = append(report.OrphanPrefixes, prefix.OrphanPrefix)
+
+			if dryRun {
+				continue
+			}
+
+			err = s.databaseService.PendingDeletion.Create().
+				SetPrefix(prefix.Prefix).
+				Exec(context.Background())
+			if err != nil {
+				logrus.WithError(err).WithField("prefix", prefix.Prefix).Error("Failed to queue orphan prefix deletion")
+				continue
+			}
+
+			report.Repaired++
+		}
+	}
+
+	return nil
+}
+
+// Report the done submissions without results and the processed submissions
+// whose sizes don't match their objects, then fail the former and fix the
+// sizes of the latter
+func (s *ReconciliationService) reconcileSubmissions(ctx context.Context, report *ReconciliationReport, sizes map[uuid.UUID]*storedSubmissionSizes, startedAt time.Time, dryRun bool) error {
+	after := uuid.Nil
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// Only the submissions processed before the objects were listed
+		submissions, err := s.databaseService.Submission.Query().
+			Where(
+				submission.IDGT(after),
+				submission.StatusIn(submission.StatusDone, submission.StatusFailed),
+				submission.ProcessingFinishedAtLT(startedAt),
+			).
+			Order(ent.Asc(submission.FieldID)).
+			Limit(reconciliationBatchSize).
+			All(context.Background())
+		if err != nil {
+			return err
+		}
+
+		if len(submissions) == 0 {
+			return nil
+		}
+
+		after = submissions[len(submissions)-1].ID
+
+		manifestSizes, err := s.getManifestSizes(submissions)
+		if err != nil {
+			return err
+		}
+
+		for _, sub := range submissions {
+			stored := sizes[sub.ID]
+			if stored == nil {
+				stored = &storedSubmissionSizes{}
+			}
+
+			if sub.Status == submission.StatusDone && !stored.HasResults {
+				// Nothing to check without a recorded results size, such as for
+				// the submissions processed before the sizes were recorded
+				if sub.SubmissionTargetSizeBytes == 0 {
+					continue
+				}
+
+				report.MissingResults = append(report.MissingResults, sub.ID)
+
+				if !dryRun {
+					s.failSubmissionWithoutResults(report, sub)
+				}
+				continue
+			}
+
+			mismatch := SubmissionSizeMismatch{
+				SubmissionID:          sub.ID,
+				SourceSizeBytes:       sub.SubmissionSourceSizeBytes,
+				ActualSourceSizeBytes: sub.SubmissionSourceSizeBytes,
+				TargetSizeBytes:       sub.SubmissionTargetSizeBytes,
+				ActualTargetSizeBytes: sub.SubmissionTargetSizeBytes,
+			}
+
+			// The sources are kept as they are if they can't be found
+			if size, ok := manifestSizes[sub.ID]; ok {
+				mismatch.ActualSourceSizeBytes = int(size)
+			} else if stored.HasWorkingCopy {
+				mismatch.ActualSourceSizeBytes = int(stored.WorkingCopySize)
+			}
+
+			if sub.Status == submission.StatusDone {
+				if sub.ResultsEncrypted {
+					mismatch.ActualTargetSizeBytes = int(stored.ResultsPlaintext)
+				} else {
+					mismatch.ActualTargetSizeBytes = int(stored.ResultsSize)
+				}
+			}
+
+			if mismatch.ActualSourceSizeBytes == mismatch.SourceSizeBytes && mismatch.ActualTargetSizeBytes == mismatch.TargetSizeBytes {
+				continue
+			}
+
+			report.SizeMismatches = append(report.SizeMismatches, mismatch)
+
+			if dryRun {
+				continue
+			}
+
+			err = s.databaseService.Submission.UpdateOne(sub).
+				SetSubmissionSourceSizeBytes(mismatch.ActualSourceSizeBytes).
+				SetSubmissionTargetSizeBytes(mismatch.ActualTargetSizeBytes).
+				Exec(context.Background())
+			if err != nil {
+				logrus.WithError(err).WithField("id", sub.ID).Error("Failed to fix submission sizes")
+				continue
+			}
+
+			report.Repaired++
+		}
+	}
+}
+
+// Get the total size of the manifests of submissions, the submissions
+// without manifest are left out
+func (s *ReconciliationService) getManifestSizes(submissions []*ent.Submission) (map[uuid.UUID]int64, error) {
+	ids := make([]uuid.UUID, len(submissions))
+	for i, sub := range submissions {
+		ids[i] = sub.ID
+	}
+
+	var rows []struct {
+		SubmissionID uuid.UUID `json:"submission_id"`
+		Sum          int64     `json:"sum"`
+	}
+
+	err := s.databaseService.SourceFile.Query().
+		Where(sourcefile.SubmissionIDIn(ids...)).
+		GroupBy(sourcefile.FieldSubmissionID).
+		Aggregate(ent.Sum(sourcefile.FieldSize)).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := map[uuid.UUID]int64{}
+	for _, row := range rows {
+		sizes[row.SubmissionID] = row.Sum
+	}
+
+	return sizes, nil
+}
+
+// Mark a done submission whose results are lost as failed so that it is not
+// listed as downloadable anymore and can be rerun
+func (s *ReconciliationService) failSubmissionWithoutResults(report *ReconciliationReport, sub *ent.Submission) {
+	updated, err := s.databaseService.Submission.Update().
+		Where(
+			submission.ID(sub.ID),
+			submission.StatusEQ(submission.StatusDone),
+		).
+		SetStatus(submission.StatusFailed).
+		SetReason(missingResultsReason).
+		SetSubmissionTargetSizeBytes(0).
+		SetHasMapping(false).
+		Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("id", sub.ID).Error("Failed to fail submission without results")
+		return
+	}
+
+	report.Repaired += updated
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// GetSubmissionInputHash returns the key under which the results of a
+// submission whose sources are stored are cached, along with the version of
+// its transpiler. The key is empty when the results can't be cached
+func (s *SubmissionService) GetSubmissionInputHash(id string, sourceLanguage string, targetLanguage string) (string, string, error) {
+	languagePairDetails, err := s.GetLanguagePairDetails(sourceLanguage, targetLanguage)
+	if err != nil {
+		return "", "", err
+	}
+
+	if languagePairDetails.TranspilerVersion == "" {
+		return "", "", nil
+	}
+
+	manifest, err := s.storageService.getSubmissionManifest(id)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(manifest) == 0 {
+		return "", languagePairDetails.TranspilerVersion, nil
+	}
+
+	hasher := sha256.New()
+	fmt.Fprintf(hasher, "%s\n%s\n%s\n", sourceLanguage, targetLanguage, languagePairDetails.TranspilerVersion)
+
+	// The manifest is ordered by path
+	for _, file := range manifest {
+		fmt.Fprintf(hasher, "%s\x00%s\n", file.Path, file.Edges.Blob.ID)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), languagePairDetails.TranspilerVersion, nil
+}
+
+// FindCachedSubmission returns the latest successful submission of a user
+// with the given input hash, or nil if there is none. The results of the
+// other users are never reused, they are encrypted with their data keys and
+// would become unreadable once their account is deleted
+func (s *SubmissionService) FindCachedSubmission(userID uuid.UUID, inputHash string) (*ent.Submission, error) {
+	cached, err := s.databaseService.Submission.Query().
+		Where(
+			submission.HasUserWith(user.ID(userID)),
+			submission.InputHash(inputHash),
+			submission.StatusEQ(submission.StatusDone),
+		).
+		Order(ent.Desc(submission.FieldProcessingFinishedAt)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return cached, nil
+}
+
+// CopySubmissionDiagnostics copies the diagnostics of a submission to another one
+func (s *SubmissionService) CopySubmissionDiagnostics(sourceID uuid.UUID, destinationID uuid.UUID) error {
+	diagnostics, err := s.databaseService.Diagnostic.Query().
+		Where(diagnostic.HasSubmissionWith(submission.ID(sourceID))).
+		All(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	diagnosticCreations := make([]*ent.DiagnosticCreate, len(diagnostics))
+	for i, d := range diagnostics {
+		diagnosticCreation := s.databaseService.Diagnostic.Create().
+			SetSubmissionID(destinationID).
+			SetSeverity(d.Severity).
+			SetMessage(d.Message)
+
+		if d.File != "" {
+			diagnosticCreation.SetFile(d.File)
+		}
+		if d.Line > 0 {
+			diagnosticCreation.SetLine(d.Line)
+		}
+		if d.Column > 0 {
+			diagnosticCreation.SetColumn(d.Column)
+		}
+		if d.Code != "" {
+			diagnosticCreation.SetCode(d.Code)
+		}
+
+		diagnosticCreations[i] = diagnosticCreation
+	}
+
+	return s.databaseService.Diagnostic.CreateBulk(diagnosticCreations...).Exec(context.Background())
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/predicate"
+	"github.com/tereus-project/tereus-api/ent/submission"
+	"github.com/tereus-project/tereus-api/ent/subscription"
+	"github.com/tereus-project/tereus-api/ent/user"
+)
+
+// Statuses a submission does not leave anymore, except to be cleaned
+var TerminalSubmissionStatuses = []submission.Status{
+	submission.StatusDone,
+	submission.StatusFailed,
+	submission.StatusCancelled,
+}
+
+func isTerminalSubmissionStatus(status submission.Status) bool {
+	for _, terminalStatus := range TerminalSubmissionStatuses {
+		if status == terminalStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RetentionPolicy is how long the submissions are kept after their creation
+// per terminal status. The submissions of a status without retention are kept
+// until deleted by their owner
+type RetentionPolicy map[submission.Status]time.Duration
+
+// ParseRetentionPolicy parses a policy such as "done=7d,failed=24h".
+// The durations accept a "d" unit for days on top of the time.ParseDuration units
+func ParseRetentionPolicy(value string) (RetentionPolicy, error) {
+	policy := RetentionPolicy{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		status, duration, ok := strings.Cut(part, "=")
+		status = strings.ToLower(strings.TrimSpace(status))
+		if !ok || !isTerminalSubmissionStatus(submission.Status(status)) {
+			return nil, fmt.Errorf("invalid retention %q: expected status=duration with a status among %v", part, TerminalSubmissionStatuses)
+		}
+
+		retention, err := parseRetentionDuration(strings.TrimSpace(duration))
+		if err != nil || retention <= 0 {
+			return nil, fmt.Errorf("invalid retention %q: duration must be positive, such as 24h or 30d", part)
+		}
+
+		policy[submission.Status(status)] = retention
+	}
+
+	return policy, nil
+}
+
+func parseRetentionDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// MaxRetention returns the longest retention of the policy, or false if the
+// submissions of some terminal status are kept
+func (p RetentionPolicy) MaxRetention() (time.Duration, bool) {
+	max := time.Duration(0)
+
+	for _, status := range TerminalSubmissionStatuses {
+		retention, ok := p[status]
+		if !ok {
+			return 0, false
+		}
+
+		if retention > max {
+			max = retention
+		}
+	}
+
+	return max, true
+}
+
+// GetSubmissionExpiration returns when a submission will be cleaned, or nil
+// if it is kept or not processed yet
+func (p RetentionPolicy) GetSubmissionExpiration(sub *ent.Submission) *time.Time {
+	if sub.Status == submission.StatusCleaned {
+		return nil
+	}
+
+	if sub.ExpiresAt != nil {
+		return sub.ExpiresAt
+	}
+
+	retention, ok := p[sub.Status]
+	if !ok {
+		return nil
+	}
+
+	expiresAt := sub.CreatedAt.Add(retention)
+	return &expiresAt
+}
+
+// CheckSubmissionExpiration checks that a submission may be set to expire at
+// the given time, it can't be kept longer than the policy allows
+func (p RetentionPolicy) CheckSubmissionExpiration(sub *ent.Submission, expiresAt time.Time) error {
+	if !expiresAt.After(time.Now()) {
+		return fmt.Errorf("the expiration must be in the future")
+	}
+
+	if max, ok := p.MaxRetention(); ok && expiresAt.After(sub.CreatedAt.Add(max)) {
+		return fmt.Errorf("the submission can't be kept after %s", sub.CreatedAt.Add(max).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+type RetentionService struct {
+	databaseService     *DatabaseService
+	subscriptionService *SubscriptionService
+	notificationService *NotificationService
+	tierPolicies        map[subscription.Tier]RetentionPolicy
+
+	// How long before their cleanup the owners are warned
+	warningLeadTime time.Duration
+}
+
+func NewRetentionService(databaseService *DatabaseService, subscriptionService *SubscriptionService, notificationService *NotificationService, tierPolicies map[subscription.Tier]RetentionPolicy, warningLeadTime time.Duration) *RetentionService {
+	return &RetentionService{
+		databaseService:     databaseService,
+		subscriptionService: subscriptionService,
+		notificationService: notificationService,
+		tierPolicies:        tierPolicies,
+		warningLeadTime:     warningLeadTime,
+	}
+}
+
+// GetUserRetentionPolicy returns the retention policy of the tier of a user
+func (s *RetentionService) GetUserRetentionPolicy(userID uuid.UUID) (RetentionPolicy, error) {
+	tier, err := s.subscriptionService.GetUserTier(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tierPolicies[tier], nil
+}
+
+// Select the submissions whose owner is in a tier, the same way as
+// SubscriptionService.GetUserTier
+func submissionOfTier(tier subscription.Tier, now time.Time) predicate.Submission {
+	ownerSubscription := func(tier predicate.Subscription) predicate.Submission {
+		return submission.HasUserWith(user.HasSubscriptionWith(
+			tier,
+			activeSubscription(now),
+		))
+	}
+
+	if tier == subscription.TierFree {
+		return submission.Not(ownerSubscription(subscription.TierNEQ(subscription.TierFree)))
+	}
+
+	return ownerSubscription(subscription.TierEQ(tier))
+}
+
+// Select the submissions in a terminal status whose expiration, chosen by
+// their owner or set by the policy of their tier, is before t
+func (s *RetentionService) submissionExpiringBefore(t time.Time) predicate.Submission {
+	now := time.Now()
+
+	predicates := []predicate.Submission{
+		submission.ExpiresAtLT(t),
+	}
+
+	for tier, policy := range s.tierPolicies {
+		for status, retention := range policy {
+			predicates = append(predicates, submission.And(
+				submission.ExpiresAtIsNil(),
+				submission.StatusEQ(status),
+				submission.CreatedAtLT(t.Add(-retention)),
+				submissionOfTier(tier, now),
+			))
+		}
+	}
+
+	return submission.And(
+		submission.StatusIn(TerminalSubmissionStatuses...),
+		submission.Or(predicates...),
+	)
+}
+
+// GetExpiredSubmissions returns the submissions in a terminal status whose
+// expiration passed. A submission is only returned once its owner was warned
+// at least the warning lead time ago, the cleanup is delayed otherwise
+func (s *RetentionService) GetExpiredSubmissions() ([]*ent.Submission, error) {
+	now := time.Now()
+
+	return s.databaseService.Submission.Query().
+		Where(
+			s.submissionExpiringBefore(now),
+			submission.RetentionWarnedAtLTE(now.Add(-s.warningLeadTime)),
+		).
+		All(context.Background())
+}
+
+// WarnExpiringSubmissions notifies the owners of the submissions cleaned
+// within the warning lead time, once per submission, and returns how many
+// users were notified. A user who can't be notified is logged and warned again
+// on the next call
+func (s *RetentionService) WarnExpiringSubmissions() (int, error) {
+	now := time.Now()
+
+	submissions, err := s.databaseService.Submission.Query().
+		Where(
+			submission.RetentionWarnedAtIsNil(),
+			s.submissionExpiringBefore(now.Add(s.warningLeadTime)),
+		).
+		WithUser().
+		Order(ent.Asc(submission.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	users := []*ent.User{}
+	userSubmissions := map[uuid.UUID][]*ent.Submission{}
+	for _, sub := range submissions {
+		owner := sub.Edges.User
+		if _, ok := userSubmissions[owner.ID]; !ok {
+			users = append(users, owner)
+		}
+		userSubmissions[owner.ID] = append(userSubmissions[owner.ID], sub)
+	}
+
+	notified := 0
+	for _, owner := range users {
+		tier, err := s.subscriptionService.GetUserTier(owner.ID)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", owner.ID).Error("Failed to get user tier")
+			continue
+		}
+
+		// The submissions are not cleaned before the lead time elapsed since
+		// the warning, even if their expiration is closer or already passed
+		earliestCleanup := now.Add(s.warningLeadTime)
+
+		expiring := []ExpiringSubmission{}
+		for _, sub := range userSubmissions[owner.ID] {
+			expiresAt := s.tierPolicies[tier].GetSubmissionExpiration(sub)
+			if expiresAt == nil {
+				continue
+			}
+
+			if expiresAt.Before(earliestCleanup) {
+				expiresAt = &earliestCleanup
+			}
+
+			expiring = append(expiring, ExpiringSubmission{
+				Submission: sub,
+				ExpiresAt:  *expiresAt,
+			})
+		}
+
+		err = s.notificationService.SendRetentionWarning(owner, expiring, tier != subscription.TierEnterprise)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", owner.ID).Error("Failed to warn user of expiring submissions")
+			continue
+		}
+
+		notified++
+	}
+
+	return notified, nil
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tereus-project/tereus-api/ent/submission"
+)
+
+func TestParseRetentionPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    RetentionPolicy
+		wantErr bool
+	}{
+		{name: "empty keeps everything", value: "", want: RetentionPolicy{}},
+		{
+			name:  "days and durations",
+			value: "done=7d,failed=24h,cancelled=90m",
+			want: RetentionPolicy{
+				submission.StatusDone:      7 * 24 * time.Hour,
+				submission.StatusFailed:    24 * time.Hour,
+				submission.StatusCancelled: 90 * time.Minute,
+			},
+		},
+		{
+			name:  "case and spaces",
+			value: " Done = 1d ,,",
+			want:  RetentionPolicy{submission.StatusDone: 24 * time.Hour},
+		},
+		{name: "non terminal status", value: "pending=1d", wantErr: true},
+		{name: "unknown status", value: "archived=1d", wantErr: true},
+		{name: "missing duration", value: "done", wantErr: true},
+		{name: "empty duration", value: "done=", wantErr: true},
+		{name: "zero duration", value: "done=0d", wantErr: true},
+		{name: "negative duration", value: "done=-1h", wantErr: true},
+		{name: "invalid days", value: "done=xd", wantErr: true},
+		{name: "invalid unit", value: "done=1w", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRetentionPolicy(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseRetentionPolicy(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseRetentionPolicy(%q) failed: %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRetentionPolicy(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetentionPolicyMaxRetention(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetentionPolicy
+		want   time.Duration
+		wantOk bool
+	}{
+		{name: "empty", policy: RetentionPolicy{}, wantOk: false},
+		{
+			name:   "some status kept",
+			policy: RetentionPolicy{submission.StatusDone: time.Hour, submission.StatusFailed: time.Hour},
+			wantOk: false,
+		},
+		{
+			name: "every terminal status",
+			policy: RetentionPolicy{
+				submission.StatusDone:      time.Hour,
+				submission.StatusFailed:    3 * time.Hour,
+				submission.StatusCancelled: 2 * time.Hour,
+			},
+			want:   3 * time.Hour,
+			wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.policy.MaxRetention()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("MaxRetention() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -1,67 +1,261 @@
 package services
 
 import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
 
-	"github.com/minio/minio-go/v7"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
-	"github.com/tereus-project/tereus-go-std/s3"
+	"github.com/tereus-project/tereus-api/ent/submission"
 )
 
+// StorageService stores the submissions in a storage backend
 type StorageService struct {
-	s3Service *s3.S3Service
+	backend StorageBackend
+
+	// Holds the manifests of the submission sources and the blob references
+	databaseService *DatabaseService
+	// Encrypts the stored sources, results and archives. The working copy
+	// read by the transpilers is kept in plaintext
+	encryptionService *EncryptionService
+}
+
+func NewStorageService(backend StorageBackend, databaseService *DatabaseService, encryptionService *EncryptionService) *StorageService {
+	return &StorageService{
+		backend:           backend,
+		databaseService:   databaseService,
+		encryptionService: encryptionService,
+	}
+}
+
+// GetObject opens an object by its full path, decrypting it if needed.
+// It returns ErrObjectNotFound if there is no such object
+func (s *StorageService) GetObject(path string) (io.ReadSeekCloser, error) {
+	object, _, err := s.openObject(path)
+	return object, err
 }
 
-func NewStorageService(endpoint string, accessKey string, secretKey string, bucket string, secure bool) (*StorageService, error) {
-	s3Service, err := s3.NewS3Service(endpoint, accessKey, secretKey, bucket, secure)
+// Open an object decrypting it if needed, the returned size is the plaintext size
+func (s *StorageService) openObject(path string) (io.ReadSeekCloser, ObjectInfo, error) {
+	object, info, err := s.backend.GetObject(path)
 	if err != nil {
-		return nil, err
+		return nil, ObjectInfo{}, err
 	}
 
-	err = s3Service.MakeBucketIfNotExists(bucket)
+	reader, size, err := s.encryptionService.DecryptObject(object, info.Size)
 	if err != nil {
-		return nil, err
+		object.Close()
+		return nil, ObjectInfo{}, err
 	}
 
-	return &StorageService{
-		s3Service: s3Service,
-	}, nil
+	info.Size = size
+	return reader, info, nil
+}
+
+// Store an object encrypted by key, or in plaintext if key is nil
+func (s *StorageService) putObject(path string, reader io.Reader, size int64, key *DataKey) error {
+	if key != nil {
+		var err error
+		reader, size, err = newEncryptingReader(key, reader, size)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := s.backend.PutObject(path, reader, size)
+	return err
+}
+
+func getSubmissionObjectPath(submissionId string, path string) string {
+	return fmt.Sprintf("transpilations/%s/%s", submissionId, path)
+}
+
+// PresignSubmissionObjectUpload returns a URL the client can PUT a source
+// file of a submission to
+func (s *StorageService) PresignSubmissionObjectUpload(submissionId string, path string, expires time.Duration) (string, error) {
+	return s.backend.PresignPutObject(getSubmissionObjectPath(submissionId, path), expires)
+}
+
+// NewSubmissionObjectMultipartUpload starts a multipart upload for a source
+// file of a submission and returns its upload ID
+func (s *StorageService) NewSubmissionObjectMultipartUpload(submissionId string, path string) (string, error) {
+	return s.backend.NewMultipartUpload(getSubmissionObjectPath(submissionId, path))
+}
+
+// PresignSubmissionObjectPartUpload returns a URL the client can PUT a part
+// of a multipart upload to. Part numbers start at 1
+func (s *StorageService) PresignSubmissionObjectPartUpload(submissionId string, path string, uploadId string, partNumber int, expires time.Duration) (string, error) {
+	return s.backend.PresignPartUpload(getSubmissionObjectPath(submissionId, path), uploadId, partNumber, expires)
 }
 
-func (s *StorageService) GetObject(path string) (*minio.Object, error) {
-	return s.s3Service.GetObject(path)
+// CompleteSubmissionObjectMultipartUpload assembles the uploaded parts of a
+// multipart upload
+func (s *StorageService) CompleteSubmissionObjectMultipartUpload(submissionId string, path string, uploadId string) error {
+	err := s.backend.CompleteMultipartUpload(getSubmissionObjectPath(submissionId, path), uploadId)
+	if err == ErrObjectNotFound {
+		return ErrSubmissionFileNotFound
+	}
+
+	return err
 }
 
-func (s *StorageService) GetObjects(path string) <-chan *s3.GetObjectsResult {
-	return s.s3Service.GetObjects(path)
+// AbortSubmissionObjectMultipartUpload discards the uploaded parts of a
+// multipart upload
+func (s *StorageService) AbortSubmissionObjectMultipartUpload(submissionId string, path string, uploadId string) error {
+	return s.backend.AbortMultipartUpload(getSubmissionObjectPath(submissionId, path), uploadId)
 }
 
-func (s *StorageService) PutSubmissionObject(submissionId string, path string, reader io.Reader, size int64) (info minio.UploadInfo, err error) {
-	return s.s3Service.PutObject(fmt.Sprintf("transpilations/%s/%s", submissionId, path), reader, size)
+// PutSubmissionObject stores a source file of a submission owned by a user
+// and adds it to the manifest of the submission
+func (s *StorageService) PutSubmissionObject(userID uuid.UUID, submissionId string, path string, reader io.Reader, size int64) error {
+	key, err := s.encryptionService.GetUserDataKey(userID)
+	if err != nil {
+		return err
+	}
+
+	hasher := sha256.New()
+
+	info, err := s.backend.PutObject(getSubmissionObjectPath(submissionId, path), io.TeeReader(reader, hasher), size)
+	if err != nil {
+		return err
+	}
+
+	return s.addSubmissionSource(submissionId, path, getBlobID(key, hasher.Sum(nil)), info.Size, key)
 }
 
-func (s *StorageService) ListSubmissionFiles(submissionID string) <-chan *s3.GetObjectsResult {
-	ch := make(chan *s3.GetObjectsResult)
+// CopySubmissionSources copies the source objects of a submission to another
+// one of the same user and returns the copied size. The objects are copied
+// without leaving the object storage unless they must be decrypted
+func (s *StorageService) CopySubmissionSources(userID uuid.UUID, sourceSubmissionId string, destinationSubmissionId string) (int64, error) {
+	key, err := s.encryptionService.GetUserDataKey(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	manifest, err := s.getSubmissionManifest(sourceSubmissionId)
+	if err != nil {
+		return 0, err
+	}
+
+	type sourceObject struct {
+		Path         string
+		RelativePath string
+		Size         int64
+		Encrypted    bool
+	}
+
+	objects := []sourceObject{}
+
+	if len(manifest) > 0 {
+		for _, file := range manifest {
+			objects = append(objects, sourceObject{
+				Path:         getBlobPath(file.Edges.Blob.ID),
+				RelativePath: file.Path,
+				Size:         file.Size,
+				Encrypted:    file.Edges.Blob.Encrypted,
+			})
+		}
+	} else {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 
-	go func() {
-		defer close(ch)
-		for _, path := range []string{"transpilations/", "transpilations-results/"} {
-			objects := s.s3Service.GetObjects(path + submissionID)
+		sourcePrefix := fmt.Sprintf("transpilations/%s/", sourceSubmissionId)
 
-			for object := range objects {
-				ch <- object
+		for object := range s.backend.ListObjects(ctx, sourcePrefix) {
+			if object.Err != nil {
+				return 0, object.Err
 			}
+
+			objects = append(objects, sourceObject{
+				Path:         object.Path,
+				RelativePath: strings.TrimPrefix(object.Path, sourcePrefix),
+				Size:         object.Size,
+			})
+		}
+	}
+
+	size := int64(0)
+
+	for _, object := range objects {
+		destinationPath := getSubmissionObjectPath(destinationSubmissionId, object.RelativePath)
+
+		// The transpilers read the working copy in plaintext
+		if object.Encrypted {
+			err = s.copyDecryptedObject(object.Path, destinationPath)
+		} else {
+			err = s.backend.CopyObject(object.Path, destinationPath)
+		}
+		if err != nil {
+			return size, err
+		}
+
+		if len(manifest) > 0 {
+			err = s.addSubmissionSource(destinationSubmissionId, object.RelativePath, path.Base(object.Path), object.Size, key)
+		} else {
+			err = s.IngestSubmissionObject(userID, destinationSubmissionId, object.RelativePath)
+		}
+		if err != nil {
+			return size, err
 		}
-	}()
 
-	return ch
+		size += object.Size
+	}
+
+	return size, nil
 }
 
-func (s *StorageService) DeleteSubmission(id string) error {
-	logrus.WithField("id", id).Debug("Deleting submission from S3")
-	for _, path := range []string{"transpilations/", "transpilations-results/"} {
-		err := s.s3Service.RemoveObjects(path + id)
+func (s *StorageService) copyDecryptedObject(sourcePath string, destinationPath string) error {
+	reader, info, err := s.openObject(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	_, err = s.backend.PutObject(destinationPath, reader, info.Size)
+	return err
+}
+
+func getResumableUploadChunkPath(uploadId string, chunk string) string {
+	return fmt.Sprintf("tus-uploads/%s/%s", uploadId, chunk)
+}
+
+// PutResumableUploadChunk stores a chunk of a resumable upload received at the
+// given offset under a unique name, so that concurrent requests for the same
+// offset don't overwrite each other. Return the name of the chunk
+func (s *StorageService) PutResumableUploadChunk(uploadId string, offset int64, reader io.Reader, size int64) (string, error) {
+	chunk := fmt.Sprintf("%020d-%s", offset, uuid.New())
+
+	_, err := s.backend.PutObject(getResumableUploadChunkPath(uploadId, chunk), reader, size)
+	if err != nil {
+		return "", err
+	}
+
+	return chunk, nil
+}
+
+func (s *StorageService) DeleteResumableUploadChunk(uploadId string, chunk string) error {
+	return s.backend.RemoveObject(getResumableUploadChunkPath(uploadId, chunk))
+}
+
+// WriteResumableUpload writes the given chunks of a resumable upload to w in order
+func (s *StorageService) WriteResumableUpload(w io.Writer, uploadId string, chunks []string) error {
+	for _, chunk := range chunks {
+		reader, _, err := s.backend.GetObject(getResumableUploadChunkPath(uploadId, chunk))
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(w, reader)
+		reader.Close()
 		if err != nil {
 			return err
 		}
@@ -70,20 +264,89 @@ func (s *StorageService) DeleteSubmission(id string) error {
 	return nil
 }
 
-func (s *StorageService) SizeofObjects(prefix string) int64 {
-	size := int64(0)
+func (s *StorageService) DeleteResumableUpload(uploadId string) error {
+	logrus.WithField("id", uploadId).Debug("Deleting resumable upload from storage")
+	return s.backend.RemoveObjects(fmt.Sprintf("tus-uploads/%s/", uploadId))
+}
 
-	for object := range s.s3Service.GetObjects(prefix) {
-		size += object.Size
+// CopySubmissionResults copies the output files and mapping artifacts of a
+// submission to another one without leaving the object storage
+func (s *StorageService) CopySubmissionResults(sourceSubmissionId string, destinationSubmissionId string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, folder := range []string{"transpilations-results/", "transpilations-mappings/"} {
+		sourcePrefix := fmt.Sprintf("%s%s/", folder, sourceSubmissionId)
+
+		for object := range s.backend.ListObjects(ctx, sourcePrefix) {
+			if object.Err != nil {
+				return object.Err
+			}
+
+			err := s.backend.CopyObject(object.Path, fmt.Sprintf("%s%s/%s", folder, destinationSubmissionId, strings.TrimPrefix(object.Path, sourcePrefix)))
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	return size
+	return nil
 }
 
-// Set a tag for the objects to be deleted by Lifecycle later on
-func (s *StorageService) ScheduleForDeletion(id string) error {
-	for _, path := range []string{"transpilations/", "transpilations-results/"} {
-		err := s.s3Service.ScheduleForDeletion(path + id)
+// EncryptSubmissionResults encrypts in place the output files and mapping
+// artifacts written in plaintext by the transpiler for a submission owned by a
+// user. It returns false if the encryption is disabled
+func (s *StorageService) EncryptSubmissionResults(userID uuid.UUID, submissionId string) (bool, error) {
+	key, err := s.encryptionService.GetUserDataKey(userID)
+	if err != nil || key == nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, folder := range []string{"transpilations-results/", "transpilations-mappings/"} {
+		for object := range s.backend.ListObjects(ctx, fmt.Sprintf("%s%s/", folder, submissionId)) {
+			if object.Err != nil {
+				return false, object.Err
+			}
+
+			err := s.encryptObject(object.Path, key)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// Replace a plaintext object by its encrypted content
+func (s *StorageService) encryptObject(path string, key *DataKey) error {
+	reader, info, err := s.backend.GetObject(path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	encrypted, err := isEncryptedObject(reader)
+	if err != nil || encrypted {
+		return err
+	}
+
+	_, err = reader.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	return s.putObject(path, reader, info.Size, key)
+}
+
+// DeleteSubmissionResults removes the output files, mapping artifacts and
+// archive of a submission while keeping its sources
+func (s *StorageService) DeleteSubmissionResults(id string) error {
+	for _, path := range []string{"transpilations-results/", "transpilations-mappings/", "transpilations-archives/"} {
+		err := s.backend.RemoveObjects(path + id)
 		if err != nil {
 			return err
 		}
@@ -91,3 +354,317 @@ func (s *StorageService) ScheduleForDeletion(id string) error {
 
 	return nil
 }
+
+type SubmissionFileType string
+
+const (
+	SubmissionFileTypeSource  SubmissionFileType = "source"
+	SubmissionFileTypeOutput  SubmissionFileType = "output"
+	SubmissionFileTypeMapping SubmissionFileType = "mapping"
+)
+
+// Name of the line mapping artifact transpilers can upload with the results
+const SubmissionMappingFileName = "mapping.json"
+
+type SubmissionFile struct {
+	// Full object key in the bucket, the sources listed in a manifest are
+	// stored as blobs and their key is not under the submission folder
+	Path string
+	// Key of the file under the submission folder whatever object it is stored in
+	FolderPath string
+	// Path relative to the submission source or output folder
+	RelativePath string
+	Type         SubmissionFileType
+	Size         int64
+	Checksum     string
+}
+
+var submissionFileTypePrefixes = []struct {
+	Type   SubmissionFileType
+	Prefix string
+}{
+	{SubmissionFileTypeSource, "transpilations/"},
+	{SubmissionFileTypeOutput, "transpilations-results/"},
+	{SubmissionFileTypeMapping, "transpilations-mappings/"},
+}
+
+var ErrSubmissionFileNotFound = errors.New("submission file not found")
+
+func getSubmissionFilePrefix(fileType SubmissionFileType) (string, bool) {
+	for _, folder := range submissionFileTypePrefixes {
+		if folder.Type == fileType {
+			return folder.Prefix, true
+		}
+	}
+
+	return "", false
+}
+
+// Get the object key of a single file of a submission
+func (s *StorageService) getSubmissionFilePath(submissionID string, fileType SubmissionFileType, path string) (string, error) {
+	if fileType == SubmissionFileTypeSource {
+		return s.getSubmissionSourcePath(submissionID, path)
+	}
+
+	prefix, ok := getSubmissionFilePrefix(fileType)
+	if !ok {
+		return "", ErrSubmissionFileNotFound
+	}
+
+	return fmt.Sprintf("%s%s/%s", prefix, submissionID, path), nil
+}
+
+// GetSubmissionFile opens a single source or output file of a submission.
+// It returns ErrSubmissionFileNotFound if there is no such file
+func (s *StorageService) GetSubmissionFile(submissionID string, fileType SubmissionFileType, path string) (io.ReadSeekCloser, ObjectInfo, error) {
+	objectPath, err := s.getSubmissionFilePath(submissionID, fileType, path)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	object, info, err := s.openObject(objectPath)
+	if err != nil {
+		if err == ErrObjectNotFound {
+			return nil, ObjectInfo{}, ErrSubmissionFileNotFound
+		}
+
+		return nil, ObjectInfo{}, err
+	}
+
+	return object, info, nil
+}
+
+// StatSubmissionFile returns the information of the object storing a single
+// source or output file of a submission, its size is the stored size.
+// It returns ErrSubmissionFileNotFound if there is no such file
+func (s *StorageService) StatSubmissionFile(submissionID string, fileType SubmissionFileType, path string) (ObjectInfo, error) {
+	objectPath, err := s.getSubmissionFilePath(submissionID, fileType, path)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	info, err := s.backend.StatObject(objectPath)
+	if err != nil {
+		if err == ErrObjectNotFound {
+			return ObjectInfo{}, ErrSubmissionFileNotFound
+		}
+
+		return ObjectInfo{}, err
+	}
+
+	return info, nil
+}
+
+// PresignObjectDownload returns a URL the client can GET an object from,
+// downloaded as the given file name.
+// It returns ErrPresignNotSupported if the backend can't be reached by the
+// clients or if the objects are encrypted as they must be decrypted by the API
+func (s *StorageService) PresignObjectDownload(path string, expires time.Duration, filename string) (string, error) {
+	if s.encryptionService.Enabled() {
+		return "", ErrPresignNotSupported
+	}
+
+	return s.backend.PresignGetObject(path, expires, filename)
+}
+
+// PresignSubmissionFileDownload returns a URL the client can GET a single
+// source or output file of a submission from.
+// It returns ErrSubmissionFileNotFound if there is no such file
+func (s *StorageService) PresignSubmissionFileDownload(submissionID string, fileType SubmissionFileType, filePath string, expires time.Duration) (string, error) {
+	info, err := s.StatSubmissionFile(submissionID, fileType, filePath)
+	if err != nil {
+		return "", err
+	}
+
+	return s.PresignObjectDownload(info.Path, expires, path.Base(filePath))
+}
+
+func getSubmissionArchivePath(submissionID string) string {
+	return fmt.Sprintf("transpilations-archives/%s.zip", submissionID)
+}
+
+// GetSubmissionArchive returns the path of the zip archive of the results of
+// a submission owned by a user. The archive is built and cached in the bucket
+// on first call
+func (s *StorageService) GetSubmissionArchive(userID uuid.UUID, submissionID string) (string, error) {
+	archivePath := getSubmissionArchivePath(submissionID)
+
+	_, err := s.backend.StatObject(archivePath)
+	if err == nil {
+		return archivePath, nil
+	}
+
+	if err != ErrObjectNotFound {
+		return "", err
+	}
+
+	logrus.WithField("id", submissionID).Debug("Building submission archive")
+
+	file, err := ioutil.TempFile("", "archive")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	defer os.Remove(file.Name())
+
+	err = s.writeSubmissionResultsZip(file, submissionID)
+	if err != nil {
+		return "", err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := s.encryptionService.GetUserDataKey(userID)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.putObject(archivePath, file, size, key)
+	if err != nil {
+		return "", err
+	}
+
+	return archivePath, nil
+}
+
+// Write the output files of a submission to w as a zip archive where they are
+// stored under a folder named after the submission ID
+func (s *StorageService) writeSubmissionResultsZip(w io.Writer, submissionID string) error {
+	zipFile := zip.NewWriter(w)
+
+	prefix, _ := getSubmissionFilePrefix(SubmissionFileTypeOutput)
+	objectStoragePath := fmt.Sprintf("%s%s/", prefix, submissionID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for object := range s.backend.ListObjects(ctx, objectStoragePath) {
+		if object.Err != nil {
+			return object.Err
+		}
+
+		reader, _, err := s.openObject(object.Path)
+		if err != nil {
+			return err
+		}
+
+		writer, err := zipFile.Create(fmt.Sprintf("%s/%s", submissionID, strings.TrimPrefix(object.Path, objectStoragePath)))
+		if err != nil {
+			reader.Close()
+			return err
+		}
+
+		_, err = io.Copy(writer, reader)
+		reader.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return zipFile.Close()
+}
+
+// ListSubmissionFiles lists the source files, the output files then the
+// mapping artifacts of a submission
+func (s *StorageService) ListSubmissionFiles(submissionID string) ([]*SubmissionFile, error) {
+	id, err := uuid.Parse(submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sizes of the encrypted results are listed as plaintext sizes
+	resultsEncrypted, err := s.databaseService.Submission.Query().
+		Where(submission.ID(id), submission.ResultsEncrypted(true)).
+		Exist(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*SubmissionFile{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, folder := range submissionFileTypePrefixes {
+		prefix := fmt.Sprintf("%s%s/", folder.Prefix, submissionID)
+
+		if folder.Type == SubmissionFileTypeSource {
+			manifest, err := s.getSubmissionManifest(submissionID)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(manifest) > 0 {
+				for _, file := range manifest {
+					files = append(files, &SubmissionFile{
+						Path:         getBlobPath(file.Edges.Blob.ID),
+						FolderPath:   prefix + file.Path,
+						RelativePath: file.Path,
+						Type:         folder.Type,
+						Size:         file.Size,
+						Checksum:     file.Edges.Blob.ID,
+					})
+				}
+				continue
+			}
+		}
+
+		for object := range s.backend.ListObjects(ctx, prefix) {
+			if object.Err != nil {
+				return nil, object.Err
+			}
+
+			size := object.Size
+			if resultsEncrypted && folder.Type != SubmissionFileTypeSource {
+				size = DecryptedSize(size)
+			}
+
+			files = append(files, &SubmissionFile{
+				Path:         object.Path,
+				FolderPath:   object.Path,
+				RelativePath: strings.TrimPrefix(object.Path, prefix),
+				Type:         folder.Type,
+				Size:         size,
+				Checksum:     object.ETag,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+// DeleteSubmission queues the deletion of the objects of a submission and
+// releases its references to the source blobs, which are collected later on
+func (s *StorageService) DeleteSubmission(id string) error {
+	logrus.WithField("id", id).Debug("Queueing submission deletion from storage")
+
+	err := s.queueSubmissionDeletion(id)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteSubmissionManifest(id)
+}
+
+// Ping checks that the storage backend can be reached
+func (s *StorageService) Ping(ctx context.Context) error {
+	return s.backend.Ping(ctx)
+}
+
+func (s *StorageService) SizeofObjects(prefix string) int64 {
+	size := int64(0)
+
+	for object := range s.backend.ListObjects(context.Background(), prefix) {
+		size += object.Size
+	}
+
+	return size
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	// Returned by backends the clients can't reach directly and when the
+	// objects are encrypted
+	ErrPresignNotSupported = errors.New("presigned URLs are not supported by the storage configuration")
+)
+
+type ObjectInfo struct {
+	Path         string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+type ListedObject struct {
+	Err error
+	ObjectInfo
+}
+
+// StorageBackend is an object storage where objects are addressed by a path
+// made of "/" separated segments.
+// The operations return ErrObjectNotFound when the object does not exist
+type StorageBackend interface {
+	PutObject(path string, reader io.Reader, size int64) (ObjectInfo, error)
+	GetObject(path string) (io.ReadSeekCloser, ObjectInfo, error)
+	StatObject(path string) (ObjectInfo, error)
+	CopyObject(sourcePath string, destinationPath string) error
+	RemoveObject(path string) error
+	// ListObjects lists recursively the objects whose path starts with
+	// prefix, ordered by path. The listing stops once ctx is done, the callers
+	// returning before the end of the listing must cancel it
+	ListObjects(ctx context.Context, prefix string) <-chan *ListedObject
+	RemoveObjects(prefix string) error
+	// ScheduleForDeletion marks the objects whose path starts with prefix
+	// to be deleted later on by the backend itself
+	ScheduleForDeletion(prefix string) error
+
+	PresignGetObject(path string, expires time.Duration, filename string) (string, error)
+	PresignPutObject(path string, expires time.Duration) (string, error)
+	NewMultipartUpload(path string) (string, error)
+	PresignPartUpload(path string, uploadId string, partNumber int, expires time.Duration) (string, error)
+	// CompleteMultipartUpload assembles the uploaded parts, it returns
+	// ErrObjectNotFound if no part was uploaded
+	CompleteMultipartUpload(path string, uploadId string) error
+	AbortMultipartUpload(path string, uploadId string) error
+
+	// Ping checks that the storage can be reached
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Objects being written are stored as temporary files named with this prefix
+const (
+	localStorageTemporaryPrefix  = ".tereus-upload-"
+	localStorageTemporaryPattern = localStorageTemporaryPrefix + "*"
+)
+
+// LocalStorageBackend stores the objects as files under a root directory,
+// for single node installs where the transpilers share the same filesystem.
+// The clients can't reach it directly so presigned URLs are not supported
+type LocalStorageBackend struct {
+	root string
+}
+
+func NewLocalStorageBackend(root string) (*LocalStorageBackend, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(root, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStorageBackend{
+		root: root,
+	}, nil
+}
+
+// Get the file path of an object, the object paths may come from user
+// provided archives so they must not escape the root directory
+func (b *LocalStorageBackend) getFilePath(path string) (string, error) {
+	filePath := filepath.Join(b.root, filepath.FromSlash(path))
+	if filePath == b.root || !strings.HasPrefix(filePath, b.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object path %q", path)
+	}
+
+	return filePath, nil
+}
+
+func (b *LocalStorageBackend) getObjectPath(filePath string) string {
+	return filepath.ToSlash(strings.TrimPrefix(filePath, b.root+string(filepath.Separator)))
+}
+
+func (b *LocalStorageBackend) toObjectInfo(filePath string, info fs.FileInfo) ObjectInfo {
+	return ObjectInfo{
+		Path: b.getObjectPath(filePath),
+		Size: info.Size(),
+		// Changes whenever the file is rewritten
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		LastModified: info.ModTime(),
+	}
+}
+
+func (b *LocalStorageBackend) PutObject(path string, reader io.Reader, size int64) (ObjectInfo, error) {
+	filePath, err := b.getFilePath(path)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	// Written next to the destination then renamed so that readers never see
+	// a partial object
+	file, err := ioutil.TempFile(filepath.Dir(filePath), localStorageTemporaryPattern)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	defer os.Remove(file.Name())
+
+	written, err := io.Copy(file, reader)
+	if err != nil {
+		file.Close()
+		return ObjectInfo{}, err
+	}
+
+	if size >= 0 && written != size {
+		file.Close()
+		return ObjectInfo{}, fmt.Errorf("object %s is %d bytes but %d bytes were announced", path, written, size)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	err = os.Rename(file.Name(), filePath)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return b.StatObject(path)
+}
+
+func (b *LocalStorageBackend) GetObject(path string) (io.ReadSeekCloser, ObjectInfo, error) {
+	filePath, err := b.getFilePath(path)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ObjectInfo{}, ErrObjectNotFound
+		}
+
+		return nil, ObjectInfo{}, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, ObjectInfo{}, err
+	}
+
+	if info.IsDir() {
+		file.Close()
+		return nil, ObjectInfo{}, ErrObjectNotFound
+	}
+
+	return file, b.toObjectInfo(filePath, info), nil
+}
+
+func (b *LocalStorageBackend) StatObject(path string) (ObjectInfo, error) {
+	filePath, err := b.getFilePath(path)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+
+		return ObjectInfo{}, err
+	}
+
+	if info.IsDir() {
+		return ObjectInfo{}, ErrObjectNotFound
+	}
+
+	return b.toObjectInfo(filePath, info), nil
+}
+
+func (b *LocalStorageBackend) CopyObject(sourcePath string, destinationPath string) error {
+	source, info, err := b.GetObject(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	_, err = b.PutObject(destinationPath, source, info.Size)
+	return err
+}
+
+func (b *LocalStorageBackend) RemoveObject(path string) error {
+	filePath, err := b.getFilePath(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	b.removeEmptyDirectories(filepath.Dir(filePath))
+
+	return nil
+}
+
+// Remove the directories left empty by a removal up to the root directory
+func (b *LocalStorageBackend) removeEmptyDirectories(dir string) {
+	for dir != b.root && strings.HasPrefix(dir, b.root) {
+		// Fails if the directory is not empty
+		if os.Remove(dir) != nil {
+			return
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+func (b *LocalStorageBackend) ListObjects(ctx context.Context, prefix string) <-chan *ListedObject {
+	ch := make(chan *ListedObject)
+
+	go func() {
+		defer close(ch)
+
+		send := func(object *ListedObject) bool {
+			select {
+			case ch <- object:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// Like S3 the prefix is not necessarily a directory, walk the
+		// deepest directory it names
+		dir := b.root
+		if i := strings.LastIndex(prefix, "/"); i >= 0 {
+			var err error
+			dir, err = b.getFilePath(prefix[:i])
+			if err != nil {
+				send(&ListedObject{Err: err})
+				return
+			}
+		}
+
+		objects := []ObjectInfo{}
+
+		err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			if entry.IsDir() {
+				if filePath == dir {
+					return nil
+				}
+
+				// Skip the directories that can't contain a matching object
+				dirPath := b.getObjectPath(filePath) + "/"
+				if !strings.HasPrefix(dirPath, prefix) && !strings.HasPrefix(prefix, dirPath) {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if strings.HasPrefix(entry.Name(), localStorageTemporaryPrefix) {
+				return nil
+			}
+
+			if !strings.HasPrefix(b.getObjectPath(filePath), prefix) {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+
+			objects = append(objects, b.toObjectInfo(filePath, info))
+			return nil
+		})
+		if err != nil {
+			send(&ListedObject{Err: err})
+			return
+		}
+
+		// The walk order differs from the S3 order when names contain
+		// characters sorted before "/"
+		sort.Slice(objects, func(i, j int) bool {
+			return objects[i].Path < objects[j].Path
+		})
+
+		for _, object := range objects {
+			if !send(&ListedObject{ObjectInfo: object}) {
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (b *LocalStorageBackend) RemoveObjects(prefix string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for object := range b.ListObjects(ctx, prefix) {
+		if object.Err != nil {
+			return object.Err
+		}
+
+		err := b.RemoveObject(object.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ScheduleForDeletion removes the objects right away as there is no lifecycle
+// management on the local filesystem
+func (b *LocalStorageBackend) ScheduleForDeletion(prefix string) error {
+	return b.RemoveObjects(prefix)
+}
+
+func (b *LocalStorageBackend) PresignGetObject(path string, expires time.Duration, filename string) (string, error) {
+	return "", ErrPresignNotSupported
+}
+
+func (b *LocalStorageBackend) PresignPutObject(path string, expires time.Duration) (string, error) {
+	return "", ErrPresignNotSupported
+}
+
+func (b *LocalStorageBackend) NewMultipartUpload(path string) (string, error) {
+	return "", ErrPresignNotSupported
+}
+
+func (b *LocalStorageBackend) PresignPartUpload(path string, uploadId string, partNumber int, expires time.Duration) (string, error) {
+	return "", ErrPresignNotSupported
+}
+
+func (b *LocalStorageBackend) CompleteMultipartUpload(path string, uploadId string) error {
+	return ErrPresignNotSupported
+}
+
+func (b *LocalStorageBackend) AbortMultipartUpload(path string, uploadId string) error {
+	return nil
+}
+
+func (b *LocalStorageBackend) Ping(ctx context.Context) error {
+	info, err := os.Stat(b.root)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", b.root)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestLocalStorageBackend(t *testing.T) *LocalStorageBackend {
+	t.Helper()
+
+	backend, err := NewLocalStorageBackend(filepath.Join(t.TempDir(), "storage"))
+	if err != nil {
+		t.Fatalf("NewLocalStorageBackend() failed: %v", err)
+	}
+
+	return backend
+}
+
+func putTestObjects(t *testing.T, backend *LocalStorageBackend, paths ...string) {
+	t.Helper()
+
+	for _, path := range paths {
+		if _, err := backend.PutObject(path, strings.NewReader(path), int64(len(path))); err != nil {
+			t.Fatalf("PutObject(%q) failed: %v", path, err)
+		}
+	}
+}
+
+func listTestObjects(t *testing.T, backend *LocalStorageBackend, prefix string) []string {
+	t.Helper()
+
+	paths := []string{}
+	for object := range backend.ListObjects(context.Background(), prefix) {
+		if object.Err != nil {
+			t.Fatalf("ListObjects(%q) failed: %v", prefix, object.Err)
+		}
+		paths = append(paths, object.Path)
+	}
+
+	return paths
+}
+
+func TestLocalStorageBackendPutGet(t *testing.T) {
+	backend := newTestLocalStorageBackend(t)
+
+	info, err := backend.PutObject("a/b/c.txt", strings.NewReader("hello"), 5)
+	if err != nil {
+		t.Fatalf("PutObject() failed: %v", err)
+	}
+	if info.Path != "a/b/c.txt" || info.Size != 5 {
+		t.Errorf("PutObject() = %+v, want a/b/c.txt of 5 bytes", info)
+	}
+
+	reader, info, err := backend.GetObject("a/b/c.txt")
+	if err != nil {
+		t.Fatalf("GetObject() failed: %v", err)
+	}
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatalf("failed to read the object: %v", err)
+	}
+	if string(content) != "hello" || info.Size != 5 {
+		t.Errorf("GetObject() = %q of %d bytes, want hello of 5 bytes", content, info.Size)
+	}
+
+	if _, err := backend.PutObject("a/short.txt", strings.NewReader("hello"), 6); err == nil {
+		t.Error("PutObject() with a wrong size succeeded, want an error")
+	}
+	if _, err := backend.StatObject("a/short.txt"); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("StatObject() of a failed upload = %v, want ErrObjectNotFound", err)
+	}
+
+	for _, path := range []string{"a/missing.txt", "a/b"} {
+		if _, _, err := backend.GetObject(path); !errors.Is(err, ErrObjectNotFound) {
+			t.Errorf("GetObject(%q) = %v, want ErrObjectNotFound", path, err)
+		}
+	}
+}
+
+func TestLocalStorageBackendListObjects(t *testing.T) {
+	backend := newTestLocalStorageBackend(t)
+	putTestObjects(t, backend, "results/1/b.txt", "results/1/a/z.txt", "results/1/a.txt", "results/10/a.txt", "results/2/a.txt")
+
+	// Left behind by an interrupted upload
+	if err := os.WriteFile(filepath.Join(backend.root, "results", "1", localStorageTemporaryPrefix+"x"), nil, 0644); err != nil {
+		t.Fatalf("failed to write a temporary file: %v", err)
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{prefix: "results/1/", want: []string{"results/1/a.txt", "results/1/a/z.txt", "results/1/b.txt"}},
+		{prefix: "results/1", want: []string{"results/1/a.txt", "results/1/a/z.txt", "results/1/b.txt", "results/10/a.txt"}},
+		{prefix: "results/1/a", want: []string{"results/1/a.txt", "results/1/a/z.txt"}},
+		{prefix: "results/3/", want: []string{}},
+		{prefix: "missing/", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			if got := listTestObjects(t, backend, tt.prefix); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListObjects(%q) = %v, want %v", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalStorageBackendListObjectsCancel(t *testing.T) {
+	backend := newTestLocalStorageBackend(t)
+	for i := 0; i < 50; i++ {
+		putTestObjects(t, backend, fmt.Sprintf("results/1/%02d.txt", i))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	objects := backend.ListObjects(ctx, "results/")
+	<-objects
+	cancel()
+
+	// The listing ends without all the remaining objects being sent
+	remaining := 0
+	for range objects {
+		remaining++
+	}
+	if remaining == 49 {
+		t.Error("ListObjects() kept sending after its context was cancelled")
+	}
+}
+
+func TestLocalStorageBackendRemoveObjects(t *testing.T) {
+	backend := newTestLocalStorageBackend(t)
+	putTestObjects(t, backend, "results/1/a.txt", "results/1/a/z.txt", "results/10/a.txt")
+
+	if err := backend.RemoveObjects("results/1/"); err != nil {
+		t.Fatalf("RemoveObjects() failed: %v", err)
+	}
+
+	if got, want := listTestObjects(t, backend, ""), []string{"results/10/a.txt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListObjects() after RemoveObjects() = %v, want %v", got, want)
+	}
+
+	// The emptied directories are removed
+	if _, err := os.Stat(filepath.Join(backend.root, "results", "1")); !os.IsNotExist(err) {
+		t.Errorf("the directory of the removed objects is still there: %v", err)
+	}
+
+	if err := backend.RemoveObject("results/missing.txt"); err != nil {
+		t.Errorf("RemoveObject() of a missing object failed: %v", err)
+	}
+}
+
+func TestLocalStorageBackendPathTraversal(t *testing.T) {
+	backend := newTestLocalStorageBackend(t)
+
+	// Next to the root directory, must not be reachable
+	outside := filepath.Join(filepath.Dir(backend.root), "outside.txt")
+	if err := os.WriteFile(outside, []byte("secret"), 0644); err != nil {
+		t.Fatalf("failed to write the outside file: %v", err)
+	}
+
+	paths := []string{"", ".", "..", "../outside.txt", "a/../../outside.txt", "../storage-other/a.txt"}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			if _, err := backend.PutObject(path, strings.NewReader("x"), 1); err == nil {
+				t.Errorf("PutObject(%q) succeeded, want an error", path)
+			}
+			if _, _, err := backend.GetObject(path); err == nil {
+				t.Errorf("GetObject(%q) succeeded, want an error", path)
+			}
+			if err := backend.RemoveObject(path); err == nil {
+				t.Errorf("RemoveObject(%q) succeeded, want an error", path)
+			}
+		})
+	}
+
+	for object := range backend.ListObjects(context.Background(), "../") {
+		if object.Err == nil {
+			t.Errorf("ListObjects(../) listed %q, want an error", object.Path)
+		}
+	}
+
+	if content, err := os.ReadFile(outside); err != nil || string(content) != "secret" {
+		t.Errorf("the outside file was changed: %q, %v", content, err)
+	}
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-go-std/s3"
+)
+
+// Lifecycle rule expiring the objects tagged by ScheduleForDeletion
+const deletionLifecycleRuleID = "tereus-scheduled-deletion"
+
+// S3StorageBackend stores the objects in a bucket of an S3 compatible object storage
+type S3StorageBackend struct {
+	s3Service *s3.S3Service
+
+	// Direct client for the operations not exposed by the S3 service
+	client *minio.Client
+	bucket string
+}
+
+func NewS3StorageBackend(endpoint string, accessKey string, secretKey string, bucket string, secure bool) (*S3StorageBackend, error) {
+	s3Service, err := s3.NewS3Service(endpoint, accessKey, secretKey, bucket, secure)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s3Service.MakeBucketIfNotExists(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: secure,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &S3StorageBackend{
+		s3Service: s3Service,
+		client:    client,
+		bucket:    bucket,
+	}
+
+	// The deletion worker removes the objects itself if the rule is missing
+	err = backend.ensureDeletionLifecycleRule()
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to configure the bucket lifecycle, objects scheduled for deletion will be removed by the API")
+	}
+
+	return backend, nil
+}
+
+// Add the rule expiring the objects scheduled for deletion to the lifecycle
+// configuration of the bucket, keeping the other rules
+func (b *S3StorageBackend) ensureDeletionLifecycleRule() error {
+	config, err := b.client.GetBucketLifecycle(context.Background(), b.bucket)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code != "NoSuchLifecycleConfiguration" {
+			return err
+		}
+
+		config = lifecycle.NewConfiguration()
+	}
+
+	for _, rule := range config.Rules {
+		if rule.ID == deletionLifecycleRuleID {
+			return nil
+		}
+	}
+
+	config.Rules = append(config.Rules, lifecycle.Rule{
+		ID:     deletionLifecycleRuleID,
+		Status: "Enabled",
+		RuleFilter: lifecycle.Filter{
+			Tag: lifecycle.Tag{
+				Key:   "to-delete",
+				Value: "true",
+			},
+		},
+		Expiration: lifecycle.Expiration{
+			Days: 1,
+		},
+	})
+
+	return b.client.SetBucketLifecycle(context.Background(), b.bucket, config)
+}
+
+func isNoSuchKey(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
+func toObjectInfo(info minio.ObjectInfo) ObjectInfo {
+	return ObjectInfo{
+		Path:         info.Key,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}
+}
+
+func (b *S3StorageBackend) PutObject(path string, reader io.Reader, size int64) (ObjectInfo, error) {
+	info, err := b.s3Service.PutObject(path, reader, size)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return ObjectInfo{
+		Path:         info.Key,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (b *S3StorageBackend) GetObject(path string) (io.ReadSeekCloser, ObjectInfo, error) {
+	object, err := b.s3Service.GetObject(path)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	// The object is only requested on first use
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+
+		if isNoSuchKey(err) {
+			return nil, ObjectInfo{}, ErrObjectNotFound
+		}
+
+		return nil, ObjectInfo{}, err
+	}
+
+	return object, toObjectInfo(info), nil
+}
+
+func (b *S3StorageBackend) StatObject(path string) (ObjectInfo, error) {
+	info, err := b.client.StatObject(context.Background(), b.bucket, path, minio.StatObjectOptions{})
+	if err != nil {
+		if isNoSuchKey(err) {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+
+		return ObjectInfo{}, err
+	}
+
+	return toObjectInfo(info), nil
+}
+
+// CopyObject copies an object without leaving the object storage
+func (b *S3StorageBackend) CopyObject(sourcePath string, destinationPath string) error {
+	_, err := b.client.CopyObject(
+		context.Background(),
+		minio.CopyDestOptions{
+			Bucket: b.bucket,
+			Object: destinationPath,
+		},
+		minio.CopySrcOptions{
+			Bucket: b.bucket,
+			Object: sourcePath,
+		},
+	)
+	if err != nil && isNoSuchKey(err) {
+		return ErrObjectNotFound
+	}
+
+	return err
+}
+
+func (b *S3StorageBackend) RemoveObject(path string) error {
+	return b.s3Service.RemoveObject(path)
+}
+
+func (b *S3StorageBackend) ListObjects(ctx context.Context, prefix string) <-chan *ListedObject {
+	ch := make(chan *ListedObject)
+
+	go func() {
+		defer close(ch)
+
+		// Cancelling ctx also ends the listing requests of the client
+		objects := b.client.ListObjects(ctx, b.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+
+		for object := range objects {
+			select {
+			case ch <- &ListedObject{
+				Err:        object.Err,
+				ObjectInfo: toObjectInfo(object),
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (b *S3StorageBackend) RemoveObjects(prefix string) error {
+	return b.s3Service.RemoveObjects(prefix)
+}
+
+// ScheduleForDeletion sets a tag for the objects to be deleted by Lifecycle later on
+func (b *S3StorageBackend) ScheduleForDeletion(prefix string) error {
+	return b.s3Service.ScheduleForDeletion(prefix)
+}
+
+// PresignGetObject returns a URL the client can GET an object from,
+// downloaded as the given file name
+func (b *S3StorageBackend) PresignGetObject(path string, expires time.Duration, filename string) (string, error) {
+	params := url.Values{}
+	params.Set("response-content-disposition", fmt.Sprintf(`attachment; filename="%s"`, strings.ReplaceAll(filename, `"`, "")))
+
+	u, err := b.client.PresignedGetObject(context.Background(), b.bucket, path, expires, params)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (b *S3StorageBackend) PresignPutObject(path string, expires time.Duration) (string, error) {
+	u, err := b.client.PresignedPutObject(context.Background(), b.bucket, path, expires)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (b *S3StorageBackend) NewMultipartUpload(path string) (string, error) {
+	core := minio.Core{Client: b.client}
+	return core.NewMultipartUpload(context.Background(), b.bucket, path, minio.PutObjectOptions{})
+}
+
+// PresignPartUpload returns a URL the client can PUT a part of a multipart
+// upload to. Part numbers start at 1
+func (b *S3StorageBackend) PresignPartUpload(path string, uploadId string, partNumber int, expires time.Duration) (string, error) {
+	params := url.Values{}
+	params.Set("partNumber", strconv.Itoa(partNumber))
+	params.Set("uploadId", uploadId)
+
+	u, err := b.client.Presign(context.Background(), http.MethodPut, b.bucket, path, expires, params)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (b *S3StorageBackend) CompleteMultipartUpload(path string, uploadId string) error {
+	core := minio.Core{Client: b.client}
+
+	parts := []minio.CompletePart{}
+	partNumberMarker := 0
+	for {
+		result, err := core.ListObjectParts(context.Background(), b.bucket, path, uploadId, partNumberMarker, 1000)
+		if err != nil {
+			return err
+		}
+
+		for _, part := range result.ObjectParts {
+			parts = append(parts, minio.CompletePart{
+				PartNumber: part.PartNumber,
+				ETag:       part.ETag,
+			})
+		}
+
+		if !result.IsTruncated {
+			break
+		}
+		partNumberMarker = result.NextPartNumberMarker
+	}
+
+	if len(parts) == 0 {
+		return ErrObjectNotFound
+	}
+
+	_, err := core.CompleteMultipartUpload(context.Background(), b.bucket, path, uploadId, parts, minio.PutObjectOptions{})
+	return err
+}
+
+func (b *S3StorageBackend) AbortMultipartUpload(path string, uploadId string) error {
+	core := minio.Core{Client: b.client}
+	return core.AbortMultipartUpload(context.Background(), b.bucket, path, uploadId)
+}
+
+func (b *S3StorageBackend) Ping(ctx context.Context) error {
+	exists, err := b.client.BucketExists(ctx, b.bucket)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("bucket %q does not exist", b.bucket)
+	}
+
+	return nil
+}
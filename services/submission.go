@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/diagnostic"
 	"github.com/tereus-project/tereus-api/ent/submission"
-	"github.com/tereus-project/tereus-go-std/queue"
+	"github.com/tereus-project/tereus-api/ent/subscription"
 )
 
 type TranspilerDetails struct {
@@ -20,21 +25,100 @@ type TranspilerDetails struct {
 type TranspilerDetailsTarget struct {
 	FileExtension string
 	QueueName     string
+	// Version of the deployed transpiler, the results are only cached when it is known
+	Version string
+}
+
+// The standard lane publishes to the historical topic name without suffix,
+// the only topic the existing transpilers consume. The other lanes need
+// transpilers subscribed to their own topic.
+const StandardSubmissionLane = "standard"
+
+type SubmissionLane struct {
+	Name   string
+	Weight int
+}
+
+// ParseSubmissionLanes parses a lane list such as "priority:3,standard:1".
+// The weight is optional and defaults to 1.
+func ParseSubmissionLanes(value string) ([]SubmissionLane, error) {
+	lanes := []SubmissionLane{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, weight, hasWeight := strings.Cut(part, ":")
+		lane := SubmissionLane{
+			Name:   strings.TrimSpace(name),
+			Weight: 1,
+		}
+
+		if lane.Name == "" {
+			return nil, fmt.Errorf("invalid submission lane %q: missing name", part)
+		}
+
+		if hasWeight {
+			w, err := strconv.Atoi(strings.TrimSpace(weight))
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("invalid submission lane %q: weight must be a positive integer", part)
+			}
+			lane.Weight = w
+		}
+
+		lanes = append(lanes, lane)
+	}
+
+	if len(lanes) == 0 {
+		return nil, fmt.Errorf("at least one submission lane is required")
+	}
+
+	return lanes, nil
+}
+
+// ParseTranspilerVersions parses a version list such as "c/go=1.2.0,lua/ruby=0.3.1"
+// into versions keyed by "source/target" language pair.
+func ParseTranspilerVersions(value string) (map[string]string, error) {
+	versions := map[string]string{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		pair, version, ok := strings.Cut(part, "=")
+		pair = strings.ToLower(strings.TrimSpace(pair))
+		version = strings.TrimSpace(version)
+
+		source, target, hasTarget := strings.Cut(pair, "/")
+		if !ok || !hasTarget || source == "" || target == "" || version == "" {
+			return nil, fmt.Errorf("invalid transpiler version %q: expected source/target=version", part)
+		}
+
+		versions[pair] = version
+	}
+
+	return versions, nil
 }
 
 type SubmissionService struct {
-	queueService    *queue.QueueService
+	queueService    *QueueService
 	databaseService *DatabaseService
 	storageService  *StorageService
 
 	submissionQueues map[string]*TranspilerDetails
+	tierLanes        map[subscription.Tier][]SubmissionLane
 }
 
-func NewSubmissionService(queueService *queue.QueueService, databaseService *DatabaseService, storageService *StorageService) *SubmissionService {
-	return &SubmissionService{
+func NewSubmissionService(queueService *QueueService, databaseService *DatabaseService, storageService *StorageService, tierLanes map[subscription.Tier][]SubmissionLane, transpilerVersions map[string]string) *SubmissionService {
+	service := &SubmissionService{
 		queueService:    queueService,
 		databaseService: databaseService,
 		storageService:  storageService,
+		tierLanes:       tierLanes,
 		submissionQueues: map[string]*TranspilerDetails{
 			"c": {
 				FileExtension: ".c",
@@ -54,11 +138,26 @@ func NewSubmissionService(queueService *queue.QueueService, databaseService *Dat
 			},
 		},
 	}
+
+	for pair, version := range transpilerVersions {
+		sourceLanguage, targetLanguage, _ := strings.Cut(pair, "/")
+
+		source, ok := service.submissionQueues[sourceLanguage]
+		if !ok || source.Targets[targetLanguage] == nil {
+			logrus.WithField("pair", pair).Warn("Ignoring version of unsupported transpiler")
+			continue
+		}
+
+		source.Targets[targetLanguage].Version = version
+	}
+
+	return service
 }
 
 type LanguagePairDetails struct {
 	SourceLanguageFileExtension string
 	TargetLanguageFileExtension string
+	TranspilerVersion           string
 }
 
 func (s *SubmissionService) GetLanguagePairDetails(sourceLanguage string, targetLanguage string) (*LanguagePairDetails, error) {
@@ -75,6 +174,7 @@ func (s *SubmissionService) GetLanguagePairDetails(sourceLanguage string, target
 	return &LanguagePairDetails{
 		SourceLanguageFileExtension: source.FileExtension,
 		TargetLanguageFileExtension: target.FileExtension,
+		TranspilerVersion:           target.Version,
 	}, nil
 }
 
@@ -82,23 +182,307 @@ type SubmissionMessage struct {
 	ID             string `json:"id"`
 	SourceLanguage string `json:"source_language"`
 	TargetLanguage string `json:"target_language"`
+	Lane           string `json:"lane"`
+}
+
+type LineMapping struct {
+	SourceLine int `json:"source_line"`
+	OutputLine int `json:"output_line"`
+}
+
+type FileMapping struct {
+	Source   string        `json:"source"`
+	Output   string        `json:"output"`
+	Mappings []LineMapping `json:"mappings"`
+}
+
+// SubmissionMapping is the line mapping artifact a transpiler can upload as
+// transpilations-mappings/<id>/mapping.json along with the results
+type SubmissionMapping struct {
+	Version int           `json:"version"`
+	Files   []FileMapping `json:"files"`
+}
+
+func (m *SubmissionMapping) Validate() error {
+	if m.Version != 1 {
+		return fmt.Errorf("unsupported mapping version %d", m.Version)
+	}
+
+	for i, file := range m.Files {
+		if file.Source == "" || file.Output == "" {
+			return fmt.Errorf("file mapping %d is missing its source or output path", i)
+		}
+
+		for j, mapping := range file.Mappings {
+			if mapping.SourceLine < 1 || mapping.OutputLine < 1 {
+				return fmt.Errorf("line mapping %d of %s has an invalid line number", j, file.Source)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSubmissionMapping reads and validates the mapping artifact of a submission.
+// It returns ErrSubmissionFileNotFound if the transpiler did not upload one
+func (s *SubmissionService) GetSubmissionMapping(id string) (*SubmissionMapping, error) {
+	object, _, err := s.storageService.GetSubmissionFile(id, SubmissionFileTypeMapping, SubmissionMappingFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	mapping := &SubmissionMapping{}
+	err = json.NewDecoder(object).Decode(mapping)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mapping: %w", err)
+	}
+
+	err = mapping.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+type SubmissionCancellationMessage struct {
+	ID        string `json:"id"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type SubmissionDiagnostic struct {
+	Severity diagnostic.Severity `json:"severity"`
+	File     string              `json:"file"`
+	Line     int                 `json:"line"`
+	Column   int                 `json:"column"`
+	Code     string              `json:"code"`
+	Message  string              `json:"message"`
 }
 
 type SubmissionStatusMessage struct {
-	ID        string            `json:"id"`
-	Status    submission.Status `json:"status"`
-	Reason    string            `json:"reason"`
-	Timestamp int64             `json:"timestamp"`
+	ID          string                 `json:"id"`
+	Status      submission.Status      `json:"status"`
+	Reason      string                 `json:"reason"`
+	Diagnostics []SubmissionDiagnostic `json:"diagnostics"`
+	Timestamp   int64                  `json:"timestamp"`
+}
+
+// Pick a lane for the given tier, randomly weighted by the configured lane weights
+func (s *SubmissionService) pickLane(tier subscription.Tier) string {
+	lanes, ok := s.tierLanes[tier]
+	if !ok || len(lanes) == 0 {
+		return StandardSubmissionLane
+	}
+
+	total := 0
+	for _, lane := range lanes {
+		total += lane.Weight
+	}
+
+	n := rand.Intn(total)
+	for _, lane := range lanes {
+		if n < lane.Weight {
+			return lane.Name
+		}
+		n -= lane.Weight
+	}
+
+	return lanes[len(lanes)-1].Name
+}
+
+func getSubmissionTopic(sourceLanguage string, targetLanguage string, lane string) string {
+	topic := fmt.Sprintf("transpilation_jobs_%s_to_%s", sourceLanguage, targetLanguage)
+	if lane != StandardSubmissionLane {
+		topic = fmt.Sprintf("%s_%s", topic, lane)
+	}
+
+	return topic
 }
 
-func (s *SubmissionService) PublishSubmissionToTranspile(sub SubmissionMessage) error {
+// PublishSubmissionToTranspile routes the submission to a lane of the given
+// subscription tier and returns the name of the lane it was published to
+func (s *SubmissionService) PublishSubmissionToTranspile(sub SubmissionMessage, tier subscription.Tier) (string, error) {
+	sub.Lane = s.pickLane(tier)
+
 	bytes, err := json.Marshal(sub)
+	if err != nil {
+		return "", err
+	}
+
+	topic := getSubmissionTopic(sub.SourceLanguage, sub.TargetLanguage, sub.Lane)
+	return sub.Lane, s.queueService.Publish(topic, bytes)
+}
+
+// CancelSubmission marks a pending or processing submission as cancelled,
+// notifies the transpilers and frees its stored objects.
+// It returns false if the submission was not in a cancellable state.
+func (s *SubmissionService) CancelSubmission(id uuid.UUID) (bool, error) {
+	updated, err := s.databaseService.Submission.
+		Update().
+		Where(
+			submission.ID(id),
+			submission.StatusIn(submission.StatusPending, submission.StatusProcessing),
+		).
+		SetStatus(submission.StatusCancelled).
+		SetProcessingFinishedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	if updated == 0 {
+		return false, nil
+	}
+
+	err = s.publishCancellation(id)
+	if err != nil {
+		return true, err
+	}
+
+	return true, s.storageService.DeleteSubmission(id.String())
+}
+
+// Tell the transpilers to stop processing a submission
+func (s *SubmissionService) publishCancellation(id uuid.UUID) error {
+	bytes, err := json.Marshal(SubmissionCancellationMessage{
+		ID:        id.String(),
+		Timestamp: time.Now().UnixMilli(),
+	})
 	if err != nil {
 		return err
 	}
 
-	topic := fmt.Sprintf("transpilation_jobs_%s_to_%s", sub.SourceLanguage, sub.TargetLanguage)
-	return s.queueService.Publish(topic, bytes)
+	return s.queueService.Publish("transpilation_submission_cancellation", bytes)
+}
+
+// RetrySubmission publishes a pending, processing or failed submission again
+// under the same ID, for the submissions lost by the queue or failed because
+// of the transpilers. It returns false if the submission can't be retried
+func (s *SubmissionService) RetrySubmission(sub *ent.Submission, tier subscription.Tier) (bool, error) {
+	retryable := []submission.Status{submission.StatusPending, submission.StatusProcessing, submission.StatusFailed}
+
+	switch sub.Status {
+	case submission.StatusPending, submission.StatusProcessing:
+	case submission.StatusFailed:
+		// The working copy was released when the submission failed
+		err := s.storageService.RestoreSubmissionWorkingCopy(sub.ID.String())
+		if err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := tx.Submission.
+		Update().
+		Where(
+			submission.ID(sub.ID),
+			submission.StatusIn(retryable...),
+		).
+		SetStatus(submission.StatusPending).
+		ClearReason().
+		SetProcessingStartedAt(time.Now()).
+		ClearProcessingFinishedAt().
+		SetSubmissionTargetSizeBytes(0).
+		SetHasMapping(false).
+		Save(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if updated == 0 {
+		return false, tx.Rollback()
+	}
+
+	// The diagnostics of the previous attempt would be mixed with the new ones
+	_, err = tx.Diagnostic.Delete().
+		Where(diagnostic.HasSubmissionWith(submission.ID(sub.ID))).
+		Exec(context.Background())
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	lane, err := s.PublishSubmissionToTranspile(SubmissionMessage{
+		ID:             sub.ID.String(),
+		SourceLanguage: sub.SourceLanguage,
+		TargetLanguage: sub.TargetLanguage,
+	}, tier)
+	if err != nil {
+		return true, err
+	}
+
+	return true, s.databaseService.Submission.UpdateOneID(sub.ID).SetQueueLane(lane).Exec(context.Background())
+}
+
+// FailSubmission marks a pending or processing submission as failed with the
+// given reason and tells the transpilers to stop processing it.
+// It returns false if the submission was not being processed.
+func (s *SubmissionService) FailSubmission(id uuid.UUID, reason string) (bool, error) {
+	updated, err := s.databaseService.Submission.
+		Update().
+		Where(
+			submission.ID(id),
+			submission.StatusIn(submission.StatusPending, submission.StatusProcessing),
+		).
+		SetStatus(submission.StatusFailed).
+		SetReason(reason).
+		SetProcessingFinishedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	if updated == 0 {
+		return false, nil
+	}
+
+	err = s.publishCancellation(id)
+	if err != nil {
+		return true, err
+	}
+
+	return true, s.storageService.ReleaseSubmissionWorkingCopy(id.String())
+}
+
+// CleanSubmission frees the stored objects of a done, failed or cancelled
+// submission and marks it as cleaned. It returns false if the submission is
+// still being processed
+func (s *SubmissionService) CleanSubmission(sub *ent.Submission) (bool, error) {
+	if sub.Status == submission.StatusCleaned {
+		return true, nil
+	}
+
+	if !isTerminalSubmissionStatus(sub.Status) {
+		return false, nil
+	}
+
+	err := s.storageService.DeleteSubmission(sub.ID.String())
+	if err != nil {
+		return false, err
+	}
+
+	err = s.databaseService.Submission.
+		UpdateOneID(sub.ID).
+		SetStatus(submission.StatusCleaned).
+		Exec(context.Background())
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (s *SubmissionService) HandleSubmissionStatus(msg SubmissionStatusMessage) error {
@@ -110,12 +494,67 @@ func (s *SubmissionService) HandleSubmissionStatus(msg SubmissionStatusMessage)
 		return nil
 	}
 
+	sub, err := s.databaseService.Submission.Get(context.Background(), id)
+	if err != nil {
+		// Requeued unless the submission was deleted meanwhile
+		if ent.IsNotFound(err) {
+			logrus.WithField("id", id).Warn("Ignoring status of deleted submission")
+			return nil
+		}
+
+		logrus.WithError(err).Error("Failed to get submission")
+		return err
+	}
+
+	// The transpiler may still report on a job it did not stop in time, any
+	// results it uploaded are removed again
+	if sub.Status == submission.StatusCancelled {
+		logrus.WithField("id", id).Info("Ignoring status of cancelled submission")
+
+		if msg.Status == submission.StatusDone {
+			err = s.storageService.DeleteSubmission(id.String())
+			if err != nil {
+				logrus.WithError(err).Error("Failed to delete cancelled submission from S3")
+			}
+		}
+
+		return nil
+	}
+
+	// Results reported after an admin failed the submission are not kept
+	if sub.Status == submission.StatusFailed && msg.Status == submission.StatusDone {
+		logrus.WithField("id", id).Info("Ignoring results of failed submission")
+
+		err = s.storageService.DeleteSubmissionResults(id.String())
+		if err != nil {
+			logrus.WithError(err).Error("Failed to delete failed submission results from S3")
+		}
+
+		return nil
+	}
+
 	var submissionBytesCount int64
+	hasMapping := false
 	if msg.Status == submission.StatusDone {
 		submissionBytesCount = s.storageService.SizeofObjects(fmt.Sprintf("transpilations-results/%s/", id))
+
+		_, err = s.GetSubmissionMapping(id.String())
+		if err == nil {
+			hasMapping = true
+		} else if err != ErrSubmissionFileNotFound {
+			logrus.WithError(err).WithField("id", id).Warn("Ignoring submission mapping")
+		}
+	}
+
+	// The diagnostics are saved with the status so that a requeued message
+	// doesn't find the submission already updated without them
+	tx, err := s.databaseService.Tx(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to start transaction")
+		return err
 	}
 
-	submissionUpdate := s.databaseService.Submission.
+	submissionUpdate := tx.Submission.
 		Update().
 		Where(
 			submission.ID(id),
@@ -123,7 +562,8 @@ func (s *SubmissionService) HandleSubmissionStatus(msg SubmissionStatusMessage)
 		).
 		SetSubmissionTargetSizeBytes(int(submissionBytesCount)).
 		SetStatus(msg.Status).
-		SetReason(msg.Reason)
+		SetReason(msg.Reason).
+		SetHasMapping(hasMapping)
 
 	receivedAt := time.UnixMilli(msg.Timestamp)
 
@@ -134,11 +574,97 @@ func (s *SubmissionService) HandleSubmissionStatus(msg SubmissionStatusMessage)
 		submissionUpdate = submissionUpdate.SetProcessingFinishedAt(receivedAt)
 	}
 
-	err = submissionUpdate.Exec(context.Background())
+	updated, err := submissionUpdate.Save(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to update submission status")
+		_ = tx.Rollback()
+		return err
+	}
+
+	if updated == 0 {
+		return tx.Rollback()
+	}
+
+	diagnosticCreations := make([]*ent.DiagnosticCreate, 0, len(msg.Diagnostics))
+	for _, d := range msg.Diagnostics {
+		if err := diagnostic.SeverityValidator(d.Severity); err != nil {
+			logrus.WithError(err).WithField("id", id).Warn("Ignoring submission diagnostic")
+			continue
+		}
+
+		diagnosticCreation := tx.Diagnostic.Create().
+			SetSubmissionID(id).
+			SetSeverity(d.Severity).
+			SetMessage(d.Message)
+
+		if d.File != "" {
+			diagnosticCreation.SetFile(d.File)
+		}
+		if d.Line > 0 {
+			diagnosticCreation.SetLine(d.Line)
+		}
+		if d.Column > 0 {
+			diagnosticCreation.SetColumn(d.Column)
+		}
+		if d.Code != "" {
+			diagnosticCreation.SetCode(d.Code)
+		}
+
+		diagnosticCreations = append(diagnosticCreations, diagnosticCreation)
+	}
+
+	if len(diagnosticCreations) > 0 {
+		err = tx.Diagnostic.CreateBulk(diagnosticCreations...).Exec(context.Background())
+		if err != nil {
+			logrus.WithError(err).Error("Failed to save submission diagnostics")
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		logrus.WithError(err).Error("Failed to update submission status")
 		return err
 	}
 
+	if msg.Status == submission.StatusDone {
+		s.encryptSubmissionResults(sub)
+	}
+
+	if msg.Status == submission.StatusDone || msg.Status == submission.StatusFailed {
+		err = s.storageService.ReleaseSubmissionWorkingCopy(id.String())
+		if err != nil {
+			logrus.WithError(err).WithField("id", id).Error("Failed to delete submission working copy from S3")
+		}
+	}
+
 	return nil
 }
+
+// Encrypt the results the transpiler wrote in plaintext. A failure leaves
+// them readable as they are, decryption is only applied to encrypted objects
+func (s *SubmissionService) encryptSubmissionResults(sub *ent.Submission) {
+	owner, err := sub.QueryUser().OnlyID(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("id", sub.ID).Error("Failed to get submission owner")
+		return
+	}
+
+	encrypted, err := s.storageService.EncryptSubmissionResults(owner, sub.ID.String())
+	if err != nil {
+		logrus.WithError(err).WithField("id", sub.ID).Error("Failed to encrypt submission results")
+		return
+	}
+
+	if !encrypted {
+		return
+	}
+
+	err = s.databaseService.Submission.UpdateOne(sub).
+		SetResultsEncrypted(true).
+		Exec(context.Background())
+	if err != nil {
+		logrus.WithError(err).WithField("id", sub.ID).Error("Failed to mark submission results as encrypted")
+	}
+}
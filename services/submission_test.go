@@ -0,0 +1,102 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSubmissionLanes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []SubmissionLane
+		wantErr bool
+	}{
+		{
+			name:  "default weight",
+			value: "standard",
+			want:  []SubmissionLane{{Name: "standard", Weight: 1}},
+		},
+		{
+			name:  "weighted lanes",
+			value: "priority:3,standard:1",
+			want:  []SubmissionLane{{Name: "priority", Weight: 3}, {Name: "standard", Weight: 1}},
+		},
+		{
+			name:  "spaces and empty entries",
+			value: " priority : 2 ,, standard ",
+			want:  []SubmissionLane{{Name: "priority", Weight: 2}, {Name: "standard", Weight: 1}},
+		},
+		{name: "empty", value: "", wantErr: true},
+		{name: "only separators", value: " , ,", wantErr: true},
+		{name: "missing name", value: ":2", wantErr: true},
+		{name: "zero weight", value: "priority:0", wantErr: true},
+		{name: "negative weight", value: "priority:-1", wantErr: true},
+		{name: "non numeric weight", value: "priority:high", wantErr: true},
+		{name: "empty weight", value: "priority:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSubmissionLanes(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSubmissionLanes(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseSubmissionLanes(%q) failed: %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSubmissionLanes(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTranspilerVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: map[string]string{}},
+		{
+			name:  "several pairs",
+			value: "c/go=1.2.0,lua/ruby=0.3.1",
+			want:  map[string]string{"c/go": "1.2.0", "lua/ruby": "0.3.1"},
+		},
+		{
+			name:  "case and spaces",
+			value: " C/Go = 1.2.0 ,",
+			want:  map[string]string{"c/go": "1.2.0"},
+		},
+		{name: "missing version", value: "c/go=", wantErr: true},
+		{name: "missing equal sign", value: "c/go", wantErr: true},
+		{name: "missing target", value: "c=1.2.0", wantErr: true},
+		{name: "empty source", value: "/go=1.2.0", wantErr: true},
+		{name: "empty target", value: "c/=1.2.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTranspilerVersions(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseTranspilerVersions(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseTranspilerVersions(%q) failed: %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTranspilerVersions(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/stripe/stripe-go/v72/customer"
 	"github.com/stripe/stripe-go/v72/sub"
 	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/predicate"
 	"github.com/tereus-project/tereus-api/ent/subscription"
 	"github.com/tereus-project/tereus-api/ent/user"
 	"github.com/tereus-project/tereus-api/services/internal"
@@ -27,6 +30,7 @@ type TierPrices struct {
 
 type SubscriptionService struct {
 	databaseService *DatabaseService
+	auditService    *AuditService
 	stripeService   *internal.StripeService
 
 	stripeTierPriceIds map[string]TierPrices
@@ -37,12 +41,14 @@ func NewSubscriptionService(
 	stripeProTierPrices TierPrices,
 	stripeEnterpriseTierPrices TierPrices,
 	databaseService *DatabaseService,
+	auditService *AuditService,
 ) *SubscriptionService {
 	logrus.Debugln("Initializing stripe service")
 	stripeService := internal.NewStripeService(stripeSecretKey)
 
 	return &SubscriptionService{
 		databaseService: databaseService,
+		auditService:    auditService,
 		stripeService:   stripeService,
 
 		stripeTierPriceIds: map[string]TierPrices{
@@ -84,19 +90,47 @@ func (s *SubscriptionService) ConstructWebhookEvent(w http.ResponseWriter, req *
 	return s.stripeService.ConstructWebhookEvent(w, req, endpointSecret)
 }
 
+// Select the subscriptions giving their tier to their user at now, the manual
+// ones and the ones paid through Stripe
+func activeSubscription(now time.Time) predicate.Subscription {
+	return subscription.And(
+		subscription.ExpiresAtGTE(now),
+		subscription.Or(
+			subscription.Manual(true),
+			subscription.And(
+				subscription.StripeSubscriptionIDNotNil(),
+				subscription.StripeCustomerIDNotNil(),
+			),
+		),
+	)
+}
+
 func (s *SubscriptionService) GetCurrentUserSubscription(userID uuid.UUID) (*ent.Subscription, error) {
 	return s.databaseService.Subscription.Query().
 		Where(
 			subscription.HasUserWith(
 				user.ID(userID),
 			),
-			subscription.ExpiresAtGTE(time.Now()),
-			subscription.StripeSubscriptionIDNotNil(),
-			subscription.StripeCustomerIDNotNil(),
+			activeSubscription(time.Now()),
 		).
 		Only(context.Background())
 }
 
+// GetUserTier returns the tier of the user's active subscription, or the free
+// tier if the user has none
+func (s *SubscriptionService) GetUserTier(userID uuid.UUID) (subscription.Tier, error) {
+	userSubscription, err := s.GetCurrentUserSubscription(userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return subscription.TierFree, nil
+		}
+
+		return "", err
+	}
+
+	return userSubscription.Tier, nil
+}
+
 func (s *SubscriptionService) GetLastUserSubscription(userID uuid.UUID) (*ent.Subscription, error) {
 	return s.databaseService.Subscription.Query().
 		Where(
@@ -286,7 +320,7 @@ func (s *SubscriptionService) UpdateStripeSubscription(stripeSubscriptionId stri
 	})
 }
 
-func (s *SubscriptionService) UpdateSubscription(stripeSubscription *stripe.Subscription) error {
+func (s *SubscriptionService) UpdateSubscription(stripeSubscription *stripe.Subscription, actor AuditActor) error {
 	stripeCustomer, err := customer.Get(stripeSubscription.Customer.ID, nil)
 	if err != nil {
 		return err
@@ -302,12 +336,16 @@ func (s *SubscriptionService) UpdateSubscription(stripeSubscription *stripe.Subs
 		return err
 	}
 
+	tier := subscription.Tier(s.GetTierFromPriceId(stripeSubscription.Items.Data[0].Price.ID))
+	expiresAt := time.Unix(stripeSubscription.CurrentPeriodEnd, 0)
+
 	_, err = s.databaseService.Subscription.Create().
 		SetStripeCustomerID(stripeCustomer.ID).
 		SetStripeSubscriptionID(stripeSubscription.ID).
-		SetTier(subscription.Tier(s.GetTierFromPriceId(stripeSubscription.Items.Data[0].Price.ID))).
-		SetExpiresAt(time.Unix(stripeSubscription.CurrentPeriodEnd, 0)).
+		SetTier(tier).
+		SetExpiresAt(expiresAt).
 		SetCancelled(stripeSubscription.CancelAtPeriodEnd).
+		SetManual(false).
 		SetUser(subscribingUser).
 		OnConflict(
 			sql.ConflictColumns("user_subscription"),
@@ -318,6 +356,12 @@ func (s *SubscriptionService) UpdateSubscription(stripeSubscription *stripe.Subs
 		return err
 	}
 
+	s.auditService.Record(subscribingUser.ID, auditevent.TypeSubscriptionUpdated, actor, map[string]interface{}{
+		"tier":       tier.String(),
+		"expires_at": expiresAt.UTC().Format(time.RFC3339),
+		"cancelled":  stripeSubscription.CancelAtPeriodEnd,
+	})
+
 	return nil
 }
 
@@ -334,8 +378,8 @@ func (s *SubscriptionService) CancelStripeSubscription(stripeSubscriptionId stri
 	return err
 }
 
-func (s *SubscriptionService) CancelUserSubscription(userId uuid.UUID, expiresAt time.Time) error {
-	_, err := s.databaseService.Subscription.Update().
+func (s *SubscriptionService) CancelUserSubscription(userId uuid.UUID, expiresAt time.Time, actor AuditActor) error {
+	cancelled, err := s.databaseService.Subscription.Update().
 		SetExpiresAt(expiresAt).
 		SetCancelled(true).
 		Where(
@@ -344,11 +388,20 @@ func (s *SubscriptionService) CancelUserSubscription(userId uuid.UUID, expiresAt
 			),
 		).
 		Save(context.Background())
+	if err != nil {
+		return err
+	}
 
-	return err
+	if cancelled > 0 {
+		s.auditService.Record(userId, auditevent.TypeSubscriptionCancelled, actor, map[string]interface{}{
+			"expires_at": expiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return nil
 }
 
-func (s *SubscriptionService) CancelSubscriptionFromStripeCustomerId(stripeCustomerId string, expiresAt time.Time) error {
+func (s *SubscriptionService) CancelSubscriptionFromStripeCustomerId(stripeCustomerId string, expiresAt time.Time, actor AuditActor) error {
 	subscribingUser, err := s.databaseService.User.Query().
 		Where(
 			user.HasSubscriptionWith(
@@ -364,11 +417,15 @@ func (s *SubscriptionService) CancelSubscriptionFromStripeCustomerId(stripeCusto
 		return err
 	}
 
-	return s.CancelUserSubscription(subscribingUser.ID, expiresAt)
+	return s.CancelUserSubscription(subscribingUser.ID, expiresAt, actor)
 }
 
+// IsActive reports whether a subscription gives its tier to its user, the same
+// way as GetCurrentUserSubscription
 func (s *SubscriptionService) IsActive(subscription *ent.Subscription) bool {
-	return subscription != nil && subscription.StripeSubscriptionID != "" && subscription.ExpiresAt.After(time.Now())
+	return subscription != nil &&
+		(subscription.Manual || (subscription.StripeSubscriptionID != "" && subscription.StripeCustomerID != "")) &&
+		subscription.ExpiresAt.After(time.Now())
 }
 
 func (s *SubscriptionService) GetActiveSubscriptions(offset int, limit int) ([]*ent.Subscription, error) {
@@ -384,8 +441,20 @@ func (s *SubscriptionService) GetActiveSubscriptions(offset int, limit int) ([]*
 		All(context.Background())
 }
 
-func (s *SubscriptionService) RemoveSubscriptionsFromStripeCustomerId(stripeCustomerId string) error {
-	return s.databaseService.Subscription.Update().
+func (s *SubscriptionService) RemoveSubscriptionsFromStripeCustomerId(stripeCustomerId string, actor AuditActor) error {
+	// The customer ID is cleared by the update, the users are looked up first
+	userIDs, err := s.databaseService.User.Query().
+		Where(
+			user.HasSubscriptionWith(
+				subscription.StripeCustomerID(stripeCustomerId),
+			),
+		).
+		IDs(context.Background())
+	if err != nil {
+		return err
+	}
+
+	err = s.databaseService.Subscription.Update().
 		Where(
 			subscription.StripeCustomerID(stripeCustomerId),
 		).
@@ -394,4 +463,81 @@ func (s *SubscriptionService) RemoveSubscriptionsFromStripeCustomerId(stripeCust
 		ClearExpiresAt().
 		SetTier(subscription.TierFree).
 		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		s.auditService.Record(userID, auditevent.TypeSubscriptionDeleted, actor, nil)
+	}
+
+	return nil
+}
+
+var ErrStripeSubscriptionActive = errors.New("the user has an active Stripe subscription")
+
+// SetManualTier gives a tier to a user until expiresAt without going through
+// Stripe, the free tier ends the manual subscription. The tier of the users
+// paying through Stripe is left to Stripe
+func (s *SubscriptionService) SetManualTier(userID uuid.UUID, tier subscription.Tier, expiresAt time.Time, actor AuditActor) (*ent.Subscription, error) {
+	lastUserSubscription, err := s.GetLastUserSubscription(userID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if lastUserSubscription == nil {
+		if tier == subscription.TierFree {
+			return nil, nil
+		}
+
+		created, err := s.databaseService.Subscription.Create().
+			SetUserID(userID).
+			SetTier(tier).
+			SetExpiresAt(expiresAt).
+			SetManual(true).
+			Save(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		s.recordManualTier(userID, created, actor)
+
+		return created, nil
+	}
+
+	if !lastUserSubscription.Manual && s.IsActive(lastUserSubscription) {
+		return nil, ErrStripeSubscriptionActive
+	}
+
+	update := s.databaseService.Subscription.UpdateOne(lastUserSubscription).
+		SetTier(tier).
+		SetCancelled(false).
+		ClearStripeSubscriptionID()
+
+	if tier == subscription.TierFree {
+		update = update.SetManual(false).ClearExpiresAt()
+	} else {
+		update = update.SetManual(true).SetExpiresAt(expiresAt)
+	}
+
+	updated, err := update.Save(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordManualTier(userID, updated, actor)
+
+	return updated, nil
+}
+
+func (s *SubscriptionService) recordManualTier(userID uuid.UUID, manualSubscription *ent.Subscription, actor AuditActor) {
+	metadata := map[string]interface{}{
+		"tier":   manualSubscription.Tier.String(),
+		"manual": manualSubscription.Manual,
+	}
+	if manualSubscription.Manual {
+		metadata["expires_at"] = manualSubscription.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	s.auditService.Record(userID, auditevent.TypeSubscriptionUpdated, actor, metadata)
 }
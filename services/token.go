@@ -5,24 +5,30 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/tereus-project/tereus-api/ent"
+	"github.com/tereus-project/tereus-api/ent/auditevent"
+	"github.com/tereus-project/tereus-api/ent/predicate"
 	"github.com/tereus-project/tereus-api/ent/token"
+	"github.com/tereus-project/tereus-api/ent/user"
 )
 
 type TokenService struct {
 	databaseService *DatabaseService
+	auditService    *AuditService
 }
 
-func NewTokenService(databaseService *DatabaseService) *TokenService {
+func NewTokenService(databaseService *DatabaseService, auditService *AuditService) *TokenService {
 	return &TokenService{
 		databaseService: databaseService,
+		auditService:    auditService,
 	}
 }
 
-func (s *TokenService) GenerateToken(userId uuid.UUID) (uuid.UUID, error) {
+func (s *TokenService) GenerateToken(userId uuid.UUID, actor AuditActor) (uuid.UUID, error) {
 	token, err := s.databaseService.Token.Create().
 		SetUserID(userId).
 		Save(context.Background())
@@ -31,24 +37,67 @@ func (s *TokenService) GenerateToken(userId uuid.UUID) (uuid.UUID, error) {
 		return uuid.Nil, err
 	}
 
+	s.auditService.Record(userId, auditevent.TypeTokenCreated, actor, nil)
+
 	return token.ID, nil
 }
 
-func (s *TokenService) ValidateToken(tokenId uuid.UUID) (bool, error) {
-	return s.databaseService.Token.Query().
+// Lifetime of the tokens given to the admins impersonating a user
+const impersonationTokenDuration = time.Hour
+
+// GenerateImpersonationToken creates a read-only token of a user for an admin
+func (s *TokenService) GenerateImpersonationToken(userId uuid.UUID, adminId uuid.UUID, actor AuditActor) (*ent.Token, error) {
+	impersonationToken, err := s.databaseService.Token.Create().
+		SetUserID(userId).
+		SetImpersonatorID(adminId).
+		SetReadOnly(true).
+		SetExpiresAt(time.Now().Add(impersonationTokenDuration)).
+		Save(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(userId, auditevent.TypeTokenCreated, actor, map[string]interface{}{
+		"read_only":  true,
+		"expires_at": impersonationToken.ExpiresAt.Format(time.RFC3339),
+	})
+
+	return impersonationToken, nil
+}
+
+// RevokeUserTokens deactivates all the tokens of a user and returns how many
+// were still active
+func (s *TokenService) RevokeUserTokens(userId uuid.UUID) (int, error) {
+	return s.databaseService.Token.Update().
 		Where(
-			token.ID(tokenId),
+			token.HasUserWith(user.ID(userId)),
 			token.IsActive(true),
 		).
+		SetIsActive(false).
+		Save(context.Background())
+}
+
+// The tokens that are active and not expired
+func usableToken(tokenId uuid.UUID) predicate.Token {
+	return token.And(
+		token.ID(tokenId),
+		token.IsActive(true),
+		token.Or(
+			token.ExpiresAtIsNil(),
+			token.ExpiresAtGT(time.Now()),
+		),
+	)
+}
+
+func (s *TokenService) ValidateToken(tokenId uuid.UUID) (bool, error) {
+	return s.databaseService.Token.Query().
+		Where(usableToken(tokenId)).
 		Exist(context.Background())
 }
 
 func (s *TokenService) GetUser(tokenId uuid.UUID) (*ent.User, error) {
 	return s.databaseService.Token.Query().
-		Where(
-			token.ID(tokenId),
-			token.IsActive(true),
-		).
+		Where(usableToken(tokenId)).
 		QueryUser().
 		Only(context.Background())
 }
@@ -71,19 +120,42 @@ func (s *TokenService) GetTokenFromContext(c echo.Context) (uuid.UUID, error) {
 	return token, nil
 }
 
-// Return a *echo.HTTPError if failing
-func (s *TokenService) GetUserFromContext(c echo.Context) (*ent.User, error) {
-	token, err := s.GetTokenFromContext(c)
+// Get the token of the request with its user, return a *echo.HTTPError if failing
+func (s *TokenService) getUsableTokenFromContext(c echo.Context) (*ent.Token, error) {
+	tokenId, err := s.GetTokenFromContext(c)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
 	}
 
-	user, err := s.GetUser(token)
+	usable, err := s.databaseService.Token.Query().
+		Where(usableToken(tokenId)).
+		WithUser().
+		Only(context.Background())
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Expired or invalid token")
 	}
 
-	return user, nil
+	return usable, nil
+}
+
+// Return a *echo.HTTPError if failing
+func (s *TokenService) GetUserFromContext(c echo.Context) (*ent.User, error) {
+	usable, err := s.getUsableTokenFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The impersonation tokens let admins see what a user sees without
+	// acting on their behalf
+	if usable.ReadOnly {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			return nil, echo.NewHTTPError(http.StatusForbidden, "This token is read-only")
+		}
+	}
+
+	return usable.Edges.User, nil
 }
 
 // Return a *echo.HTTPError if failing
@@ -100,3 +172,17 @@ func (s *TokenService) VaidateTokenFromContext(c echo.Context) (bool, error) {
 
 	return valid, nil
 }
+
+// Return a *echo.HTTPError if failing or if the user is not an admin
+func (s *TokenService) GetAdminFromContext(c echo.Context) (*ent.User, error) {
+	usable, err := s.getUsableTokenFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if usable.ImpersonatorID != nil || usable.Edges.User.Role != user.RoleAdmin {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "Admin access required")
+	}
+
+	return usable.Edges.User, nil
+}
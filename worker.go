@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/workers"
+)
+
+type worker struct {
+	name string
+	run  func(ctx context.Context, app *application)
+}
+
+// The workers a process can run, they all return once ctx is done
+var availableWorkers = []worker{
+	{"status-consumer", func(ctx context.Context, app *application) {
+		err := workers.StatusConsumerWorker(ctx, app.submissionService, app.queueService)
+		if err != nil {
+			logrus.WithError(err).Fatalln("Failed to start submission status consumer worker")
+		}
+	}},
+	{"usage", func(ctx context.Context, app *application) {
+		workers.SubscriptionDataUsageReportingWorker(ctx, app.leaderElectionService, app.subscriptionService, app.databaseService)
+	}},
+	{"retention", func(ctx context.Context, app *application) {
+		workers.RetentionWorker(ctx, app.leaderElectionService, app.databaseService, app.storageService, app.retentionService)
+	}},
+	{"deletion", func(ctx context.Context, app *application) {
+		workers.DeletionWorker(ctx, app.leaderElectionService, app.storageService, app.config.StorageDeletionVerifyDelay)
+	}},
+	{"reconciliation", func(ctx context.Context, app *application) {
+		workers.ReconciliationWorker(ctx, app.leaderElectionService, app.reconciliationService, app.config.StorageReconciliationInterval, app.config.StorageReconciliationRepair)
+	}},
+	{"upload-expiration", func(ctx context.Context, app *application) {
+		workers.UploadExpirationWorker(ctx, app.leaderElectionService, app.databaseService, app.storageService)
+	}},
+}
+
+func getWorkerNames() []string {
+	names := make([]string, len(availableWorkers))
+	for i, w := range availableWorkers {
+		names[i] = w.name
+	}
+
+	return names
+}
+
+// Parse a comma separated list of worker names, all the workers are
+// selected if the list is empty
+func parseWorkers(value string) ([]worker, error) {
+	if strings.TrimSpace(value) == "" {
+		return availableWorkers, nil
+	}
+
+	selected := []worker{}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+
+		found := false
+		for _, w := range availableWorkers {
+			if w.name == name {
+				selected = append(selected, w)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown worker %q, expected one of %s", name, strings.Join(getWorkerNames(), ", "))
+		}
+	}
+
+	return selected, nil
+}
+
+// Start the workers in the background, the returned wait group is done once
+// they all returned
+func startWorkers(ctx context.Context, app *application, selected []worker) *sync.WaitGroup {
+	wg := &sync.WaitGroup{}
+
+	for _, w := range selected {
+		logrus.WithField("worker", w.name).Debugln("Starting worker")
+
+		wg.Add(1)
+		go func(w worker) {
+			defer wg.Done()
+			w.run(ctx, app)
+			logrus.WithField("worker", w.name).Debugln("Stopped worker")
+		}(w)
+	}
+
+	return wg
+}
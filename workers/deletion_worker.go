@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// Maximum number of pending deletions handled per step and iteration so that
+// a large cleanup is spread over several iterations
+const deletionWorkerBatchSize = 100
+
+// DeletionWorker schedules the queued object deletions with the storage
+// backend and verifies their removal later on
+func DeletionWorker(ctx context.Context, leaderElectionService *services.LeaderElectionService, storageService *services.StorageService, verifyDelay time.Duration) {
+	leadership := leaderElectionService.Campaign("deletion-worker")
+	defer leadership.Release()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !leadership.IsLeader() {
+			continue
+		}
+
+		leaderCtx, cancel := leadership.Context(ctx)
+
+		scheduled, err := storageService.SchedulePendingDeletions(deletionWorkerBatchSize, verifyDelay)
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to schedule pending deletions")
+		}
+
+		// Left to the next leader if the lease was lost in the meantime
+		var completed int
+		if leaderCtx.Err() == nil {
+			completed, err = storageService.VerifyScheduledDeletions(deletionWorkerBatchSize)
+			if err != nil {
+				logrus.WithError(err).Errorln("Failed to verify scheduled deletions")
+			}
+		}
+
+		cancel()
+
+		if scheduled > 0 || completed > 0 {
+			logrus.WithFields(logrus.Fields{
+				"scheduled": scheduled,
+				"completed": completed,
+			}).Infoln("Processed pending deletions")
+		}
+	}
+}
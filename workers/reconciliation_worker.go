@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// ReconciliationWorker checks periodically that the object storage matches
+// the database and repairs the inconsistencies if repair is set
+func ReconciliationWorker(ctx context.Context, leaderElectionService *services.LeaderElectionService, reconciliationService *services.ReconciliationService, interval time.Duration, repair bool) {
+	leadership := leaderElectionService.Campaign("reconciliation-worker")
+	defer leadership.Release()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !leadership.IsLeader() {
+			continue
+		}
+
+		logrus.Info("New reconciliation worker iteration")
+
+		// Stopped if the lease is lost, another process may take over
+		leaderCtx, cancel := leadership.Context(ctx)
+		report, err := reconciliationService.ReconcileStorage(leaderCtx, !repair)
+		cancel()
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to reconcile storage")
+			continue
+		}
+
+		fields := logrus.Fields{
+			"orphan_prefixes": len(report.OrphanPrefixes),
+			"missing_results": len(report.MissingResults),
+			"size_mismatches": len(report.SizeMismatches),
+			"repaired":        report.Repaired,
+		}
+
+		if len(report.OrphanPrefixes) > 0 || len(report.MissingResults) > 0 || len(report.SizeMismatches) > 0 {
+			logrus.WithFields(fields).Warnln("Found storage inconsistencies")
+		} else {
+			logrus.WithFields(fields).Infoln("Storage is consistent")
+		}
+	}
+}
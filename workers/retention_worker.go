@@ -6,27 +6,47 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/tereus-project/tereus-api/ent/submission"
-	"github.com/tereus-project/tereus-api/ent/subscription"
-	"github.com/tereus-project/tereus-api/ent/user"
 	"github.com/tereus-project/tereus-api/services"
 )
 
-func RetentionWorker(databaseService *services.DatabaseService, storageService *services.StorageService) {
+func RetentionWorker(ctx context.Context, leaderElectionService *services.LeaderElectionService, databaseService *services.DatabaseService, storageService *services.StorageService, retentionService *services.RetentionService) {
+	leadership := leaderElectionService.Campaign("retention-worker")
+	defer leadership.Release()
+
 	ticker := time.NewTicker(time.Hour)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Only the process holding the lease runs the iteration
+		if !leadership.IsLeader() {
+			continue
+		}
+
 		logrus.Info("New retention worker iteration")
 
-		// Query all submissions where user is in free tier and updated date is > 1 day
-		submissions, err := databaseService.Submission.Query().
-			Where(submission.CreatedAtLT(time.Now().AddDate(0, 0, -1))).
-			Where(submission.Or(
-				submission.HasUserWith(user.HasSubscriptionWith(subscription.TierEQ(subscription.TierFree))),
-				submission.Not(submission.HasUserWith(user.HasSubscription())),
-			)).
-			Where(submission.StatusEQ(submission.StatusDone)).
-			All(context.Background())
+		// Stopped between the submissions if the lease is lost, another
+		// process may take over
+		leaderCtx, cancel := leadership.Context(ctx)
+
+		// Warn the owners before any submission is deleted
+		notified, err := retentionService.WarnExpiringSubmissions()
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to warn users of expiring submissions")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"count": notified,
+		}).Infoln("Warned users of expiring submissions")
+
+		// Query all submissions expired by their owner or by the retention
+		// policy of their tier
+		submissions, err := retentionService.GetExpiredSubmissions()
 		if err != nil {
 			logrus.WithError(err).Errorln("Failed to get submissions")
 		}
@@ -36,6 +56,18 @@ func RetentionWorker(databaseService *services.DatabaseService, storageService *
 		}).Infoln("Found submissions to delete")
 
 		for _, sub := range submissions {
+			if leaderCtx.Err() != nil {
+				break
+			}
+
+			// Queued first, the deletion worker marks the submission as
+			// cleaned if the update below does not happen
+			err = storageService.DeleteSubmission(sub.ID.String())
+			if err != nil {
+				logrus.WithField("submission_id", sub.ID).WithError(err).Errorln("Failed to queue submission deletion")
+				continue
+			}
+
 			err = databaseService.Submission.
 				UpdateOneID(sub.ID).
 				SetStatus(submission.StatusCleaned).
@@ -43,12 +75,23 @@ func RetentionWorker(databaseService *services.DatabaseService, storageService *
 			if err != nil {
 				logrus.WithError(err).Error("Failed to update submission")
 			}
+		}
 
-			// Delete submission from S3
-			err = storageService.DeleteSubmission(sub.ID.String())
-			if err != nil {
-				logrus.WithField("submission_id", sub.ID).WithError(err).Errorln("Failed to delete submission from S3")
-			}
+		if leaderCtx.Err() != nil {
+			cancel()
+			logrus.Warnln("Stopped retention worker iteration")
+			continue
 		}
+
+		collected, err := storageService.CollectOrphanBlobs()
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to collect orphan blobs")
+		}
+
+		cancel()
+
+		logrus.WithFields(logrus.Fields{
+			"count": collected,
+		}).Infoln("Collected orphan blobs")
 	}
 }
@@ -1,21 +1,41 @@
 package workers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"sync"
 
 	"github.com/nsqio/go-nsq"
 	"github.com/sirupsen/logrus"
 	"github.com/tereus-project/tereus-api/services"
-	"github.com/tereus-project/tereus-go-std/queue"
 )
 
 type SubsmissionStatusHandler struct {
 	submissionService *services.SubmissionService
+
+	// Set once the worker is stopping, the messages received afterwards are
+	// requeued for another consumer
+	mu       sync.RWMutex
+	stopping bool
+	// Messages being handled
+	inFlight sync.WaitGroup
 }
 
+var errConsumerStopping = errors.New("submission status consumer is stopping")
+
 // HandleMessage implements the Handler interface.
 // Returning a non-nil error will automatically send a REQ command to NSQ to re-queue the message.
 func (h *SubsmissionStatusHandler) HandleMessage(m *nsq.Message) error {
+	h.mu.RLock()
+	if h.stopping {
+		h.mu.RUnlock()
+		return errConsumerStopping
+	}
+	h.inFlight.Add(1)
+	h.mu.RUnlock()
+	defer h.inFlight.Done()
+
 	logrus.WithField("nsq_msg_id", m.ID).Info("Received submission status message")
 
 	var msg services.SubmissionStatusMessage
@@ -28,14 +48,30 @@ func (h *SubsmissionStatusHandler) HandleMessage(m *nsq.Message) error {
 	return h.submissionService.HandleSubmissionStatus(msg)
 }
 
-func RegisterStatusConsumerWorker(submissionService *services.SubmissionService, queueService *queue.QueueService) error {
+// StatusConsumerWorker consumes the submission statuses until ctx is done,
+// then waits for the messages being handled
+func StatusConsumerWorker(ctx context.Context, submissionService *services.SubmissionService, queueService *services.QueueService) error {
 	logrus.Info("Starting submission status consumer worker")
 
 	h := &SubsmissionStatusHandler{
 		submissionService: submissionService,
 	}
 
-	err := queueService.AddHandler("transpilation_submission_status", "api", h.HandleMessage)
+	consumer, err := queueService.Consume("transpilation_submission_status", "api", h.HandleMessage)
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	h.mu.Lock()
+	h.stopping = true
+	h.mu.Unlock()
+
+	// Stopped here rather than when the queue service is closed, after the
+	// workers returned
+	consumer.Stop()
+	h.inFlight.Wait()
 
-	return err
+	return nil
 }
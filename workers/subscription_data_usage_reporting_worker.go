@@ -16,15 +16,32 @@ import (
 
 const mb = 1024 * 1024
 
-func SubscriptionDataUsageReportingWorker(subscriptionService *services.SubscriptionService, databaseService *services.DatabaseService) {
+func SubscriptionDataUsageReportingWorker(ctx context.Context, leaderElectionService *services.LeaderElectionService, subscriptionService *services.SubscriptionService, databaseService *services.DatabaseService) {
 	config := env.Get()
 	subscriptionsBatchSize := 100
 
+	leadership := leaderElectionService.Campaign("subscription-data-usage-reporting-worker")
+	defer leadership.Release()
+
 	ticker := time.NewTicker(time.Hour)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		for page := 0; ; page++ {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !leadership.IsLeader() {
+			continue
+		}
+
+		// Stopped between the subscriptions if the lease is lost, another
+		// process may take over
+		leaderCtx, cancel := leadership.Context(ctx)
+
+		for page := 0; leaderCtx.Err() == nil; page++ {
 			subscriptions, err := subscriptionService.GetActiveSubscriptions(page*subscriptionsBatchSize, (page+1)*subscriptionsBatchSize)
 			if err != nil {
 				logrus.WithError(err).Errorln("Failed to get active subscriptions")
@@ -36,6 +53,10 @@ func SubscriptionDataUsageReportingWorker(subscriptionService *services.Subscrip
 			}
 
 			for _, subscription := range subscriptions {
+				if leaderCtx.Err() != nil {
+					break
+				}
+
 				userId, err := subscription.QueryUser().OnlyID(context.Background())
 				if err != nil {
 					logrus.WithError(err).Errorf("Failed to get user ID for subscription %s", subscription.ID)
@@ -103,5 +124,7 @@ func SubscriptionDataUsageReportingWorker(subscriptionService *services.Subscrip
 				}
 			}
 		}
+
+		cancel()
 	}
 }
@@ -0,0 +1,109 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/tereus-project/tereus-api/ent/resumableupload"
+	"github.com/tereus-project/tereus-api/ent/uploadsession"
+	"github.com/tereus-project/tereus-api/services"
+)
+
+// UploadExpirationWorker removes the upload sessions and resumable uploads
+// that expired before being handed over to the submission pipeline
+func UploadExpirationWorker(ctx context.Context, leaderElectionService *services.LeaderElectionService, databaseService *services.DatabaseService, storageService *services.StorageService) {
+	leadership := leaderElectionService.Campaign("upload-expiration-worker")
+	defer leadership.Release()
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !leadership.IsLeader() {
+			continue
+		}
+
+		logrus.Info("New upload expiration worker iteration")
+
+		// Stopped between the uploads if the lease is lost, another process
+		// may take over
+		leaderCtx, cancel := leadership.Context(ctx)
+
+		uploads, err := databaseService.ResumableUpload.Query().
+			Where(resumableupload.ExpiresAtLT(time.Now())).
+			All(context.Background())
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to get expired resumable uploads")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"count": len(uploads),
+		}).Infoln("Found expired resumable uploads to delete")
+
+		for _, upload := range uploads {
+			if leaderCtx.Err() != nil {
+				break
+			}
+
+			err = storageService.DeleteResumableUpload(upload.ID.String())
+			if err != nil {
+				logrus.WithField("upload_id", upload.ID).WithError(err).Errorln("Failed to delete resumable upload from S3")
+				continue
+			}
+
+			err = databaseService.ResumableUpload.DeleteOneID(upload.ID).Exec(context.Background())
+			if err != nil {
+				logrus.WithField("upload_id", upload.ID).WithError(err).Errorln("Failed to delete resumable upload")
+			}
+		}
+
+		sessions, err := databaseService.UploadSession.Query().
+			Where(uploadsession.ExpiresAtLT(time.Now())).
+			All(context.Background())
+		if err != nil {
+			logrus.WithError(err).Errorln("Failed to get expired upload sessions")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"count": len(sessions),
+		}).Infoln("Found expired upload sessions to delete")
+
+		for _, session := range sessions {
+			if leaderCtx.Err() != nil {
+				break
+			}
+
+			for _, file := range session.Files {
+				if file.UploadID == "" {
+					continue
+				}
+
+				err = storageService.AbortSubmissionObjectMultipartUpload(session.ID.String(), file.Path, file.UploadID)
+				if err != nil {
+					logrus.WithField("upload_session_id", session.ID).WithError(err).Warnln("Failed to abort multipart upload")
+				}
+			}
+
+			// The files uploaded so far are stored as the sources of the future submission
+			err = storageService.DeleteSubmission(session.ID.String())
+			if err != nil {
+				logrus.WithField("upload_session_id", session.ID).WithError(err).Errorln("Failed to delete upload session from S3")
+				continue
+			}
+
+			err = databaseService.UploadSession.DeleteOneID(session.ID).Exec(context.Background())
+			if err != nil {
+				logrus.WithField("upload_session_id", session.ID).WithError(err).Errorln("Failed to delete upload session")
+			}
+		}
+
+		cancel()
+	}
+}